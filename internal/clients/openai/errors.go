@@ -0,0 +1,39 @@
+package openai
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"github.com/pkg/errors"
+	openaigo "github.com/sashabaranov/go-openai"
+)
+
+// IsTransientError reports whether a failed call to OpenAI is worth making again,
+// because it was rate limited, timed out, or failed on the OpenAI side.
+func IsTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var apiErr *openaigo.APIError
+	if errors.As(err, &apiErr) {
+		return isTransientStatusCode(apiErr.StatusCode)
+	}
+
+	var reqErr *openaigo.RequestError
+	if errors.As(err, &reqErr) {
+		return isTransientStatusCode(reqErr.StatusCode)
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+func isTransientStatusCode(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusRequestTimeout || statusCode >= http.StatusInternalServerError
+}
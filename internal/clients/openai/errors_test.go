@@ -0,0 +1,67 @@
+package openai
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/pkg/errors"
+	openaigo "github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IsTransientError(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          error
+		expectedOutput bool
+	}{
+		{
+			name:           "nil is not transient",
+			input:          nil,
+			expectedOutput: false,
+		},
+		{
+			name:           "rate limit is transient",
+			input:          errors.Wrap(fmt.Errorf("error, status code: 429, message: %w", &openaigo.APIError{StatusCode: 429}), "Open Ai error"),
+			expectedOutput: true,
+		},
+		{
+			name:           "server error is transient",
+			input:          errors.Wrap(fmt.Errorf("error, %w", &openaigo.RequestError{StatusCode: 502}), "Open Ai error"),
+			expectedOutput: true,
+		},
+		{
+			name:           "bad request is not transient",
+			input:          errors.Wrap(fmt.Errorf("error, status code: 400, message: %w", &openaigo.APIError{StatusCode: 400}), "Open Ai error"),
+			expectedOutput: false,
+		},
+		{
+			name:           "unauthorized is not transient",
+			input:          errors.Wrap(fmt.Errorf("error, %w", &openaigo.RequestError{StatusCode: 401}), "Open Ai error"),
+			expectedOutput: false,
+		},
+		{
+			name:           "deadline exceeded is transient",
+			input:          errors.Wrap(context.DeadlineExceeded, "Open Ai error"),
+			expectedOutput: true,
+		},
+		{
+			name:           "network error is transient",
+			input:          errors.Wrap(&net.OpError{Op: "dial", Err: errors.New("connection refused")}, "Open Ai error"),
+			expectedOutput: true,
+		},
+		{
+			name:           "any other error is not transient",
+			input:          errors.New("no messages provided to be sent to OpenAI"),
+			expectedOutput: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedOutput, IsTransientError(tt.input))
+		})
+	}
+}
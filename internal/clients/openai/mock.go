@@ -1,5 +1,7 @@
 package openai
 
+import "github.com/pkg/errors"
+
 type MockClientSuccess struct {
 	Text string
 }
@@ -11,3 +13,13 @@ func (m *MockClientSuccess) CallCompletionApi(prompt string) (string, error) {
 func (m *MockClientSuccess) CallChatCompletionApi(request chatCompletionRequest) (string, error) {
 	return m.Text, nil
 }
+
+type MockClientFailure struct{}
+
+func (m *MockClientFailure) CallCompletionApi(prompt string) (string, error) {
+	return "", errors.New("unable to call Open Ai")
+}
+
+func (m *MockClientFailure) CallChatCompletionApi(request chatCompletionRequest) (string, error) {
+	return "", errors.New("unable to call Open Ai")
+}
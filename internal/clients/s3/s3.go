@@ -15,6 +15,8 @@ import (
 type Client interface {
 	GetPresignedUploadUrl(path, fileName string) (string, error)
 	GetLocalFilePath(path, fileName string) (string, error)
+	UploadFile(path, fileName string, body io.ReadSeeker) error
+	DeleteFile(path, fileName string) error
 }
 
 type client struct {
@@ -79,6 +81,25 @@ func (c *client) GetLocalFilePath(path, fileName string) (string, error) {
 	return localTmpFile, nil
 }
 
+func (c *client) UploadFile(path, fileName string, body io.ReadSeeker) error {
+	fullPath := filepath.Join(path, fileName)
+	_, err := c.s3Client.PutObject(&s3go.PutObjectInput{
+		Bucket: aws.String(c.s3Bucket),
+		Key:    aws.String(fullPath),
+		Body:   body,
+	})
+	return err
+}
+
+func (c *client) DeleteFile(path, fileName string) error {
+	fullPath := filepath.Join(path, fileName)
+	_, err := c.s3Client.DeleteObject(&s3go.DeleteObjectInput{
+		Bucket: aws.String(c.s3Bucket),
+		Key:    aws.String(fullPath),
+	})
+	return err
+}
+
 func createLocalTmpFile(path, fileName string, data io.Reader) (string, error) {
 	tempDirPath := filepath.Join(os.TempDir(), path)
 	fileMode := os.FileMode(0700)
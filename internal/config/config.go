@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -24,6 +25,12 @@ type Config struct {
 	Environment       string
 	LoggerMode        string
 	AllowFileDeletion bool
+	// ProcessingLease is how long a file upload can go without a heartbeat while ONGOING before it is reaped.
+	ProcessingLease time.Duration
+	// MaxConcurrentFileUploads is the most file uploads dispatched or being processed at once, across all teams and workers.
+	MaxConcurrentFileUploads int
+	// MaxConcurrentFileUploadsPerTeam is the most file uploads of a single team dispatched or being processed at once.
+	MaxConcurrentFileUploadsPerTeam int
 }
 
 func envVarLoaderBool(envVarName string, required bool, errorCollector *[]error) bool {
@@ -51,6 +58,32 @@ func envVarLoaderString(envVarName string, required bool, errorCollector *[]erro
 	return value
 }
 
+func envVarLoaderDuration(envVarName string, defaultValue time.Duration, errorCollector *[]error) time.Duration {
+	value, ok := os.LookupEnv(envVarName)
+	if !ok {
+		return defaultValue
+	}
+	durationValue, err := time.ParseDuration(value)
+	if err != nil || durationValue <= 0 {
+		*errorCollector = append(*errorCollector, errors.Errorf("Env var %s is expected to be a positive duration", envVarName))
+		return defaultValue
+	}
+	return durationValue
+}
+
+func envVarLoaderPositiveInt(envVarName string, defaultValue int, errorCollector *[]error) int {
+	value, ok := os.LookupEnv(envVarName)
+	if !ok {
+		return defaultValue
+	}
+	intValue, err := strconv.Atoi(value)
+	if err != nil || intValue <= 0 {
+		*errorCollector = append(*errorCollector, errors.Errorf("Env var %s is expected to be a positive integer", envVarName))
+		return defaultValue
+	}
+	return intValue
+}
+
 func NewConfigFromEnvVars() (*Config, []error) {
 	c := Config{}
 
@@ -72,6 +105,9 @@ func NewConfigFromEnvVars() (*Config, []error) {
 	c.Environment = envVarLoaderString("ENVIRONMENT", true, &errs)
 	c.LoggerMode = envVarLoaderString("LOGGER_MODE", true, &errs)
 	c.AllowFileDeletion = envVarLoaderBool("ALLOW_FILE_DELETION", false, &errs)
+	c.ProcessingLease = envVarLoaderDuration("PROCESSING_LEASE", 5*time.Minute, &errs)
+	c.MaxConcurrentFileUploads = envVarLoaderPositiveInt("MAX_CONCURRENT_FILE_UPLOADS", 10, &errs)
+	c.MaxConcurrentFileUploadsPerTeam = envVarLoaderPositiveInt("MAX_CONCURRENT_FILE_UPLOADS_PER_TEAM", 3, &errs)
 
 	return &c, errs
 }
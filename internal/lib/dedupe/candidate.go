@@ -0,0 +1,255 @@
+package dedupe
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+)
+
+type MatchReason string
+
+const (
+	MatchingEmail          MatchReason = "EMAIL"
+	MatchingPhone          MatchReason = "PHONE"
+	MatchingNameAndCompany MatchReason = "NAME AND COMPANY"
+)
+
+// Names that are at least this similar are treated as the same name, which allows for typos and transliterations.
+const minNameSimilarity = 0.85
+
+// Match explains why two candidates in a cluster are considered to be the same person.
+type Match struct {
+	CandidateId      string
+	OtherCandidateId string
+	Reasons          []MatchReason
+}
+
+// Cluster is a group of candidates that are probably the same person.
+// Every candidate in it is matched with at least one other candidate in it.
+type Cluster struct {
+	Candidates []*model.Candidate
+	Matches    []Match
+}
+
+type candidateKeys struct {
+	email     string
+	phone     string
+	name      string
+	companies map[string]bool
+}
+
+// FindDuplicateCandidates groups candidates that share an email or a phone, or that have a similar name and have worked at the same company.
+// Clusters, and candidates within them, keep the order of the given candidates.
+func FindDuplicateCandidates(candidates []*model.Candidate) []*Cluster {
+	keys := make([]candidateKeys, len(candidates))
+	for i, candidate := range candidates {
+		keys[i] = keysForPersona(candidate.Persona())
+	}
+
+	parents := make([]int, len(candidates))
+	for i := range parents {
+		parents[i] = i
+	}
+	var root func(i int) int
+	root = func(i int) int {
+		if parents[i] != i {
+			parents[i] = root(parents[i])
+		}
+		return parents[i]
+	}
+
+	type pair struct {
+		first, second int
+		reasons       []MatchReason
+	}
+	pairs := []pair{}
+	for i := range candidates {
+		for j := i + 1; j < len(candidates); j++ {
+			reasons := matchReasons(keys[i], keys[j])
+			if len(reasons) == 0 {
+				continue
+			}
+			pairs = append(pairs, pair{first: i, second: j, reasons: reasons})
+			rootI, rootJ := root(i), root(j)
+			if rootI < rootJ {
+				parents[rootJ] = rootI
+			} else {
+				parents[rootI] = rootJ
+			}
+		}
+	}
+
+	clusters := []*Cluster{}
+	clusterForRoot := map[int]*Cluster{}
+	for _, p := range pairs {
+		r := root(p.first)
+		cluster, ok := clusterForRoot[r]
+		if !ok {
+			cluster = &Cluster{}
+			clusterForRoot[r] = cluster
+		}
+		cluster.Matches = append(cluster.Matches, Match{
+			CandidateId:      candidates[p.first].Id(),
+			OtherCandidateId: candidates[p.second].Id(),
+			Reasons:          p.reasons,
+		})
+	}
+
+	for i, candidate := range candidates {
+		cluster, ok := clusterForRoot[root(i)]
+		if !ok {
+			continue
+		}
+		if len(cluster.Candidates) == 0 {
+			clusters = append(clusters, cluster)
+		}
+		cluster.Candidates = append(cluster.Candidates, candidate)
+	}
+	return clusters
+}
+
+func matchReasons(first, second candidateKeys) []MatchReason {
+	reasons := []MatchReason{}
+	if first.email != "" && first.email == second.email {
+		reasons = append(reasons, MatchingEmail)
+	}
+	if first.phone != "" && first.phone == second.phone {
+		reasons = append(reasons, MatchingPhone)
+	}
+	if first.name != "" && second.name != "" && nameSimilarity(first.name, second.name) >= minNameSimilarity && sharesCompany(first, second) {
+		reasons = append(reasons, MatchingNameAndCompany)
+	}
+	return reasons
+}
+
+func sharesCompany(first, second candidateKeys) bool {
+	for company := range first.companies {
+		if second.companies[company] {
+			return true
+		}
+	}
+	return false
+}
+
+func keysForPersona(persona *model.Persona) candidateKeys {
+	keys := candidateKeys{companies: map[string]bool{}}
+	if persona == nil {
+		return keys
+	}
+	keys.email = NormalizeEmail(persona.Email)
+	keys.phone = NormalizePhone(persona.Phone)
+	keys.name = NormalizeName(persona.Name)
+	for _, experience := range persona.Experience {
+		company := NormalizeCompanyName(experience.CompanyName)
+		if company != "" {
+			keys.companies[company] = true
+		}
+	}
+	return keys
+}
+
+// NormalizeEmail lowercases the email and drops any +tag from it, so that tagged addresses of the same inbox match.
+func NormalizeEmail(email string) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+	local, domain, found := strings.Cut(email, "@")
+	if !found || local == "" || domain == "" {
+		return ""
+	}
+	local, _, _ = strings.Cut(local, "+")
+	return local + "@" + domain
+}
+
+// NormalizePhone keeps the last 10 digits of the phone, which drops country codes and trunk prefixes.
+// Numbers too short to identify a person are ignored.
+func NormalizePhone(phone string) string {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+	if len(digits) < 7 {
+		return ""
+	}
+	if len(digits) > 10 {
+		return digits[len(digits)-10:]
+	}
+	return digits
+}
+
+// NormalizeName lowercases the name and sorts its words, so that "Doe, Jane" and "Jane Doe" match.
+func NormalizeName(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	sort.Strings(words)
+	return strings.Join(words, " ")
+}
+
+var companySuffixes = map[string]bool{
+	"co":          true,
+	"company":     true,
+	"corp":        true,
+	"corporation": true,
+	"gmbh":        true,
+	"inc":         true,
+	"limited":     true,
+	"llc":         true,
+	"llp":         true,
+	"ltd":         true,
+	"plc":         true,
+	"private":     true,
+	"pvt":         true,
+}
+
+// NormalizeCompanyName drops punctuation and legal suffixes, so that "Acme Pvt. Ltd." and "ACME" match.
+func NormalizeCompanyName(companyName string) string {
+	words := strings.FieldsFunc(strings.ToLower(companyName), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for len(words) > 1 && companySuffixes[words[len(words)-1]] {
+		words = words[:len(words)-1]
+	}
+	return strings.Join(words, " ")
+}
+
+// nameSimilarity is 1 for identical names and 0 for names that have nothing in common, based on their edit distance.
+func nameSimilarity(first, second string) float64 {
+	a, b := []rune(first), []rune(second)
+	longest := len(a)
+	if len(b) > longest {
+		longest = len(b)
+	}
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(editDistance(a, b))/float64(longest)
+}
+
+func editDistance(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = previous[j-1] + cost
+			if previous[j]+1 < current[j] {
+				current[j] = previous[j] + 1
+			}
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,202 @@
+package dedupe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+)
+
+func Test_FindDuplicateCandidates(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	newCandidate := func(id string, persona *model.Persona) *model.Candidate {
+		candidate, _ := model.NewCandidate(model.CandidateOptions{
+			Id:                     id,
+			Team:                   team,
+			ManuallyCreatedPersona: persona,
+		})
+		return candidate
+	}
+	janeByEmail := newCandidate("c_id1", &model.Persona{Name: "Jane Doe", Email: "Jane.Doe@Example.com"})
+	janeByTaggedEmail := newCandidate("c_id2", &model.Persona{Name: "Jane D", Email: "jane.doe+agency@example.com"})
+	janeByPhone := newCandidate("c_id3", &model.Persona{Name: "Jane", Phone: "+91 98765-43210"})
+	janeWithPhone := newCandidate("c_id4", &model.Persona{Name: "Jane Doe", Email: "jane.doe@example.com", Phone: "098765 43210"})
+	johnAtAcme := newCandidate("c_id5", &model.Persona{
+		Name:       "Jon Smith",
+		Experience: []model.Experience{{Title: "Engineer", CompanyName: "Acme Pvt. Ltd."}},
+	})
+	johnAtAcmeAgain := newCandidate("c_id6", &model.Persona{
+		Name: "Smith, John",
+		Experience: []model.Experience{
+			{Title: "Intern", CompanyName: "Initech"},
+			{Title: "Senior Engineer", CompanyName: "ACME"},
+		},
+	})
+	johnElsewhere := newCandidate("c_id7", &model.Persona{
+		Name:       "John Smith",
+		Experience: []model.Experience{{Title: "Engineer", CompanyName: "Globex"}},
+	})
+	someoneAtAcme := newCandidate("c_id8", &model.Persona{
+		Name:       "Priya Kumar",
+		Experience: []model.Experience{{Title: "Engineer", CompanyName: "Acme"}},
+	})
+
+	tests := []struct {
+		name           string
+		input          []*model.Candidate
+		expectedOutput []*Cluster
+	}{
+		{
+			name:           "returns no clusters when there are no candidates",
+			input:          []*model.Candidate{},
+			expectedOutput: []*Cluster{},
+		},
+		{
+			name:           "returns no clusters when no candidates match",
+			input:          []*model.Candidate{janeByEmail, johnAtAcme, johnElsewhere, someoneAtAcme},
+			expectedOutput: []*Cluster{},
+		},
+		{
+			name:  "matches on normalized email",
+			input: []*model.Candidate{janeByEmail, johnAtAcme, janeByTaggedEmail},
+			expectedOutput: []*Cluster{
+				{
+					Candidates: []*model.Candidate{janeByEmail, janeByTaggedEmail},
+					Matches: []Match{
+						{CandidateId: "c_id1", OtherCandidateId: "c_id2", Reasons: []MatchReason{MatchingEmail}},
+					},
+				},
+			},
+		},
+		{
+			name:  "matches on normalized phone and joins matches into a single cluster",
+			input: []*model.Candidate{janeByEmail, janeByPhone, janeWithPhone},
+			expectedOutput: []*Cluster{
+				{
+					Candidates: []*model.Candidate{janeByEmail, janeByPhone, janeWithPhone},
+					Matches: []Match{
+						{CandidateId: "c_id1", OtherCandidateId: "c_id4", Reasons: []MatchReason{MatchingEmail}},
+						{CandidateId: "c_id3", OtherCandidateId: "c_id4", Reasons: []MatchReason{MatchingPhone}},
+					},
+				},
+			},
+		},
+		{
+			name:  "matches on a similar name and a shared company",
+			input: []*model.Candidate{johnAtAcme, johnElsewhere, someoneAtAcme, johnAtAcmeAgain},
+			expectedOutput: []*Cluster{
+				{
+					Candidates: []*model.Candidate{johnAtAcme, johnAtAcmeAgain},
+					Matches: []Match{
+						{CandidateId: "c_id5", OtherCandidateId: "c_id6", Reasons: []MatchReason{MatchingNameAndCompany}},
+					},
+				},
+			},
+		},
+		{
+			name:  "returns separate clusters in the order of the candidates",
+			input: []*model.Candidate{johnAtAcme, janeByEmail, johnAtAcmeAgain, janeByTaggedEmail},
+			expectedOutput: []*Cluster{
+				{
+					Candidates: []*model.Candidate{johnAtAcme, johnAtAcmeAgain},
+					Matches: []Match{
+						{CandidateId: "c_id5", OtherCandidateId: "c_id6", Reasons: []MatchReason{MatchingNameAndCompany}},
+					},
+				},
+				{
+					Candidates: []*model.Candidate{janeByEmail, janeByTaggedEmail},
+					Matches: []Match{
+						{CandidateId: "c_id1", OtherCandidateId: "c_id2", Reasons: []MatchReason{MatchingEmail}},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedOutput, FindDuplicateCandidates(tt.input))
+		})
+	}
+}
+
+func Test_NormalizeEmail(t *testing.T) {
+	tests := []struct {
+		input          string
+		expectedOutput string
+	}{
+		{input: " Jane.Doe@Example.COM ", expectedOutput: "jane.doe@example.com"},
+		{input: "jane.doe+jobs@example.com", expectedOutput: "jane.doe@example.com"},
+		{input: "not an email", expectedOutput: ""},
+		{input: "@example.com", expectedOutput: ""},
+		{input: "", expectedOutput: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expectedOutput, NormalizeEmail(tt.input))
+		})
+	}
+}
+
+func Test_NormalizePhone(t *testing.T) {
+	tests := []struct {
+		input          string
+		expectedOutput string
+	}{
+		{input: "+91 98765-43210", expectedOutput: "9876543210"},
+		{input: "098765 43210", expectedOutput: "9876543210"},
+		{input: "(555) 123-4567", expectedOutput: "5551234567"},
+		{input: "555-1234", expectedOutput: "5551234"},
+		{input: "12345", expectedOutput: ""},
+		{input: "", expectedOutput: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expectedOutput, NormalizePhone(tt.input))
+		})
+	}
+}
+
+func Test_NormalizeName(t *testing.T) {
+	tests := []struct {
+		input          string
+		expectedOutput string
+	}{
+		{input: "Jane Doe", expectedOutput: "doe jane"},
+		{input: "DOE, Jane", expectedOutput: "doe jane"},
+		{input: "  ", expectedOutput: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expectedOutput, NormalizeName(tt.input))
+		})
+	}
+}
+
+func Test_NormalizeCompanyName(t *testing.T) {
+	tests := []struct {
+		input          string
+		expectedOutput string
+	}{
+		{input: "Acme Pvt. Ltd.", expectedOutput: "acme"},
+		{input: "ACME, Inc.", expectedOutput: "acme"},
+		{input: "Tata Consultancy Services", expectedOutput: "tata consultancy services"},
+		{input: "Limited", expectedOutput: "limited"},
+		{input: "", expectedOutput: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expectedOutput, NormalizeCompanyName(tt.input))
+		})
+	}
+}
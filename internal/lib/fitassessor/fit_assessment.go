@@ -0,0 +1,98 @@
+package fitassessor
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/clients/openai"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+)
+
+// PROMPT_VERSION needs to change whenever the prompt changes, so that verdicts from the old prompt are not served from the cache.
+const PROMPT_VERSION = "1.0.0"
+
+var ErrInvalidVerdictJson = errors.New("unable to parse fit verdict json")
+
+func Assess(jobOpening *model.JobOpening, persona *model.Persona, openAiClient openai.Client) (*model.FitVerdict, error) {
+	if jobOpening == nil {
+		return nil, errors.New("jobOpening cannot be nil")
+	}
+
+	if persona == nil {
+		return nil, errors.New("persona cannot be nil")
+	}
+
+	response, err := OpenAiResponseForFitAssessment(jobOpening, persona, openAiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseVerdictFromJson(response)
+}
+
+func OpenAiResponseForFitAssessment(jobOpening *model.JobOpening, persona *model.Persona, openAiClient openai.Client) (string, error) {
+	personaJson, err := json.Marshal(persona)
+	if err != nil {
+		return "", err
+	}
+
+	openAiChatCompletionRequest := openai.ChatCompletionRequest{
+		Messages: []openai.ChatCompletionMessage{
+			{
+				Role:    "system",
+				Content: "You are a hiring assistant. You compare the persona of a candidate with a job opening and give an honest verdict on how well the candidate fits the job.",
+			},
+			{
+				Role:    "user",
+				Content: jobOpeningDescription(jobOpening),
+			},
+			{
+				Role:    "user",
+				Content: fmt.Sprintf("Candidate persona in JSON:\n%s", personaJson),
+			},
+			{
+				Role:    "user",
+				Content: "Given the above job opening and candidate persona, please give your verdict in JSON with the following attributes. How well the candidate fits the job as \"Rating\" (one of \"STRONG FIT\", \"GOOD FIT\", \"WEAK FIT\" or \"NOT A FIT\"), What makes the candidate a good fit as \"Strengths\" (type array of string, max length 5), What the candidate lacks for the job as \"Gaps\" (type array of string, max length 5), Questions to ask the candidate to check the gaps and strengths as \"Interview Questions\" (type array of string, max length 5). Return only the JSON.",
+			},
+		},
+	}
+
+	return openAiClient.CallChatCompletionApi(&openAiChatCompletionRequest)
+}
+
+func jobOpeningDescription(jobOpening *model.JobOpening) string {
+	lines := []string{fmt.Sprintf("Job title: %s", jobOpening.Title())}
+	if jobOpening.Description() != "" {
+		lines = append(lines, fmt.Sprintf("Job description: %s", jobOpening.Description()))
+	}
+	if len(jobOpening.RequiredSkills()) > 0 {
+		lines = append(lines, fmt.Sprintf("Required skills: %s", strings.Join(jobOpening.RequiredSkills(), ", ")))
+	}
+	if len(jobOpening.NiceToHaveSkills()) > 0 {
+		lines = append(lines, fmt.Sprintf("Nice to have skills: %s", strings.Join(jobOpening.NiceToHaveSkills(), ", ")))
+	}
+	if jobOpening.Location() != "" {
+		lines = append(lines, fmt.Sprintf("Location: %s", jobOpening.Location()))
+	}
+	if jobOpening.MinYoE() > 0 {
+		lines = append(lines, fmt.Sprintf("Minimum years of experience: %d", jobOpening.MinYoE()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func ParseVerdictFromJson(verdictJson string) (*model.FitVerdict, error) {
+	var verdict model.FitVerdict
+
+	err := json.Unmarshal([]byte(verdictJson), &verdict)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidVerdictJson, err)
+	}
+
+	if !verdict.IsValid() {
+		return nil, fmt.Errorf("%w: invalid rating: %s", ErrInvalidVerdictJson, verdict.Rating)
+	}
+
+	return &verdict, nil
+}
@@ -0,0 +1,144 @@
+package fitassessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/clients/openai"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+)
+
+func Test_Assess(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	jobOpening, _ := model.NewJobOpening(model.JobOpeningOptions{
+		Id:             "jo_id1",
+		Title:          "Backend Engineer",
+		RequiredSkills: []string{"Go", "Kubernetes"},
+		MinYoE:         3,
+		Team:           team,
+	})
+	persona := &model.Persona{Name: "Jane Doe", YoE: 5, TechSkills: []string{"Go"}}
+
+	tests := []struct {
+		name  string
+		input struct {
+			jobOpening   *model.JobOpening
+			persona      *model.Persona
+			openAiClient openai.Client
+		}
+		output        *model.FitVerdict
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when job opening is nil",
+			input: struct {
+				jobOpening   *model.JobOpening
+				persona      *model.Persona
+				openAiClient openai.Client
+			}{
+				persona: persona,
+			},
+			errorExpected: true,
+			errorString:   "jobOpening cannot be nil",
+		},
+		{
+			name: "errors when persona is nil",
+			input: struct {
+				jobOpening   *model.JobOpening
+				persona      *model.Persona
+				openAiClient openai.Client
+			}{
+				jobOpening: jobOpening,
+			},
+			errorExpected: true,
+			errorString:   "persona cannot be nil",
+		},
+		{
+			name: "errors when Open Ai fails",
+			input: struct {
+				jobOpening   *model.JobOpening
+				persona      *model.Persona
+				openAiClient openai.Client
+			}{
+				jobOpening:   jobOpening,
+				persona:      persona,
+				openAiClient: &openai.MockClientFailure{},
+			},
+			errorExpected: true,
+			errorString:   "unable to call Open Ai",
+		},
+		{
+			name: "errors when the response is not json",
+			input: struct {
+				jobOpening   *model.JobOpening
+				persona      *model.Persona
+				openAiClient openai.Client
+			}{
+				jobOpening:   jobOpening,
+				persona:      persona,
+				openAiClient: &openai.MockClientSuccess{Text: "A good fit"},
+			},
+			errorExpected: true,
+			errorString:   "unable to parse fit verdict json: invalid character 'A' looking for beginning of value",
+		},
+		{
+			name: "errors when the rating is unknown",
+			input: struct {
+				jobOpening   *model.JobOpening
+				persona      *model.Persona
+				openAiClient openai.Client
+			}{
+				jobOpening:   jobOpening,
+				persona:      persona,
+				openAiClient: &openai.MockClientSuccess{Text: `{"Rating": "MAYBE"}`},
+			},
+			errorExpected: true,
+			errorString:   "unable to parse fit verdict json: invalid rating: MAYBE",
+		},
+		{
+			name: "returns the verdict",
+			input: struct {
+				jobOpening   *model.JobOpening
+				persona      *model.Persona
+				openAiClient openai.Client
+			}{
+				jobOpening: jobOpening,
+				persona:    persona,
+				openAiClient: &openai.MockClientSuccess{Text: `{
+					"Rating": "GOOD FIT",
+					"Strengths": ["5 years of Go"],
+					"Gaps": ["No Kubernetes"],
+					"Interview Questions": ["How would you deploy a Go service?"]
+				}`},
+			},
+			output: &model.FitVerdict{
+				Rating:             "GOOD FIT",
+				Strengths:          []string{"5 years of Go"},
+				Gaps:               []string{"No Kubernetes"},
+				InterviewQuestions: []string{"How would you deploy a Go service?"},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := Assess(tt.input.jobOpening, tt.input.persona, tt.input.openAiClient)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			assert.Equal(t, tt.output, verdict)
+		})
+	}
+}
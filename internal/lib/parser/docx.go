@@ -0,0 +1,213 @@
+package parser
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const DOCX_EXTRACTOR_VERSION = "docx-v1"
+
+// A resume's document is nowhere near this large, so a larger one is most likely a zip bomb.
+const maxDocxDocumentSize = 20 << 20
+
+const (
+	docxDocumentPath   = "word/document.xml"
+	docxAppPropsPath   = "docProps/app.xml"
+	wordprocessingMLNs = "http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+	markupCompatNs     = "http://schemas.openxmlformats.org/markup-compatibility/2006"
+)
+
+// docxExtractor reads the WordprocessingML of a docx file directly, so no external tools are needed.
+type docxExtractor struct{}
+
+func (d *docxExtractor) Extract(filePath string) (*ExtractedText, error) {
+	reader, err := zip.OpenReader(filePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to open docx")
+	}
+	defer reader.Close()
+
+	document := findZipFile(&reader.Reader, docxDocumentPath)
+	if document == nil {
+		return nil, errors.Errorf("docx does not have %s", docxDocumentPath)
+	}
+
+	if document.UncompressedSize64 > maxDocxDocumentSize {
+		return nil, errors.Errorf("docx document is too large: %d bytes", document.UncompressedSize64)
+	}
+
+	documentReader, err := document.Open()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to open docx document")
+	}
+	defer documentReader.Close()
+
+	// The size in the zip header cannot be trusted, so a document cut off at the limit fails to parse instead.
+	text, err := GetTextFromWordprocessingML(io.LimitReader(documentReader, maxDocxDocumentSize))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExtractedText{
+		Text:             text,
+		PageCount:        docxPageCount(&reader.Reader),
+		ExtractorVersion: DOCX_EXTRACTOR_VERSION,
+	}, nil
+}
+
+// GetTextFromWordprocessingML returns the text of a word/document.xml in document order.
+// Paragraphs end with a new line. Table cells are separated by tabs and table rows end with a new line.
+func GetTextFromWordprocessingML(r io.Reader) (string, error) {
+	decoder := xml.NewDecoder(r)
+	text := &wordprocessingMLText{}
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", errors.Wrap(err, "unable to parse docx document")
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Space == markupCompatNs && t.Name.Local == "Fallback" {
+				// Fallback repeats the content of the preferred choice, e.g. for text boxes.
+				err = decoder.Skip()
+				if err != nil {
+					return "", errors.Wrap(err, "unable to parse docx document")
+				}
+				continue
+			}
+			if t.Name.Space != wordprocessingMLNs {
+				continue
+			}
+			switch t.Name.Local {
+			case "pPr", "rPr":
+				// Properties hold no text, but tab stop definitions would be read as tabs.
+				err = decoder.Skip()
+				if err != nil {
+					return "", errors.Wrap(err, "unable to parse docx document")
+				}
+			case "t":
+				text.inText = true
+			case "tab":
+				text.write("\t")
+			case "br", "cr":
+				text.write("\n")
+			case "noBreakHyphen":
+				text.write("-")
+			case "tr":
+				text.startRow()
+			case "tc":
+				text.startCell()
+			}
+		case xml.EndElement:
+			if t.Name.Space != wordprocessingMLNs {
+				continue
+			}
+			switch t.Name.Local {
+			case "t":
+				text.inText = false
+			case "p":
+				text.write("\n")
+			case "tc":
+				text.endCell()
+			case "tr":
+				text.endRow()
+			}
+		case xml.CharData:
+			if text.inText {
+				text.write(string(t))
+			}
+		}
+	}
+
+	return text.String(), nil
+}
+
+// wordprocessingMLText collects text while keeping track of the table cells it is written into.
+// Tables can be nested inside cells, so both rows and cells are stacks.
+type wordprocessingMLText struct {
+	document strings.Builder
+	cells    []*strings.Builder
+	rows     [][]string
+	inText   bool
+}
+
+func (w *wordprocessingMLText) write(s string) {
+	if len(w.cells) > 0 {
+		w.cells[len(w.cells)-1].WriteString(s)
+		return
+	}
+	w.document.WriteString(s)
+}
+
+func (w *wordprocessingMLText) startRow() {
+	w.rows = append(w.rows, []string{})
+}
+
+func (w *wordprocessingMLText) endRow() {
+	if len(w.rows) == 0 {
+		return
+	}
+	row := w.rows[len(w.rows)-1]
+	w.rows = w.rows[:len(w.rows)-1]
+	w.write(strings.Join(row, "\t") + "\n")
+}
+
+func (w *wordprocessingMLText) startCell() {
+	w.cells = append(w.cells, &strings.Builder{})
+}
+
+func (w *wordprocessingMLText) endCell() {
+	if len(w.cells) == 0 {
+		return
+	}
+	cell := w.cells[len(w.cells)-1]
+	w.cells = w.cells[:len(w.cells)-1]
+	if len(w.rows) == 0 {
+		w.write(cell.String())
+		return
+	}
+	w.rows[len(w.rows)-1] = append(w.rows[len(w.rows)-1], strings.Join(strings.Fields(cell.String()), " "))
+}
+
+func (w *wordprocessingMLText) String() string {
+	return w.document.String()
+}
+
+// docxPageCount reads the page count word saved in the document properties.
+// It is 0 when the document does not have one.
+func docxPageCount(reader *zip.Reader) int {
+	appProps := findZipFile(reader, docxAppPropsPath)
+	if appProps == nil {
+		return 0
+	}
+
+	appPropsReader, err := appProps.Open()
+	if err != nil {
+		return 0
+	}
+	defer appPropsReader.Close()
+
+	var properties struct {
+		Pages string `xml:"Pages"`
+	}
+	err = xml.NewDecoder(io.LimitReader(appPropsReader, maxDocxDocumentSize)).Decode(&properties)
+	if err != nil {
+		return 0
+	}
+
+	pageCount, err := strconv.Atoi(strings.TrimSpace(properties.Pages))
+	if err != nil || pageCount < 0 {
+		return 0
+	}
+	return pageCount
+}
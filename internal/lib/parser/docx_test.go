@@ -0,0 +1,132 @@
+package parser
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_docxExtractor_Extract(t *testing.T) {
+	// The zip header claims a document larger than the limit, without the test having to write one.
+	oversizedDocxPath := filepath.Join(t.TempDir(), "oversized.docx")
+	oversizedDocx, err := os.Create(oversizedDocxPath)
+	assert.NoError(t, err)
+	oversizedDocxWriter := zip.NewWriter(oversizedDocx)
+	_, err = oversizedDocxWriter.CreateRaw(&zip.FileHeader{
+		Name:               docxDocumentPath,
+		Method:             zip.Deflate,
+		UncompressedSize64: maxDocxDocumentSize + 1,
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, oversizedDocxWriter.Close())
+	assert.NoError(t, oversizedDocx.Close())
+
+	tests := []struct {
+		name           string
+		input          string
+		expectedOutput *ExtractedText
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "errors if file is not a zip",
+			input:          "test_fixtures/missing.docx",
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "unable to open docx: open test_fixtures/missing.docx: no such file or directory",
+		},
+		{
+			name:           "errors if the document is too large",
+			input:          oversizedDocxPath,
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "docx document is too large: 20971521 bytes",
+		},
+		{
+			name:  "extracts paragraphs and tables in document order",
+			input: "test_fixtures/test-resume.docx",
+			expectedOutput: &ExtractedText{
+				Text: "First Last\n" +
+					"first.last@example.com |\t+91 1234567890\n" +
+					"Experience\n" +
+					"Senior Software Engineer\tCoinbase 2021 - Present\n" +
+					"Software Engineer II\tMicrosoft 2020 - 2021\n" +
+					"Skills\n" +
+					"Go, TypeScript\n\n" +
+					"Education\nDehradun Institute of Technology\n",
+				PageCount:        2,
+				ExtractorVersion: DOCX_EXTRACTOR_VERSION,
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			extractor := &docxExtractor{}
+			result, err := extractor.Extract(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
+
+func Test_GetTextFromWordprocessingML(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectedOutput string
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "errors if xml is invalid",
+			input:          `<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>`,
+			expectedOutput: "",
+			errorExpected:  true,
+			errorString:    "unable to parse docx document: XML syntax error on line 1: unexpected EOF",
+		},
+		{
+			name: "ignores text outside of the wordprocessingml namespace",
+			input: `<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" xmlns:o="urn:other"><w:body>
+				<w:p><w:r><w:t>kept</w:t><o:t>ignored</o:t></w:r></w:p>
+			</w:body></w:document>`,
+			expectedOutput: "kept\n",
+			errorExpected:  false,
+			errorString:    "",
+		},
+		{
+			name: "keeps nested tables inside their cell",
+			input: `<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>
+				<w:tbl><w:tr>
+					<w:tc><w:p><w:r><w:t>outer</w:t></w:r></w:p></w:tc>
+					<w:tc><w:tbl><w:tr><w:tc><w:p><w:r><w:t>inner 1</w:t></w:r></w:p></w:tc><w:tc><w:p><w:r><w:t>inner 2</w:t></w:r></w:p></w:tc></w:tr></w:tbl></w:tc>
+				</w:tr></w:tbl>
+			</w:body></w:document>`,
+			expectedOutput: "outer\tinner 1 inner 2\n",
+			errorExpected:  false,
+			errorString:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := GetTextFromWordprocessingML(strings.NewReader(tt.input))
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
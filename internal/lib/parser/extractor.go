@@ -0,0 +1,135 @@
+package parser
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ExtractedText is the plain text of an uploaded file along with how it was extracted.
+type ExtractedText struct {
+	Text             string
+	PageCount        int
+	ExtractorVersion string
+}
+
+type Extractor interface {
+	Extract(filePath string) (*ExtractedText, error)
+}
+
+// ExtractText picks an extractor for the file and extracts its text.
+func ExtractText(filePath, fileName string) (*ExtractedText, error) {
+	extractor, err := ExtractorForFile(filePath, fileName)
+	if err != nil {
+		return nil, err
+	}
+	return extractor.Extract(filePath)
+}
+
+// ExtractorForFile sniffs the content of the file to pick an extractor.
+// The extension of the file name is only used when the content is not recognised.
+func ExtractorForFile(filePath, fileName string) (Extractor, error) {
+	header, err := readFileHeader(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return extractorForHeader(header, fileName, func() bool { return isDocx(filePath) })
+}
+
+// IsSupportedFileHeader tells whether ExtractorForFile is likely to support a file from its first 512 bytes and its name,
+// without needing the whole file. A zip is taken to be a DOCX when its name says so.
+func IsSupportedFileHeader(header []byte, fileName string) bool {
+	_, err := extractorForHeader(header, fileName, func() bool {
+		return strings.ToLower(filepath.Ext(fileName)) == ".docx"
+	})
+	return err == nil
+}
+
+func extractorForHeader(header []byte, fileName string, isDocx func() bool) (Extractor, error) {
+	contentType := http.DetectContentType(header)
+	switch {
+	case bytes.HasPrefix(header, []byte("%PDF-")):
+		return &pdfExtractor{}, nil
+	case bytes.HasPrefix(header, []byte("PK\x03\x04")) && isDocx():
+		return &docxExtractor{}, nil
+	case bytes.HasPrefix(bytes.TrimSpace(header), []byte(`{\rtf`)):
+		return &rtfExtractor{}, nil
+	case strings.HasPrefix(contentType, "text/html"):
+		return &htmlExtractor{}, nil
+	}
+
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".pdf":
+		return &pdfExtractor{}, nil
+	case ".docx":
+		return &docxExtractor{}, nil
+	case ".rtf":
+		return &rtfExtractor{}, nil
+	case ".html", ".htm":
+		return &htmlExtractor{}, nil
+	case ".md", ".markdown":
+		return &markdownExtractor{}, nil
+	case ".txt":
+		return &textExtractor{}, nil
+	}
+
+	if strings.HasPrefix(contentType, "text/plain") {
+		return &textExtractor{}, nil
+	}
+
+	return nil, errors.Errorf("unsupported file type: %s", fileName)
+}
+
+// IsCurrentExtractorVersion is false for text extracted by an older version of an extractor, which should be extracted again.
+func IsCurrentExtractorVersion(extractorVersion string) bool {
+	switch extractorVersion {
+	case PDF_EXTRACTOR_VERSION,
+		DOCX_EXTRACTOR_VERSION,
+		RTF_EXTRACTOR_VERSION,
+		HTML_EXTRACTOR_VERSION,
+		MARKDOWN_EXTRACTOR_VERSION,
+		TEXT_EXTRACTOR_VERSION:
+		return true
+	}
+	return false
+}
+
+func readFileHeader(filePath string) ([]byte, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	header := make([]byte, 512)
+	n, err := io.ReadFull(file, header)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, err
+	}
+	return header[:n], nil
+}
+
+func isDocx(filePath string) bool {
+	reader, err := zip.OpenReader(filePath)
+	if err != nil {
+		return false
+	}
+	defer reader.Close()
+	return findZipFile(&reader.Reader, docxDocumentPath) != nil
+}
+
+func findZipFile(reader *zip.Reader, name string) *zip.File {
+	for _, file := range reader.File {
+		if file.Name == name {
+			return file
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package parser
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ExtractorForFile(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name string, content []byte) string {
+		path := filepath.Join(dir, name)
+		err := os.WriteFile(path, content, 0o600)
+		assert.NoError(t, err)
+		return path
+	}
+	docx, err := os.ReadFile("test_fixtures/test-resume.docx")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		filePath       string
+		fileName       string
+		expectedOutput Extractor
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "errors if file does not exist",
+			filePath:       filepath.Join(dir, "missing"),
+			fileName:       "resume.pdf",
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "open " + filepath.Join(dir, "missing") + ": no such file or directory",
+		},
+		{
+			name:           "picks pdf extractor for pdf content",
+			filePath:       writeFile("pdf_content", []byte("%PDF-1.4\n")),
+			fileName:       "resume",
+			expectedOutput: &pdfExtractor{},
+			errorExpected:  false,
+			errorString:    "",
+		},
+		{
+			name:           "picks docx extractor for docx content",
+			filePath:       writeFile("docx_content", docx),
+			fileName:       "resume.pdf",
+			expectedOutput: &docxExtractor{},
+			errorExpected:  false,
+			errorString:    "",
+		},
+		{
+			name:           "picks extractor by extension if content is not recognised",
+			filePath:       writeFile("unknown_content", []byte("unknown")),
+			fileName:       "resume.DOCX",
+			expectedOutput: &docxExtractor{},
+			errorExpected:  false,
+			errorString:    "",
+		},
+		{
+			name:           "picks rtf extractor for rtf content",
+			filePath:       writeFile("rtf_content", []byte(`{\rtf1\ansi First Last}`)),
+			fileName:       "resume.doc",
+			expectedOutput: &rtfExtractor{},
+			errorExpected:  false,
+			errorString:    "",
+		},
+		{
+			name:           "picks html extractor for html content",
+			filePath:       writeFile("html_content", []byte("<!DOCTYPE html><html><body>First Last</body></html>")),
+			fileName:       "resume",
+			expectedOutput: &htmlExtractor{},
+			errorExpected:  false,
+			errorString:    "",
+		},
+		{
+			name:           "picks markdown extractor by extension",
+			filePath:       writeFile("markdown_content", []byte("# First Last")),
+			fileName:       "resume.md",
+			expectedOutput: &markdownExtractor{},
+			errorExpected:  false,
+			errorString:    "",
+		},
+		{
+			name:           "picks text extractor by extension",
+			filePath:       writeFile("text_content", []byte("First Last")),
+			fileName:       "resume.txt",
+			expectedOutput: &textExtractor{},
+			errorExpected:  false,
+			errorString:    "",
+		},
+		{
+			name:           "picks text extractor for text content with an unknown extension",
+			filePath:       writeFile("unknown_text_content", []byte("First Last")),
+			fileName:       "resume",
+			expectedOutput: &textExtractor{},
+			errorExpected:  false,
+			errorString:    "",
+		},
+		{
+			name:           "errors if file type is not supported",
+			filePath:       writeFile("unsupported_content", []byte("MZ\x90\x00\x03\x00\x00\x00")),
+			fileName:       "resume.exe",
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "unsupported file type: resume.exe",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ExtractorForFile(tt.filePath, tt.fileName)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
+
+func Test_IsSupportedFileHeader(t *testing.T) {
+	tests := []struct {
+		name           string
+		header         []byte
+		fileName       string
+		expectedOutput bool
+	}{
+		{
+			name:           "supports pdf content",
+			header:         []byte("%PDF-1.4\n"),
+			fileName:       "resume",
+			expectedOutput: true,
+		},
+		{
+			name:           "supports a zip named as a docx",
+			header:         []byte("PK\x03\x04\x14\x00"),
+			fileName:       "resume.docx",
+			expectedOutput: true,
+		},
+		{
+			name:           "does not support a zip that is not named as a docx",
+			header:         []byte("PK\x03\x04\x14\x00"),
+			fileName:       "resumes.zip",
+			expectedOutput: false,
+		},
+		{
+			name:           "supports plain text",
+			header:         []byte("Jane Doe\nSoftware Engineer"),
+			fileName:       "resume",
+			expectedOutput: true,
+		},
+		{
+			name:           "does not support unknown content",
+			header:         []byte("MZ\x90\x00\x03\x00\x00\x00"),
+			fileName:       "resume.exe",
+			expectedOutput: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedOutput, IsSupportedFileHeader(tt.header, tt.fileName))
+		})
+	}
+}
+
+func Test_IsCurrentExtractorVersion(t *testing.T) {
+	t.Run("returns true for the version of every extractor", func(t *testing.T) {
+		for _, extractorVersion := range []string{
+			PDF_EXTRACTOR_VERSION,
+			DOCX_EXTRACTOR_VERSION,
+			RTF_EXTRACTOR_VERSION,
+			HTML_EXTRACTOR_VERSION,
+			MARKDOWN_EXTRACTOR_VERSION,
+			TEXT_EXTRACTOR_VERSION,
+		} {
+			assert.True(t, IsCurrentExtractorVersion(extractorVersion), extractorVersion)
+		}
+	})
+
+	t.Run("returns false for an older version", func(t *testing.T) {
+		assert.False(t, IsCurrentExtractorVersion("pdf2go-v0.1.0"))
+	})
+
+	t.Run("returns false for an empty version", func(t *testing.T) {
+		assert.False(t, IsCurrentExtractorVersion(""))
+	})
+}
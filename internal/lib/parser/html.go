@@ -0,0 +1,156 @@
+package parser
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"golang.org/x/net/html/charset"
+)
+
+const HTML_EXTRACTOR_VERSION = "html-v1"
+
+type htmlExtractor struct{}
+
+func (e *htmlExtractor) Extract(filePath string) (*ExtractedText, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := GetTextFromHtml(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExtractedText{
+		Text:             text,
+		ExtractorVersion: HTML_EXTRACTOR_VERSION,
+	}, nil
+}
+
+// GetTextFromHtml returns the visible text of a html page.
+// The charset is taken from a byte order mark or meta tag, and entities are decoded while parsing.
+func GetTextFromHtml(content []byte) (string, error) {
+	encoding, _, _ := charset.DetermineEncoding(content, "text/html")
+	reader := encoding.NewDecoder().Reader(bytes.NewReader(content))
+
+	document, err := html.Parse(reader)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to parse html")
+	}
+
+	var text strings.Builder
+	writeHtmlText(&text, document, false)
+	return normalizeText(text.String()), nil
+}
+
+var htmlSkippedElements = map[atom.Atom]bool{
+	atom.Head:     true,
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Noscript: true,
+	atom.Template: true,
+	atom.Svg:      true,
+	atom.Iframe:   true,
+	atom.Object:   true,
+	atom.Button:   true,
+	atom.Select:   true,
+}
+
+var htmlBlockElements = map[atom.Atom]bool{
+	atom.Address: true, atom.Article: true, atom.Aside: true, atom.Blockquote: true,
+	atom.Dd: true, atom.Div: true, atom.Dl: true, atom.Dt: true,
+	atom.Fieldset: true, atom.Figcaption: true, atom.Figure: true, atom.Footer: true,
+	atom.Form: true, atom.H1: true, atom.H2: true, atom.H3: true,
+	atom.H4: true, atom.H5: true, atom.H6: true, atom.Header: true,
+	atom.Hr: true, atom.Li: true, atom.Main: true, atom.Nav: true,
+	atom.Ol: true, atom.P: true, atom.Pre: true, atom.Section: true,
+	atom.Table: true, atom.Tr: true, atom.Ul: true, atom.Caption: true,
+}
+
+func writeHtmlText(w io.StringWriter, node *html.Node, preformatted bool) {
+	switch node.Type {
+	case html.TextNode:
+		if preformatted {
+			w.WriteString(node.Data)
+		} else {
+			// Whitespace around inline text separates it from its neighbours, and runs of it are collapsed later.
+			if strings.TrimLeftFunc(node.Data, unicode.IsSpace) != node.Data {
+				w.WriteString(" ")
+			}
+			w.WriteString(strings.Join(strings.Fields(node.Data), " "))
+			if strings.TrimRightFunc(node.Data, unicode.IsSpace) != node.Data {
+				w.WriteString(" ")
+			}
+		}
+		return
+	case html.CommentNode, html.DoctypeNode:
+		return
+	case html.ElementNode:
+		if htmlSkippedElements[node.DataAtom] {
+			return
+		}
+	}
+
+	block := node.Type == html.ElementNode && htmlBlockElements[node.DataAtom]
+	if block {
+		w.WriteString("\n")
+	}
+	switch node.DataAtom {
+	case atom.Br:
+		w.WriteString("\n")
+	case atom.Li:
+		w.WriteString("- ")
+	case atom.Img:
+		w.WriteString(htmlAttribute(node, "alt"))
+	}
+
+	if node.DataAtom == atom.A {
+		writeHtmlLink(w, node, preformatted)
+	} else {
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			writeHtmlText(w, child, preformatted || node.DataAtom == atom.Pre)
+		}
+	}
+
+	if node.DataAtom == atom.Td || node.DataAtom == atom.Th {
+		w.WriteString("\t")
+	}
+	if block {
+		w.WriteString("\n")
+	}
+}
+
+// writeHtmlLink keeps the target of external and mailto links next to the link text, unless the text already shows it.
+func writeHtmlLink(w io.StringWriter, node *html.Node, preformatted bool) {
+	var text strings.Builder
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		writeHtmlText(&text, child, preformatted)
+	}
+	w.WriteString(text.String())
+
+	href := htmlAttribute(node, "href")
+	if !strings.HasPrefix(href, "http://") && !strings.HasPrefix(href, "https://") && !strings.HasPrefix(href, "mailto:") {
+		return
+	}
+	target := strings.TrimPrefix(href, "mailto:")
+	if strings.Contains(text.String(), target) {
+		return
+	}
+	w.WriteString(" (" + target + ") ")
+}
+
+func htmlAttribute(node *html.Node, key string) string {
+	for _, attribute := range node.Attr {
+		if attribute.Key == key {
+			return attribute.Val
+		}
+	}
+	return ""
+}
@@ -0,0 +1,52 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetTextFromHtml(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          []byte
+		expectedOutput string
+	}{
+		{
+			name: "keeps visible text in block order",
+			input: []byte(`<!DOCTYPE html><html><head><title>Profile</title><style>h1 { color: red; }</style></head>
+				<body><h1>First   Last</h1><p>Senior <b>Software</b> Engineer<br>Bangalore</p>
+				<script>var tracking = true;</script><!-- comment -->
+				<ul><li>Go</li><li>TypeScript</li></ul></body></html>`),
+			expectedOutput: "First Last\n\nSenior Software Engineer\nBangalore\n\n- Go\n\n- TypeScript",
+		},
+		{
+			name:           "turns tables into tab separated rows",
+			input:          []byte(`<table><tr><th>Company</th><th>Years</th></tr><tr><td>Coinbase</td><td>2021 - Present</td></tr></table>`),
+			expectedOutput: "Company\tYears\n\nCoinbase\t2021 - Present",
+		},
+		{
+			name:           "decodes entities and keeps external link targets",
+			input:          []byte(`<p>R&amp;D &mdash; <a href="https://linkedin.com/in/firstlast">LinkedIn</a> <a href="mailto:first.last@example.com">first.last@example.com</a> <a href="/about">About</a></p>`),
+			expectedOutput: "R&D — LinkedIn (https://linkedin.com/in/firstlast) first.last@example.com About",
+		},
+		{
+			name:           "decodes the charset from the meta tag",
+			input:          []byte("<html><head><meta charset=\"windows-1252\"></head><body><p>Jos\xe9</p></body></html>"),
+			expectedOutput: "José",
+		},
+		{
+			name:           "keeps whitespace of preformatted text",
+			input:          []byte("<pre>Go     5 years\nRuby   2 years</pre>"),
+			expectedOutput: "Go 5 years\nRuby 2 years",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := GetTextFromHtml(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
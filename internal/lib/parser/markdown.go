@@ -0,0 +1,121 @@
+package parser
+
+import (
+	"html"
+	"os"
+	"regexp"
+	"strings"
+)
+
+const MARKDOWN_EXTRACTOR_VERSION = "markdown-v1"
+
+type markdownExtractor struct{}
+
+func (e *markdownExtractor) Extract(filePath string) (*ExtractedText, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := decodeText(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExtractedText{
+		Text:             GetTextFromMarkdown(text),
+		ExtractorVersion: MARKDOWN_EXTRACTOR_VERSION,
+	}, nil
+}
+
+var (
+	markdownFenceRegex         = regexp.MustCompile("^\\s*(```|~~~)")
+	markdownRuleRegex          = regexp.MustCompile(`^\s*([-*_])(\s*[-*_]){2,}\s*$`)
+	markdownSetextRegex        = regexp.MustCompile(`^\s*(=+|-+)\s*$`)
+	markdownTableDividerRegex  = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?\s*$`)
+	markdownHeadingRegex       = regexp.MustCompile(`^\s{0,3}#{1,6}\s+(.*?)\s*#*\s*$`)
+	markdownBlockquoteRegex    = regexp.MustCompile(`^\s*(>\s?)+`)
+	markdownBulletRegex        = regexp.MustCompile(`^(\s*)[*+-]\s+`)
+	markdownImageRegex         = regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`)
+	markdownLinkRegex          = regexp.MustCompile(`\[([^\]]*)\]\(\s*<?([^)\s>]*)>?(?:\s+"[^"]*")?\s*\)`)
+	markdownAutolinkRegex      = regexp.MustCompile(`<((?:https?://|mailto:)[^>\s]+)>`)
+	markdownHtmlTagRegex       = regexp.MustCompile(`</?[a-zA-Z][^>]*>`)
+	markdownCodeRegex          = regexp.MustCompile("`+([^`]*)`+")
+	markdownStrongRegex        = regexp.MustCompile(`(\*\*|__)(\S(?:.*?\S)?)(\*\*|__)`)
+	markdownEmphasisRegex      = regexp.MustCompile(`(^|[^\w*])[*_](\S(?:[^*_]*?\S)?)[*_]([^\w*]|$)`)
+	markdownStrikethroughRegex = regexp.MustCompile(`~~(.+?)~~`)
+	markdownEscapedCharRegex   = regexp.MustCompile("\\\\([\\\\`*_{}\\[\\]()#+\\-.!|>~])")
+)
+
+// GetTextFromMarkdown strips markdown syntax and keeps the text.
+// Link targets are kept next to the link text, since profile and portfolio links matter in a resume.
+func GetTextFromMarkdown(markdown string) string {
+	lines := []string{}
+	inCodeBlock := false
+	for _, line := range strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n") {
+		if markdownFenceRegex.MatchString(line) {
+			inCodeBlock = !inCodeBlock
+			lines = append(lines, "")
+			continue
+		}
+		if inCodeBlock {
+			lines = append(lines, line)
+			continue
+		}
+		if strings.Contains(line, "|") && markdownTableDividerRegex.MatchString(line) {
+			continue
+		}
+		lines = append(lines, markdownLineToText(line))
+	}
+	return normalizeText(html.UnescapeString(strings.Join(lines, "\n")))
+}
+
+func markdownLineToText(line string) string {
+	if markdownRuleRegex.MatchString(line) || markdownSetextRegex.MatchString(line) {
+		return ""
+	}
+
+	// Escaped characters are hidden while the rest of the syntax is stripped, so that they are kept as they are.
+	escaped := []string{}
+	line = markdownEscapedCharRegex.ReplaceAllStringFunc(line, func(s string) string {
+		escaped = append(escaped, s[1:])
+		return "\x00"
+	})
+
+	line = markdownHeadingRegex.ReplaceAllString(line, "$1")
+	line = markdownBlockquoteRegex.ReplaceAllString(line, "")
+	line = markdownBulletRegex.ReplaceAllString(line, "$1- ")
+	line = markdownImageRegex.ReplaceAllString(line, "$1")
+	line = markdownLinkRegex.ReplaceAllStringFunc(line, func(link string) string {
+		match := markdownLinkRegex.FindStringSubmatch(link)
+		text, target := strings.TrimSpace(match[1]), match[2]
+		if text == "" {
+			return target
+		}
+		if target == "" || text == target {
+			return text
+		}
+		return text + " (" + target + ")"
+	})
+	line = markdownAutolinkRegex.ReplaceAllString(line, "$1")
+	line = markdownHtmlTagRegex.ReplaceAllString(line, " ")
+	line = markdownCodeRegex.ReplaceAllString(line, "$1")
+	line = markdownStrongRegex.ReplaceAllString(line, "$2")
+	// Emphasis consumes the character around it, so emphasis next to each other needs a second pass.
+	line = markdownEmphasisRegex.ReplaceAllString(line, "$1$2$3")
+	line = markdownEmphasisRegex.ReplaceAllString(line, "$1$2$3")
+	line = markdownStrikethroughRegex.ReplaceAllString(line, "$1")
+
+	if strings.HasPrefix(strings.TrimSpace(line), "|") {
+		cells := strings.Split(strings.Trim(strings.TrimSpace(line), "|"), "|")
+		for i, cell := range cells {
+			cells[i] = strings.TrimSpace(cell)
+		}
+		line = strings.Join(cells, "\t")
+	}
+
+	for _, char := range escaped {
+		line = strings.Replace(line, "\x00", char, 1)
+	}
+	return line
+}
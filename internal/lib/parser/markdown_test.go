@@ -0,0 +1,57 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetTextFromMarkdown(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectedOutput string
+	}{
+		{
+			name:           "strips headings and emphasis",
+			input:          "# First Last\n\n**Senior** _Software_ *Engineer* ~~intern~~\n\nTitle\n=====",
+			expectedOutput: "First Last\n\nSenior Software Engineer intern\n\nTitle",
+		},
+		{
+			name:           "keeps underscores inside words",
+			input:          "first_last@example.com and snake_case_name",
+			expectedOutput: "first_last@example.com and snake_case_name",
+		},
+		{
+			name:           "keeps link targets and image alt text",
+			input:          "[LinkedIn](https://linkedin.com/in/firstlast) <https://github.com/firstlast> ![photo](me.png) [https://example.com](https://example.com)",
+			expectedOutput: "LinkedIn (https://linkedin.com/in/firstlast) https://github.com/firstlast photo https://example.com",
+		},
+		{
+			name:           "normalizes lists, quotes and rules",
+			input:          "* Go\n+ TypeScript\n  - React\n1. First\n> Quoted\n\n---\n",
+			expectedOutput: "- Go\n- TypeScript\n- React\n1. First\nQuoted",
+		},
+		{
+			name:           "turns tables into tab separated rows",
+			input:          "| Company | Years |\n| --- | :---: |\n| Coinbase | 2021 - Present |",
+			expectedOutput: "Company\tYears\nCoinbase\t2021 - Present",
+		},
+		{
+			name:           "keeps code and escaped characters as they are",
+			input:          "Uses `go test` and\n```\n**not bold**\n```\n\\*literal\\* C\\#",
+			expectedOutput: "Uses go test and\n\n**not bold**\n\n*literal* C#",
+		},
+		{
+			name:           "strips html and decodes entities",
+			input:          "Research &amp; Development<br/>R&D <span>team</span>",
+			expectedOutput: "Research & Development R&D team",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedOutput, GetTextFromMarkdown(tt.input))
+		})
+	}
+}
@@ -2,14 +2,41 @@ package parser
 
 import "github.com/rudolfoborges/pdf2go"
 
+// Stored alongside extracted text, so that text extracted by an older extractor can be found and re-extracted.
+const PDF_EXTRACTOR_VERSION = "pdf2go-v0.1.1"
+
+type pdfExtractor struct{}
+
+func (p *pdfExtractor) Extract(filePath string) (*ExtractedText, error) {
+	text, pageCount, err := GetTextAndPageCountFromPdf(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return &ExtractedText{
+		Text:             text,
+		PageCount:        pageCount,
+		ExtractorVersion: PDF_EXTRACTOR_VERSION,
+	}, nil
+}
+
 func GetTextFromPdf(filePath string) (string, error) {
+	text, _, err := GetTextAndPageCountFromPdf(filePath)
+	return text, err
+}
+
+func GetTextAndPageCountFromPdf(filePath string) (string, int, error) {
 	pdf, err := pdf2go.New(filePath, pdf2go.Config{
 		LogLevel: pdf2go.LogLevelError,
 	})
 
 	if err != nil {
-		return "", err
+		return "", 0, err
+	}
+
+	text, err := pdf.Text()
+	if err != nil {
+		return "", 0, err
 	}
 
-	return pdf.Text()
+	return text, pdf.PagesNumber(), nil
 }
@@ -13,6 +13,11 @@ import (
 const NOT_A_RESUME = "NOT A RESUME"
 const BUILDER_VERSION = "1.0.0"
 
+var (
+	ErrNotAResume         = errors.New("needs a valid resume to parse")
+	ErrInvalidPersonaJson = errors.New("unable to parse persona json")
+)
+
 func Build(resumeText string, openAiClient openai.Client) (*model.Persona, error) {
 	response, err := OpenAiResponseForResumeText(resumeText, openAiClient)
 	if err != nil {
@@ -53,7 +58,7 @@ func OpenAiResponseForResumeText(resumeText string, openAiClient openai.Client)
 
 func getPersonaDataFromOpenAiResponse(response string) (*model.Persona, error) {
 	if strings.Contains(response, NOT_A_RESUME) {
-		return nil, errors.New("needs a valid resume to parse")
+		return nil, ErrNotAResume
 	}
 
 	persona, err := ParsePersonaFromJson(response)
@@ -72,7 +77,7 @@ func ParsePersonaFromJson(personaJson string) (*model.Persona, error) {
 
 	err := json.Unmarshal([]byte(personaJson), &persona)
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to parse persona json")
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPersonaJson, err)
 	}
 
 	return &persona, nil
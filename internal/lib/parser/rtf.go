@@ -0,0 +1,198 @@
+package parser
+
+import (
+	"bytes"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/encoding/charmap"
+)
+
+const RTF_EXTRACTOR_VERSION = "rtf-v1"
+
+type rtfExtractor struct{}
+
+func (e *rtfExtractor) Extract(filePath string) (*ExtractedText, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := GetTextFromRtf(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExtractedText{
+		Text:             text,
+		ExtractorVersion: RTF_EXTRACTOR_VERSION,
+	}, nil
+}
+
+var rtfCodepages = map[int]*charmap.Charmap{
+	437:   charmap.CodePage437,
+	850:   charmap.CodePage850,
+	1250:  charmap.Windows1250,
+	1251:  charmap.Windows1251,
+	1252:  charmap.Windows1252,
+	1253:  charmap.Windows1253,
+	1254:  charmap.Windows1254,
+	1255:  charmap.Windows1255,
+	1256:  charmap.Windows1256,
+	1257:  charmap.Windows1257,
+	1258:  charmap.Windows1258,
+	10000: charmap.Macintosh,
+}
+
+// Destinations that never hold document text.
+var rtfSkippedDestinations = map[string]bool{
+	"fonttbl": true, "colortbl": true, "stylesheet": true, "info": true,
+	"pict": true, "object": true, "nonshppict": true, "fldinst": true,
+	"listtable": true, "listoverridetable": true, "rsidtbl": true, "generator": true,
+	"xmlnstbl": true, "themedata": true, "colorschememapping": true, "latentstyles": true,
+	"datastore": true, "filetbl": true, "revtbl": true, "pgdsctbl": true,
+}
+
+var rtfSymbols = map[string]string{
+	"par": "\n", "line": "\n", "sect": "\n", "page": "\n", "row": "\n",
+	"tab": "\t", "cell": "\t",
+	"emdash": "—", "endash": "–", "bullet": "•",
+	"lquote": "‘", "rquote": "’", "ldblquote": "“", "rdblquote": "”",
+	"emspace": " ", "enspace": " ", "qmspace": " ",
+}
+
+type rtfGroup struct {
+	skip        bool
+	unicodeSkip int
+}
+
+// GetTextFromRtf returns the text of a rtf document, leaving out control words and non text destinations.
+// Characters written as \'hh are decoded with the codepage of the document, and \u characters are used as is.
+func GetTextFromRtf(content []byte) (string, error) {
+	if !bytes.HasPrefix(bytes.TrimSpace(content), []byte(`{\rtf`)) {
+		return "", errors.New("not a rtf document")
+	}
+
+	var text strings.Builder
+	group := rtfGroup{unicodeSkip: 1}
+	groups := []rtfGroup{}
+	codepage := charmap.Windows1252
+	pendingSkip := 0
+
+	write := func(s string) {
+		if pendingSkip > 0 {
+			pendingSkip--
+			return
+		}
+		if !group.skip {
+			text.WriteString(s)
+		}
+	}
+
+	for i := 0; i < len(content); {
+		c := content[i]
+		switch {
+		case c == '{':
+			groups = append(groups, group)
+			pendingSkip = 0
+			i++
+		case c == '}':
+			if len(groups) == 0 {
+				return "", errors.New("unable to parse rtf document: unbalanced groups")
+			}
+			group = groups[len(groups)-1]
+			groups = groups[:len(groups)-1]
+			pendingSkip = 0
+			i++
+		case c == '\r' || c == '\n':
+			i++
+		case c != '\\':
+			write(string(codepage.DecodeByte(c)))
+			i++
+		case i+1 >= len(content):
+			i++
+		case isAsciiLetter(content[i+1]):
+			word, param, hasParam, next := readRtfControlWord(content, i+1)
+			i = next
+			switch {
+			case rtfSkippedDestinations[word]:
+				group.skip = true
+			case word == "u" && hasParam:
+				if param < 0 {
+					param += 65536
+				}
+				write(string(rune(param)))
+				pendingSkip = group.unicodeSkip
+			case word == "uc" && hasParam:
+				group.unicodeSkip = param
+			case word == "ansicpg" && hasParam:
+				if cp, ok := rtfCodepages[param]; ok {
+					codepage = cp
+				}
+			case word == "bin" && hasParam:
+				i += param
+			case rtfSymbols[word] != "":
+				write(rtfSymbols[word])
+			}
+		case content[i+1] == '\'':
+			if i+3 < len(content) {
+				b, err := strconv.ParseUint(string(content[i+2:i+4]), 16, 8)
+				if err == nil {
+					write(string(codepage.DecodeByte(byte(b))))
+				}
+			}
+			i += 4
+		case content[i+1] == '*':
+			group.skip = true
+			i += 2
+		default:
+			switch content[i+1] {
+			case '\\', '{', '}':
+				write(string(content[i+1]))
+			case '~':
+				write(" ")
+			case '_':
+				write("-")
+			case '\r', '\n':
+				write("\n")
+			}
+			i += 2
+		}
+	}
+
+	return normalizeText(text.String()), nil
+}
+
+// readRtfControlWord reads a control word and its optional numeric parameter starting at start.
+// A single space after the control word is part of it and is consumed as well.
+func readRtfControlWord(content []byte, start int) (string, int, bool, int) {
+	i := start
+	for i < len(content) && isAsciiLetter(content[i]) {
+		i++
+	}
+	word := string(content[start:i])
+
+	paramStart := i
+	if i < len(content) && content[i] == '-' {
+		i++
+	}
+	for i < len(content) && content[i] >= '0' && content[i] <= '9' {
+		i++
+	}
+	param, err := strconv.Atoi(string(content[paramStart:i]))
+	hasParam := err == nil
+	if !hasParam {
+		i = paramStart
+	}
+
+	if i < len(content) && content[i] == ' ' {
+		i++
+	}
+	return word, param, hasParam, i
+}
+
+func isAsciiLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
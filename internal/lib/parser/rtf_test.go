@@ -0,0 +1,76 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetTextFromRtf(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectedOutput string
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "errors if content is not rtf",
+			input:          "First Last",
+			expectedOutput: "",
+			errorExpected:  true,
+			errorString:    "not a rtf document",
+		},
+		{
+			name:           "errors if groups are not balanced",
+			input:          `{\rtf1 First Last}}`,
+			expectedOutput: "",
+			errorExpected:  true,
+			errorString:    "unable to parse rtf document: unbalanced groups",
+		},
+		{
+			name: "strips control words and non text destinations",
+			input: `{\rtf1\ansi\deff0{\fonttbl{\f0 Times New Roman;}}{\colortbl;\red0\green0\blue0;}{\*\generator Writer;}{\info{\author Someone}}
+{\pard\b First Last\b0\par}
+{\pard Senior Software Engineer\tab Coinbase\line Bangalore\par}
+{\*\themedata 0a0b0c}{\pict\pngblip 89504e47}
+}`,
+			expectedOutput: "First Last\nSenior Software Engineer\tCoinbase\nBangalore",
+			errorExpected:  false,
+			errorString:    "",
+		},
+		{
+			name:           "decodes codepage and unicode characters",
+			input:          `{\rtf1\ansi\ansicpg1252 Jos\'e9 \ldblquote M\u252\'fcller\rdblquote  \uc2\u8212\'97\'97 \{x\} a\~b}`,
+			expectedOutput: "José “Müller” — {x} a b",
+			errorExpected:  false,
+			errorString:    "",
+		},
+		{
+			name:           "keeps field results and skips field instructions",
+			input:          `{\rtf1{\field{\*\fldinst HYPERLINK "https://linkedin.com/in/firstlast"}{\fldrslt LinkedIn}}}`,
+			expectedOutput: "LinkedIn",
+			errorExpected:  false,
+			errorString:    "",
+		},
+		{
+			name:           "turns table cells into tab separated rows",
+			input:          `{\rtf1\trowd\cellx1000\cellx2000 Coinbase\cell 2021\cell\row Microsoft\cell 2020\cell\row}`,
+			expectedOutput: "Coinbase\t2021\nMicrosoft\t2020",
+			errorExpected:  false,
+			errorString:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := GetTextFromRtf([]byte(tt.input))
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
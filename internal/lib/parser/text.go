@@ -0,0 +1,98 @@
+package parser
+
+import (
+	"bytes"
+	"os"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	textunicode "golang.org/x/text/encoding/unicode"
+)
+
+const TEXT_EXTRACTOR_VERSION = "text-v1"
+
+type textExtractor struct{}
+
+func (e *textExtractor) Extract(filePath string) (*ExtractedText, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := decodeText(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExtractedText{
+		Text:             normalizeText(text),
+		ExtractorVersion: TEXT_EXTRACTOR_VERSION,
+	}, nil
+}
+
+// decodeText converts file content to UTF-8.
+// A byte order mark decides the encoding. Without one, content that is not valid UTF-8 is read as Windows-1252,
+// which is what most non UTF-8 resumes are saved as.
+func decodeText(content []byte) (string, error) {
+	var decoder *encoding.Decoder
+	switch {
+	case bytes.HasPrefix(content, []byte("\xef\xbb\xbf")):
+		return string(content[3:]), nil
+	case bytes.HasPrefix(content, []byte("\xff\xfe")):
+		decoder = textunicode.UTF16(textunicode.LittleEndian, textunicode.UseBOM).NewDecoder()
+	case bytes.HasPrefix(content, []byte("\xfe\xff")):
+		decoder = textunicode.UTF16(textunicode.BigEndian, textunicode.UseBOM).NewDecoder()
+	case utf8.Valid(content):
+		return string(content), nil
+	default:
+		decoder = charmap.Windows1252.NewDecoder()
+	}
+
+	decoded, err := decoder.Bytes(content)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to decode text")
+	}
+	return string(decoded), nil
+}
+
+var (
+	repeatedSpacesRegex = regexp.MustCompile(`[ \t]*\t[ \t]*|  +`)
+	blankLinesRegex     = regexp.MustCompile(`\n{3,}`)
+)
+
+// normalizeText cleans up extracted text so every extractor hands over text that looks the same.
+// Control characters are dropped, runs of spaces are collapsed, lines are trimmed and at most one blank line is kept.
+func normalizeText(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+	text = strings.Map(func(r rune) rune {
+		switch {
+		case r == '\n' || r == '\t':
+			return r
+		case r == ' ':
+			return ' '
+		case unicode.IsControl(r) || r == utf8.RuneError || r == '\uFEFF':
+			return -1
+		}
+		return r
+	}, text)
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		line = repeatedSpacesRegex.ReplaceAllStringFunc(line, func(spaces string) string {
+			if strings.Contains(spaces, "\t") {
+				return "\t"
+			}
+			return " "
+		})
+		lines[i] = strings.TrimSpace(line)
+	}
+	text = strings.Join(lines, "\n")
+	text = blankLinesRegex.ReplaceAllString(text, "\n\n")
+	return strings.TrimSpace(text)
+}
@@ -0,0 +1,84 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_decodeText(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          []byte
+		expectedOutput string
+	}{
+		{
+			name:           "keeps utf-8 text",
+			input:          []byte("José Müller"),
+			expectedOutput: "José Müller",
+		},
+		{
+			name:           "strips utf-8 byte order mark",
+			input:          []byte("\xef\xbb\xbfJosé"),
+			expectedOutput: "José",
+		},
+		{
+			name:           "decodes utf-16 little endian text",
+			input:          []byte("\xff\xfeJ\x00o\x00s\x00\xe9\x00"),
+			expectedOutput: "José",
+		},
+		{
+			name:           "decodes utf-16 big endian text",
+			input:          []byte("\xfe\xff\x00J\x00o\x00s\x00\xe9"),
+			expectedOutput: "José",
+		},
+		{
+			name:           "decodes text that is not utf-8 as windows-1252",
+			input:          []byte("Jos\xe9 \x93quoted\x94"),
+			expectedOutput: "José “quoted”",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := decodeText(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
+
+func Test_normalizeText(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectedOutput string
+	}{
+		{
+			name:           "normalizes line endings",
+			input:          "First Last\r\nEngineer\rBangalore",
+			expectedOutput: "First Last\nEngineer\nBangalore",
+		},
+		{
+			name:           "drops control characters and collapses spaces",
+			input:          "First\x00   Last\x07  Engineer\uFEFF",
+			expectedOutput: "First Last Engineer",
+		},
+		{
+			name:           "keeps a single tab between columns",
+			input:          "Coinbase  \t  2021",
+			expectedOutput: "Coinbase\t2021",
+		},
+		{
+			name:           "trims lines and keeps at most one blank line",
+			input:          "\n\n  First Last  \n\n\n\n  Engineer\n\n",
+			expectedOutput: "First Last\n\nEngineer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedOutput, normalizeText(tt.input))
+		})
+	}
+}
@@ -0,0 +1,330 @@
+package scoring
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+)
+
+type Criterion string
+
+const (
+	RequiredSkills   Criterion = "REQUIRED SKILLS"
+	NiceToHaveSkills Criterion = "NICE TO HAVE SKILLS"
+	YoE              Criterion = "YOE"
+	Location         Criterion = "LOCATION"
+	RecommendedRoles Criterion = "RECOMMENDED ROLES"
+)
+
+// Weights are relative, so only how they compare to each other matters.
+type Weights struct {
+	RequiredSkills   float64
+	NiceToHaveSkills float64
+	YoE              float64
+	Location         float64
+	RecommendedRoles float64
+}
+
+var DefaultWeights = Weights{
+	RequiredSkills:   4,
+	NiceToHaveSkills: 1,
+	YoE:              2,
+	Location:         1.5,
+	RecommendedRoles: 1.5,
+}
+
+func (w Weights) validate() error {
+	weights := []float64{w.RequiredSkills, w.NiceToHaveSkills, w.YoE, w.Location, w.RecommendedRoles}
+	total := 0.0
+	for _, weight := range weights {
+		if weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			return errors.New("weights cannot be negative")
+		}
+		total += weight
+	}
+	if total == 0 {
+		return errors.New("at least one weight needs to be positive")
+	}
+	return nil
+}
+
+// CriterionScore explains how a persona did on one criterion. Score is between 0 and 1.
+type CriterionScore struct {
+	Criterion   Criterion
+	Weight      float64
+	Score       float64
+	Matched     []string
+	Missing     []string
+	Explanation string
+}
+
+// Total is between 0 and 100. Criteria that the job opening does not ask for, such as a location when it has none, are left out of
+// the breakdown and do not count towards the score.
+type Score struct {
+	Total     float64
+	Breakdown []CriterionScore
+}
+
+type CandidateScore struct {
+	Candidate *model.Candidate
+	Score
+}
+
+// RankCandidates scores every candidate against the job opening, best first. Candidates with the same score keep their given order.
+func RankCandidates(jobOpening *model.JobOpening, candidates []*model.Candidate, weights Weights) ([]*CandidateScore, error) {
+	if jobOpening == nil {
+		return nil, errors.New("jobOpening cannot be nil")
+	}
+
+	err := weights.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := []*CandidateScore{}
+	for _, candidate := range candidates {
+		ranked = append(ranked, &CandidateScore{
+			Candidate: candidate,
+			Score:     scorePersona(jobOpening, candidate.Persona(), weights),
+		})
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Total > ranked[j].Total
+	})
+	return ranked, nil
+}
+
+func ScorePersona(jobOpening *model.JobOpening, persona *model.Persona, weights Weights) (*Score, error) {
+	if jobOpening == nil {
+		return nil, errors.New("jobOpening cannot be nil")
+	}
+
+	err := weights.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	score := scorePersona(jobOpening, persona, weights)
+	return &score, nil
+}
+
+func scorePersona(jobOpening *model.JobOpening, persona *model.Persona, weights Weights) Score {
+	if persona == nil {
+		persona = &model.Persona{}
+	}
+
+	breakdown := []CriterionScore{}
+	if len(jobOpening.RequiredSkills()) > 0 && weights.RequiredSkills > 0 {
+		breakdown = append(breakdown, skillsScore(RequiredSkills, weights.RequiredSkills, jobOpening.RequiredSkills(), persona.TechSkills))
+	}
+	if len(jobOpening.NiceToHaveSkills()) > 0 && weights.NiceToHaveSkills > 0 {
+		breakdown = append(breakdown, skillsScore(NiceToHaveSkills, weights.NiceToHaveSkills, jobOpening.NiceToHaveSkills(), persona.TechSkills))
+	}
+	if jobOpening.MinYoE() > 0 && weights.YoE > 0 {
+		breakdown = append(breakdown, yoeScore(weights.YoE, jobOpening.MinYoE(), persona.YoE))
+	}
+	if normalizeLocation(jobOpening.Location()) != "" && weights.Location > 0 {
+		breakdown = append(breakdown, locationScore(weights.Location, jobOpening.Location(), persona))
+	}
+	if weights.RecommendedRoles > 0 {
+		breakdown = append(breakdown, rolesScore(weights.RecommendedRoles, jobOpening.Title(), persona.RecommendedRoles))
+	}
+
+	totalWeight, weightedScore := 0.0, 0.0
+	for _, criterionScore := range breakdown {
+		totalWeight += criterionScore.Weight
+		weightedScore += criterionScore.Weight * criterionScore.Score
+	}
+	total := 0.0
+	if totalWeight > 0 {
+		total = roundScore(100 * weightedScore / totalWeight)
+	}
+	return Score{Total: total, Breakdown: breakdown}
+}
+
+func skillsScore(criterion Criterion, weight float64, jobSkills, personaSkills []string) CriterionScore {
+	personaSkillSet := map[string]bool{}
+	for _, skill := range personaSkills {
+		personaSkillSet[NormalizeSkill(skill)] = true
+	}
+
+	matched, missing := []string{}, []string{}
+	for _, skill := range jobSkills {
+		if personaSkillSet[NormalizeSkill(skill)] {
+			matched = append(matched, skill)
+		} else {
+			missing = append(missing, skill)
+		}
+	}
+	return CriterionScore{
+		Criterion:   criterion,
+		Weight:      weight,
+		Score:       roundScore(float64(len(matched)) / float64(len(jobSkills))),
+		Matched:     matched,
+		Missing:     missing,
+		Explanation: fmt.Sprintf("has %d of %d skills", len(matched), len(jobSkills)),
+	}
+}
+
+// yoeScore gives partial credit to candidates short of the minimum, in proportion to the years they have.
+func yoeScore(weight float64, minYoE, yoe int) CriterionScore {
+	score := 1.0
+	if yoe <= 0 {
+		score = 0
+	} else if yoe < minYoE {
+		score = roundScore(float64(yoe) / float64(minYoE))
+	}
+	return CriterionScore{
+		Criterion:   YoE,
+		Weight:      weight,
+		Score:       score,
+		Explanation: fmt.Sprintf("has %d of the %d years needed", yoe, minYoE),
+	}
+}
+
+// locationScore matches the city, state or country of the persona against the comma separated parts of the job location,
+// so "Bangalore, India" fully matches candidates in Bangalore and partly matches anyone else in India.
+// Remote job openings match every candidate.
+func locationScore(weight float64, jobLocation string, persona *model.Persona) CriterionScore {
+	criterionScore := CriterionScore{
+		Criterion:   Location,
+		Weight:      weight,
+		Explanation: "location does not match",
+	}
+
+	parts := map[string]bool{}
+	for _, part := range strings.Split(jobLocation, ",") {
+		parts[normalizeLocation(part)] = true
+	}
+	if parts["remote"] {
+		criterionScore.Score = 1
+		criterionScore.Explanation = "job opening is remote"
+		return criterionScore
+	}
+
+	matches := []struct {
+		place       string
+		score       float64
+		explanation string
+	}{
+		{place: persona.City, score: 1, explanation: "city matches"},
+		{place: persona.State, score: 0.75, explanation: "state matches"},
+		{place: persona.Country, score: 0.5, explanation: "country matches"},
+	}
+	for _, match := range matches {
+		place := normalizeLocation(match.place)
+		if place != "" && parts[place] {
+			criterionScore.Score = match.score
+			criterionScore.Matched = []string{match.place}
+			criterionScore.Explanation = match.explanation
+			return criterionScore
+		}
+	}
+	return criterionScore
+}
+
+// rolesScore is the share of the words of the job title found in the closest recommended role,
+// so "Software Engineer" covers two thirds of "Senior Software Engineer".
+func rolesScore(weight float64, jobTitle string, roles []string) CriterionScore {
+	criterionScore := CriterionScore{
+		Criterion:   RecommendedRoles,
+		Weight:      weight,
+		Explanation: "no recommended role matches the title",
+	}
+
+	titleWords := roleWords(jobTitle)
+	if len(titleWords) == 0 {
+		return criterionScore
+	}
+
+	for _, role := range roles {
+		words := map[string]bool{}
+		for _, word := range roleWords(role) {
+			words[word] = true
+		}
+		common := 0
+		for _, word := range titleWords {
+			if words[word] {
+				common++
+			}
+		}
+		score := roundScore(float64(common) / float64(len(titleWords)))
+		if score > criterionScore.Score {
+			criterionScore.Score = score
+			criterionScore.Matched = []string{role}
+			criterionScore.Explanation = fmt.Sprintf("closest recommended role is %s", role)
+		}
+	}
+	return criterionScore
+}
+
+var skillAliases = map[string]string{
+	"golang": "go",
+	"js":     "javascript",
+	"ts":     "typescript",
+	"k8s":    "kubernetes",
+	"react":  "reactjs",
+	"node":   "nodejs",
+}
+
+// NormalizeSkill lowercases the skill and drops spaces and punctuation other than + and #, so that "React JS" and "ReactJS" match
+// while "C", "C++" and "C#" stay different.
+func NormalizeSkill(skill string) string {
+	normalized := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '+' || r == '#' {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, skill)
+	if alias, ok := skillAliases[normalized]; ok {
+		return alias
+	}
+	return normalized
+}
+
+var locationAliases = map[string]string{
+	"usa":       "united states",
+	"us":        "united states",
+	"uk":        "united kingdom",
+	"uae":       "united arab emirates",
+	"bengaluru": "bangalore",
+}
+
+func normalizeLocation(location string) string {
+	normalized := strings.Join(strings.FieldsFunc(strings.ToLower(location), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+	if alias, ok := locationAliases[normalized]; ok {
+		return alias
+	}
+	return normalized
+}
+
+var roleWordAliases = map[string]string{
+	"sr":  "senior",
+	"jr":  "junior",
+	"eng": "engineer",
+	"dev": "developer",
+	"mgr": "manager",
+}
+
+func roleWords(role string) []string {
+	words := strings.FieldsFunc(strings.ToLower(role), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, word := range words {
+		if alias, ok := roleWordAliases[word]; ok {
+			words[i] = alias
+		}
+	}
+	return words
+}
+
+func roundScore(score float64) float64 {
+	return math.Round(score*100) / 100
+}
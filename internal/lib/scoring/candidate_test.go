@@ -0,0 +1,316 @@
+package scoring
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/clients/openai"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/lib/parser/personabuilder"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+)
+
+func Test_ScorePersona(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	backendOpening, _ := model.NewJobOpening(model.JobOpeningOptions{
+		Id:               "jo_id1",
+		Title:            "Senior Backend Engineer",
+		RequiredSkills:   []string{"Go", "PostgreSQL", "Kubernetes"},
+		NiceToHaveSkills: []string{"gRPC"},
+		Location:         "Bangalore, India",
+		MinYoE:           4,
+		Team:             team,
+	})
+	titleOnlyOpening, _ := model.NewJobOpening(model.JobOpeningOptions{
+		Id:    "jo_id2",
+		Title: "Designer",
+		Team:  team,
+	})
+	remoteOpening, _ := model.NewJobOpening(model.JobOpeningOptions{
+		Id:       "jo_id3",
+		Title:    "Designer",
+		Location: "Remote",
+		Team:     team,
+	})
+
+	tests := []struct {
+		name  string
+		input struct {
+			jobOpening *model.JobOpening
+			persona    *model.Persona
+			weights    Weights
+		}
+		output        *Score
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when job opening is nil",
+			input: struct {
+				jobOpening *model.JobOpening
+				persona    *model.Persona
+				weights    Weights
+			}{
+				persona: &model.Persona{Name: "Jane"},
+				weights: DefaultWeights,
+			},
+			errorExpected: true,
+			errorString:   "jobOpening cannot be nil",
+		},
+		{
+			name: "errors when a weight is negative",
+			input: struct {
+				jobOpening *model.JobOpening
+				persona    *model.Persona
+				weights    Weights
+			}{
+				jobOpening: backendOpening,
+				weights:    Weights{RequiredSkills: 1, YoE: -1},
+			},
+			errorExpected: true,
+			errorString:   "weights cannot be negative",
+		},
+		{
+			name: "errors when every weight is zero",
+			input: struct {
+				jobOpening *model.JobOpening
+				persona    *model.Persona
+				weights    Weights
+			}{
+				jobOpening: backendOpening,
+				weights:    Weights{},
+			},
+			errorExpected: true,
+			errorString:   "at least one weight needs to be positive",
+		},
+		{
+			name: "scores a persona on every criterion",
+			input: struct {
+				jobOpening *model.JobOpening
+				persona    *model.Persona
+				weights    Weights
+			}{
+				jobOpening: backendOpening,
+				persona: &model.Persona{
+					Name:             "Jane",
+					City:             "Mumbai",
+					Country:          "India",
+					YoE:              3,
+					TechSkills:       []string{"Golang", "postgresql", "Docker"},
+					RecommendedRoles: []string{"Backend Developer", "Sr. Backend Engineer"},
+				},
+				weights: Weights{RequiredSkills: 3, NiceToHaveSkills: 1, YoE: 2, Location: 2, RecommendedRoles: 2},
+			},
+			output: &Score{
+				Total: 65.1,
+				Breakdown: []CriterionScore{
+					{Criterion: RequiredSkills, Weight: 3, Score: 0.67, Matched: []string{"Go", "PostgreSQL"}, Missing: []string{"Kubernetes"}, Explanation: "has 2 of 3 skills"},
+					{Criterion: NiceToHaveSkills, Weight: 1, Score: 0, Matched: []string{}, Missing: []string{"gRPC"}, Explanation: "has 0 of 1 skills"},
+					{Criterion: YoE, Weight: 2, Score: 0.75, Explanation: "has 3 of the 4 years needed"},
+					{Criterion: Location, Weight: 2, Score: 0.5, Matched: []string{"India"}, Explanation: "country matches"},
+					{Criterion: RecommendedRoles, Weight: 2, Score: 1, Matched: []string{"Sr. Backend Engineer"}, Explanation: "closest recommended role is Sr. Backend Engineer"},
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "leaves out criteria the job opening does not ask for",
+			input: struct {
+				jobOpening *model.JobOpening
+				persona    *model.Persona
+				weights    Weights
+			}{
+				jobOpening: titleOnlyOpening,
+				persona:    &model.Persona{Name: "Jane", RecommendedRoles: []string{"Product Designer"}},
+				weights:    DefaultWeights,
+			},
+			output: &Score{
+				Total: 100,
+				Breakdown: []CriterionScore{
+					{Criterion: RecommendedRoles, Weight: 1.5, Score: 1, Matched: []string{"Product Designer"}, Explanation: "closest recommended role is Product Designer"},
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "leaves out criteria with no weight",
+			input: struct {
+				jobOpening *model.JobOpening
+				persona    *model.Persona
+				weights    Weights
+			}{
+				jobOpening: backendOpening,
+				persona:    &model.Persona{Name: "Jane", YoE: 8},
+				weights:    Weights{YoE: 1},
+			},
+			output: &Score{
+				Total: 100,
+				Breakdown: []CriterionScore{
+					{Criterion: YoE, Weight: 1, Score: 1, Explanation: "has 8 of the 4 years needed"},
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "matches every candidate for a remote job opening",
+			input: struct {
+				jobOpening *model.JobOpening
+				persona    *model.Persona
+				weights    Weights
+			}{
+				jobOpening: remoteOpening,
+				persona:    nil,
+				weights:    Weights{Location: 1},
+			},
+			output: &Score{
+				Total: 100,
+				Breakdown: []CriterionScore{
+					{Criterion: Location, Weight: 1, Score: 1, Explanation: "job opening is remote"},
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, err := ScorePersona(tt.input.jobOpening, tt.input.persona, tt.input.weights)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			assert.Equal(t, tt.output, score)
+		})
+	}
+}
+
+func Test_RankCandidates(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+
+	fileContent, err := os.ReadFile("../parser/personabuilder/persona_testcase_inputs.txt")
+	assert.NoError(t, err)
+	candidates := []*model.Candidate{}
+	for i, testInput := range strings.Split(string(fileContent), "***") {
+		persona, err := personabuilder.Build(testInput, &openai.MockClientSuccess{Text: testInput})
+		assert.NoError(t, err)
+		persona.FileUploadId = fmt.Sprintf("fp_id%d", i+1)
+		candidate, err := model.NewCandidate(model.CandidateOptions{
+			Id:                 fmt.Sprintf("c_id%d", i+1),
+			Team:               team,
+			AiGeneratedPersona: persona,
+			FileUploadId:       persona.FileUploadId,
+		})
+		assert.NoError(t, err)
+		candidates = append(candidates, candidate)
+	}
+
+	engineerOpening, _ := model.NewJobOpening(model.JobOpeningOptions{
+		Id:               "jo_id1",
+		Title:            "Senior Software Engineer",
+		RequiredSkills:   []string{"Go", "TypeScript", "Node.js"},
+		NiceToHaveSkills: []string{"React", "AWS"},
+		Location:         "San Francisco, United States",
+		MinYoE:           5,
+		Team:             team,
+	})
+	marketingOpening, _ := model.NewJobOpening(model.JobOpeningOptions{
+		Id:             "jo_id2",
+		Title:          "Marketing Manager",
+		RequiredSkills: []string{"Marketing Strategy", "Digital Marketing"},
+		Location:       "Mumbai, India",
+		MinYoE:         8,
+		Team:           team,
+	})
+
+	tests := []struct {
+		name          string
+		input         *model.JobOpening
+		output        []string
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name:          "errors when job opening is nil",
+			input:         nil,
+			output:        nil,
+			errorExpected: true,
+			errorString:   "jobOpening cannot be nil",
+		},
+		{
+			name:          "ranks software engineers first for an engineering job opening",
+			input:         engineerOpening,
+			output:        []string{"c_id6", "c_id1", "c_id12", "c_id3"},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name:          "ranks marketers first for a marketing job opening",
+			input:         marketingOpening,
+			output:        []string{"c_id2", "c_id8"},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranked, err := RankCandidates(tt.input, candidates, DefaultWeights)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Len(t, ranked, len(candidates))
+				for i, id := range tt.output {
+					assert.Equal(t, id, ranked[i].Candidate.Id())
+				}
+				for i := 1; i < len(ranked); i++ {
+					assert.GreaterOrEqual(t, ranked[i-1].Total, ranked[i].Total)
+				}
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+				assert.Nil(t, ranked)
+			}
+		})
+	}
+}
+
+func Test_NormalizeSkill(t *testing.T) {
+	tests := []struct {
+		input  string
+		output string
+	}{
+		{input: "React JS", output: "reactjs"},
+		{input: "ReactJS", output: "reactjs"},
+		{input: "React", output: "reactjs"},
+		{input: "Node.js", output: "nodejs"},
+		{input: "Golang", output: "go"},
+		{input: "C++", output: "c++"},
+		{input: "C#", output: "c#"},
+		{input: " ", output: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.output, NormalizeSkill(tt.input))
+		})
+	}
+}
@@ -17,6 +17,8 @@ type Candidate struct {
 	manuallyCreatedPersona *Persona
 	team                   *Team
 	fileUploadId           string
+	archivedAt             time.Time
+	pipelineStageId        string
 }
 
 type CandidateOptions struct {
@@ -27,6 +29,8 @@ type CandidateOptions struct {
 	ManuallyCreatedPersona *Persona
 	Team                   *Team
 	FileUploadId           string
+	ArchivedAt             time.Time
+	PipelineStageId        string
 }
 
 func NewCandidate(opts CandidateOptions) (*Candidate, error) {
@@ -59,6 +63,8 @@ func NewCandidate(opts CandidateOptions) (*Candidate, error) {
 		manuallyCreatedPersona: manuallyCreatedPersona,
 		team:                   opts.Team,
 		fileUploadId:           opts.FileUploadId,
+		archivedAt:             opts.ArchivedAt,
+		pipelineStageId:        opts.PipelineStageId,
 	}
 	return &candidate, nil
 }
@@ -97,10 +103,36 @@ func (c *Candidate) FileUploadId() string {
 	return c.fileUploadId
 }
 
+// Persona is the manually created persona when there is one, otherwise the ai generated persona.
+func (c *Candidate) Persona() *Persona {
+	if c.manuallyCreatedPersona != nil {
+		return c.manuallyCreatedPersona
+	}
+	return c.aiGeneratedPersona
+}
+
+func (c *Candidate) CreatedAt() time.Time {
+	return c.createdAt
+}
+
 func (c *Candidate) UpdatedAt() time.Time {
 	return c.updatedAt
 }
 
+// ArchivedAt is the zero time for candidates that are not archived.
+func (c *Candidate) ArchivedAt() time.Time {
+	return c.archivedAt
+}
+
+func (c *Candidate) IsArchived() bool {
+	return !c.archivedAt.IsZero()
+}
+
+// PipelineStageId is blank for candidates that have not been moved into a pipeline stage yet.
+func (c *Candidate) PipelineStageId() string {
+	return c.pipelineStageId
+}
+
 func (c *Candidate) IsEqual(other *Candidate) bool {
 	fmt.Println(c.manuallyCreatedPersona)
 	fmt.Println(other.manuallyCreatedPersona)
@@ -108,5 +140,7 @@ func (c *Candidate) IsEqual(other *Candidate) bool {
 		c.aiGeneratedPersona.IsEqual(other.aiGeneratedPersona) &&
 		c.manuallyCreatedPersona.IsEqual(other.manuallyCreatedPersona) &&
 		c.team == other.team &&
-		c.fileUploadId == other.fileUploadId
+		c.fileUploadId == other.fileUploadId &&
+		c.archivedAt.Equal(other.archivedAt) &&
+		c.pipelineStageId == other.pipelineStageId
 }
@@ -0,0 +1,197 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+// CandidateFilter is matched against the effective persona of a candidate.
+// The manually created persona, when present, overrides the AI generated one.
+type CandidateFilter struct {
+	techSkills       []string
+	minYoE           *int
+	maxYoE           *int
+	city             string
+	state            string
+	country          string
+	recommendedRole  string
+	certification    string
+	builtBy          string
+	pipelineStageIds []string
+	anyTagIds        []string
+	allTagIds        []string
+	includeArchived  bool
+	sorts            []CandidateSort
+}
+
+type CandidateSort struct {
+	field      candidateSortField
+	descending bool
+}
+
+type CandidateFilterOptions struct {
+	TechSkills       []string
+	MinYoE           *int
+	MaxYoE           *int
+	City             string
+	State            string
+	Country          string
+	RecommendedRole  string
+	Certification    string
+	BuiltBy          string
+	PipelineStageIds []string
+	AnyTagIds        []string
+	AllTagIds        []string
+	IncludeArchived  bool
+	Sorts            []CandidateSortOptions
+}
+
+type CandidateSortOptions struct {
+	Field      string
+	Descending bool
+}
+
+func NewCandidateFilter(opts CandidateFilterOptions) (*CandidateFilter, error) {
+	if opts.MinYoE != nil && *opts.MinYoE < 0 {
+		return nil, errors.New("cannot create CandidateFilter with a negative min YoE")
+	}
+
+	if opts.MaxYoE != nil && *opts.MaxYoE < 0 {
+		return nil, errors.New("cannot create CandidateFilter with a negative max YoE")
+	}
+
+	if opts.MinYoE != nil && opts.MaxYoE != nil && *opts.MinYoE > *opts.MaxYoE {
+		return nil, errors.New("cannot create CandidateFilter with min YoE greater than max YoE")
+	}
+
+	techSkills := []string{}
+	for _, techSkill := range opts.TechSkills {
+		if !utilities.IsBlank(techSkill) {
+			techSkills = append(techSkills, strings.TrimSpace(techSkill))
+		}
+	}
+
+	pipelineStageIds := []string{}
+	for _, pipelineStageId := range opts.PipelineStageIds {
+		if !utilities.IsBlank(pipelineStageId) {
+			pipelineStageIds = append(pipelineStageIds, strings.TrimSpace(pipelineStageId))
+		}
+	}
+
+	anyTagIds := uniqueNonBlankIds(opts.AnyTagIds)
+	allTagIds := uniqueNonBlankIds(opts.AllTagIds)
+
+	sorts := []CandidateSort{}
+	for _, sortOpts := range opts.Sorts {
+		field := CandidateSortField(sortOpts.Field)
+		if !field.Valid() {
+			return nil, errors.Errorf("cannot create CandidateFilter with an invalid sort field: %s", sortOpts.Field)
+		}
+		sorts = append(sorts, CandidateSort{
+			field:      field,
+			descending: sortOpts.Descending,
+		})
+	}
+
+	return &CandidateFilter{
+		techSkills:       techSkills,
+		minYoE:           opts.MinYoE,
+		maxYoE:           opts.MaxYoE,
+		city:             strings.TrimSpace(opts.City),
+		state:            strings.TrimSpace(opts.State),
+		country:          strings.TrimSpace(opts.Country),
+		recommendedRole:  strings.TrimSpace(opts.RecommendedRole),
+		certification:    strings.TrimSpace(opts.Certification),
+		builtBy:          strings.TrimSpace(opts.BuiltBy),
+		pipelineStageIds: pipelineStageIds,
+		anyTagIds:        anyTagIds,
+		allTagIds:        allTagIds,
+		includeArchived:  opts.IncludeArchived,
+		sorts:            sorts,
+	}, nil
+}
+
+func (f *CandidateFilter) TechSkills() []string {
+	return f.techSkills
+}
+
+func (f *CandidateFilter) MinYoE() *int {
+	return f.minYoE
+}
+
+func (f *CandidateFilter) MaxYoE() *int {
+	return f.maxYoE
+}
+
+func (f *CandidateFilter) City() string {
+	return f.city
+}
+
+func (f *CandidateFilter) State() string {
+	return f.state
+}
+
+func (f *CandidateFilter) Country() string {
+	return f.country
+}
+
+func (f *CandidateFilter) RecommendedRole() string {
+	return f.recommendedRole
+}
+
+func (f *CandidateFilter) Certification() string {
+	return f.certification
+}
+
+func (f *CandidateFilter) BuiltBy() string {
+	return f.builtBy
+}
+
+// PipelineStageIds matches candidates in any of the stages.
+func (f *CandidateFilter) PipelineStageIds() []string {
+	return f.pipelineStageIds
+}
+
+// AnyTagIds matches candidates with at least one of the tags.
+func (f *CandidateFilter) AnyTagIds() []string {
+	return f.anyTagIds
+}
+
+// AllTagIds matches candidates with every one of the tags.
+func (f *CandidateFilter) AllTagIds() []string {
+	return f.allTagIds
+}
+
+// IncludeArchived is false by default, which hides archived candidates.
+func (f *CandidateFilter) IncludeArchived() bool {
+	return f.includeArchived
+}
+
+func (f *CandidateFilter) Sorts() []CandidateSort {
+	return f.sorts
+}
+
+func (s CandidateSort) Field() string {
+	return s.field.String()
+}
+
+func (s CandidateSort) Descending() bool {
+	return s.descending
+}
+
+// uniqueNonBlankIds keeps the first occurrence of each id, since the all tags filter compares counts.
+func uniqueNonBlankIds(ids []string) []string {
+	seen := map[string]bool{}
+	uniqueIds := []string{}
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		uniqueIds = append(uniqueIds, id)
+	}
+	return uniqueIds
+}
@@ -0,0 +1,134 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewCandidateFilter(t *testing.T) {
+	negative := -1
+	two := 2
+	five := 5
+	tests := []struct {
+		name           string
+		input          CandidateFilterOptions
+		expectedOutput *CandidateFilter
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name: "min YoE is negative",
+			input: CandidateFilterOptions{
+				MinYoE: &negative,
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create CandidateFilter with a negative min YoE",
+		},
+		{
+			name: "max YoE is negative",
+			input: CandidateFilterOptions{
+				MaxYoE: &negative,
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create CandidateFilter with a negative max YoE",
+		},
+		{
+			name: "min YoE is greater than max YoE",
+			input: CandidateFilterOptions{
+				MinYoE: &five,
+				MaxYoE: &two,
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create CandidateFilter with min YoE greater than max YoE",
+		},
+		{
+			name: "sort field is invalid",
+			input: CandidateFilterOptions{
+				Sorts: []CandidateSortOptions{{Field: "EMAIL"}},
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create CandidateFilter with an invalid sort field: EMAIL",
+		},
+		{
+			name:  "CandidateFilter gets created successfully when empty",
+			input: CandidateFilterOptions{},
+			expectedOutput: &CandidateFilter{
+				techSkills:       []string{},
+				pipelineStageIds: []string{},
+				anyTagIds:        []string{},
+				allTagIds:        []string{},
+				sorts:            []CandidateSort{},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "CandidateFilter gets created successfully",
+			input: CandidateFilterOptions{
+				TechSkills:       []string{" Go ", "", "  ", "React"},
+				MinYoE:           &two,
+				MaxYoE:           &five,
+				City:             " Mumbai ",
+				State:            "Maharashtra",
+				Country:          "India",
+				RecommendedRole:  "engineer",
+				Certification:    "AWS",
+				BuiltBy:          "AI",
+				PipelineStageIds: []string{" ps_id1 ", "", "ps_id2"},
+				AnyTagIds:        []string{"tag_id1", " ", "tag_id2"},
+				AllTagIds:        []string{"tag_id3", " tag_id3 ", "tag_id4"},
+				IncludeArchived:  true,
+				Sorts: []CandidateSortOptions{
+					{Field: "YOE", Descending: true},
+					{Field: "NAME"},
+				},
+			},
+			expectedOutput: &CandidateFilter{
+				techSkills:       []string{"Go", "React"},
+				minYoE:           &two,
+				maxYoE:           &five,
+				city:             "Mumbai",
+				state:            "Maharashtra",
+				country:          "India",
+				recommendedRole:  "engineer",
+				certification:    "AWS",
+				builtBy:          "AI",
+				pipelineStageIds: []string{"ps_id1", "ps_id2"},
+				anyTagIds:        []string{"tag_id1", "tag_id2"},
+				allTagIds:        []string{"tag_id3", "tag_id4"},
+				includeArchived:  true,
+				sorts: []CandidateSort{
+					{field: sortByYoE, descending: true},
+					{field: sortByName, descending: false},
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewCandidateFilter(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
+
+func Test_CandidateSort(t *testing.T) {
+	t.Run("returns Field and Descending", func(t *testing.T) {
+		sort := CandidateSort{field: sortByUpdatedAt, descending: true}
+		assert.Equal(t, "UPDATED_AT", sort.Field())
+		assert.True(t, sort.Descending())
+	})
+}
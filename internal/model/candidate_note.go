@@ -0,0 +1,82 @@
+package model
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+// CandidateNote is a note left on a candidate by a member of the team.
+// The author id is blank when the author has since been deleted.
+type CandidateNote struct {
+	id              string
+	candidateId     string
+	authorUserId    string
+	authorUserEmail string
+	body            string
+	createdAt       time.Time
+	updatedAt       time.Time
+}
+
+type CandidateNoteOptions struct {
+	Id              string
+	CandidateId     string
+	AuthorUserId    string
+	AuthorUserEmail string
+	Body            string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func NewCandidateNote(opts CandidateNoteOptions) (*CandidateNote, error) {
+	if utilities.IsBlank(opts.Id) {
+		return nil, errors.New("cannot create CandidateNote with an empty id")
+	}
+
+	if utilities.IsBlank(opts.CandidateId) {
+		return nil, errors.New("cannot create CandidateNote with an empty candidate id")
+	}
+
+	if utilities.IsBlank(opts.Body) {
+		return nil, errors.New("cannot create CandidateNote with an empty body")
+	}
+
+	return &CandidateNote{
+		id:              opts.Id,
+		candidateId:     opts.CandidateId,
+		authorUserId:    opts.AuthorUserId,
+		authorUserEmail: opts.AuthorUserEmail,
+		body:            opts.Body,
+		createdAt:       opts.CreatedAt,
+		updatedAt:       opts.UpdatedAt,
+	}, nil
+}
+
+func (c *CandidateNote) Id() string {
+	return c.id
+}
+
+func (c *CandidateNote) CandidateId() string {
+	return c.candidateId
+}
+
+func (c *CandidateNote) AuthorUserId() string {
+	return c.authorUserId
+}
+
+func (c *CandidateNote) AuthorUserEmail() string {
+	return c.authorUserEmail
+}
+
+func (c *CandidateNote) Body() string {
+	return c.body
+}
+
+func (c *CandidateNote) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *CandidateNote) UpdatedAt() time.Time {
+	return c.updatedAt
+}
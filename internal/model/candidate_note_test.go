@@ -0,0 +1,83 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewCandidateNote(t *testing.T) {
+	createdAt := time.Date(2023, 4, 5, 6, 7, 8, 0, time.UTC)
+	updatedAt := time.Date(2023, 4, 6, 6, 7, 8, 0, time.UTC)
+	tests := []struct {
+		name           string
+		input          CandidateNoteOptions
+		expectedOutput *CandidateNote
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "id is empty",
+			input:          CandidateNoteOptions{},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create CandidateNote with an empty id",
+		},
+		{
+			name: "candidate id is empty",
+			input: CandidateNoteOptions{
+				Id: "cn_id1",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create CandidateNote with an empty candidate id",
+		},
+		{
+			name: "body is empty",
+			input: CandidateNoteOptions{
+				Id:          "cn_id1",
+				CandidateId: "c_id1",
+				Body:        "  ",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create CandidateNote with an empty body",
+		},
+		{
+			name: "CandidateNote gets created successfully",
+			input: CandidateNoteOptions{
+				Id:              "cn_id1",
+				CandidateId:     "c_id1",
+				AuthorUserId:    "user_id1",
+				AuthorUserEmail: "user1@example.com",
+				Body:            "Strong systems design round",
+				CreatedAt:       createdAt,
+				UpdatedAt:       updatedAt,
+			},
+			expectedOutput: &CandidateNote{
+				id:              "cn_id1",
+				candidateId:     "c_id1",
+				authorUserId:    "user_id1",
+				authorUserEmail: "user1@example.com",
+				body:            "Strong systems design round",
+				createdAt:       createdAt,
+				updatedAt:       updatedAt,
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewCandidateNote(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+// CandidatePersonaRevision is a persona as it was written to a candidate, either by AI or by a member of the team.
+// Revisions are never changed, so together they are the edit history of the candidate.
+// The editor id is blank for personas built by AI, and when the editor has since been deleted.
+type CandidatePersonaRevision struct {
+	id                     string
+	candidateId            string
+	persona                *Persona
+	editedByUserId         string
+	editedByUserEmail      string
+	revertedFromRevisionId string
+	createdAt              time.Time
+}
+
+type CandidatePersonaRevisionOptions struct {
+	Id                     string
+	CandidateId            string
+	Persona                *Persona
+	EditedByUserId         string
+	EditedByUserEmail      string
+	RevertedFromRevisionId string
+	CreatedAt              time.Time
+}
+
+func NewCandidatePersonaRevision(opts CandidatePersonaRevisionOptions) (*CandidatePersonaRevision, error) {
+	if utilities.IsBlank(opts.Id) {
+		return nil, errors.New("cannot create CandidatePersonaRevision with an empty id")
+	}
+
+	if utilities.IsBlank(opts.CandidateId) {
+		return nil, errors.New("cannot create CandidatePersonaRevision with an empty candidate id")
+	}
+
+	if opts.Persona == nil {
+		return nil, errors.New("cannot create CandidatePersonaRevision with a nil persona")
+	}
+
+	return &CandidatePersonaRevision{
+		id:                     opts.Id,
+		candidateId:            opts.CandidateId,
+		persona:                opts.Persona,
+		editedByUserId:         opts.EditedByUserId,
+		editedByUserEmail:      opts.EditedByUserEmail,
+		revertedFromRevisionId: opts.RevertedFromRevisionId,
+		createdAt:              opts.CreatedAt,
+	}, nil
+}
+
+func (c *CandidatePersonaRevision) Id() string {
+	return c.id
+}
+
+func (c *CandidatePersonaRevision) CandidateId() string {
+	return c.candidateId
+}
+
+func (c *CandidatePersonaRevision) Persona() *Persona {
+	return c.persona
+}
+
+func (c *CandidatePersonaRevision) PersonaAsJsonString() string {
+	jsonString, err := json.Marshal(c.persona)
+	if err != nil {
+		return ""
+	}
+
+	return string(jsonString)
+}
+
+func (c *CandidatePersonaRevision) BuiltBy() string {
+	return c.persona.BuiltBy
+}
+
+func (c *CandidatePersonaRevision) BuilderVersion() string {
+	return c.persona.BuilderVersion
+}
+
+func (c *CandidatePersonaRevision) EditedByUserId() string {
+	return c.editedByUserId
+}
+
+func (c *CandidatePersonaRevision) EditedByUserEmail() string {
+	return c.editedByUserEmail
+}
+
+// RevertedFromRevisionId is set when the revision was created by reverting to an earlier one.
+func (c *CandidatePersonaRevision) RevertedFromRevisionId() string {
+	return c.revertedFromRevisionId
+}
+
+func (c *CandidatePersonaRevision) CreatedAt() time.Time {
+	return c.createdAt
+}
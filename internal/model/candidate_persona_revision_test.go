@@ -0,0 +1,85 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewCandidatePersonaRevision(t *testing.T) {
+	createdAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	persona := &Persona{Name: "user_1", BuiltBy: "AI", BuilderVersion: "v2", FileUploadId: "fp_id1"}
+	tests := []struct {
+		name           string
+		input          CandidatePersonaRevisionOptions
+		expectedOutput *CandidatePersonaRevision
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "id is empty",
+			input:          CandidatePersonaRevisionOptions{},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create CandidatePersonaRevision with an empty id",
+		},
+		{
+			name: "candidate id is empty",
+			input: CandidatePersonaRevisionOptions{
+				Id: "pr_id1",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create CandidatePersonaRevision with an empty candidate id",
+		},
+		{
+			name: "persona is nil",
+			input: CandidatePersonaRevisionOptions{
+				Id:          "pr_id1",
+				CandidateId: "c_id1",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create CandidatePersonaRevision with a nil persona",
+		},
+		{
+			name: "CandidatePersonaRevision gets created successfully",
+			input: CandidatePersonaRevisionOptions{
+				Id:                     "pr_id2",
+				CandidateId:            "c_id1",
+				Persona:                persona,
+				EditedByUserId:         "user_id1",
+				EditedByUserEmail:      "test@example.com",
+				RevertedFromRevisionId: "pr_id1",
+				CreatedAt:              createdAt,
+			},
+			expectedOutput: &CandidatePersonaRevision{
+				id:                     "pr_id2",
+				candidateId:            "c_id1",
+				persona:                persona,
+				editedByUserId:         "user_id1",
+				editedByUserEmail:      "test@example.com",
+				revertedFromRevisionId: "pr_id1",
+				createdAt:              createdAt,
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewCandidatePersonaRevision(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "AI", result.BuiltBy())
+				assert.Equal(t, "v2", result.BuilderVersion())
+				assert.Equal(t, `{"Name":"user_1","BuilderVersion":"v2","BuiltBy":"AI","FileUploadId":"fp_id1"}`, result.PersonaAsJsonString())
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
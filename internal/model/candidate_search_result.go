@@ -0,0 +1,47 @@
+package model
+
+import (
+	"github.com/pkg/errors"
+)
+
+// CandidateSearchResult is a candidate matched by a full text search.
+// The snippet contains the matching parts of the candidate's persona with matches wrapped in <mark></mark>.
+type CandidateSearchResult struct {
+	candidate *Candidate
+	rank      float64
+	snippet   string
+}
+
+type CandidateSearchResultOptions struct {
+	Candidate *Candidate
+	Rank      float64
+	Snippet   string
+}
+
+func NewCandidateSearchResult(opts CandidateSearchResultOptions) (*CandidateSearchResult, error) {
+	if opts.Candidate == nil {
+		return nil, errors.New("cannot create CandidateSearchResult with a nil Candidate")
+	}
+
+	if opts.Rank < 0 {
+		return nil, errors.New("cannot create CandidateSearchResult with a negative rank")
+	}
+
+	return &CandidateSearchResult{
+		candidate: opts.Candidate,
+		rank:      opts.Rank,
+		snippet:   opts.Snippet,
+	}, nil
+}
+
+func (r *CandidateSearchResult) Candidate() *Candidate {
+	return r.candidate
+}
+
+func (r *CandidateSearchResult) Rank() float64 {
+	return r.rank
+}
+
+func (r *CandidateSearchResult) Snippet() string {
+	return r.snippet
+}
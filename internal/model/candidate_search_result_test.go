@@ -0,0 +1,74 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewCandidateSearchResult(t *testing.T) {
+	currentFileCount := 1
+	team, _ := NewTeam(TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	candidate, _ := NewCandidate(CandidateOptions{
+		Id:                     "c_id1",
+		ManuallyCreatedPersona: &Persona{Name: "persona"},
+		Team:                   team,
+	})
+	tests := []struct {
+		name           string
+		input          CandidateSearchResultOptions
+		expectedOutput *CandidateSearchResult
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "has nil Candidate",
+			input:          CandidateSearchResultOptions{},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create CandidateSearchResult with a nil Candidate",
+		},
+		{
+			name: "has negative rank",
+			input: CandidateSearchResultOptions{
+				Candidate: candidate,
+				Rank:      -0.1,
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create CandidateSearchResult with a negative rank",
+		},
+		{
+			name: "CandidateSearchResult gets created successfully",
+			input: CandidateSearchResultOptions{
+				Candidate: candidate,
+				Rank:      0.5,
+				Snippet:   "<mark>persona</mark>",
+			},
+			expectedOutput: &CandidateSearchResult{
+				candidate: candidate,
+				rank:      0.5,
+				snippet:   "<mark>persona</mark>",
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewCandidateSearchResult(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
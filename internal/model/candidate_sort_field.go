@@ -0,0 +1,45 @@
+package model
+
+type candidateSortField int64
+
+const (
+	undefinedCandidateSortField candidateSortField = iota
+	sortByCreatedAt
+	sortByUpdatedAt
+	sortByName
+	sortByYoE
+)
+
+func CandidateSortField(str string) candidateSortField {
+	switch str {
+	case "CREATED_AT":
+		return sortByCreatedAt
+	case "UPDATED_AT":
+		return sortByUpdatedAt
+	case "NAME":
+		return sortByName
+	case "YOE":
+		return sortByYoE
+	default:
+		return undefinedCandidateSortField
+	}
+}
+
+func (f candidateSortField) String() string {
+	switch f {
+	case sortByCreatedAt:
+		return "CREATED_AT"
+	case sortByUpdatedAt:
+		return "UPDATED_AT"
+	case sortByName:
+		return "NAME"
+	case sortByYoE:
+		return "YOE"
+	default:
+		return "UNDEFINED"
+	}
+}
+
+func (f candidateSortField) Valid() bool {
+	return f.String() != "UNDEFINED"
+}
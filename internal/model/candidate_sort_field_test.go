@@ -0,0 +1,98 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CandidateSortField(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectedOutput candidateSortField
+	}{
+		{
+			name:           "creates CREATED_AT candidate sort field",
+			input:          "CREATED_AT",
+			expectedOutput: sortByCreatedAt,
+		},
+		{
+			name:           "creates UPDATED_AT candidate sort field",
+			input:          "UPDATED_AT",
+			expectedOutput: sortByUpdatedAt,
+		},
+		{
+			name:           "creates NAME candidate sort field",
+			input:          "NAME",
+			expectedOutput: sortByName,
+		},
+		{
+			name:           "creates YOE candidate sort field",
+			input:          "YOE",
+			expectedOutput: sortByYoE,
+		},
+		{
+			name:           "handles unknown candidate sort field",
+			input:          "unknown",
+			expectedOutput: undefinedCandidateSortField,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field := CandidateSortField(tt.input)
+			assert.Equal(t, tt.expectedOutput, field)
+		})
+	}
+}
+
+func Test_CandidateSortField_String(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          candidateSortField
+		expectedOutput string
+	}{
+		{
+			name:           "gets CREATED_AT from sortByCreatedAt",
+			input:          sortByCreatedAt,
+			expectedOutput: "CREATED_AT",
+		},
+		{
+			name:           "gets UPDATED_AT from sortByUpdatedAt",
+			input:          sortByUpdatedAt,
+			expectedOutput: "UPDATED_AT",
+		},
+		{
+			name:           "gets NAME from sortByName",
+			input:          sortByName,
+			expectedOutput: "NAME",
+		},
+		{
+			name:           "gets YOE from sortByYoE",
+			input:          sortByYoE,
+			expectedOutput: "YOE",
+		},
+		{
+			name:           "gets UNDEFINED from undefinedCandidateSortField",
+			input:          undefinedCandidateSortField,
+			expectedOutput: "UNDEFINED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedOutput, tt.input.String())
+		})
+	}
+}
+
+func Test_CandidateSortField_Valid(t *testing.T) {
+	t.Run("returns true for a valid candidate sort field", func(t *testing.T) {
+		assert.True(t, sortByName.Valid())
+	})
+
+	t.Run("returns false for a invalid candidate sort field", func(t *testing.T) {
+		assert.False(t, undefinedCandidateSortField.Valid())
+	})
+}
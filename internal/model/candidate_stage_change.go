@@ -0,0 +1,104 @@
+package model
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+// CandidateStageChange records a candidate moving between pipeline stages.
+// Stage names are kept as they were at the time of the change. The stage ids are blank when there was no stage,
+// or when the stage has since been deleted.
+type CandidateStageChange struct {
+	id                 string
+	candidateId        string
+	fromStageId        string
+	fromStageName      string
+	toStageId          string
+	toStageName        string
+	changedByUserId    string
+	changedByUserEmail string
+	reason             string
+	changedAt          time.Time
+}
+
+type CandidateStageChangeOptions struct {
+	Id                 string
+	CandidateId        string
+	FromStageId        string
+	FromStageName      string
+	ToStageId          string
+	ToStageName        string
+	ChangedByUserId    string
+	ChangedByUserEmail string
+	Reason             string
+	ChangedAt          time.Time
+}
+
+func NewCandidateStageChange(opts CandidateStageChangeOptions) (*CandidateStageChange, error) {
+	if utilities.IsBlank(opts.Id) {
+		return nil, errors.New("cannot create CandidateStageChange with an empty id")
+	}
+
+	if utilities.IsBlank(opts.CandidateId) {
+		return nil, errors.New("cannot create CandidateStageChange with an empty candidate id")
+	}
+
+	if utilities.IsBlank(opts.ToStageName) {
+		return nil, errors.New("cannot create CandidateStageChange with an empty to stage name")
+	}
+
+	return &CandidateStageChange{
+		id:                 opts.Id,
+		candidateId:        opts.CandidateId,
+		fromStageId:        opts.FromStageId,
+		fromStageName:      opts.FromStageName,
+		toStageId:          opts.ToStageId,
+		toStageName:        opts.ToStageName,
+		changedByUserId:    opts.ChangedByUserId,
+		changedByUserEmail: opts.ChangedByUserEmail,
+		reason:             opts.Reason,
+		changedAt:          opts.ChangedAt,
+	}, nil
+}
+
+func (c *CandidateStageChange) Id() string {
+	return c.id
+}
+
+func (c *CandidateStageChange) CandidateId() string {
+	return c.candidateId
+}
+
+func (c *CandidateStageChange) FromStageId() string {
+	return c.fromStageId
+}
+
+func (c *CandidateStageChange) FromStageName() string {
+	return c.fromStageName
+}
+
+func (c *CandidateStageChange) ToStageId() string {
+	return c.toStageId
+}
+
+func (c *CandidateStageChange) ToStageName() string {
+	return c.toStageName
+}
+
+func (c *CandidateStageChange) ChangedByUserId() string {
+	return c.changedByUserId
+}
+
+func (c *CandidateStageChange) ChangedByUserEmail() string {
+	return c.changedByUserEmail
+}
+
+func (c *CandidateStageChange) Reason() string {
+	return c.reason
+}
+
+func (c *CandidateStageChange) ChangedAt() time.Time {
+	return c.changedAt
+}
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewCandidateStageChange(t *testing.T) {
+	changedAt := time.Date(2023, 4, 5, 6, 7, 8, 0, time.UTC)
+	tests := []struct {
+		name           string
+		input          CandidateStageChangeOptions
+		expectedOutput *CandidateStageChange
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "id is empty",
+			input:          CandidateStageChangeOptions{},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create CandidateStageChange with an empty id",
+		},
+		{
+			name: "candidate id is empty",
+			input: CandidateStageChangeOptions{
+				Id: "csc_id1",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create CandidateStageChange with an empty candidate id",
+		},
+		{
+			name: "to stage name is empty",
+			input: CandidateStageChangeOptions{
+				Id:          "csc_id1",
+				CandidateId: "c_id1",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create CandidateStageChange with an empty to stage name",
+		},
+		{
+			name: "CandidateStageChange gets created successfully",
+			input: CandidateStageChangeOptions{
+				Id:                 "csc_id1",
+				CandidateId:        "c_id1",
+				FromStageId:        "ps_id1",
+				FromStageName:      "Sourced",
+				ToStageId:          "ps_id2",
+				ToStageName:        "Screening",
+				ChangedByUserId:    "user_id1",
+				ChangedByUserEmail: "user1@example.com",
+				Reason:             "looks promising",
+				ChangedAt:          changedAt,
+			},
+			expectedOutput: &CandidateStageChange{
+				id:                 "csc_id1",
+				candidateId:        "c_id1",
+				fromStageId:        "ps_id1",
+				fromStageName:      "Sourced",
+				toStageId:          "ps_id2",
+				toStageName:        "Screening",
+				changedByUserId:    "user_id1",
+				changedByUserEmail: "user1@example.com",
+				reason:             "looks promising",
+				changedAt:          changedAt,
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewCandidateStageChange(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -192,3 +193,82 @@ func Test_Candidate_ManuallyCreatedPersonaAsJsonString(t *testing.T) {
 		assert.Equal(t, "", candidate.ManuallyCreatedPersonaAsJsonString())
 	})
 }
+
+func Test_Candidate_Persona(t *testing.T) {
+	currentFileCount := 1
+	team, _ := NewTeam(TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	t.Run("returns the manually created persona when present", func(t *testing.T) {
+		candidate := &Candidate{
+			id:                     "c_id1",
+			aiGeneratedPersona:     &Persona{Name: "candidate_1"},
+			manuallyCreatedPersona: &Persona{Name: "candidate 1"},
+			team:                   team,
+			fileUploadId:           "fp_id1",
+		}
+		assert.Equal(t, &Persona{Name: "candidate 1"}, candidate.Persona())
+	})
+
+	t.Run("returns the ai generated persona otherwise", func(t *testing.T) {
+		candidate := &Candidate{
+			id:                 "c_id1",
+			aiGeneratedPersona: &Persona{Name: "candidate_1"},
+			team:               team,
+			fileUploadId:       "fp_id1",
+		}
+		assert.Equal(t, &Persona{Name: "candidate_1"}, candidate.Persona())
+	})
+}
+
+func Test_Candidate_IsArchived(t *testing.T) {
+	currentFileCount := 1
+	team, _ := NewTeam(TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	t.Run("returns true for an archived candidate", func(t *testing.T) {
+		archivedAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+		candidate := &Candidate{
+			id:                 "c_id1",
+			aiGeneratedPersona: &Persona{Name: "candidate_1"},
+			team:               team,
+			archivedAt:         archivedAt,
+		}
+		assert.True(t, candidate.IsArchived())
+		assert.Equal(t, archivedAt, candidate.ArchivedAt())
+	})
+
+	t.Run("returns false otherwise", func(t *testing.T) {
+		candidate := &Candidate{
+			id:                 "c_id1",
+			aiGeneratedPersona: &Persona{Name: "candidate_1"},
+			team:               team,
+		}
+		assert.False(t, candidate.IsArchived())
+	})
+}
+
+func Test_Candidate_PipelineStageId(t *testing.T) {
+	currentFileCount := 1
+	team, _ := NewTeam(TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	t.Run("returns PipelineStageId", func(t *testing.T) {
+		candidate, _ := NewCandidate(CandidateOptions{
+			Id:                 "c_id1",
+			AiGeneratedPersona: &Persona{Name: "candidate_1"},
+			Team:               team,
+			PipelineStageId:    "ps_id1",
+		})
+		assert.Equal(t, "ps_id1", candidate.PipelineStageId())
+	})
+}
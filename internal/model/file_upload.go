@@ -2,27 +2,38 @@ package model
 
 import (
 	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
 )
 
 type FileUpload struct {
-	id               string
-	name             string
-	presignedUrl     string
-	processingStatus fileUploadProcessingStatus
-	status           fileUploadStatus
-	team             *Team
+	id                     string
+	name                   string
+	presignedUrl           string
+	processingStatus       fileUploadProcessingStatus
+	status                 fileUploadStatus
+	team                   *Team
+	failure                *FileUploadFailure
+	parentFileUploadId     string
+	contentHash            string
+	duplicateOfCandidateId string
+	mergedIntoCandidateId  string
 }
 
 type FileUploadOptions struct {
-	Id               string
-	Name             string
-	PresignedUrl     string
-	ProcessingStatus string
-	Status           string
-	Team             *Team
+	Id                     string
+	Name                   string
+	PresignedUrl           string
+	ProcessingStatus       string
+	Status                 string
+	Team                   *Team
+	Failure                *FileUploadFailure
+	ParentFileUploadId     string
+	ContentHash            string
+	DuplicateOfCandidateId string
+	MergedIntoCandidateId  string
 }
 
 func NewFileUpload(opts FileUploadOptions) (*FileUpload, error) {
@@ -49,12 +60,17 @@ func NewFileUpload(opts FileUploadOptions) (*FileUpload, error) {
 	}
 
 	return &FileUpload{
-		id:               opts.Id,
-		name:             opts.Name,
-		presignedUrl:     opts.PresignedUrl,
-		processingStatus: processingStatus,
-		status:           status,
-		team:             opts.Team,
+		id:                     opts.Id,
+		name:                   opts.Name,
+		presignedUrl:           opts.PresignedUrl,
+		processingStatus:       processingStatus,
+		status:                 status,
+		team:                   opts.Team,
+		failure:                opts.Failure,
+		parentFileUploadId:     opts.ParentFileUploadId,
+		contentHash:            opts.ContentHash,
+		duplicateOfCandidateId: opts.DuplicateOfCandidateId,
+		mergedIntoCandidateId:  opts.MergedIntoCandidateId,
 	}, nil
 }
 
@@ -91,7 +107,19 @@ func (f *FileUpload) ProcessingOngoing() bool {
 }
 
 func (f *FileUpload) ProcessingFinised() bool {
-	return f.processingStatus == completed || f.processingStatus == failed
+	return f.processingStatus == completed || f.processingStatus == failed || f.processingStatus == duplicate
+}
+
+// Only uploaded files whose processing has finished, successfully or not, can be processed again.
+// Files whose candidate was merged into another candidate, or was deleted, cannot, so that the candidate is not created again.
+func (f *FileUpload) CanBeReprocessed() bool {
+	if f.mergedIntoCandidateId != "" {
+		return false
+	}
+	if f.processingStatus == duplicate && f.duplicateOfCandidateId == "" {
+		return false
+	}
+	return f.status == success && f.ProcessingFinised()
 }
 
 func (f *FileUpload) StoragePath() string {
@@ -101,3 +129,33 @@ func (f *FileUpload) StoragePath() string {
 func (f *FileUpload) Team() *Team {
 	return f.team
 }
+
+// Failure is only set when processing has failed, or has failed and is being retried. It is nil otherwise.
+func (f *FileUpload) Failure() *FileUploadFailure {
+	return f.failure
+}
+
+// ParentFileUploadId is the id of the archive a file upload was unpacked from. It is empty otherwise.
+func (f *FileUpload) ParentFileUploadId() string {
+	return f.parentFileUploadId
+}
+
+// Archives are not processed into a candidate. They are unpacked into a file upload for each file they contain.
+func (f *FileUpload) IsArchive() bool {
+	return strings.EqualFold(filepath.Ext(f.name), ".zip")
+}
+
+// ContentHash is the hex encoded SHA-256 of the uploaded file. It is empty until the file has been processed.
+func (f *FileUpload) ContentHash() string {
+	return f.contentHash
+}
+
+// DuplicateOfCandidateId is the id of the candidate that was already created from the same file. It is only set for duplicates.
+func (f *FileUpload) DuplicateOfCandidateId() string {
+	return f.duplicateOfCandidateId
+}
+
+// MergedIntoCandidateId is the id of the candidate that the candidate created from this file was merged into.
+func (f *FileUpload) MergedIntoCandidateId() string {
+	return f.mergedIntoCandidateId
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+// FileUploadFailure records why processing of a file upload failed, so that it can be shown to the team.
+type FileUploadFailure struct {
+	category fileUploadFailureCategory
+	message  string
+	failedAt time.Time
+}
+
+type FileUploadFailureOptions struct {
+	Category string
+	Message  string
+	FailedAt time.Time
+}
+
+func NewFileUploadFailure(opts FileUploadFailureOptions) (*FileUploadFailure, error) {
+	category := FileUploadFailureCategory(opts.Category)
+	if !category.Valid() {
+		return nil, errors.New("cannot create FileUploadFailure with an invalid category")
+	}
+
+	if utilities.IsBlank(opts.Message) {
+		return nil, errors.New("cannot create FileUploadFailure with an empty message")
+	}
+
+	if opts.FailedAt.IsZero() {
+		return nil, errors.New("cannot create FileUploadFailure without a failed at time")
+	}
+
+	return &FileUploadFailure{
+		category: category,
+		message:  opts.Message,
+		failedAt: opts.FailedAt,
+	}, nil
+}
+
+func (f *FileUploadFailure) Category() string {
+	return f.category.String()
+}
+
+func (f *FileUploadFailure) Message() string {
+	return f.message
+}
+
+func (f *FileUploadFailure) FailedAt() time.Time {
+	return f.failedAt
+}
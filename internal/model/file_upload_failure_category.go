@@ -0,0 +1,65 @@
+package model
+
+type fileUploadFailureCategory int64
+
+const (
+	undefinedFileUploadFailureCategory fileUploadFailureCategory = iota
+	not_a_resume
+	unreadable_file
+	llm_error
+	invalid_json
+	storage_error
+	file_limit_reached
+	processing_stalled
+	unknown_error
+)
+
+func FileUploadFailureCategory(str string) fileUploadFailureCategory {
+	switch str {
+	case "NOT A RESUME":
+		return not_a_resume
+	case "UNREADABLE FILE":
+		return unreadable_file
+	case "LLM ERROR":
+		return llm_error
+	case "INVALID JSON":
+		return invalid_json
+	case "STORAGE ERROR":
+		return storage_error
+	case "FILE LIMIT REACHED":
+		return file_limit_reached
+	case "PROCESSING STALLED":
+		return processing_stalled
+	case "UNKNOWN ERROR":
+		return unknown_error
+	default:
+		return undefinedFileUploadFailureCategory
+	}
+}
+
+func (c fileUploadFailureCategory) String() string {
+	switch c {
+	case not_a_resume:
+		return "NOT A RESUME"
+	case unreadable_file:
+		return "UNREADABLE FILE"
+	case llm_error:
+		return "LLM ERROR"
+	case invalid_json:
+		return "INVALID JSON"
+	case storage_error:
+		return "STORAGE ERROR"
+	case file_limit_reached:
+		return "FILE LIMIT REACHED"
+	case processing_stalled:
+		return "PROCESSING STALLED"
+	case unknown_error:
+		return "UNKNOWN ERROR"
+	default:
+		return "UNDEFINED"
+	}
+}
+
+func (c fileUploadFailureCategory) Valid() bool {
+	return c.String() != "UNDEFINED"
+}
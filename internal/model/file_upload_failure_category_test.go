@@ -0,0 +1,129 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FileUploadFailureCategory(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectedOutput fileUploadFailureCategory
+	}{
+		{
+			name:           "creates NOT A RESUME file upload failure category",
+			input:          "NOT A RESUME",
+			expectedOutput: not_a_resume,
+		},
+		{
+			name:           "creates UNREADABLE FILE file upload failure category",
+			input:          "UNREADABLE FILE",
+			expectedOutput: unreadable_file,
+		},
+		{
+			name:           "creates LLM ERROR file upload failure category",
+			input:          "LLM ERROR",
+			expectedOutput: llm_error,
+		},
+		{
+			name:           "creates INVALID JSON file upload failure category",
+			input:          "INVALID JSON",
+			expectedOutput: invalid_json,
+		},
+		{
+			name:           "creates STORAGE ERROR file upload failure category",
+			input:          "STORAGE ERROR",
+			expectedOutput: storage_error,
+		},
+		{
+			name:           "creates FILE LIMIT REACHED file upload failure category",
+			input:          "FILE LIMIT REACHED",
+			expectedOutput: file_limit_reached,
+		},
+		{
+			name:           "creates PROCESSING STALLED file upload failure category",
+			input:          "PROCESSING STALLED",
+			expectedOutput: processing_stalled,
+		},
+		{
+			name:           "creates UNKNOWN ERROR file upload failure category",
+			input:          "UNKNOWN ERROR",
+			expectedOutput: unknown_error,
+		},
+		{
+			name:           "handles unknown file upload failure category",
+			input:          "unknown",
+			expectedOutput: undefinedFileUploadFailureCategory,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			category := FileUploadFailureCategory(tt.input)
+			assert.Equal(t, category, tt.expectedOutput)
+		})
+	}
+}
+
+func Test_FileUploadFailureCategory_String(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          fileUploadFailureCategory
+		expectedOutput string
+	}{
+		{
+			name:           "gets NOT A RESUME from not_a_resume file upload failure category",
+			input:          not_a_resume,
+			expectedOutput: "NOT A RESUME",
+		},
+		{
+			name:           "gets UNREADABLE FILE from unreadable_file file upload failure category",
+			input:          unreadable_file,
+			expectedOutput: "UNREADABLE FILE",
+		},
+		{
+			name:           "gets LLM ERROR from llm_error file upload failure category",
+			input:          llm_error,
+			expectedOutput: "LLM ERROR",
+		},
+		{
+			name:           "gets INVALID JSON from invalid_json file upload failure category",
+			input:          invalid_json,
+			expectedOutput: "INVALID JSON",
+		},
+		{
+			name:           "gets STORAGE ERROR from storage_error file upload failure category",
+			input:          storage_error,
+			expectedOutput: "STORAGE ERROR",
+		},
+		{
+			name:           "gets FILE LIMIT REACHED from file_limit_reached file upload failure category",
+			input:          file_limit_reached,
+			expectedOutput: "FILE LIMIT REACHED",
+		},
+		{
+			name:           "gets PROCESSING STALLED from processing_stalled file upload failure category",
+			input:          processing_stalled,
+			expectedOutput: "PROCESSING STALLED",
+		},
+		{
+			name:           "gets UNKNOWN ERROR from unknown_error file upload failure category",
+			input:          unknown_error,
+			expectedOutput: "UNKNOWN ERROR",
+		},
+		{
+			name:           "gets UNDEFINED from undefinedFileUploadFailureCategory file upload failure category",
+			input:          undefinedFileUploadFailureCategory,
+			expectedOutput: "UNDEFINED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categoryString := tt.input.String()
+			assert.Equal(t, categoryString, tt.expectedOutput)
+		})
+	}
+}
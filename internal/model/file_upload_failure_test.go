@@ -0,0 +1,73 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewFileUploadFailure(t *testing.T) {
+	failedAt := time.Date(2023, time.March, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		input          FileUploadFailureOptions
+		expectedOutput *FileUploadFailure
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "category is invalid",
+			input:          FileUploadFailureOptions{Category: "unknown"},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create FileUploadFailure with an invalid category",
+		},
+		{
+			name: "message is empty",
+			input: FileUploadFailureOptions{
+				Category: "NOT A RESUME",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create FileUploadFailure with an empty message",
+		},
+		{
+			name: "failed at is empty",
+			input: FileUploadFailureOptions{
+				Category: "NOT A RESUME",
+				Message:  "needs a valid resume to parse",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create FileUploadFailure without a failed at time",
+		},
+		{
+			name: "FileUploadFailure gets created successfully",
+			input: FileUploadFailureOptions{
+				Category: "NOT A RESUME",
+				Message:  "needs a valid resume to parse",
+				FailedAt: failedAt,
+			},
+			expectedOutput: &FileUploadFailure{
+				category: not_a_resume,
+				message:  "needs a valid resume to parse",
+				failedAt: failedAt,
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFileUploadFailure(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOutput, got)
+			}
+		})
+	}
+}
@@ -8,6 +8,8 @@ const (
 	ongoing
 	completed
 	failed
+	duplicate
+	retrying
 )
 
 func FileUploadProcessingStatus(str string) fileUploadProcessingStatus {
@@ -20,6 +22,10 @@ func FileUploadProcessingStatus(str string) fileUploadProcessingStatus {
 		return completed
 	case "FAILED":
 		return failed
+	case "DUPLICATE":
+		return duplicate
+	case "RETRYING":
+		return retrying
 	default:
 		return undefinedFileUploadProcessingStatus
 	}
@@ -35,6 +41,10 @@ func (b fileUploadProcessingStatus) String() string {
 		return "COMPLETED"
 	case failed:
 		return "FAILED"
+	case duplicate:
+		return "DUPLICATE"
+	case retrying:
+		return "RETRYING"
 	default:
 		return "UNDEFINED"
 	}
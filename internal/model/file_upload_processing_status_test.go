@@ -32,6 +32,16 @@ func Test_FileUploadProcessingStatus(t *testing.T) {
 			input:          "FAILED",
 			expectedOutput: failed,
 		},
+		{
+			name:           "creates DUPLICATE file upload status",
+			input:          "DUPLICATE",
+			expectedOutput: duplicate,
+		},
+		{
+			name:           "creates RETRYING file upload status",
+			input:          "RETRYING",
+			expectedOutput: retrying,
+		},
 		{
 			name:           "handles unknown file upload processing status",
 			input:          "unknown",
@@ -73,6 +83,16 @@ func Test_FileUploadProcessingStatus_String(t *testing.T) {
 			input:          failed,
 			expectedOutput: "FAILED",
 		},
+		{
+			name:           "gets DUPLICATE from duplicate file upload processing state",
+			input:          duplicate,
+			expectedOutput: "DUPLICATE",
+		},
+		{
+			name:           "gets RETRYING from retrying file upload processing state",
+			input:          retrying,
+			expectedOutput: "RETRYING",
+		},
 		{
 			name:           "gets unknown from undefinedFileUploadProcessingStatus file upload processing state",
 			input:          undefinedFileUploadProcessingStatus,
@@ -107,6 +107,68 @@ func Test_NewFileUpload(t *testing.T) {
 			errorExpected: false,
 			errorString:   "",
 		},
+		{
+			name: "FileUpload gets created successfully with a failure",
+			input: FileUploadOptions{
+				Id:               "123",
+				Name:             "test",
+				PresignedUrl:     "some_url",
+				Status:           "SUCCESS",
+				ProcessingStatus: "FAILED",
+				Team: &Team{
+					id:   "team_id1",
+					name: "test",
+				},
+				Failure: &FileUploadFailure{
+					category: not_a_resume,
+					message:  "needs a valid resume to parse",
+				},
+			},
+			expectedOutput: &FileUpload{
+				id:               "123",
+				name:             "test",
+				presignedUrl:     "some_url",
+				processingStatus: failed,
+				status:           success,
+				team: &Team{
+					id:   "team_id1",
+					name: "test",
+				},
+				failure: &FileUploadFailure{
+					category: not_a_resume,
+					message:  "needs a valid resume to parse",
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "FileUpload gets created successfully with a parent file upload",
+			input: FileUploadOptions{
+				Id:               "123",
+				Name:             "test.pdf",
+				Status:           "SUCCESS",
+				ProcessingStatus: "NOT STARTED",
+				Team: &Team{
+					id:   "team_id1",
+					name: "test",
+				},
+				ParentFileUploadId: "fp_id1",
+			},
+			expectedOutput: &FileUpload{
+				id:               "123",
+				name:             "test.pdf",
+				processingStatus: not_started,
+				status:           success,
+				team: &Team{
+					id:   "team_id1",
+					name: "test",
+				},
+				parentFileUploadId: "fp_id1",
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
 	}
 
 	for _, tt := range tests {
@@ -352,6 +414,17 @@ func Test_FileUpload_ProcessingFinised(t *testing.T) {
 		assert.False(t, fileUpload.ProcessingFinised())
 	})
 
+	t.Run("ProcessingFinised returns false if processingStatus is retrying", func(t *testing.T) {
+		fileUpload := &FileUpload{
+			id:               "fp_id1",
+			name:             "file1.pdf",
+			presignedUrl:     "http://presignedUrl1",
+			processingStatus: retrying,
+			status:           success,
+		}
+		assert.False(t, fileUpload.ProcessingFinised())
+	})
+
 	t.Run("ProcessingFinised returns true if processingStatus is completed", func(t *testing.T) {
 		fileUpload := &FileUpload{
 			id:               "fp_id1",
@@ -373,6 +446,98 @@ func Test_FileUpload_ProcessingFinised(t *testing.T) {
 		}
 		assert.True(t, fileUpload.ProcessingFinised())
 	})
+
+	t.Run("ProcessingFinised returns true if processingStatus is duplicate", func(t *testing.T) {
+		fileUpload := &FileUpload{
+			id:               "fp_id1",
+			name:             "file1.pdf",
+			presignedUrl:     "http://presignedUrl1",
+			processingStatus: duplicate,
+			status:           success,
+		}
+		assert.True(t, fileUpload.ProcessingFinised())
+	})
+}
+
+func Test_FileUpload_CanBeReprocessed(t *testing.T) {
+	t.Run("CanBeReprocessed returns false if processing has not finished", func(t *testing.T) {
+		fileUpload := &FileUpload{
+			id:               "fp_id1",
+			name:             "file1.pdf",
+			presignedUrl:     "http://presignedUrl1",
+			processingStatus: ongoing,
+			status:           success,
+		}
+		assert.False(t, fileUpload.CanBeReprocessed())
+	})
+
+	t.Run("CanBeReprocessed returns false if file was not uploaded successfully", func(t *testing.T) {
+		fileUpload := &FileUpload{
+			id:               "fp_id1",
+			name:             "file1.pdf",
+			presignedUrl:     "http://presignedUrl1",
+			processingStatus: failed,
+			status:           failure,
+		}
+		assert.False(t, fileUpload.CanBeReprocessed())
+	})
+
+	t.Run("CanBeReprocessed returns true if processing has completed", func(t *testing.T) {
+		fileUpload := &FileUpload{
+			id:               "fp_id1",
+			name:             "file1.pdf",
+			presignedUrl:     "http://presignedUrl1",
+			processingStatus: completed,
+			status:           success,
+		}
+		assert.True(t, fileUpload.CanBeReprocessed())
+	})
+
+	t.Run("CanBeReprocessed returns true if processing has failed", func(t *testing.T) {
+		fileUpload := &FileUpload{
+			id:               "fp_id1",
+			name:             "file1.pdf",
+			presignedUrl:     "http://presignedUrl1",
+			processingStatus: failed,
+			status:           success,
+		}
+		assert.True(t, fileUpload.CanBeReprocessed())
+	})
+
+	t.Run("CanBeReprocessed returns true if file is a duplicate of an existing candidate", func(t *testing.T) {
+		fileUpload := &FileUpload{
+			id:                     "fp_id1",
+			name:                   "file1.pdf",
+			presignedUrl:           "http://presignedUrl1",
+			processingStatus:       duplicate,
+			status:                 success,
+			duplicateOfCandidateId: "candidate_id1",
+		}
+		assert.True(t, fileUpload.CanBeReprocessed())
+	})
+
+	t.Run("CanBeReprocessed returns false if its candidate was merged into another candidate", func(t *testing.T) {
+		fileUpload := &FileUpload{
+			id:                    "fp_id1",
+			name:                  "file1.pdf",
+			presignedUrl:          "http://presignedUrl1",
+			processingStatus:      completed,
+			status:                success,
+			mergedIntoCandidateId: "candidate_id1",
+		}
+		assert.False(t, fileUpload.CanBeReprocessed())
+	})
+
+	t.Run("CanBeReprocessed returns false if file is a duplicate of a deleted candidate", func(t *testing.T) {
+		fileUpload := &FileUpload{
+			id:               "fp_id1",
+			name:             "file1.pdf",
+			presignedUrl:     "http://presignedUrl1",
+			processingStatus: duplicate,
+			status:           success,
+		}
+		assert.False(t, fileUpload.CanBeReprocessed())
+	})
 }
 
 func Test_FileUpload_StoragePath(t *testing.T) {
@@ -411,3 +576,77 @@ func Test_FileUpload_Team(t *testing.T) {
 		}, fileUpload.Team())
 	})
 }
+
+func Test_FileUpload_ParentFileUploadId(t *testing.T) {
+	t.Run("ParentFileUploadId returns fileUpload's parent file upload id", func(t *testing.T) {
+		fileUpload := &FileUpload{
+			id:                 "fp_id2",
+			name:               "file2.pdf",
+			processingStatus:   not_started,
+			status:             success,
+			parentFileUploadId: "fp_id1",
+		}
+		assert.Equal(t, "fp_id1", fileUpload.ParentFileUploadId())
+	})
+}
+
+func Test_FileUpload_IsArchive(t *testing.T) {
+	t.Run("IsArchive returns true for a zip file", func(t *testing.T) {
+		fileUpload := &FileUpload{
+			id:               "fp_id1",
+			name:             "resumes.ZIP",
+			processingStatus: not_started,
+			status:           success,
+		}
+		assert.True(t, fileUpload.IsArchive())
+	})
+
+	t.Run("IsArchive returns false for any other file", func(t *testing.T) {
+		fileUpload := &FileUpload{
+			id:               "fp_id1",
+			name:             "file1.pdf",
+			processingStatus: not_started,
+			status:           success,
+		}
+		assert.False(t, fileUpload.IsArchive())
+	})
+}
+
+func Test_FileUpload_ContentHash(t *testing.T) {
+	t.Run("ContentHash returns fileUpload's content hash", func(t *testing.T) {
+		fileUpload := &FileUpload{
+			id:               "fp_id1",
+			name:             "file1.pdf",
+			processingStatus: completed,
+			status:           success,
+			contentHash:      "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+		}
+		assert.Equal(t, "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", fileUpload.ContentHash())
+	})
+}
+
+func Test_FileUpload_DuplicateOfCandidateId(t *testing.T) {
+	t.Run("DuplicateOfCandidateId returns the id of the candidate fileUpload duplicates", func(t *testing.T) {
+		fileUpload := &FileUpload{
+			id:                     "fp_id2",
+			name:                   "file2.pdf",
+			processingStatus:       duplicate,
+			status:                 success,
+			duplicateOfCandidateId: "candidate_id1",
+		}
+		assert.Equal(t, "candidate_id1", fileUpload.DuplicateOfCandidateId())
+	})
+}
+
+func Test_FileUpload_MergedIntoCandidateId(t *testing.T) {
+	t.Run("MergedIntoCandidateId returns the id of the candidate fileUpload was merged into", func(t *testing.T) {
+		fileUpload := &FileUpload{
+			id:                    "fp_id2",
+			name:                  "file2.pdf",
+			processingStatus:      completed,
+			status:                success,
+			mergedIntoCandidateId: "candidate_id1",
+		}
+		assert.Equal(t, "candidate_id1", fileUpload.MergedIntoCandidateId())
+	})
+}
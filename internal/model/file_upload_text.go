@@ -0,0 +1,73 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+// FileUploadText is the text extracted from an uploaded file.
+// It is kept so that features like search and persona rebuilding do not need to fetch the file again.
+type FileUploadText struct {
+	fileUploadId     string
+	text             string
+	textHash         string
+	pageCount        int
+	extractorVersion string
+}
+
+type FileUploadTextOptions struct {
+	FileUploadId     string
+	Text             string
+	PageCount        int
+	ExtractorVersion string
+}
+
+func NewFileUploadText(opts FileUploadTextOptions) (*FileUploadText, error) {
+	if utilities.IsBlank(opts.FileUploadId) {
+		return nil, errors.New("cannot create FileUploadText with an empty file upload id")
+	}
+
+	if opts.PageCount < 0 {
+		return nil, errors.New("cannot create FileUploadText with a negative page count")
+	}
+
+	if utilities.IsBlank(opts.ExtractorVersion) {
+		return nil, errors.New("cannot create FileUploadText with an empty extractor version")
+	}
+
+	return &FileUploadText{
+		fileUploadId:     opts.FileUploadId,
+		text:             opts.Text,
+		textHash:         hashText(opts.Text),
+		pageCount:        opts.PageCount,
+		extractorVersion: opts.ExtractorVersion,
+	}, nil
+}
+
+func hashText(text string) string {
+	hash := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(hash[:])
+}
+
+func (f *FileUploadText) FileUploadId() string {
+	return f.fileUploadId
+}
+
+func (f *FileUploadText) Text() string {
+	return f.text
+}
+
+func (f *FileUploadText) TextHash() string {
+	return f.textHash
+}
+
+func (f *FileUploadText) PageCount() int {
+	return f.pageCount
+}
+
+func (f *FileUploadText) ExtractorVersion() string {
+	return f.extractorVersion
+}
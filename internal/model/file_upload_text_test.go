@@ -0,0 +1,75 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewFileUploadText(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          FileUploadTextOptions
+		expectedOutput *FileUploadText
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "file upload id is empty",
+			input:          FileUploadTextOptions{},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create FileUploadText with an empty file upload id",
+		},
+		{
+			name: "page count is negative",
+			input: FileUploadTextOptions{
+				FileUploadId: "fp_id1",
+				PageCount:    -1,
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create FileUploadText with a negative page count",
+		},
+		{
+			name: "extractor version is empty",
+			input: FileUploadTextOptions{
+				FileUploadId: "fp_id1",
+				PageCount:    1,
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create FileUploadText with an empty extractor version",
+		},
+		{
+			name: "FileUploadText gets created successfully",
+			input: FileUploadTextOptions{
+				FileUploadId:     "fp_id1",
+				Text:             "resume text",
+				PageCount:        2,
+				ExtractorVersion: "pdf2go-v0.1.1",
+			},
+			expectedOutput: &FileUploadText{
+				fileUploadId:     "fp_id1",
+				text:             "resume text",
+				textHash:         "a8722f53a5b1d3ed2e23f2ecddc927aa6015a3da6274a3ae4754c40aa232e13f",
+				pageCount:        2,
+				extractorVersion: "pdf2go-v0.1.1",
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewFileUploadText(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
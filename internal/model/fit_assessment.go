@@ -0,0 +1,183 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+// FitAssessment is the LLM's verdict on how well a candidate fits a job opening. It is kept for the persona version, job opening version
+// and prompt version it was made with, so it is only made again when any of them changes.
+type FitAssessment struct {
+	id                string
+	candidateId       string
+	jobOpeningId      string
+	personaVersion    string
+	jobOpeningVersion string
+	promptVersion     string
+	status            fitAssessmentStatus
+	verdict           *FitVerdict
+	failureMessage    string
+	team              *Team
+	createdAt         time.Time
+	updatedAt         time.Time
+}
+
+type FitAssessmentOptions struct {
+	Id                string
+	CandidateId       string
+	JobOpeningId      string
+	PersonaVersion    string
+	JobOpeningVersion string
+	PromptVersion     string
+	Status            string
+	Verdict           *FitVerdict
+	FailureMessage    string
+	Team              *Team
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// NewFitAssessment creates a fit assessment that is not started unless a status is given.
+func NewFitAssessment(opts FitAssessmentOptions) (*FitAssessment, error) {
+	if utilities.IsBlank(opts.Id) {
+		return nil, errors.New("cannot create FitAssessment with an empty id")
+	}
+
+	if utilities.IsBlank(opts.CandidateId) {
+		return nil, errors.New("cannot create FitAssessment with an empty candidate id")
+	}
+
+	if utilities.IsBlank(opts.JobOpeningId) {
+		return nil, errors.New("cannot create FitAssessment with an empty job opening id")
+	}
+
+	if utilities.IsBlank(opts.PersonaVersion) {
+		return nil, errors.New("cannot create FitAssessment with an empty persona version")
+	}
+
+	if utilities.IsBlank(opts.JobOpeningVersion) {
+		return nil, errors.New("cannot create FitAssessment with an empty job opening version")
+	}
+
+	if utilities.IsBlank(opts.PromptVersion) {
+		return nil, errors.New("cannot create FitAssessment with an empty prompt version")
+	}
+
+	status := notStartedFitAssessment
+	if opts.Status != "" {
+		status = FitAssessmentStatus(opts.Status)
+		if !status.Valid() {
+			return nil, errors.Errorf("cannot create FitAssessment with an invalid status: %s", opts.Status)
+		}
+	}
+
+	if status == completedFitAssessment && !opts.Verdict.IsValid() {
+		return nil, errors.New("cannot create a COMPLETED FitAssessment without a valid verdict")
+	}
+
+	if opts.Team == nil {
+		return nil, errors.New("cannot create FitAssessment with a nil Team")
+	}
+
+	return &FitAssessment{
+		id:                opts.Id,
+		candidateId:       opts.CandidateId,
+		jobOpeningId:      opts.JobOpeningId,
+		personaVersion:    opts.PersonaVersion,
+		jobOpeningVersion: opts.JobOpeningVersion,
+		promptVersion:     opts.PromptVersion,
+		status:            status,
+		verdict:           opts.Verdict,
+		failureMessage:    opts.FailureMessage,
+		team:              opts.Team,
+		createdAt:         opts.CreatedAt,
+		updatedAt:         opts.UpdatedAt,
+	}, nil
+}
+
+func (f *FitAssessment) Id() string {
+	return f.id
+}
+
+func (f *FitAssessment) CandidateId() string {
+	return f.candidateId
+}
+
+func (f *FitAssessment) JobOpeningId() string {
+	return f.jobOpeningId
+}
+
+func (f *FitAssessment) PersonaVersion() string {
+	return f.personaVersion
+}
+
+func (f *FitAssessment) JobOpeningVersion() string {
+	return f.jobOpeningVersion
+}
+
+func (f *FitAssessment) PromptVersion() string {
+	return f.promptVersion
+}
+
+func (f *FitAssessment) Status() string {
+	return f.status.String()
+}
+
+func (f *FitAssessment) NotStarted() bool {
+	return f.status == notStartedFitAssessment
+}
+
+// Verdict is nil until the assessment is completed.
+func (f *FitAssessment) Verdict() *FitVerdict {
+	return f.verdict
+}
+
+func (f *FitAssessment) FailureMessage() string {
+	return f.failureMessage
+}
+
+func (f *FitAssessment) Team() *Team {
+	return f.team
+}
+
+func (f *FitAssessment) CreatedAt() time.Time {
+	return f.createdAt
+}
+
+func (f *FitAssessment) UpdatedAt() time.Time {
+	return f.updatedAt
+}
+
+type FitVerdict struct {
+	Rating             string   `json:"Rating"`
+	Strengths          []string `json:"Strengths"`
+	Gaps               []string `json:"Gaps"`
+	InterviewQuestions []string `json:"Interview Questions"`
+}
+
+func (v *FitVerdict) IsValid() bool {
+	if v == nil {
+		return false
+	}
+	return FitRating(v.Rating).Valid()
+}
+
+func (v *FitVerdict) Value() (driver.Value, error) {
+	return json.Marshal(v)
+}
+
+func (v *FitVerdict) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, &v)
+}
@@ -0,0 +1,45 @@
+package model
+
+type fitAssessmentStatus int64
+
+const (
+	undefinedFitAssessmentStatus fitAssessmentStatus = iota
+	notStartedFitAssessment
+	ongoingFitAssessment
+	completedFitAssessment
+	failedFitAssessment
+)
+
+func FitAssessmentStatus(str string) fitAssessmentStatus {
+	switch str {
+	case "NOT STARTED":
+		return notStartedFitAssessment
+	case "ONGOING":
+		return ongoingFitAssessment
+	case "COMPLETED":
+		return completedFitAssessment
+	case "FAILED":
+		return failedFitAssessment
+	default:
+		return undefinedFitAssessmentStatus
+	}
+}
+
+func (f fitAssessmentStatus) String() string {
+	switch f {
+	case notStartedFitAssessment:
+		return "NOT STARTED"
+	case ongoingFitAssessment:
+		return "ONGOING"
+	case completedFitAssessment:
+		return "COMPLETED"
+	case failedFitAssessment:
+		return "FAILED"
+	default:
+		return "UNDEFINED"
+	}
+}
+
+func (f fitAssessmentStatus) Valid() bool {
+	return f.String() != "UNDEFINED"
+}
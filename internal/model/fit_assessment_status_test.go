@@ -0,0 +1,99 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FitAssessmentStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectedOutput fitAssessmentStatus
+	}{
+		{
+			name:           "creates NOT STARTED fit assessment status",
+			input:          "NOT STARTED",
+			expectedOutput: notStartedFitAssessment,
+		},
+		{
+			name:           "creates ONGOING fit assessment status",
+			input:          "ONGOING",
+			expectedOutput: ongoingFitAssessment,
+		},
+		{
+			name:           "creates COMPLETED fit assessment status",
+			input:          "COMPLETED",
+			expectedOutput: completedFitAssessment,
+		},
+		{
+			name:           "creates FAILED fit assessment status",
+			input:          "FAILED",
+			expectedOutput: failedFitAssessment,
+		},
+		{
+			name:           "handles unknown fit assessment status",
+			input:          "unknown",
+			expectedOutput: undefinedFitAssessmentStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := FitAssessmentStatus(tt.input)
+			assert.Equal(t, status, tt.expectedOutput)
+		})
+	}
+}
+
+func Test_FitAssessmentStatus_String(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          fitAssessmentStatus
+		expectedOutput string
+	}{
+		{
+			name:           "gets NOT STARTED from not started fit assessment status",
+			input:          notStartedFitAssessment,
+			expectedOutput: "NOT STARTED",
+		},
+		{
+			name:           "gets ONGOING from ongoing fit assessment status",
+			input:          ongoingFitAssessment,
+			expectedOutput: "ONGOING",
+		},
+		{
+			name:           "gets COMPLETED from completed fit assessment status",
+			input:          completedFitAssessment,
+			expectedOutput: "COMPLETED",
+		},
+		{
+			name:           "gets FAILED from failed fit assessment status",
+			input:          failedFitAssessment,
+			expectedOutput: "FAILED",
+		},
+		{
+			name:           "gets unknown from undefinedFitAssessmentStatus fit assessment status",
+			input:          undefinedFitAssessmentStatus,
+			expectedOutput: "UNDEFINED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fitAssessmentStatusString := tt.input.String()
+			assert.Equal(t, fitAssessmentStatusString, tt.expectedOutput)
+		})
+	}
+}
+
+func Test_FitAssessmentStatus_Valid(t *testing.T) {
+	t.Run("returns true for a valid fit assessment status", func(t *testing.T) {
+		assert.True(t, failedFitAssessment.Valid())
+	})
+
+	t.Run("returns false for a invalid fit assessment status", func(t *testing.T) {
+		assert.False(t, undefinedFitAssessmentStatus.Valid())
+	})
+}
@@ -0,0 +1,202 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewFitAssessment(t *testing.T) {
+	currentFileCount := 1
+	team, _ := NewTeam(TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	verdict := &FitVerdict{
+		Rating:             "GOOD FIT",
+		Strengths:          []string{"Knows Go"},
+		Gaps:               []string{"Has not used Kubernetes"},
+		InterviewQuestions: []string{"How would you deploy a Go service?"},
+	}
+
+	tests := []struct {
+		name           string
+		input          FitAssessmentOptions
+		expectedOutput *FitAssessment
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "id is empty",
+			input:          FitAssessmentOptions{},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create FitAssessment with an empty id",
+		},
+		{
+			name: "candidate id is empty",
+			input: FitAssessmentOptions{
+				Id: "fa_id1",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create FitAssessment with an empty candidate id",
+		},
+		{
+			name: "job opening id is empty",
+			input: FitAssessmentOptions{
+				Id:          "fa_id1",
+				CandidateId: "c_id1",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create FitAssessment with an empty job opening id",
+		},
+		{
+			name: "persona version is empty",
+			input: FitAssessmentOptions{
+				Id:           "fa_id1",
+				CandidateId:  "c_id1",
+				JobOpeningId: "jo_id1",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create FitAssessment with an empty persona version",
+		},
+		{
+			name: "job opening version is empty",
+			input: FitAssessmentOptions{
+				Id:             "fa_id1",
+				CandidateId:    "c_id1",
+				JobOpeningId:   "jo_id1",
+				PersonaVersion: "pv1",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create FitAssessment with an empty job opening version",
+		},
+		{
+			name: "prompt version is empty",
+			input: FitAssessmentOptions{
+				Id:                "fa_id1",
+				CandidateId:       "c_id1",
+				JobOpeningId:      "jo_id1",
+				PersonaVersion:    "pv1",
+				JobOpeningVersion: "jv1",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create FitAssessment with an empty prompt version",
+		},
+		{
+			name: "status is invalid",
+			input: FitAssessmentOptions{
+				Id:                "fa_id1",
+				CandidateId:       "c_id1",
+				JobOpeningId:      "jo_id1",
+				PersonaVersion:    "pv1",
+				JobOpeningVersion: "jv1",
+				PromptVersion:     "1.0.0",
+				Status:            "DONE",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create FitAssessment with an invalid status: DONE",
+		},
+		{
+			name: "completed without a valid verdict",
+			input: FitAssessmentOptions{
+				Id:                "fa_id1",
+				CandidateId:       "c_id1",
+				JobOpeningId:      "jo_id1",
+				PersonaVersion:    "pv1",
+				JobOpeningVersion: "jv1",
+				PromptVersion:     "1.0.0",
+				Status:            "COMPLETED",
+				Verdict:           &FitVerdict{Rating: "MAYBE"},
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create a COMPLETED FitAssessment without a valid verdict",
+		},
+		{
+			name: "team is nil",
+			input: FitAssessmentOptions{
+				Id:                "fa_id1",
+				CandidateId:       "c_id1",
+				JobOpeningId:      "jo_id1",
+				PersonaVersion:    "pv1",
+				JobOpeningVersion: "jv1",
+				PromptVersion:     "1.0.0",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create FitAssessment with a nil Team",
+		},
+		{
+			name: "FitAssessment gets created as not started",
+			input: FitAssessmentOptions{
+				Id:                "fa_id1",
+				CandidateId:       "c_id1",
+				JobOpeningId:      "jo_id1",
+				PersonaVersion:    "pv1",
+				JobOpeningVersion: "jv1",
+				PromptVersion:     "1.0.0",
+				Team:              team,
+			},
+			expectedOutput: &FitAssessment{
+				id:                "fa_id1",
+				candidateId:       "c_id1",
+				jobOpeningId:      "jo_id1",
+				personaVersion:    "pv1",
+				jobOpeningVersion: "jv1",
+				promptVersion:     "1.0.0",
+				status:            notStartedFitAssessment,
+				team:              team,
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "FitAssessment gets created with a verdict",
+			input: FitAssessmentOptions{
+				Id:                "fa_id1",
+				CandidateId:       "c_id1",
+				JobOpeningId:      "jo_id1",
+				PersonaVersion:    "pv1",
+				JobOpeningVersion: "jv1",
+				PromptVersion:     "1.0.0",
+				Status:            "COMPLETED",
+				Verdict:           verdict,
+				Team:              team,
+			},
+			expectedOutput: &FitAssessment{
+				id:                "fa_id1",
+				candidateId:       "c_id1",
+				jobOpeningId:      "jo_id1",
+				personaVersion:    "pv1",
+				jobOpeningVersion: "jv1",
+				promptVersion:     "1.0.0",
+				status:            completedFitAssessment,
+				verdict:           verdict,
+				team:              team,
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewFitAssessment(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package model
+
+type fitRating int64
+
+const (
+	undefinedFitRating fitRating = iota
+	strongFit
+	goodFit
+	weakFit
+	notAFit
+)
+
+func FitRating(str string) fitRating {
+	switch str {
+	case "STRONG FIT":
+		return strongFit
+	case "GOOD FIT":
+		return goodFit
+	case "WEAK FIT":
+		return weakFit
+	case "NOT A FIT":
+		return notAFit
+	default:
+		return undefinedFitRating
+	}
+}
+
+func (f fitRating) String() string {
+	switch f {
+	case strongFit:
+		return "STRONG FIT"
+	case goodFit:
+		return "GOOD FIT"
+	case weakFit:
+		return "WEAK FIT"
+	case notAFit:
+		return "NOT A FIT"
+	default:
+		return "UNDEFINED"
+	}
+}
+
+func (f fitRating) Valid() bool {
+	return f.String() != "UNDEFINED"
+}
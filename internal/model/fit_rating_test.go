@@ -0,0 +1,99 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FitRating(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectedOutput fitRating
+	}{
+		{
+			name:           "creates STRONG FIT fit rating",
+			input:          "STRONG FIT",
+			expectedOutput: strongFit,
+		},
+		{
+			name:           "creates GOOD FIT fit rating",
+			input:          "GOOD FIT",
+			expectedOutput: goodFit,
+		},
+		{
+			name:           "creates WEAK FIT fit rating",
+			input:          "WEAK FIT",
+			expectedOutput: weakFit,
+		},
+		{
+			name:           "creates NOT A FIT fit rating",
+			input:          "NOT A FIT",
+			expectedOutput: notAFit,
+		},
+		{
+			name:           "handles unknown fit rating",
+			input:          "unknown",
+			expectedOutput: undefinedFitRating,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rating := FitRating(tt.input)
+			assert.Equal(t, rating, tt.expectedOutput)
+		})
+	}
+}
+
+func Test_FitRating_String(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          fitRating
+		expectedOutput string
+	}{
+		{
+			name:           "gets STRONG FIT from strong fit rating",
+			input:          strongFit,
+			expectedOutput: "STRONG FIT",
+		},
+		{
+			name:           "gets GOOD FIT from good fit rating",
+			input:          goodFit,
+			expectedOutput: "GOOD FIT",
+		},
+		{
+			name:           "gets WEAK FIT from weak fit rating",
+			input:          weakFit,
+			expectedOutput: "WEAK FIT",
+		},
+		{
+			name:           "gets NOT A FIT from not a fit rating",
+			input:          notAFit,
+			expectedOutput: "NOT A FIT",
+		},
+		{
+			name:           "gets unknown from undefinedFitRating fit rating",
+			input:          undefinedFitRating,
+			expectedOutput: "UNDEFINED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fitRatingString := tt.input.String()
+			assert.Equal(t, fitRatingString, tt.expectedOutput)
+		})
+	}
+}
+
+func Test_FitRating_Valid(t *testing.T) {
+	t.Run("returns true for a valid fit rating", func(t *testing.T) {
+		assert.True(t, weakFit.Valid())
+	})
+
+	t.Run("returns false for a invalid fit rating", func(t *testing.T) {
+		assert.False(t, undefinedFitRating.Valid())
+	})
+}
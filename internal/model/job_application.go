@@ -0,0 +1,76 @@
+package model
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+// JobApplication attaches a candidate to a job opening. Each application moves through the pipeline stages on its own,
+// independent of the stage of the candidate.
+type JobApplication struct {
+	id              string
+	jobOpeningId    string
+	candidateId     string
+	pipelineStageId string
+	createdAt       time.Time
+	updatedAt       time.Time
+}
+
+type JobApplicationOptions struct {
+	Id              string
+	JobOpeningId    string
+	CandidateId     string
+	PipelineStageId string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func NewJobApplication(opts JobApplicationOptions) (*JobApplication, error) {
+	if utilities.IsBlank(opts.Id) {
+		return nil, errors.New("cannot create JobApplication with an empty id")
+	}
+
+	if utilities.IsBlank(opts.JobOpeningId) {
+		return nil, errors.New("cannot create JobApplication with an empty job opening id")
+	}
+
+	if utilities.IsBlank(opts.CandidateId) {
+		return nil, errors.New("cannot create JobApplication with an empty candidate id")
+	}
+
+	return &JobApplication{
+		id:              opts.Id,
+		jobOpeningId:    opts.JobOpeningId,
+		candidateId:     opts.CandidateId,
+		pipelineStageId: opts.PipelineStageId,
+		createdAt:       opts.CreatedAt,
+		updatedAt:       opts.UpdatedAt,
+	}, nil
+}
+
+func (j *JobApplication) Id() string {
+	return j.id
+}
+
+func (j *JobApplication) JobOpeningId() string {
+	return j.jobOpeningId
+}
+
+func (j *JobApplication) CandidateId() string {
+	return j.candidateId
+}
+
+// PipelineStageId is blank when the team had no pipeline stages when the candidate was added.
+func (j *JobApplication) PipelineStageId() string {
+	return j.pipelineStageId
+}
+
+func (j *JobApplication) CreatedAt() time.Time {
+	return j.createdAt
+}
+
+func (j *JobApplication) UpdatedAt() time.Time {
+	return j.updatedAt
+}
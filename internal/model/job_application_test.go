@@ -0,0 +1,73 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewJobApplication(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          JobApplicationOptions
+		expectedOutput *JobApplication
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "id is empty",
+			input:          JobApplicationOptions{},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create JobApplication with an empty id",
+		},
+		{
+			name: "job opening id is empty",
+			input: JobApplicationOptions{
+				Id: "ja_id1",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create JobApplication with an empty job opening id",
+		},
+		{
+			name: "candidate id is empty",
+			input: JobApplicationOptions{
+				Id:           "ja_id1",
+				JobOpeningId: "jo_id1",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create JobApplication with an empty candidate id",
+		},
+		{
+			name: "JobApplication gets created successfully",
+			input: JobApplicationOptions{
+				Id:              "ja_id1",
+				JobOpeningId:    "jo_id1",
+				CandidateId:     "c_id1",
+				PipelineStageId: "ps_id1",
+			},
+			expectedOutput: &JobApplication{
+				id:              "ja_id1",
+				jobOpeningId:    "jo_id1",
+				candidateId:     "c_id1",
+				pipelineStageId: "ps_id1",
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewJobApplication(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+type JobOpening struct {
+	id               string
+	title            string
+	description      string
+	requiredSkills   []string
+	niceToHaveSkills []string
+	location         string
+	minYoE           int
+	status           jobOpeningStatus
+	team             *Team
+	createdAt        time.Time
+	updatedAt        time.Time
+}
+
+type JobOpeningOptions struct {
+	Id               string
+	Title            string
+	Description      string
+	RequiredSkills   []string
+	NiceToHaveSkills []string
+	Location         string
+	MinYoE           int
+	Status           string
+	Team             *Team
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// NewJobOpening creates an open job opening unless a status is given.
+func NewJobOpening(opts JobOpeningOptions) (*JobOpening, error) {
+	if utilities.IsBlank(opts.Id) {
+		return nil, errors.New("cannot create JobOpening with an empty id")
+	}
+
+	if utilities.IsBlank(opts.Title) {
+		return nil, errors.New("cannot create JobOpening with an empty title")
+	}
+
+	if opts.MinYoE < 0 {
+		return nil, errors.New("cannot create JobOpening with a negative min YoE")
+	}
+
+	status := openJobOpening
+	if opts.Status != "" {
+		status = JobOpeningStatus(opts.Status)
+		if !status.Valid() {
+			return nil, errors.Errorf("cannot create JobOpening with an invalid status: %s", opts.Status)
+		}
+	}
+
+	if opts.Team == nil {
+		return nil, errors.New("cannot create JobOpening with a nil Team")
+	}
+
+	return &JobOpening{
+		id:               opts.Id,
+		title:            strings.TrimSpace(opts.Title),
+		description:      strings.TrimSpace(opts.Description),
+		requiredSkills:   trimmedNonBlankStrings(opts.RequiredSkills),
+		niceToHaveSkills: trimmedNonBlankStrings(opts.NiceToHaveSkills),
+		location:         strings.TrimSpace(opts.Location),
+		minYoE:           opts.MinYoE,
+		status:           status,
+		team:             opts.Team,
+		createdAt:        opts.CreatedAt,
+		updatedAt:        opts.UpdatedAt,
+	}, nil
+}
+
+func trimmedNonBlankStrings(strs []string) []string {
+	trimmed := []string{}
+	for _, str := range strs {
+		if !utilities.IsBlank(str) {
+			trimmed = append(trimmed, strings.TrimSpace(str))
+		}
+	}
+	return trimmed
+}
+
+func (j *JobOpening) Id() string {
+	return j.id
+}
+
+func (j *JobOpening) Title() string {
+	return j.title
+}
+
+func (j *JobOpening) Description() string {
+	return j.description
+}
+
+func (j *JobOpening) RequiredSkills() []string {
+	return j.requiredSkills
+}
+
+func (j *JobOpening) NiceToHaveSkills() []string {
+	return j.niceToHaveSkills
+}
+
+func (j *JobOpening) Location() string {
+	return j.location
+}
+
+func (j *JobOpening) MinYoE() int {
+	return j.minYoE
+}
+
+// Version changes whenever a field that describes the job changes, so anything derived from a job opening can tell when it is out of date.
+// The status is left out, since it does not change what the job is.
+func (j *JobOpening) Version() string {
+	jobOpeningJson, _ := json.Marshal(struct {
+		Title            string
+		Description      string
+		RequiredSkills   []string
+		NiceToHaveSkills []string
+		Location         string
+		MinYoE           int
+	}{
+		Title:            j.title,
+		Description:      j.description,
+		RequiredSkills:   j.requiredSkills,
+		NiceToHaveSkills: j.niceToHaveSkills,
+		Location:         j.location,
+		MinYoE:           j.minYoE,
+	})
+	hash := sha256.Sum256(jobOpeningJson)
+	return hex.EncodeToString(hash[:])
+}
+
+func (j *JobOpening) Status() string {
+	return j.status.String()
+}
+
+func (j *JobOpening) IsOpen() bool {
+	return j.status == openJobOpening
+}
+
+func (j *JobOpening) Team() *Team {
+	return j.team
+}
+
+func (j *JobOpening) CreatedAt() time.Time {
+	return j.createdAt
+}
+
+func (j *JobOpening) UpdatedAt() time.Time {
+	return j.updatedAt
+}
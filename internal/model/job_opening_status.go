@@ -0,0 +1,35 @@
+package model
+
+type jobOpeningStatus int64
+
+const (
+	undefinedJobOpeningStatus jobOpeningStatus = iota
+	openJobOpening
+	closedJobOpening
+)
+
+func JobOpeningStatus(str string) jobOpeningStatus {
+	switch str {
+	case "OPEN":
+		return openJobOpening
+	case "CLOSED":
+		return closedJobOpening
+	default:
+		return undefinedJobOpeningStatus
+	}
+}
+
+func (j jobOpeningStatus) String() string {
+	switch j {
+	case openJobOpening:
+		return "OPEN"
+	case closedJobOpening:
+		return "CLOSED"
+	default:
+		return "UNDEFINED"
+	}
+}
+
+func (j jobOpeningStatus) Valid() bool {
+	return j.String() != "UNDEFINED"
+}
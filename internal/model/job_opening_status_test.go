@@ -0,0 +1,79 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_JobOpeningStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectedOutput jobOpeningStatus
+	}{
+		{
+			name:           "creates OPEN job opening status",
+			input:          "OPEN",
+			expectedOutput: openJobOpening,
+		},
+		{
+			name:           "creates CLOSED job opening status",
+			input:          "CLOSED",
+			expectedOutput: closedJobOpening,
+		},
+		{
+			name:           "handles unknown job opening status",
+			input:          "unknown",
+			expectedOutput: undefinedJobOpeningStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := JobOpeningStatus(tt.input)
+			assert.Equal(t, status, tt.expectedOutput)
+		})
+	}
+}
+
+func Test_JobOpeningStatus_String(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          jobOpeningStatus
+		expectedOutput string
+	}{
+		{
+			name:           "gets OPEN from open job opening status",
+			input:          openJobOpening,
+			expectedOutput: "OPEN",
+		},
+		{
+			name:           "gets CLOSED from closed job opening status",
+			input:          closedJobOpening,
+			expectedOutput: "CLOSED",
+		},
+		{
+			name:           "gets unknown from undefinedJobOpeningStatus job opening status",
+			input:          undefinedJobOpeningStatus,
+			expectedOutput: "UNDEFINED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobOpeningStatusString := tt.input.String()
+			assert.Equal(t, jobOpeningStatusString, tt.expectedOutput)
+		})
+	}
+}
+
+func Test_JobOpeningStatus_Valid(t *testing.T) {
+	t.Run("returns true for a valid job opening status", func(t *testing.T) {
+		assert.True(t, closedJobOpening.Valid())
+	})
+
+	t.Run("returns false for a invalid job opening status", func(t *testing.T) {
+		assert.False(t, undefinedJobOpeningStatus.Valid())
+	})
+}
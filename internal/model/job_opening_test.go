@@ -0,0 +1,159 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewJobOpening(t *testing.T) {
+	currentFileCount := 1
+	team, _ := NewTeam(TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	tests := []struct {
+		name           string
+		input          JobOpeningOptions
+		expectedOutput *JobOpening
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "id is empty",
+			input:          JobOpeningOptions{},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create JobOpening with an empty id",
+		},
+		{
+			name: "title is empty",
+			input: JobOpeningOptions{
+				Id: "jo_id1",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create JobOpening with an empty title",
+		},
+		{
+			name: "min YoE is negative",
+			input: JobOpeningOptions{
+				Id:     "jo_id1",
+				Title:  "Backend Engineer",
+				MinYoE: -1,
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create JobOpening with a negative min YoE",
+		},
+		{
+			name: "status is invalid",
+			input: JobOpeningOptions{
+				Id:     "jo_id1",
+				Title:  "Backend Engineer",
+				Status: "PAUSED",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create JobOpening with an invalid status: PAUSED",
+		},
+		{
+			name: "team is nil",
+			input: JobOpeningOptions{
+				Id:    "jo_id1",
+				Title: "Backend Engineer",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create JobOpening with a nil Team",
+		},
+		{
+			name: "JobOpening gets created as open by default",
+			input: JobOpeningOptions{
+				Id:               "jo_id1",
+				Title:            " Backend Engineer ",
+				Description:      "Builds APIs",
+				RequiredSkills:   []string{" Go ", "", "Postgres"},
+				NiceToHaveSkills: []string{"Kubernetes", "  "},
+				Location:         " Mumbai ",
+				MinYoE:           3,
+				Team:             team,
+			},
+			expectedOutput: &JobOpening{
+				id:               "jo_id1",
+				title:            "Backend Engineer",
+				description:      "Builds APIs",
+				requiredSkills:   []string{"Go", "Postgres"},
+				niceToHaveSkills: []string{"Kubernetes"},
+				location:         "Mumbai",
+				minYoE:           3,
+				status:           openJobOpening,
+				team:             team,
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "JobOpening gets created with a status",
+			input: JobOpeningOptions{
+				Id:     "jo_id1",
+				Title:  "Backend Engineer",
+				Status: "CLOSED",
+				Team:   team,
+			},
+			expectedOutput: &JobOpening{
+				id:               "jo_id1",
+				title:            "Backend Engineer",
+				requiredSkills:   []string{},
+				niceToHaveSkills: []string{},
+				status:           closedJobOpening,
+				team:             team,
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewJobOpening(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
+
+func Test_JobOpening_IsOpen(t *testing.T) {
+	t.Run("returns true for an open job opening", func(t *testing.T) {
+		jobOpening := &JobOpening{status: openJobOpening}
+		assert.True(t, jobOpening.IsOpen())
+		assert.Equal(t, "OPEN", jobOpening.Status())
+	})
+
+	t.Run("returns false for a closed job opening", func(t *testing.T) {
+		jobOpening := &JobOpening{status: closedJobOpening}
+		assert.False(t, jobOpening.IsOpen())
+		assert.Equal(t, "CLOSED", jobOpening.Status())
+	})
+}
+
+func Test_JobOpening_Version(t *testing.T) {
+	t.Run("returns the same version for job openings that describe the same job", func(t *testing.T) {
+		jobOpening := &JobOpening{title: "Backend Engineer", requiredSkills: []string{"Go"}, status: openJobOpening}
+		other := &JobOpening{title: "Backend Engineer", requiredSkills: []string{"Go"}, status: closedJobOpening}
+		assert.Equal(t, jobOpening.Version(), other.Version())
+		assert.Len(t, jobOpening.Version(), 64)
+	})
+
+	t.Run("returns a different version once the job changes", func(t *testing.T) {
+		jobOpening := &JobOpening{title: "Backend Engineer", requiredSkills: []string{"Go"}, minYoE: 3}
+		other := &JobOpening{title: "Backend Engineer", requiredSkills: []string{"Go"}, minYoE: 5}
+		assert.NotEqual(t, jobOpening.Version(), other.Version())
+	})
+}
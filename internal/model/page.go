@@ -0,0 +1,74 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const MAX_PAGE_SIZE = 100
+
+// A PageRequest with size 0 asks for every remaining row.
+type PageRequest struct {
+	size  int
+	token string
+}
+
+type PageRequestOptions struct {
+	Size  int
+	Token string
+}
+
+func NewPageRequest(opts PageRequestOptions) (*PageRequest, error) {
+	if opts.Size < 0 {
+		return nil, errors.New("cannot create PageRequest with a negative size")
+	}
+
+	size := opts.Size
+	if size > MAX_PAGE_SIZE {
+		size = MAX_PAGE_SIZE
+	}
+
+	return &PageRequest{
+		size:  size,
+		token: strings.TrimSpace(opts.Token),
+	}, nil
+}
+
+func (p *PageRequest) Size() int {
+	return p.size
+}
+
+func (p *PageRequest) Token() string {
+	return p.token
+}
+
+func (p *PageRequest) Unbounded() bool {
+	return p == nil || p.size == 0
+}
+
+type PageInfo struct {
+	nextPageToken string
+	totalCount    int
+}
+
+func NewPageInfo(nextPageToken string, totalCount int) *PageInfo {
+	return &PageInfo{
+		nextPageToken: nextPageToken,
+		totalCount:    totalCount,
+	}
+}
+
+func (p *PageInfo) NextPageToken() string {
+	if p == nil {
+		return ""
+	}
+	return p.nextPageToken
+}
+
+func (p *PageInfo) TotalCount() int {
+	if p == nil {
+		return 0
+	}
+	return p.totalCount
+}
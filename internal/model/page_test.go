@@ -0,0 +1,80 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewPageRequest(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          PageRequestOptions
+		expectedOutput *PageRequest
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "size is negative",
+			input:          PageRequestOptions{Size: -1},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create PageRequest with a negative size",
+		},
+		{
+			name:           "PageRequest gets created successfully",
+			input:          PageRequestOptions{Size: 20, Token: " token1 "},
+			expectedOutput: &PageRequest{size: 20, token: "token1"},
+			errorExpected:  false,
+			errorString:    "",
+		},
+		{
+			name:           "PageRequest size is capped",
+			input:          PageRequestOptions{Size: 1000},
+			expectedOutput: &PageRequest{size: MAX_PAGE_SIZE},
+			errorExpected:  false,
+			errorString:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewPageRequest(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
+
+func Test_PageRequest_Unbounded(t *testing.T) {
+	t.Run("returns true for a nil PageRequest", func(t *testing.T) {
+		var page *PageRequest
+		assert.True(t, page.Unbounded())
+	})
+
+	t.Run("returns true for a PageRequest with size 0", func(t *testing.T) {
+		assert.True(t, (&PageRequest{token: "token1"}).Unbounded())
+	})
+
+	t.Run("returns false for a PageRequest with a size", func(t *testing.T) {
+		assert.False(t, (&PageRequest{size: 10}).Unbounded())
+	})
+}
+
+func Test_PageInfo(t *testing.T) {
+	t.Run("returns NextPageToken and TotalCount", func(t *testing.T) {
+		pageInfo := NewPageInfo("token1", 30)
+		assert.Equal(t, "token1", pageInfo.NextPageToken())
+		assert.Equal(t, 30, pageInfo.TotalCount())
+	})
+
+	t.Run("returns zero values for a nil PageInfo", func(t *testing.T) {
+		var pageInfo *PageInfo
+		assert.Equal(t, "", pageInfo.NextPageToken())
+		assert.Equal(t, 0, pageInfo.TotalCount())
+	})
+}
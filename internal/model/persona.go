@@ -1,8 +1,11 @@
 package model
 
 import (
+	"crypto/sha256"
 	"database/sql/driver"
+	"encoding/hex"
 	"encoding/json"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
@@ -82,6 +85,13 @@ func (p *Persona) IsEqual(other *Persona) bool {
 		p.FileUploadId == other.FileUploadId
 }
 
+// Version changes whenever any field of the persona changes, so anything derived from a persona can tell when it is out of date.
+func (p *Persona) Version() string {
+	personaJson, _ := json.Marshal(p)
+	hash := sha256.Sum256(personaJson)
+	return hex.EncodeToString(hash[:])
+}
+
 func EqualPersonaAttributeArray[A comparable](first, second []A) bool {
 	if len(first) != len(second) {
 		return false
@@ -95,6 +105,74 @@ func EqualPersonaAttributeArray[A comparable](first, second []A) bool {
 	return true
 }
 
+// MergePersonas combines the personas of candidates that are the same person. Fields of the primary persona win,
+// blank fields are filled from the others in order and lists are combined without repeats.
+// The merged persona is built by a human, so it is not tied to any file upload.
+func MergePersonas(primary *Persona, others ...*Persona) *Persona {
+	merged := &Persona{}
+	for _, persona := range append([]*Persona{primary}, others...) {
+		if persona == nil {
+			continue
+		}
+		merged.Name = firstNonBlank(merged.Name, persona.Name)
+		merged.Email = firstNonBlank(merged.Email, persona.Email)
+		merged.Phone = firstNonBlank(merged.Phone, persona.Phone)
+		merged.City = firstNonBlank(merged.City, persona.City)
+		merged.State = firstNonBlank(merged.State, persona.State)
+		merged.Country = firstNonBlank(merged.Country, persona.Country)
+		if persona.YoE > merged.YoE {
+			merged.YoE = persona.YoE
+		}
+		merged.TechSkills = appendMissingStrings(merged.TechSkills, persona.TechSkills)
+		merged.SoftSkills = appendMissingStrings(merged.SoftSkills, persona.SoftSkills)
+		merged.RecommendedRoles = appendMissingStrings(merged.RecommendedRoles, persona.RecommendedRoles)
+		merged.Education = appendMissing(merged.Education, persona.Education)
+		merged.Experience = appendMissing(merged.Experience, persona.Experience)
+		merged.Certifications = appendMissingStrings(merged.Certifications, persona.Certifications)
+	}
+	merged.BuiltBy = "HUMAN"
+	return merged
+}
+
+func firstNonBlank(current, candidate string) string {
+	if utilities.IsBlank(current) {
+		return candidate
+	}
+	return current
+}
+
+func appendMissingStrings(current, additional []string) []string {
+	for _, value := range additional {
+		found := false
+		for _, existing := range current {
+			if strings.EqualFold(strings.TrimSpace(existing), strings.TrimSpace(value)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			current = append(current, value)
+		}
+	}
+	return current
+}
+
+func appendMissing[A comparable](current, additional []A) []A {
+	for _, value := range additional {
+		found := false
+		for _, existing := range current {
+			if existing == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			current = append(current, value)
+		}
+	}
+	return current
+}
+
 func (p *Persona) Value() (driver.Value, error) {
 	return json.Marshal(p)
 }
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// PersonaFieldChange is a field that differs between two personas. Fields are named as in the persona json.
+// Text values are kept as they are, numbers are written out in decimal and lists are json encoded.
+// A value is blank when the field is not set.
+type PersonaFieldChange struct {
+	field    string
+	oldValue string
+	newValue string
+}
+
+// DiffPersonas lists the fields that changed from one persona to the other, in the order of the persona json.
+func DiffPersonas(from, to *Persona) []*PersonaFieldChange {
+	if from == nil {
+		from = &Persona{}
+	}
+	if to == nil {
+		to = &Persona{}
+	}
+
+	fromValue := reflect.ValueOf(*from)
+	toValue := reflect.ValueOf(*to)
+	personaType := fromValue.Type()
+
+	changes := []*PersonaFieldChange{}
+	for i := 0; i < personaType.NumField(); i++ {
+		oldValue := personaFieldValueAsString(fromValue.Field(i))
+		newValue := personaFieldValueAsString(toValue.Field(i))
+		if oldValue == newValue {
+			continue
+		}
+		changes = append(changes, &PersonaFieldChange{
+			field:    strings.Split(personaType.Field(i).Tag.Get("json"), ",")[0],
+			oldValue: oldValue,
+			newValue: newValue,
+		})
+	}
+	return changes
+}
+
+func personaFieldValueAsString(value reflect.Value) string {
+	switch value.Kind() {
+	case reflect.String:
+		return value.String()
+	case reflect.Int:
+		if value.Int() == 0 {
+			return ""
+		}
+		return strconv.FormatInt(value.Int(), 10)
+	case reflect.Slice:
+		if value.Len() == 0 {
+			return ""
+		}
+	}
+	jsonValue, err := json.Marshal(value.Interface())
+	if err != nil {
+		return ""
+	}
+	return string(jsonValue)
+}
+
+func (p *PersonaFieldChange) Field() string {
+	return p.field
+}
+
+func (p *PersonaFieldChange) OldValue() string {
+	return p.oldValue
+}
+
+func (p *PersonaFieldChange) NewValue() string {
+	return p.newValue
+}
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DiffPersonas(t *testing.T) {
+	tests := []struct {
+		name  string
+		input struct {
+			from *Persona
+			to   *Persona
+		}
+		expectedOutput []*PersonaFieldChange
+	}{
+		{
+			name: "equal personas have no changes",
+			input: struct {
+				from *Persona
+				to   *Persona
+			}{
+				from: &Persona{Name: "user_1", TechSkills: []string{"Go"}},
+				to:   &Persona{Name: "user_1", TechSkills: []string{"Go"}},
+			},
+			expectedOutput: []*PersonaFieldChange{},
+		},
+		{
+			name: "empty and missing lists are the same",
+			input: struct {
+				from *Persona
+				to   *Persona
+			}{
+				from: &Persona{Name: "user_1", TechSkills: []string{}},
+				to:   &Persona{Name: "user_1"},
+			},
+			expectedOutput: []*PersonaFieldChange{},
+		},
+		{
+			name: "lists changed fields in persona order",
+			input: struct {
+				from *Persona
+				to   *Persona
+			}{
+				from: &Persona{
+					Name:           "user_1",
+					City:           "Pune",
+					YoE:            4,
+					TechSkills:     []string{"Go"},
+					BuiltBy:        "AI",
+					BuilderVersion: "v1",
+				},
+				to: &Persona{
+					Name:       "user_1",
+					Email:      "user_1@example.com",
+					YoE:        5,
+					TechSkills: []string{"Go", "SQL"},
+					Experience: []Experience{{Title: "Engineer", Ongoing: true}},
+					BuiltBy:    "HUMAN",
+				},
+			},
+			expectedOutput: []*PersonaFieldChange{
+				{field: "Email", oldValue: "", newValue: "user_1@example.com"},
+				{field: "City", oldValue: "Pune", newValue: ""},
+				{field: "YoE", oldValue: "4", newValue: "5"},
+				{field: "Tech Skills", oldValue: `["Go"]`, newValue: `["Go","SQL"]`},
+				{field: "Experience", oldValue: "", newValue: `[{"Title":"Engineer","Ongoing":true}]`},
+				{field: "BuilderVersion", oldValue: "v1", newValue: ""},
+				{field: "BuiltBy", oldValue: "AI", newValue: "HUMAN"},
+			},
+		},
+		{
+			name: "a nil persona is empty",
+			input: struct {
+				from *Persona
+				to   *Persona
+			}{
+				from: nil,
+				to:   &Persona{Name: "user_1"},
+			},
+			expectedOutput: []*PersonaFieldChange{
+				{field: "Name", oldValue: "", newValue: "user_1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedOutput, DiffPersonas(tt.input.from, tt.input.to))
+		})
+	}
+}
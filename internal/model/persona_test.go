@@ -36,3 +36,90 @@ func Test_Persona_IsValid(t *testing.T) {
 		assert.True(t, persona.IsValid())
 	})
 }
+
+func Test_Persona_Version(t *testing.T) {
+	t.Run("returns the same version for equal personas", func(t *testing.T) {
+		persona := &Persona{Name: "awesome persona", TechSkills: []string{"Go"}}
+		other := &Persona{Name: "awesome persona", TechSkills: []string{"Go"}}
+		assert.Equal(t, persona.Version(), other.Version())
+		assert.Len(t, persona.Version(), 64)
+	})
+
+	t.Run("returns a different version once the persona changes", func(t *testing.T) {
+		persona := &Persona{Name: "awesome persona", TechSkills: []string{"Go"}}
+		other := &Persona{Name: "awesome persona", TechSkills: []string{"Go", "SQL"}}
+		assert.NotEqual(t, persona.Version(), other.Version())
+	})
+}
+
+func Test_MergePersonas(t *testing.T) {
+	tests := []struct {
+		name           string
+		primary        *Persona
+		others         []*Persona
+		expectedOutput *Persona
+	}{
+		{
+			name:    "fills blank fields from the other personas",
+			primary: &Persona{Name: "Jane Doe", BuiltBy: "AI", FileUploadId: "fp_id1", BuilderVersion: "v1"},
+			others: []*Persona{
+				{Name: "J. Doe", Email: "jane@example.com", City: "Pune"},
+				{Phone: "+91 98765 43210", City: "Mumbai", Country: "India"},
+			},
+			expectedOutput: &Persona{
+				Name:    "Jane Doe",
+				Email:   "jane@example.com",
+				Phone:   "+91 98765 43210",
+				City:    "Pune",
+				Country: "India",
+				BuiltBy: "HUMAN",
+			},
+		},
+		{
+			name: "combines lists without repeats and keeps the highest YoE",
+			primary: &Persona{
+				Name:       "Jane Doe",
+				YoE:        3,
+				TechSkills: []string{"Go", "SQL"},
+				Experience: []Experience{{Title: "Engineer", CompanyName: "Acme", StartingYear: "2019", Ongoing: true}},
+			},
+			others: []*Persona{
+				{
+					Name:           "Jane Doe",
+					YoE:            5,
+					TechSkills:     []string{"go", "Kubernetes"},
+					Certifications: []string{"CKA"},
+					Experience: []Experience{
+						{Title: "Engineer", CompanyName: "Acme", StartingYear: "2019", Ongoing: true},
+						{Title: "Intern", CompanyName: "Initech", StartingYear: "2018", EndingYear: "2019"},
+					},
+					Education: []Education{{Institute: "IIT Bombay", Qualification: "B.Tech", CompletionYear: "2018"}},
+				},
+			},
+			expectedOutput: &Persona{
+				Name:           "Jane Doe",
+				YoE:            5,
+				TechSkills:     []string{"Go", "SQL", "Kubernetes"},
+				Certifications: []string{"CKA"},
+				Experience: []Experience{
+					{Title: "Engineer", CompanyName: "Acme", StartingYear: "2019", Ongoing: true},
+					{Title: "Intern", CompanyName: "Initech", StartingYear: "2018", EndingYear: "2019"},
+				},
+				Education: []Education{{Institute: "IIT Bombay", Qualification: "B.Tech", CompletionYear: "2018"}},
+				BuiltBy:   "HUMAN",
+			},
+		},
+		{
+			name:           "ignores nil personas",
+			primary:        &Persona{Name: "Jane Doe"},
+			others:         []*Persona{nil},
+			expectedOutput: &Persona{Name: "Jane Doe", BuiltBy: "HUMAN"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedOutput, MergePersonas(tt.primary, tt.others...))
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+// DefaultPipelineStageNames are the stages a team starts with, in order.
+var DefaultPipelineStageNames = []string{"Sourced", "Screening", "Interview", "Offer", "Hired", "Rejected"}
+
+type PipelineStage struct {
+	id       string
+	name     string
+	position int
+	team     *Team
+}
+
+type PipelineStageOptions struct {
+	Id       string
+	Name     string
+	Position int
+	Team     *Team
+}
+
+func NewPipelineStage(opts PipelineStageOptions) (*PipelineStage, error) {
+	if utilities.IsBlank(opts.Id) {
+		return nil, errors.New("cannot create PipelineStage with an empty id")
+	}
+
+	if utilities.IsBlank(opts.Name) {
+		return nil, errors.New("cannot create PipelineStage with an empty name")
+	}
+
+	if opts.Position < 0 {
+		return nil, errors.New("cannot create PipelineStage with a negative position")
+	}
+
+	if opts.Team == nil {
+		return nil, errors.New("cannot create PipelineStage with a nil Team")
+	}
+
+	return &PipelineStage{
+		id:       opts.Id,
+		name:     strings.TrimSpace(opts.Name),
+		position: opts.Position,
+		team:     opts.Team,
+	}, nil
+}
+
+func (p *PipelineStage) Id() string {
+	return p.id
+}
+
+func (p *PipelineStage) Name() string {
+	return p.name
+}
+
+func (p *PipelineStage) Position() int {
+	return p.position
+}
+
+func (p *PipelineStage) Team() *Team {
+	return p.team
+}
@@ -0,0 +1,92 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewPipelineStage(t *testing.T) {
+	currentFileCount := 1
+	team, _ := NewTeam(TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	tests := []struct {
+		name           string
+		input          PipelineStageOptions
+		expectedOutput *PipelineStage
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "id is empty",
+			input:          PipelineStageOptions{},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create PipelineStage with an empty id",
+		},
+		{
+			name: "name is empty",
+			input: PipelineStageOptions{
+				Id:   "ps_id1",
+				Name: "  ",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create PipelineStage with an empty name",
+		},
+		{
+			name: "position is negative",
+			input: PipelineStageOptions{
+				Id:       "ps_id1",
+				Name:     "Sourced",
+				Position: -1,
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create PipelineStage with a negative position",
+		},
+		{
+			name: "team is nil",
+			input: PipelineStageOptions{
+				Id:   "ps_id1",
+				Name: "Sourced",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create PipelineStage with a nil Team",
+		},
+		{
+			name: "PipelineStage gets created successfully",
+			input: PipelineStageOptions{
+				Id:       "ps_id1",
+				Name:     " Sourced ",
+				Position: 2,
+				Team:     team,
+			},
+			expectedOutput: &PipelineStage{
+				id:       "ps_id1",
+				name:     "Sourced",
+				position: 2,
+				team:     team,
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewPipelineStage(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package model
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+type ScorecardRating struct {
+	criterionId string
+	rating      int
+}
+
+type ScorecardRatingOptions struct {
+	CriterionId string
+	Rating      int
+}
+
+func NewScorecardRating(opts ScorecardRatingOptions) (*ScorecardRating, error) {
+	if utilities.IsBlank(opts.CriterionId) {
+		return nil, errors.New("cannot create ScorecardRating with an empty criterion id")
+	}
+
+	if opts.Rating < MinScorecardRating || opts.Rating > MaxScorecardRating {
+		return nil, errors.Errorf("cannot create ScorecardRating with a rating outside %d to %d", MinScorecardRating, MaxScorecardRating)
+	}
+
+	return &ScorecardRating{
+		criterionId: opts.CriterionId,
+		rating:      opts.Rating,
+	}, nil
+}
+
+func (s *ScorecardRating) CriterionId() string {
+	return s.criterionId
+}
+
+func (s *ScorecardRating) Rating() int {
+	return s.rating
+}
+
+// Scorecard holds the ratings an interviewer gave a candidate, optionally for a job opening.
+// The interviewer id is blank when the interviewer has since been deleted.
+type Scorecard struct {
+	id                   string
+	candidateId          string
+	jobOpeningId         string
+	interviewerUserId    string
+	interviewerUserEmail string
+	comment              string
+	ratings              []*ScorecardRating
+	createdAt            time.Time
+	updatedAt            time.Time
+}
+
+type ScorecardOptions struct {
+	Id                   string
+	CandidateId          string
+	JobOpeningId         string
+	InterviewerUserId    string
+	InterviewerUserEmail string
+	Comment              string
+	Ratings              []*ScorecardRating
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+func NewScorecard(opts ScorecardOptions) (*Scorecard, error) {
+	if utilities.IsBlank(opts.Id) {
+		return nil, errors.New("cannot create Scorecard with an empty id")
+	}
+
+	if utilities.IsBlank(opts.CandidateId) {
+		return nil, errors.New("cannot create Scorecard with an empty candidate id")
+	}
+
+	if len(opts.Ratings) == 0 {
+		return nil, errors.New("cannot create Scorecard without ratings")
+	}
+
+	ratedCriterionIds := map[string]bool{}
+	for _, rating := range opts.Ratings {
+		if rating == nil {
+			return nil, errors.New("cannot create Scorecard with a nil rating")
+		}
+		if ratedCriterionIds[rating.CriterionId()] {
+			return nil, errors.Errorf("cannot create Scorecard with more than one rating for criterion %s", rating.CriterionId())
+		}
+		ratedCriterionIds[rating.CriterionId()] = true
+	}
+
+	return &Scorecard{
+		id:                   opts.Id,
+		candidateId:          opts.CandidateId,
+		jobOpeningId:         opts.JobOpeningId,
+		interviewerUserId:    opts.InterviewerUserId,
+		interviewerUserEmail: opts.InterviewerUserEmail,
+		comment:              opts.Comment,
+		ratings:              opts.Ratings,
+		createdAt:            opts.CreatedAt,
+		updatedAt:            opts.UpdatedAt,
+	}, nil
+}
+
+func (s *Scorecard) Id() string {
+	return s.id
+}
+
+func (s *Scorecard) CandidateId() string {
+	return s.candidateId
+}
+
+func (s *Scorecard) JobOpeningId() string {
+	return s.jobOpeningId
+}
+
+func (s *Scorecard) InterviewerUserId() string {
+	return s.interviewerUserId
+}
+
+func (s *Scorecard) InterviewerUserEmail() string {
+	return s.interviewerUserEmail
+}
+
+func (s *Scorecard) Comment() string {
+	return s.comment
+}
+
+func (s *Scorecard) Ratings() []*ScorecardRating {
+	return s.ratings
+}
+
+func (s *Scorecard) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *Scorecard) UpdatedAt() time.Time {
+	return s.updatedAt
+}
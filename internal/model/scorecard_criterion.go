@@ -0,0 +1,66 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+// Every scorecard criterion is rated on the same scale.
+const (
+	MinScorecardRating = 1
+	MaxScorecardRating = 5
+)
+
+// ScorecardCriterion is something a team rates candidates on during interviews, e.g. communication.
+type ScorecardCriterion struct {
+	id          string
+	name        string
+	description string
+	team        *Team
+}
+
+type ScorecardCriterionOptions struct {
+	Id          string
+	Name        string
+	Description string
+	Team        *Team
+}
+
+func NewScorecardCriterion(opts ScorecardCriterionOptions) (*ScorecardCriterion, error) {
+	if utilities.IsBlank(opts.Id) {
+		return nil, errors.New("cannot create ScorecardCriterion with an empty id")
+	}
+
+	if utilities.IsBlank(opts.Name) {
+		return nil, errors.New("cannot create ScorecardCriterion with an empty name")
+	}
+
+	if opts.Team == nil {
+		return nil, errors.New("cannot create ScorecardCriterion with a nil Team")
+	}
+
+	return &ScorecardCriterion{
+		id:          opts.Id,
+		name:        strings.TrimSpace(opts.Name),
+		description: strings.TrimSpace(opts.Description),
+		team:        opts.Team,
+	}, nil
+}
+
+func (s *ScorecardCriterion) Id() string {
+	return s.id
+}
+
+func (s *ScorecardCriterion) Name() string {
+	return s.name
+}
+
+func (s *ScorecardCriterion) Description() string {
+	return s.description
+}
+
+func (s *ScorecardCriterion) Team() *Team {
+	return s.team
+}
@@ -0,0 +1,81 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewScorecardCriterion(t *testing.T) {
+	currentFileCount := 1
+	team, _ := NewTeam(TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	tests := []struct {
+		name           string
+		input          ScorecardCriterionOptions
+		expectedOutput *ScorecardCriterion
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "id is empty",
+			input:          ScorecardCriterionOptions{},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create ScorecardCriterion with an empty id",
+		},
+		{
+			name: "name is empty",
+			input: ScorecardCriterionOptions{
+				Id:   "sc_crit_id1",
+				Name: " ",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create ScorecardCriterion with an empty name",
+		},
+		{
+			name: "team is nil",
+			input: ScorecardCriterionOptions{
+				Id:   "sc_crit_id1",
+				Name: "Communication",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create ScorecardCriterion with a nil Team",
+		},
+		{
+			name: "ScorecardCriterion gets created successfully",
+			input: ScorecardCriterionOptions{
+				Id:          "sc_crit_id1",
+				Name:        " System design ",
+				Description: " Can break down a large problem ",
+				Team:        team,
+			},
+			expectedOutput: &ScorecardCriterion{
+				id:          "sc_crit_id1",
+				name:        "System design",
+				description: "Can break down a large problem",
+				team:        team,
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewScorecardCriterion(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
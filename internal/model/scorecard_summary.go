@@ -0,0 +1,86 @@
+package model
+
+// Interviewers disagree on a criterion when their ratings are at least this far apart.
+const scorecardDisagreementSpread = 2
+
+// ScorecardCriterionSummary aggregates the ratings that a candidate received for a criterion.
+type ScorecardCriterionSummary struct {
+	criterionId   string
+	criterionName string
+	ratingCount   int
+	average       float64
+	minRating     int
+	maxRating     int
+}
+
+// SummarizeScorecards returns one summary per criterion, in the order of the criteria.
+// Criteria that nobody rated are included with a zero rating count.
+func SummarizeScorecards(criteria []*ScorecardCriterion, scorecards []*Scorecard) []*ScorecardCriterionSummary {
+	ratingsByCriterionId := map[string][]int{}
+	for _, scorecard := range scorecards {
+		for _, rating := range scorecard.Ratings() {
+			ratingsByCriterionId[rating.CriterionId()] = append(ratingsByCriterionId[rating.CriterionId()], rating.Rating())
+		}
+	}
+
+	summaries := []*ScorecardCriterionSummary{}
+	for _, criterion := range criteria {
+		summary := &ScorecardCriterionSummary{
+			criterionId:   criterion.Id(),
+			criterionName: criterion.Name(),
+		}
+		ratings := ratingsByCriterionId[criterion.Id()]
+		if len(ratings) > 0 {
+			total := 0
+			summary.minRating = ratings[0]
+			summary.maxRating = ratings[0]
+			for _, rating := range ratings {
+				total += rating
+				if rating < summary.minRating {
+					summary.minRating = rating
+				}
+				if rating > summary.maxRating {
+					summary.maxRating = rating
+				}
+			}
+			summary.ratingCount = len(ratings)
+			summary.average = float64(total) / float64(len(ratings))
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
+
+func (s *ScorecardCriterionSummary) CriterionId() string {
+	return s.criterionId
+}
+
+func (s *ScorecardCriterionSummary) CriterionName() string {
+	return s.criterionName
+}
+
+func (s *ScorecardCriterionSummary) RatingCount() int {
+	return s.ratingCount
+}
+
+func (s *ScorecardCriterionSummary) Average() float64 {
+	return s.average
+}
+
+func (s *ScorecardCriterionSummary) MinRating() int {
+	return s.minRating
+}
+
+func (s *ScorecardCriterionSummary) MaxRating() int {
+	return s.maxRating
+}
+
+// Spread is how far apart the lowest and highest ratings are.
+func (s *ScorecardCriterionSummary) Spread() int {
+	return s.maxRating - s.minRating
+}
+
+// HasDisagreement flags criteria where interviewers should talk before a decision is made.
+func (s *ScorecardCriterionSummary) HasDisagreement() bool {
+	return s.ratingCount > 1 && s.Spread() >= scorecardDisagreementSpread
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SummarizeScorecards(t *testing.T) {
+	communication := &ScorecardCriterion{id: "sc_crit_id1", name: "Communication"}
+	systemDesign := &ScorecardCriterion{id: "sc_crit_id2", name: "System design"}
+	coding := &ScorecardCriterion{id: "sc_crit_id3", name: "Coding"}
+	criteria := []*ScorecardCriterion{communication, systemDesign, coding}
+
+	tests := []struct {
+		name           string
+		input          []*Scorecard
+		expectedOutput []*ScorecardCriterionSummary
+		disagreements  []bool
+	}{
+		{
+			name:  "criteria without scorecards have no ratings",
+			input: nil,
+			expectedOutput: []*ScorecardCriterionSummary{
+				{criterionId: "sc_crit_id1", criterionName: "Communication"},
+				{criterionId: "sc_crit_id2", criterionName: "System design"},
+				{criterionId: "sc_crit_id3", criterionName: "Coding"},
+			},
+			disagreements: []bool{false, false, false},
+		},
+		{
+			name: "averages ratings and flags ratings that are far apart",
+			input: []*Scorecard{
+				{
+					id: "sc_id1",
+					ratings: []*ScorecardRating{
+						{criterionId: "sc_crit_id1", rating: 4},
+						{criterionId: "sc_crit_id2", rating: 5},
+						{criterionId: "sc_crit_id3", rating: 3},
+					},
+				},
+				{
+					id: "sc_id2",
+					ratings: []*ScorecardRating{
+						{criterionId: "sc_crit_id1", rating: 5},
+						{criterionId: "sc_crit_id2", rating: 2},
+						{criterionId: "deleted_crit_id", rating: 1},
+					},
+				},
+				{
+					id: "sc_id3",
+					ratings: []*ScorecardRating{
+						{criterionId: "sc_crit_id1", rating: 4},
+						{criterionId: "sc_crit_id2", rating: 3},
+					},
+				},
+			},
+			expectedOutput: []*ScorecardCriterionSummary{
+				{criterionId: "sc_crit_id1", criterionName: "Communication", ratingCount: 3, average: 13.0 / 3.0, minRating: 4, maxRating: 5},
+				{criterionId: "sc_crit_id2", criterionName: "System design", ratingCount: 3, average: 10.0 / 3.0, minRating: 2, maxRating: 5},
+				{criterionId: "sc_crit_id3", criterionName: "Coding", ratingCount: 1, average: 3, minRating: 3, maxRating: 3},
+			},
+			disagreements: []bool{false, true, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := SummarizeScorecards(criteria, tt.input)
+			assert.Equal(t, tt.expectedOutput, result)
+			disagreements := []bool{}
+			for _, summary := range result {
+				disagreements = append(disagreements, summary.HasDisagreement())
+			}
+			assert.Equal(t, tt.disagreements, disagreements)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewScorecardRating(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          ScorecardRatingOptions
+		expectedOutput *ScorecardRating
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "criterion id is empty",
+			input:          ScorecardRatingOptions{Rating: 3},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create ScorecardRating with an empty criterion id",
+		},
+		{
+			name:           "rating is below the scale",
+			input:          ScorecardRatingOptions{CriterionId: "sc_crit_id1", Rating: 0},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create ScorecardRating with a rating outside 1 to 5",
+		},
+		{
+			name:           "rating is above the scale",
+			input:          ScorecardRatingOptions{CriterionId: "sc_crit_id1", Rating: 6},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create ScorecardRating with a rating outside 1 to 5",
+		},
+		{
+			name:           "ScorecardRating gets created successfully",
+			input:          ScorecardRatingOptions{CriterionId: "sc_crit_id1", Rating: 5},
+			expectedOutput: &ScorecardRating{criterionId: "sc_crit_id1", rating: 5},
+			errorExpected:  false,
+			errorString:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewScorecardRating(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
+
+func Test_NewScorecard(t *testing.T) {
+	createdAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	rating1 := &ScorecardRating{criterionId: "sc_crit_id1", rating: 4}
+	rating2 := &ScorecardRating{criterionId: "sc_crit_id2", rating: 2}
+	tests := []struct {
+		name           string
+		input          ScorecardOptions
+		expectedOutput *Scorecard
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "id is empty",
+			input:          ScorecardOptions{},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create Scorecard with an empty id",
+		},
+		{
+			name: "candidate id is empty",
+			input: ScorecardOptions{
+				Id: "sc_id1",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create Scorecard with an empty candidate id",
+		},
+		{
+			name: "ratings are empty",
+			input: ScorecardOptions{
+				Id:          "sc_id1",
+				CandidateId: "c_id1",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create Scorecard without ratings",
+		},
+		{
+			name: "a rating is nil",
+			input: ScorecardOptions{
+				Id:          "sc_id1",
+				CandidateId: "c_id1",
+				Ratings:     []*ScorecardRating{rating1, nil},
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create Scorecard with a nil rating",
+		},
+		{
+			name: "a criterion is rated twice",
+			input: ScorecardOptions{
+				Id:          "sc_id1",
+				CandidateId: "c_id1",
+				Ratings:     []*ScorecardRating{rating1, {criterionId: "sc_crit_id1", rating: 1}},
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create Scorecard with more than one rating for criterion sc_crit_id1",
+		},
+		{
+			name: "Scorecard gets created successfully",
+			input: ScorecardOptions{
+				Id:                   "sc_id1",
+				CandidateId:          "c_id1",
+				JobOpeningId:         "jo_id1",
+				InterviewerUserId:    "user_id1",
+				InterviewerUserEmail: "test@example.com",
+				Comment:              "Good on fundamentals",
+				Ratings:              []*ScorecardRating{rating1, rating2},
+				CreatedAt:            createdAt,
+				UpdatedAt:            createdAt,
+			},
+			expectedOutput: &Scorecard{
+				id:                   "sc_id1",
+				candidateId:          "c_id1",
+				jobOpeningId:         "jo_id1",
+				interviewerUserId:    "user_id1",
+				interviewerUserEmail: "test@example.com",
+				comment:              "Good on fundamentals",
+				ratings:              []*ScorecardRating{rating1, rating2},
+				createdAt:            createdAt,
+				updatedAt:            createdAt,
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewScorecard(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+// Tag is a free-form label that a team attaches to its candidates.
+type Tag struct {
+	id             string
+	name           string
+	candidateCount int
+	team           *Team
+}
+
+type TagOptions struct {
+	Id             string
+	Name           string
+	CandidateCount int
+	Team           *Team
+}
+
+func NewTag(opts TagOptions) (*Tag, error) {
+	if utilities.IsBlank(opts.Id) {
+		return nil, errors.New("cannot create Tag with an empty id")
+	}
+
+	if utilities.IsBlank(opts.Name) {
+		return nil, errors.New("cannot create Tag with an empty name")
+	}
+
+	if opts.CandidateCount < 0 {
+		return nil, errors.New("cannot create Tag with a negative candidate count")
+	}
+
+	if opts.Team == nil {
+		return nil, errors.New("cannot create Tag with a nil Team")
+	}
+
+	return &Tag{
+		id:             opts.Id,
+		name:           strings.TrimSpace(opts.Name),
+		candidateCount: opts.CandidateCount,
+		team:           opts.Team,
+	}, nil
+}
+
+func (t *Tag) Id() string {
+	return t.id
+}
+
+func (t *Tag) Name() string {
+	return t.name
+}
+
+// CandidateCount only counts candidates that are not archived.
+func (t *Tag) CandidateCount() int {
+	return t.candidateCount
+}
+
+func (t *Tag) Team() *Team {
+	return t.team
+}
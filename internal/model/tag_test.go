@@ -0,0 +1,92 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewTag(t *testing.T) {
+	currentFileCount := 1
+	team, _ := NewTeam(TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	tests := []struct {
+		name           string
+		input          TagOptions
+		expectedOutput *Tag
+		errorExpected  bool
+		errorString    string
+	}{
+		{
+			name:           "id is empty",
+			input:          TagOptions{},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create Tag with an empty id",
+		},
+		{
+			name: "name is empty",
+			input: TagOptions{
+				Id:   "tag_id1",
+				Name: " ",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create Tag with an empty name",
+		},
+		{
+			name: "candidate count is negative",
+			input: TagOptions{
+				Id:             "tag_id1",
+				Name:           "referral",
+				CandidateCount: -1,
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create Tag with a negative candidate count",
+		},
+		{
+			name: "team is nil",
+			input: TagOptions{
+				Id:   "tag_id1",
+				Name: "referral",
+			},
+			expectedOutput: nil,
+			errorExpected:  true,
+			errorString:    "cannot create Tag with a nil Team",
+		},
+		{
+			name: "Tag gets created successfully",
+			input: TagOptions{
+				Id:             "tag_id1",
+				Name:           " relocation-ok ",
+				CandidateCount: 3,
+				Team:           team,
+			},
+			expectedOutput: &Tag{
+				id:             "tag_id1",
+				name:           "relocation-ok",
+				candidateCount: 3,
+				team:           team,
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewTag(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, result)
+		})
+	}
+}
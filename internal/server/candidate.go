@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/lib/dedupe"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/lib/parser/personabuilder"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
 	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -23,25 +25,76 @@ func (s *CandidateTrackerGoService) GetCandidates(ctx context.Context, req *pb.G
 
 	team := userWithTeam.Team()
 
+	filter, err := candidateFilterFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := pageRequestFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
 	responseData := []*pb.Candidate{}
-	candidates, err := s.storage.GetCandidatesForTeam(team)
+	candidates, pageInfo, err := s.storage.GetCandidatesForTeam(team, filter, page)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, candidate := range candidates {
-		candidateResponse := pb.Candidate{
-			Id:                     candidate.Id(),
-			AiGeneratedPersona:     candidate.AiGeneratedPersonaAsJsonString(),
-			ManuallyCreatedPersona: candidate.ManuallyCreatedPersonaAsJsonString(),
-			FileUploadId:           candidate.FileUploadId(),
-			UpdatedAt:              timestamppb.New(candidate.UpdatedAt()),
-		}
-		responseData = append(responseData, &candidateResponse)
+		responseData = append(responseData, candidateResponse(candidate))
 	}
 
 	return &pb.GetCandidatesResponse{
-		Candidates: responseData,
+		Candidates:    responseData,
+		NextPageToken: pageInfo.NextPageToken(),
+		TotalCount:    int64(pageInfo.TotalCount()),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) SearchCandidates(ctx context.Context, req *pb.SearchCandidatesRequest) (*pb.SearchCandidatesResponse, error) {
+	query := req.GetQuery()
+	if utilities.IsBlank(query) {
+		return nil, errors.New("query cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	page, err := pageRequestFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseData := []*pb.CandidateSearchResult{}
+	results, pageInfo, err := s.storage.SearchCandidatesForTeam(query, team, page)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		candidate := result.Candidate()
+		resultResponse := pb.CandidateSearchResult{
+			Candidate: candidateResponse(candidate),
+			Rank:      result.Rank(),
+			Snippet:   result.Snippet(),
+		}
+		responseData = append(responseData, &resultResponse)
+	}
+
+	return &pb.SearchCandidatesResponse{
+		Results:       responseData,
+		NextPageToken: pageInfo.NextPageToken(),
+		TotalCount:    int64(pageInfo.TotalCount()),
 	}, nil
 }
 
@@ -68,14 +121,20 @@ func (s *CandidateTrackerGoService) GetCandidate(ctx context.Context, req *pb.Ge
 		return nil, err
 	}
 
+	notes, err := s.storage.GetCandidateNotesForTeam(id, team)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.storage.GetTagsForCandidateForTeam(id, team)
+	if err != nil {
+		return nil, err
+	}
+
 	return &pb.GetCandidateResponse{
-		Candidate: &pb.Candidate{
-			Id:                     candidate.Id(),
-			AiGeneratedPersona:     candidate.AiGeneratedPersonaAsJsonString(),
-			ManuallyCreatedPersona: candidate.ManuallyCreatedPersonaAsJsonString(),
-			FileUploadId:           candidate.FileUploadId(),
-			UpdatedAt:              timestamppb.New(candidate.UpdatedAt()),
-		},
+		Candidate: candidateResponse(candidate),
+		Notes:     candidateNotesResponse(notes),
+		Tags:      tagsResponse(tags),
 	}, nil
 }
 
@@ -100,7 +159,7 @@ func (s *CandidateTrackerGoService) UpdateCandidate(ctx context.Context, req *pb
 	}
 	persona.BuiltBy = "HUMAN"
 
-	id, err := s.storage.UpdateCandidateWithManuallyCreatedPersonaForTeam(candidateId, persona, team)
+	id, err := s.storage.UpdateCandidateWithManuallyCreatedPersonaForTeam(candidateId, persona, user.GetId(), team)
 	if err != nil {
 		return nil, err
 	}
@@ -109,3 +168,253 @@ func (s *CandidateTrackerGoService) UpdateCandidate(ctx context.Context, req *pb
 		Id: id,
 	}, nil
 }
+
+func (s *CandidateTrackerGoService) GetDuplicateCandidates(ctx context.Context, req *pb.GetDuplicateCandidatesRequest) (*pb.GetDuplicateCandidatesResponse, error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	candidates, err := s.storage.GetAllCandidatesForTeam(team)
+	if err != nil {
+		return nil, err
+	}
+
+	responseData := []*pb.DuplicateCandidateCluster{}
+	for _, cluster := range dedupe.FindDuplicateCandidates(candidates) {
+		clusterResponse := pb.DuplicateCandidateCluster{}
+		for _, candidate := range cluster.Candidates {
+			clusterResponse.Candidates = append(clusterResponse.Candidates, candidateResponse(candidate))
+		}
+		for _, match := range cluster.Matches {
+			reasons := []string{}
+			for _, reason := range match.Reasons {
+				reasons = append(reasons, string(reason))
+			}
+			clusterResponse.Matches = append(clusterResponse.Matches, &pb.DuplicateCandidateMatch{
+				CandidateId:      match.CandidateId,
+				OtherCandidateId: match.OtherCandidateId,
+				Reasons:          reasons,
+			})
+		}
+		responseData = append(responseData, &clusterResponse)
+	}
+
+	return &pb.GetDuplicateCandidatesResponse{
+		Clusters: responseData,
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) MergeCandidates(ctx context.Context, req *pb.MergeCandidatesRequest) (*pb.MergeCandidatesResponse, error) {
+	survivingCandidateId := req.GetSurvivingCandidateId()
+	if utilities.IsBlank(survivingCandidateId) {
+		return nil, errors.New("survivingCandidateId cannot be blank")
+	}
+
+	mergedCandidateIds := req.GetMergedCandidateIds()
+	if len(mergedCandidateIds) == 0 {
+		return nil, errors.New("mergedCandidateIds cannot be empty")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	candidate, err := s.storage.MergeCandidatesForTeam(survivingCandidateId, mergedCandidateIds, user.GetId(), team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MergeCandidatesResponse{
+		Candidate: candidateResponse(candidate),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) ArchiveCandidate(ctx context.Context, req *pb.ArchiveCandidateRequest) (*pb.ArchiveCandidateResponse, error) {
+	id := req.GetId()
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	err = s.storage.ArchiveCandidateForTeam(id, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ArchiveCandidateResponse{}, nil
+}
+
+func (s *CandidateTrackerGoService) UnarchiveCandidate(ctx context.Context, req *pb.UnarchiveCandidateRequest) (*pb.UnarchiveCandidateResponse, error) {
+	id := req.GetId()
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	err = s.storage.UnarchiveCandidateForTeam(id, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UnarchiveCandidateResponse{}, nil
+}
+
+func (s *CandidateTrackerGoService) DeleteCandidate(ctx context.Context, req *pb.DeleteCandidateRequest) (*pb.DeleteCandidateResponse, error) {
+	id := req.GetId()
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetDeleteFileUpload() && !s.config.AllowFileDeletion {
+		return nil, errors.New("File deletion is currently disabled")
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	if !req.GetDeleteFileUpload() {
+		err = s.storage.DeleteCandidateForTeam(id, team)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.DeleteCandidateResponse{}, nil
+	}
+
+	err = s.deleteCandidateWithFileUploadForTeam(id, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteCandidateResponse{}, nil
+}
+
+// deleteCandidateWithFileUploadForTeam deletes the file upload of the candidate, which also deletes the candidate.
+// The stored file is only deleted once nothing refers to it. Failing to delete it leaves an unused file behind, so it is only logged.
+func (s *CandidateTrackerGoService) deleteCandidateWithFileUploadForTeam(id string, team *model.Team) error {
+	candidate, err := s.storage.GetCandidateForTeam(id, team)
+	if err != nil {
+		return err
+	}
+
+	if utilities.IsBlank(candidate.FileUploadId()) {
+		return s.storage.DeleteCandidateForTeam(id, team)
+	}
+
+	fileUpload, err := s.storage.GetFileUpload(candidate.FileUploadId())
+	if err != nil {
+		return err
+	}
+
+	if !fileUpload.BelongsToTeam(team) {
+		return utilities.NewBadError("unauthorized deletion of fileUpload attempted")
+	}
+
+	err = s.storage.DeleteFileUploadForTeam(fileUpload.Id(), team)
+	if err != nil {
+		return err
+	}
+
+	err = s.fileStorer.DeleteFile(fileUpload.StoragePath(), fileUpload.Name())
+	if err != nil {
+		s.logger.LogError(err)
+	}
+	return nil
+}
+
+func candidateResponse(candidate *model.Candidate) *pb.Candidate {
+	response := &pb.Candidate{
+		Id:                     candidate.Id(),
+		AiGeneratedPersona:     candidate.AiGeneratedPersonaAsJsonString(),
+		ManuallyCreatedPersona: candidate.ManuallyCreatedPersonaAsJsonString(),
+		FileUploadId:           candidate.FileUploadId(),
+		UpdatedAt:              timestamppb.New(candidate.UpdatedAt()),
+		PipelineStageId:        candidate.PipelineStageId(),
+	}
+	if candidate.IsArchived() {
+		response.ArchivedAt = timestamppb.New(candidate.ArchivedAt())
+	}
+	return response
+}
+
+func candidateFilterFromRequest(req *pb.GetCandidatesRequest) (*model.CandidateFilter, error) {
+	requestFilter := req.GetFilter()
+	filterOpts := model.CandidateFilterOptions{
+		TechSkills:       requestFilter.GetTechSkills(),
+		City:             requestFilter.GetCity(),
+		State:            requestFilter.GetState(),
+		Country:          requestFilter.GetCountry(),
+		RecommendedRole:  requestFilter.GetRecommendedRole(),
+		Certification:    requestFilter.GetCertification(),
+		BuiltBy:          requestFilter.GetBuiltBy(),
+		PipelineStageIds: requestFilter.GetPipelineStageIds(),
+		AnyTagIds:        requestFilter.GetAnyTagIds(),
+		AllTagIds:        requestFilter.GetAllTagIds(),
+		IncludeArchived:  requestFilter.GetIncludeArchived(),
+	}
+
+	if requestFilter != nil && requestFilter.MinYoE != nil {
+		minYoE := int(requestFilter.GetMinYoE())
+		filterOpts.MinYoE = &minYoE
+	}
+
+	if requestFilter != nil && requestFilter.MaxYoE != nil {
+		maxYoE := int(requestFilter.GetMaxYoE())
+		filterOpts.MaxYoE = &maxYoE
+	}
+
+	for _, sort := range req.GetSorts() {
+		filterOpts.Sorts = append(filterOpts.Sorts, model.CandidateSortOptions{
+			Field:      sort.GetField(),
+			Descending: sort.GetDescending(),
+		})
+	}
+
+	return model.NewCandidateFilter(filterOpts)
+}
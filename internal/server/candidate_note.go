@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *CandidateTrackerGoService) AddCandidateNote(ctx context.Context, req *pb.AddCandidateNoteRequest) (*pb.AddCandidateNoteResponse, error) {
+	candidateId := req.GetCandidateId()
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	body := req.GetBody()
+	if utilities.IsBlank(body) {
+		return nil, errors.New("body cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	note, err := s.storage.AddCandidateNoteForTeam(candidateId, body, user.GetId(), team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddCandidateNoteResponse{
+		Note: candidateNoteResponse(note),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) GetCandidateNotes(ctx context.Context, req *pb.GetCandidateNotesRequest) (*pb.GetCandidateNotesResponse, error) {
+	candidateId := req.GetCandidateId()
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	notes, err := s.storage.GetCandidateNotesForTeam(candidateId, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCandidateNotesResponse{
+		Notes: candidateNotesResponse(notes),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) UpdateCandidateNote(ctx context.Context, req *pb.UpdateCandidateNoteRequest) (*pb.UpdateCandidateNoteResponse, error) {
+	id := req.GetId()
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	body := req.GetBody()
+	if utilities.IsBlank(body) {
+		return nil, errors.New("body cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	note, err := s.storage.UpdateCandidateNoteForTeam(id, body, user.GetId(), team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateCandidateNoteResponse{
+		Note: candidateNoteResponse(note),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) DeleteCandidateNote(ctx context.Context, req *pb.DeleteCandidateNoteRequest) (*pb.DeleteCandidateNoteResponse, error) {
+	id := req.GetId()
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	err = s.storage.DeleteCandidateNoteForTeam(id, user.GetId(), team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteCandidateNoteResponse{}, nil
+}
+
+func candidateNoteResponse(note *model.CandidateNote) *pb.CandidateNote {
+	return &pb.CandidateNote{
+		Id:              note.Id(),
+		CandidateId:     note.CandidateId(),
+		AuthorUserId:    note.AuthorUserId(),
+		AuthorUserEmail: note.AuthorUserEmail(),
+		Body:            note.Body(),
+		CreatedAt:       timestamppb.New(note.CreatedAt()),
+		UpdatedAt:       timestamppb.New(note.UpdatedAt()),
+	}
+}
+
+func candidateNotesResponse(notes []*model.CandidateNote) []*pb.CandidateNote {
+	response := []*pb.CandidateNote{}
+	for _, note := range notes {
+		response = append(response, candidateNoteResponse(note))
+	}
+	return response
+}
@@ -0,0 +1,541 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_AddCandidateNote(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	createdAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	note, _ := model.NewCandidateNote(model.CandidateNoteOptions{
+		Id:              "cn_id1",
+		CandidateId:     "c_id1",
+		AuthorUserId:    "user_id1",
+		AuthorUserEmail: "test@example.com",
+		Body:            "Strong systems design round",
+		CreatedAt:       createdAt,
+		UpdatedAt:       createdAt,
+	})
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.New(
+			map[string]string{
+				requestingUserIdCtxKey:    "user_id1",
+				requestingUserEmailCtxKey: "user@example.com",
+			},
+		),
+	)
+
+	tests := []struct {
+		name                      string
+		ctx                       context.Context
+		input                     *pb.AddCandidateNoteRequest
+		output                    *pb.AddCandidateNoteResponse
+		teamHydratorMock          storage.TeamHydrator
+		candidateNoteAccessorMock storage.CandidateNoteAccessor
+		errorExpected             bool
+		errorString               string
+	}{
+		{
+			name:                      "errors if candidateId is blank",
+			ctx:                       ctx,
+			input:                     &pb.AddCandidateNoteRequest{Body: "note"},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			candidateNoteAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "candidateId cannot be blank",
+		},
+		{
+			name:                      "errors if body is blank",
+			ctx:                       ctx,
+			input:                     &pb.AddCandidateNoteRequest{CandidateId: "c_id1", Body: " "},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			candidateNoteAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "body cannot be blank",
+		},
+		{
+			name:                      "errors if no user in context",
+			ctx:                       context.Background(),
+			input:                     &pb.AddCandidateNoteRequest{CandidateId: "c_id1", Body: "note"},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			candidateNoteAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name:                      "errors if unable to hydrate team",
+			ctx:                       ctx,
+			input:                     &pb.AddCandidateNoteRequest{CandidateId: "c_id1", Body: "note"},
+			output:                    nil,
+			teamHydratorMock:          &storage.TeamHydratorMockFailure{},
+			candidateNoteAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "unable to hydrate team",
+		},
+		{
+			name:             "errors if unable to add note",
+			ctx:              ctx,
+			input:            &pb.AddCandidateNoteRequest{CandidateId: "c_id2", Body: "note"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateNoteAccessorMock: &storage.CandidateNoteAccessorConfigurableMock{
+				AddCandidateNoteForTeamInternal: func(candidateId, body, authorUserId string, team *model.Team) (*model.CandidateNote, error) {
+					return nil, errors.New("no candidate for id c_id2")
+				},
+			},
+			errorExpected: true,
+			errorString:   "no candidate for id c_id2",
+		},
+		{
+			name:             "adds the note as the requesting user",
+			ctx:              ctx,
+			input:            &pb.AddCandidateNoteRequest{CandidateId: "c_id1", Body: "Strong systems design round"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			output: &pb.AddCandidateNoteResponse{
+				Note: &pb.CandidateNote{
+					Id:              "cn_id1",
+					CandidateId:     "c_id1",
+					AuthorUserId:    "user_id1",
+					AuthorUserEmail: "test@example.com",
+					Body:            "Strong systems design round",
+					CreatedAt:       timestamppb.New(createdAt),
+					UpdatedAt:       timestamppb.New(createdAt),
+				},
+			},
+			candidateNoteAccessorMock: &storage.CandidateNoteAccessorConfigurableMock{
+				AddCandidateNoteForTeamInternal: func(candidateId, body, authorUserId string, team *model.Team) (*model.CandidateNote, error) {
+					if candidateId != "c_id1" || body != "Strong systems design round" || authorUserId != "user_id1" {
+						return nil, errors.New("unexpected note")
+					}
+					return note, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithCandidateNoteAccessorMock(tt.candidateNoteAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.AddCandidateNote(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_GetCandidateNotes(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	createdAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	note, _ := model.NewCandidateNote(model.CandidateNoteOptions{
+		Id:          "cn_id1",
+		CandidateId: "c_id1",
+		Body:        "left by a deleted user",
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	})
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.New(
+			map[string]string{
+				requestingUserIdCtxKey:    "user_id1",
+				requestingUserEmailCtxKey: "user@example.com",
+			},
+		),
+	)
+
+	tests := []struct {
+		name                      string
+		ctx                       context.Context
+		input                     *pb.GetCandidateNotesRequest
+		output                    *pb.GetCandidateNotesResponse
+		teamHydratorMock          storage.TeamHydrator
+		candidateNoteAccessorMock storage.CandidateNoteAccessor
+		errorExpected             bool
+		errorString               string
+	}{
+		{
+			name:                      "errors if candidateId is blank",
+			ctx:                       ctx,
+			input:                     &pb.GetCandidateNotesRequest{},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			candidateNoteAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "candidateId cannot be blank",
+		},
+		{
+			name:                      "errors if no user in context",
+			ctx:                       context.Background(),
+			input:                     &pb.GetCandidateNotesRequest{CandidateId: "c_id1"},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			candidateNoteAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name:             "errors if unable to get notes",
+			ctx:              ctx,
+			input:            &pb.GetCandidateNotesRequest{CandidateId: "c_id1"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateNoteAccessorMock: &storage.CandidateNoteAccessorConfigurableMock{
+				GetCandidateNotesForTeamInternal: func(candidateId string, team *model.Team) ([]*model.CandidateNote, error) {
+					return nil, errors.New("dbError when querying")
+				},
+			},
+			errorExpected: true,
+			errorString:   "dbError when querying",
+		},
+		{
+			name:             "returns the notes",
+			ctx:              ctx,
+			input:            &pb.GetCandidateNotesRequest{CandidateId: "c_id1"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			output: &pb.GetCandidateNotesResponse{
+				Notes: []*pb.CandidateNote{
+					{
+						Id:          "cn_id1",
+						CandidateId: "c_id1",
+						Body:        "left by a deleted user",
+						CreatedAt:   timestamppb.New(createdAt),
+						UpdatedAt:   timestamppb.New(createdAt),
+					},
+				},
+			},
+			candidateNoteAccessorMock: &storage.CandidateNoteAccessorConfigurableMock{
+				GetCandidateNotesForTeamInternal: func(candidateId string, team *model.Team) ([]*model.CandidateNote, error) {
+					return []*model.CandidateNote{note}, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithCandidateNoteAccessorMock(tt.candidateNoteAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.GetCandidateNotes(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_UpdateCandidateNote(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	createdAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	updatedAt := time.Date(2023, 1, 3, 3, 4, 5, 0, time.UTC)
+	note, _ := model.NewCandidateNote(model.CandidateNoteOptions{
+		Id:              "cn_id1",
+		CandidateId:     "c_id1",
+		AuthorUserId:    "user_id1",
+		AuthorUserEmail: "test@example.com",
+		Body:            "edited note",
+		CreatedAt:       createdAt,
+		UpdatedAt:       updatedAt,
+	})
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.New(
+			map[string]string{
+				requestingUserIdCtxKey:    "user_id1",
+				requestingUserEmailCtxKey: "user@example.com",
+			},
+		),
+	)
+
+	tests := []struct {
+		name                      string
+		ctx                       context.Context
+		input                     *pb.UpdateCandidateNoteRequest
+		output                    *pb.UpdateCandidateNoteResponse
+		teamHydratorMock          storage.TeamHydrator
+		candidateNoteAccessorMock storage.CandidateNoteAccessor
+		errorExpected             bool
+		errorString               string
+	}{
+		{
+			name:                      "errors if id is blank",
+			ctx:                       ctx,
+			input:                     &pb.UpdateCandidateNoteRequest{Body: "edited note"},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			candidateNoteAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "id cannot be blank",
+		},
+		{
+			name:                      "errors if body is blank",
+			ctx:                       ctx,
+			input:                     &pb.UpdateCandidateNoteRequest{Id: "cn_id1"},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			candidateNoteAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "body cannot be blank",
+		},
+		{
+			name:                      "errors if unable to hydrate team",
+			ctx:                       ctx,
+			input:                     &pb.UpdateCandidateNoteRequest{Id: "cn_id1", Body: "edited note"},
+			output:                    nil,
+			teamHydratorMock:          &storage.TeamHydratorMockFailure{},
+			candidateNoteAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "unable to hydrate team",
+		},
+		{
+			name:             "errors if unable to update note",
+			ctx:              ctx,
+			input:            &pb.UpdateCandidateNoteRequest{Id: "cn_id1", Body: "edited note"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateNoteAccessorMock: &storage.CandidateNoteAccessorConfigurableMock{
+				UpdateCandidateNoteForTeamInternal: func(id, body, authorUserId string, team *model.Team) (*model.CandidateNote, error) {
+					return nil, errors.New("only the author can change candidate note cn_id1")
+				},
+			},
+			errorExpected: true,
+			errorString:   "only the author can change candidate note cn_id1",
+		},
+		{
+			name:             "updates the note as the requesting user",
+			ctx:              ctx,
+			input:            &pb.UpdateCandidateNoteRequest{Id: "cn_id1", Body: "edited note"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			output: &pb.UpdateCandidateNoteResponse{
+				Note: &pb.CandidateNote{
+					Id:              "cn_id1",
+					CandidateId:     "c_id1",
+					AuthorUserId:    "user_id1",
+					AuthorUserEmail: "test@example.com",
+					Body:            "edited note",
+					CreatedAt:       timestamppb.New(createdAt),
+					UpdatedAt:       timestamppb.New(updatedAt),
+				},
+			},
+			candidateNoteAccessorMock: &storage.CandidateNoteAccessorConfigurableMock{
+				UpdateCandidateNoteForTeamInternal: func(id, body, authorUserId string, team *model.Team) (*model.CandidateNote, error) {
+					if id != "cn_id1" || body != "edited note" || authorUserId != "user_id1" {
+						return nil, errors.New("unexpected note")
+					}
+					return note, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithCandidateNoteAccessorMock(tt.candidateNoteAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.UpdateCandidateNote(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_DeleteCandidateNote(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.New(
+			map[string]string{
+				requestingUserIdCtxKey:    "user_id1",
+				requestingUserEmailCtxKey: "user@example.com",
+			},
+		),
+	)
+
+	tests := []struct {
+		name                      string
+		ctx                       context.Context
+		input                     *pb.DeleteCandidateNoteRequest
+		teamHydratorMock          storage.TeamHydrator
+		candidateNoteAccessorMock storage.CandidateNoteAccessor
+		errorExpected             bool
+		errorString               string
+	}{
+		{
+			name:                      "errors if id is blank",
+			ctx:                       ctx,
+			input:                     &pb.DeleteCandidateNoteRequest{},
+			teamHydratorMock:          nil,
+			candidateNoteAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "id cannot be blank",
+		},
+		{
+			name:                      "errors if no user in context",
+			ctx:                       context.Background(),
+			input:                     &pb.DeleteCandidateNoteRequest{Id: "cn_id1"},
+			teamHydratorMock:          nil,
+			candidateNoteAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name:             "errors if unable to delete note",
+			ctx:              ctx,
+			input:            &pb.DeleteCandidateNoteRequest{Id: "cn_id1"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateNoteAccessorMock: &storage.CandidateNoteAccessorConfigurableMock{
+				DeleteCandidateNoteForTeamInternal: func(id, authorUserId string, team *model.Team) error {
+					return errors.New("no candidate note for id cn_id1")
+				},
+			},
+			errorExpected: true,
+			errorString:   "no candidate note for id cn_id1",
+		},
+		{
+			name:             "deletes the note as the requesting user",
+			ctx:              ctx,
+			input:            &pb.DeleteCandidateNoteRequest{Id: "cn_id1"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateNoteAccessorMock: &storage.CandidateNoteAccessorConfigurableMock{
+				DeleteCandidateNoteForTeamInternal: func(id, authorUserId string, team *model.Team) error {
+					if id != "cn_id1" || authorUserId != "user_id1" {
+						return errors.New("unexpected note")
+					}
+					return nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithCandidateNoteAccessorMock(tt.candidateNoteAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.DeleteCandidateNote(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, &pb.DeleteCandidateNoteResponse{}, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
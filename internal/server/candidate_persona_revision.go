@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *CandidateTrackerGoService) GetCandidatePersonaHistory(ctx context.Context, req *pb.GetCandidatePersonaHistoryRequest) (*pb.GetCandidatePersonaHistoryResponse, error) {
+	candidateId := req.GetCandidateId()
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	revisions, err := s.storage.GetCandidatePersonaRevisionsForTeam(candidateId, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCandidatePersonaHistoryResponse{
+		Revisions: candidatePersonaRevisionsResponse(revisions),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) RevertCandidatePersona(ctx context.Context, req *pb.RevertCandidatePersonaRequest) (*pb.RevertCandidatePersonaResponse, error) {
+	candidateId := req.GetCandidateId()
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	revisionId := req.GetRevisionId()
+	if utilities.IsBlank(revisionId) {
+		return nil, errors.New("revisionId cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	revision, err := s.storage.RevertCandidatePersonaForTeam(candidateId, revisionId, user.GetId(), team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevertCandidatePersonaResponse{
+		Revision: candidatePersonaRevisionResponse(revision),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) DiffCandidatePersonaRevisions(ctx context.Context, req *pb.DiffCandidatePersonaRevisionsRequest) (*pb.DiffCandidatePersonaRevisionsResponse, error) {
+	fromRevisionId := req.GetFromRevisionId()
+	if utilities.IsBlank(fromRevisionId) {
+		return nil, errors.New("fromRevisionId cannot be blank")
+	}
+
+	toRevisionId := req.GetToRevisionId()
+	if utilities.IsBlank(toRevisionId) {
+		return nil, errors.New("toRevisionId cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	fromRevision, err := s.storage.GetCandidatePersonaRevisionForTeam(fromRevisionId, team)
+	if err != nil {
+		return nil, err
+	}
+
+	toRevision, err := s.storage.GetCandidatePersonaRevisionForTeam(toRevisionId, team)
+	if err != nil {
+		return nil, err
+	}
+
+	if fromRevision.CandidateId() != toRevision.CandidateId() {
+		return nil, errors.New("cannot diff revisions of different candidates")
+	}
+
+	return &pb.DiffCandidatePersonaRevisionsResponse{
+		Changes: personaFieldChangesResponse(model.DiffPersonas(fromRevision.Persona(), toRevision.Persona())),
+	}, nil
+}
+
+func candidatePersonaRevisionResponse(revision *model.CandidatePersonaRevision) *pb.CandidatePersonaRevision {
+	return &pb.CandidatePersonaRevision{
+		Id:                     revision.Id(),
+		CandidateId:            revision.CandidateId(),
+		Persona:                revision.PersonaAsJsonString(),
+		BuiltBy:                revision.BuiltBy(),
+		BuilderVersion:         revision.BuilderVersion(),
+		EditedByUserId:         revision.EditedByUserId(),
+		EditedByUserEmail:      revision.EditedByUserEmail(),
+		RevertedFromRevisionId: revision.RevertedFromRevisionId(),
+		CreatedAt:              timestamppb.New(revision.CreatedAt()),
+	}
+}
+
+func candidatePersonaRevisionsResponse(revisions []*model.CandidatePersonaRevision) []*pb.CandidatePersonaRevision {
+	response := []*pb.CandidatePersonaRevision{}
+	for _, revision := range revisions {
+		response = append(response, candidatePersonaRevisionResponse(revision))
+	}
+	return response
+}
+
+func personaFieldChangesResponse(changes []*model.PersonaFieldChange) []*pb.PersonaFieldChange {
+	response := []*pb.PersonaFieldChange{}
+	for _, change := range changes {
+		response = append(response, &pb.PersonaFieldChange{
+			Field:    change.Field(),
+			OldValue: change.OldValue(),
+			NewValue: change.NewValue(),
+		})
+	}
+	return response
+}
@@ -0,0 +1,415 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_GetCandidatePersonaHistory(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	createdAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	revision, _ := model.NewCandidatePersonaRevision(model.CandidatePersonaRevisionOptions{
+		Id:          "pr_id1",
+		CandidateId: "c_id1",
+		Persona:     &model.Persona{Name: "Jane D", BuiltBy: "AI", BuilderVersion: "v1"},
+		CreatedAt:   createdAt,
+	})
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.New(
+			map[string]string{
+				requestingUserIdCtxKey:    "user_id1",
+				requestingUserEmailCtxKey: "user@example.com",
+			},
+		),
+	)
+
+	tests := []struct {
+		name                                 string
+		ctx                                  context.Context
+		input                                *pb.GetCandidatePersonaHistoryRequest
+		output                               *pb.GetCandidatePersonaHistoryResponse
+		teamHydratorMock                     storage.TeamHydrator
+		candidatePersonaRevisionAccessorMock storage.CandidatePersonaRevisionAccessor
+		errorExpected                        bool
+		errorString                          string
+	}{
+		{
+			name:          "errors if candidateId is blank",
+			ctx:           ctx,
+			input:         &pb.GetCandidatePersonaHistoryRequest{},
+			errorExpected: true,
+			errorString:   "candidateId cannot be blank",
+		},
+		{
+			name:          "errors if no user in context",
+			ctx:           context.Background(),
+			input:         &pb.GetCandidatePersonaHistoryRequest{CandidateId: "c_id1"},
+			errorExpected: true,
+			errorString:   "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name:             "errors if unable to hydrate team",
+			ctx:              ctx,
+			input:            &pb.GetCandidatePersonaHistoryRequest{CandidateId: "c_id1"},
+			teamHydratorMock: &storage.TeamHydratorMockFailure{},
+			errorExpected:    true,
+			errorString:      "unable to hydrate team",
+		},
+		{
+			name:             "errors if unable to get revisions",
+			ctx:              ctx,
+			input:            &pb.GetCandidatePersonaHistoryRequest{CandidateId: "c_id1"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidatePersonaRevisionAccessorMock: &storage.CandidatePersonaRevisionAccessorConfigurableMock{
+				GetCandidatePersonaRevisionsForTeamInternal: func(candidateId string, team *model.Team) ([]*model.CandidatePersonaRevision, error) {
+					return nil, errors.New("dbError")
+				},
+			},
+			errorExpected: true,
+			errorString:   "dbError",
+		},
+		{
+			name:             "returns the persona history of the candidate",
+			ctx:              ctx,
+			input:            &pb.GetCandidatePersonaHistoryRequest{CandidateId: "c_id1"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidatePersonaRevisionAccessorMock: &storage.CandidatePersonaRevisionAccessorConfigurableMock{
+				GetCandidatePersonaRevisionsForTeamInternal: func(candidateId string, team *model.Team) ([]*model.CandidatePersonaRevision, error) {
+					if candidateId != "c_id1" {
+						return nil, errors.New("unexpected candidate")
+					}
+					return []*model.CandidatePersonaRevision{revision}, nil
+				},
+			},
+			output: &pb.GetCandidatePersonaHistoryResponse{
+				Revisions: []*pb.CandidatePersonaRevision{
+					{
+						Id:             "pr_id1",
+						CandidateId:    "c_id1",
+						Persona:        "{\"Name\":\"Jane D\",\"BuilderVersion\":\"v1\",\"BuiltBy\":\"AI\"}",
+						BuiltBy:        "AI",
+						BuilderVersion: "v1",
+						CreatedAt:      timestamppb.New(createdAt),
+					},
+				},
+			},
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithCandidatePersonaRevisionAccessorMock(tt.candidatePersonaRevisionAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.GetCandidatePersonaHistory(tt.ctx, tt.input)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_RevertCandidatePersona(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	createdAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	revision, _ := model.NewCandidatePersonaRevision(model.CandidatePersonaRevisionOptions{
+		Id:                     "pr_id3",
+		CandidateId:            "c_id1",
+		Persona:                &model.Persona{Name: "Jane D", BuiltBy: "HUMAN"},
+		EditedByUserId:         "user_id1",
+		EditedByUserEmail:      "test@example.com",
+		RevertedFromRevisionId: "pr_id1",
+		CreatedAt:              createdAt,
+	})
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.New(
+			map[string]string{
+				requestingUserIdCtxKey:    "user_id1",
+				requestingUserEmailCtxKey: "user@example.com",
+			},
+		),
+	)
+
+	tests := []struct {
+		name                                 string
+		ctx                                  context.Context
+		input                                *pb.RevertCandidatePersonaRequest
+		output                               *pb.RevertCandidatePersonaResponse
+		teamHydratorMock                     storage.TeamHydrator
+		candidatePersonaRevisionAccessorMock storage.CandidatePersonaRevisionAccessor
+		errorExpected                        bool
+		errorString                          string
+	}{
+		{
+			name:          "errors if candidateId is blank",
+			ctx:           ctx,
+			input:         &pb.RevertCandidatePersonaRequest{RevisionId: "pr_id1"},
+			errorExpected: true,
+			errorString:   "candidateId cannot be blank",
+		},
+		{
+			name:          "errors if revisionId is blank",
+			ctx:           ctx,
+			input:         &pb.RevertCandidatePersonaRequest{CandidateId: "c_id1"},
+			errorExpected: true,
+			errorString:   "revisionId cannot be blank",
+		},
+		{
+			name:          "errors if no user in context",
+			ctx:           context.Background(),
+			input:         &pb.RevertCandidatePersonaRequest{CandidateId: "c_id1", RevisionId: "pr_id1"},
+			errorExpected: true,
+			errorString:   "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name:             "errors if unable to hydrate team",
+			ctx:              ctx,
+			input:            &pb.RevertCandidatePersonaRequest{CandidateId: "c_id1", RevisionId: "pr_id1"},
+			teamHydratorMock: &storage.TeamHydratorMockFailure{},
+			errorExpected:    true,
+			errorString:      "unable to hydrate team",
+		},
+		{
+			name:             "errors if unable to revert",
+			ctx:              ctx,
+			input:            &pb.RevertCandidatePersonaRequest{CandidateId: "c_id1", RevisionId: "pr_id9"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidatePersonaRevisionAccessorMock: &storage.CandidatePersonaRevisionAccessorConfigurableMock{
+				RevertCandidatePersonaForTeamInternal: func(candidateId, revisionId, editedByUserId string, team *model.Team) (*model.CandidatePersonaRevision, error) {
+					return nil, errors.New("no candidate persona revision for id pr_id9")
+				},
+			},
+			errorExpected: true,
+			errorString:   "no candidate persona revision for id pr_id9",
+		},
+		{
+			name:             "reverts the persona as the requesting user",
+			ctx:              ctx,
+			input:            &pb.RevertCandidatePersonaRequest{CandidateId: "c_id1", RevisionId: "pr_id1"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidatePersonaRevisionAccessorMock: &storage.CandidatePersonaRevisionAccessorConfigurableMock{
+				RevertCandidatePersonaForTeamInternal: func(candidateId, revisionId, editedByUserId string, team *model.Team) (*model.CandidatePersonaRevision, error) {
+					if candidateId != "c_id1" || revisionId != "pr_id1" || editedByUserId != "user_id1" {
+						return nil, errors.New("unexpected revert")
+					}
+					return revision, nil
+				},
+			},
+			output: &pb.RevertCandidatePersonaResponse{
+				Revision: &pb.CandidatePersonaRevision{
+					Id:                     "pr_id3",
+					CandidateId:            "c_id1",
+					Persona:                "{\"Name\":\"Jane D\",\"BuiltBy\":\"HUMAN\"}",
+					BuiltBy:                "HUMAN",
+					EditedByUserId:         "user_id1",
+					EditedByUserEmail:      "test@example.com",
+					RevertedFromRevisionId: "pr_id1",
+					CreatedAt:              timestamppb.New(createdAt),
+				},
+			},
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithCandidatePersonaRevisionAccessorMock(tt.candidatePersonaRevisionAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.RevertCandidatePersona(tt.ctx, tt.input)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_DiffCandidatePersonaRevisions(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	revisions := map[string]*model.CandidatePersonaRevision{}
+	for _, opts := range []model.CandidatePersonaRevisionOptions{
+		{Id: "pr_id1", CandidateId: "c_id1", Persona: &model.Persona{Name: "Jane D", BuiltBy: "AI"}},
+		{Id: "pr_id2", CandidateId: "c_id1", Persona: &model.Persona{Name: "Jane Doe", Phone: "9876543210", BuiltBy: "HUMAN"}},
+		{Id: "pr_id3", CandidateId: "c_id2", Persona: &model.Persona{Name: "John Doe", BuiltBy: "AI"}},
+	} {
+		revision, _ := model.NewCandidatePersonaRevision(opts)
+		revisions[revision.Id()] = revision
+	}
+	revisionAccessorMock := &storage.CandidatePersonaRevisionAccessorConfigurableMock{
+		GetCandidatePersonaRevisionForTeamInternal: func(id string, team *model.Team) (*model.CandidatePersonaRevision, error) {
+			revision, ok := revisions[id]
+			if !ok {
+				return nil, errors.Errorf("no candidate persona revision for id %s", id)
+			}
+			return revision, nil
+		},
+	}
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.New(
+			map[string]string{
+				requestingUserIdCtxKey:    "user_id1",
+				requestingUserEmailCtxKey: "user@example.com",
+			},
+		),
+	)
+
+	tests := []struct {
+		name                                 string
+		ctx                                  context.Context
+		input                                *pb.DiffCandidatePersonaRevisionsRequest
+		output                               *pb.DiffCandidatePersonaRevisionsResponse
+		teamHydratorMock                     storage.TeamHydrator
+		candidatePersonaRevisionAccessorMock storage.CandidatePersonaRevisionAccessor
+		errorExpected                        bool
+		errorString                          string
+	}{
+		{
+			name:          "errors if fromRevisionId is blank",
+			ctx:           ctx,
+			input:         &pb.DiffCandidatePersonaRevisionsRequest{ToRevisionId: "pr_id2"},
+			errorExpected: true,
+			errorString:   "fromRevisionId cannot be blank",
+		},
+		{
+			name:          "errors if toRevisionId is blank",
+			ctx:           ctx,
+			input:         &pb.DiffCandidatePersonaRevisionsRequest{FromRevisionId: "pr_id1"},
+			errorExpected: true,
+			errorString:   "toRevisionId cannot be blank",
+		},
+		{
+			name:          "errors if no user in context",
+			ctx:           context.Background(),
+			input:         &pb.DiffCandidatePersonaRevisionsRequest{FromRevisionId: "pr_id1", ToRevisionId: "pr_id2"},
+			errorExpected: true,
+			errorString:   "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name:             "errors if unable to hydrate team",
+			ctx:              ctx,
+			input:            &pb.DiffCandidatePersonaRevisionsRequest{FromRevisionId: "pr_id1", ToRevisionId: "pr_id2"},
+			teamHydratorMock: &storage.TeamHydratorMockFailure{},
+			errorExpected:    true,
+			errorString:      "unable to hydrate team",
+		},
+		{
+			name:                                 "errors if a revision is not found",
+			ctx:                                  ctx,
+			input:                                &pb.DiffCandidatePersonaRevisionsRequest{FromRevisionId: "pr_id1", ToRevisionId: "pr_id9"},
+			teamHydratorMock:                     &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidatePersonaRevisionAccessorMock: revisionAccessorMock,
+			errorExpected:                        true,
+			errorString:                          "no candidate persona revision for id pr_id9",
+		},
+		{
+			name:                                 "errors if revisions belong to different candidates",
+			ctx:                                  ctx,
+			input:                                &pb.DiffCandidatePersonaRevisionsRequest{FromRevisionId: "pr_id1", ToRevisionId: "pr_id3"},
+			teamHydratorMock:                     &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidatePersonaRevisionAccessorMock: revisionAccessorMock,
+			errorExpected:                        true,
+			errorString:                          "cannot diff revisions of different candidates",
+		},
+		{
+			name:                                 "returns the changed fields",
+			ctx:                                  ctx,
+			input:                                &pb.DiffCandidatePersonaRevisionsRequest{FromRevisionId: "pr_id1", ToRevisionId: "pr_id2"},
+			teamHydratorMock:                     &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidatePersonaRevisionAccessorMock: revisionAccessorMock,
+			output: &pb.DiffCandidatePersonaRevisionsResponse{
+				Changes: []*pb.PersonaFieldChange{
+					{Field: "Name", OldValue: "Jane D", NewValue: "Jane Doe"},
+					{Field: "Phone", OldValue: "", NewValue: "9876543210"},
+					{Field: "BuiltBy", OldValue: "AI", NewValue: "HUMAN"},
+				},
+			},
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithCandidatePersonaRevisionAccessorMock(tt.candidatePersonaRevisionAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.DiffCandidatePersonaRevisions(tt.ctx, tt.input)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
@@ -3,14 +3,18 @@ package server
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/config"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/services/filestorage"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
 	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func Test_GetCandidates(t *testing.T) {
@@ -81,6 +85,8 @@ func Test_GetCandidates(t *testing.T) {
 		},
 		Team: team,
 	})
+	var minYoE int64 = 5
+	var maxYoE int64 = 2
 
 	tests := []struct {
 		name                  string
@@ -133,13 +139,168 @@ func Test_GetCandidates(t *testing.T) {
 			output:           nil,
 			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
 			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
-				GetCandidatesForTeamInternal: func(team *model.Team) ([]*model.Candidate, error) {
-					return nil, errors.New("dbError when querying")
+				GetCandidatesForTeamInternal: func(team *model.Team, filter *model.CandidateFilter, page *model.PageRequest) ([]*model.Candidate, *model.PageInfo, error) {
+					return nil, nil, errors.New("dbError when querying")
 				},
 			},
 			errorExpected: true,
 			errorString:   "dbError when querying",
 		},
+		{
+			name: "returns error if filter is invalid",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.GetCandidatesRequest{
+				Filter: &pb.CandidateFilter{
+					MinYoE: &minYoE,
+					MaxYoE: &maxYoE,
+				},
+			},
+			output:                nil,
+			teamHydratorMock:      &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{},
+			errorExpected:         true,
+			errorString:           "cannot create CandidateFilter with min YoE greater than max YoE",
+		},
+		{
+			name: "returns error if sort field is invalid",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.GetCandidatesRequest{
+				Sorts: []*pb.CandidateSort{{Field: "unknown"}},
+			},
+			output:                nil,
+			teamHydratorMock:      &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{},
+			errorExpected:         true,
+			errorString:           "cannot create CandidateFilter with an invalid sort field: unknown",
+		},
+		{
+			name: "returns error if page size is negative",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.GetCandidatesRequest{
+				PageSize: -1,
+			},
+			output:                nil,
+			teamHydratorMock:      &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{},
+			errorExpected:         true,
+			errorString:           "cannot create PageRequest with a negative size",
+		},
+		{
+			name: "passes page to storage and returns page info",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.GetCandidatesRequest{
+				PageSize:  1,
+				PageToken: "token1",
+			},
+			output: &pb.GetCandidatesResponse{
+				Candidates: []*pb.Candidate{
+					{
+						Id:                 "c_id1",
+						AiGeneratedPersona: "{\"Name\":\"ai persona 1\",\"Email\":\"email_1\",\"Phone\":\"phone_1\",\"City\":\"city_1\",\"State\":\"state_1\",\"Country\":\"country_1\",\"YoE\":5,\"Tech Skills\":[\"tech skill 1\",\"tech skill 2\",\"tech skill 3\"]}",
+						FileUploadId:       "fp_id1",
+					},
+				},
+				NextPageToken: "token2",
+				TotalCount:    3,
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				GetCandidatesForTeamInternal: func(team *model.Team, filter *model.CandidateFilter, page *model.PageRequest) ([]*model.Candidate, *model.PageInfo, error) {
+					expectedPage, _ := model.NewPageRequest(model.PageRequestOptions{Size: 1, Token: "token1"})
+					if !assert.Equal(t, expectedPage, page) {
+						return nil, nil, errors.New("unexpected page")
+					}
+					return []*model.Candidate{candidate1}, model.NewPageInfo("token2", 3), nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "passes filter and sorts to storage",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.GetCandidatesRequest{
+				Filter: &pb.CandidateFilter{
+					TechSkills:       []string{"Go"},
+					MaxYoE:           &maxYoE,
+					City:             "Mumbai",
+					BuiltBy:          "AI",
+					IncludeArchived:  true,
+					PipelineStageIds: []string{"ps_id1"},
+					AnyTagIds:        []string{"tag_id1", "tag_id2"},
+					AllTagIds:        []string{"tag_id3"},
+				},
+				Sorts: []*pb.CandidateSort{{Field: "YOE", Descending: true}},
+			},
+			output: &pb.GetCandidatesResponse{
+				Candidates: []*pb.Candidate{
+					{
+						Id:                 "c_id1",
+						AiGeneratedPersona: "{\"Name\":\"ai persona 1\",\"Email\":\"email_1\",\"Phone\":\"phone_1\",\"City\":\"city_1\",\"State\":\"state_1\",\"Country\":\"country_1\",\"YoE\":5,\"Tech Skills\":[\"tech skill 1\",\"tech skill 2\",\"tech skill 3\"]}",
+						FileUploadId:       "fp_id1",
+					},
+				},
+				TotalCount: 1,
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				GetCandidatesForTeamInternal: func(team *model.Team, filter *model.CandidateFilter, page *model.PageRequest) ([]*model.Candidate, *model.PageInfo, error) {
+					maxYoE := 2
+					expectedFilter, _ := model.NewCandidateFilter(model.CandidateFilterOptions{
+						TechSkills:       []string{"Go"},
+						MaxYoE:           &maxYoE,
+						City:             "Mumbai",
+						BuiltBy:          "AI",
+						IncludeArchived:  true,
+						PipelineStageIds: []string{"ps_id1"},
+						AnyTagIds:        []string{"tag_id1", "tag_id2"},
+						AllTagIds:        []string{"tag_id3"},
+						Sorts:            []model.CandidateSortOptions{{Field: "YOE", Descending: true}},
+					})
+					if !assert.Equal(t, expectedFilter, filter) {
+						return nil, nil, errors.New("unexpected filter")
+					}
+					return []*model.Candidate{candidate1}, model.NewPageInfo("", 1), nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
 		{
 			name: "runs successfully",
 			ctx: metadata.NewIncomingContext(
@@ -169,13 +330,14 @@ func Test_GetCandidates(t *testing.T) {
 						ManuallyCreatedPersona: "{\"Name\":\"manual persona 1\",\"Email\":\"email_1\",\"Phone\":\"phone_1\",\"City\":\"city_1\",\"State\":\"state_1\",\"Country\":\"country_1\",\"YoE\":5,\"Tech Skills\":[\"tech skill 1\",\"tech skill 2\",\"tech skill 3\"]}",
 					},
 				},
+				TotalCount: 3,
 			},
 			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
 			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
-				GetCandidatesForTeamInternal: func(team *model.Team) ([]*model.Candidate, error) {
+				GetCandidatesForTeamInternal: func(team *model.Team, filter *model.CandidateFilter, page *model.PageRequest) ([]*model.Candidate, *model.PageInfo, error) {
 					return []*model.Candidate{
 						candidate1, candidate2, candidate3,
-					}, nil
+					}, model.NewPageInfo("", 3), nil
 				},
 			},
 			errorExpected: false,
@@ -206,6 +368,8 @@ func Test_GetCandidates(t *testing.T) {
 				for i := range responseCandidates {
 					assert.True(t, candidateResponseIsEqual(responseCandidates[i], outputCandidates[i]))
 				}
+				assert.Equal(t, tt.output.GetNextPageToken(), response.GetNextPageToken())
+				assert.Equal(t, tt.output.GetTotalCount(), response.GetTotalCount())
 			} else {
 				assert.NotEmpty(t, tt.errorString)
 				assert.EqualError(t, err, tt.errorString)
@@ -214,7 +378,7 @@ func Test_GetCandidates(t *testing.T) {
 	}
 }
 
-func Test_GetCandidate(t *testing.T) {
+func Test_SearchCandidates(t *testing.T) {
 	currentFileCount := 1
 	team, _ := model.NewTeam(model.TeamOptions{
 		Id:               "team_id1",
@@ -232,55 +396,42 @@ func Test_GetCandidate(t *testing.T) {
 		Id: "c_id1",
 		AiGeneratedPersona: &model.Persona{
 			Name:       "ai persona 1",
-			Email:      "email_1",
-			Phone:      "phone_1",
-			City:       "city_1",
-			State:      "state_1",
-			Country:    "country_1",
-			YoE:        5,
-			TechSkills: []string{"tech skill 1", "tech skill 2", "tech skill 3"},
-		},
-		ManuallyCreatedPersona: &model.Persona{
-			Name:       "manual persona 1",
-			Email:      "email_1",
-			Phone:      "phone_1",
-			City:       "city_1",
-			State:      "state_1",
-			Country:    "country_1",
-			YoE:        5,
-			TechSkills: []string{"tech skill 1", "tech skill 2", "tech skill 3"},
+			TechSkills: []string{"Python"},
 		},
 		Team:         team,
 		FileUploadId: "fp_id1",
 	})
+	result1, _ := model.NewCandidateSearchResult(model.CandidateSearchResultOptions{
+		Candidate: candidate1,
+		Rank:      0.4,
+		Snippet:   "ai persona 1 <mark>Python</mark>",
+	})
 
 	tests := []struct {
 		name                  string
 		ctx                   context.Context
-		input                 *pb.GetCandidateRequest
-		output                *pb.GetCandidateResponse
+		input                 *pb.SearchCandidatesRequest
+		output                *pb.SearchCandidatesResponse
 		teamHydratorMock      storage.TeamHydrator
 		candidateAccessorMock storage.CandidateAccessor
 		errorExpected         bool
 		errorString           string
 	}{
 		{
-			name:                  "errors if id is blank",
+			name:                  "errors if query is blank",
 			ctx:                   context.Background(),
-			input:                 &pb.GetCandidateRequest{},
-			output:                &pb.GetCandidateResponse{},
+			input:                 &pb.SearchCandidatesRequest{Query: " "},
+			output:                nil,
 			teamHydratorMock:      nil,
 			candidateAccessorMock: nil,
 			errorExpected:         true,
-			errorString:           "id cannot be blank",
+			errorString:           "query cannot be blank",
 		},
 		{
-			name: "errors if no user in context",
-			ctx:  context.Background(),
-			input: &pb.GetCandidateRequest{
-				Id: "c_id1",
-			},
-			output:                &pb.GetCandidateResponse{},
+			name:                  "errors if no user in context",
+			ctx:                   context.Background(),
+			input:                 &pb.SearchCandidatesRequest{Query: "python"},
+			output:                nil,
 			teamHydratorMock:      nil,
 			candidateAccessorMock: nil,
 			errorExpected:         true,
@@ -296,17 +447,15 @@ func Test_GetCandidate(t *testing.T) {
 					},
 				),
 			),
-			input: &pb.GetCandidateRequest{
-				Id: "c_id1",
-			},
-			output:                &pb.GetCandidateResponse{},
+			input:                 &pb.SearchCandidatesRequest{Query: "python"},
+			output:                nil,
 			teamHydratorMock:      &storage.TeamHydratorMockFailure{},
 			candidateAccessorMock: nil,
 			errorExpected:         true,
 			errorString:           "unable to hydrate team",
 		},
 		{
-			name: "returns error if database errors when getting candidates",
+			name: "returns error if page size is negative",
 			ctx: metadata.NewIncomingContext(
 				context.Background(), metadata.New(
 					map[string]string{
@@ -315,14 +464,29 @@ func Test_GetCandidate(t *testing.T) {
 					},
 				),
 			),
-			input: &pb.GetCandidateRequest{
-				Id: "c_id1",
-			},
+			input:                 &pb.SearchCandidatesRequest{Query: "python", PageSize: -1},
+			output:                nil,
+			teamHydratorMock:      &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{},
+			errorExpected:         true,
+			errorString:           "cannot create PageRequest with a negative size",
+		},
+		{
+			name: "returns error if database errors when searching candidates",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.SearchCandidatesRequest{Query: "python"},
 			output:           nil,
 			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
 			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
-				GetCandidateForTeamInternal: func(id string, team *model.Team) (*model.Candidate, error) {
-					return nil, errors.New("dbError when querying")
+				SearchCandidatesForTeamInternal: func(query string, team *model.Team, page *model.PageRequest) ([]*model.CandidateSearchResult, *model.PageInfo, error) {
+					return nil, nil, errors.New("dbError when querying")
 				},
 			},
 			errorExpected: true,
@@ -338,21 +502,34 @@ func Test_GetCandidate(t *testing.T) {
 					},
 				),
 			),
-			input: &pb.GetCandidateRequest{
-				Id: "c_id1",
+			input: &pb.SearchCandidatesRequest{
+				Query:     "python",
+				PageSize:  1,
+				PageToken: "token1",
 			},
-			output: &pb.GetCandidateResponse{
-				Candidate: &pb.Candidate{
-					Id:                     "c_id1",
-					AiGeneratedPersona:     "{\"Name\":\"ai persona 1\",\"Email\":\"email_1\",\"Phone\":\"phone_1\",\"City\":\"city_1\",\"State\":\"state_1\",\"Country\":\"country_1\",\"YoE\":5,\"Tech Skills\":[\"tech skill 1\",\"tech skill 2\",\"tech skill 3\"]}",
-					ManuallyCreatedPersona: "{\"Name\":\"manual persona 1\",\"Email\":\"email_1\",\"Phone\":\"phone_1\",\"City\":\"city_1\",\"State\":\"state_1\",\"Country\":\"country_1\",\"YoE\":5,\"Tech Skills\":[\"tech skill 1\",\"tech skill 2\",\"tech skill 3\"]}",
-					FileUploadId:           "fp_id1",
+			output: &pb.SearchCandidatesResponse{
+				Results: []*pb.CandidateSearchResult{
+					{
+						Candidate: &pb.Candidate{
+							Id:                 "c_id1",
+							AiGeneratedPersona: "{\"Name\":\"ai persona 1\",\"Tech Skills\":[\"Python\"]}",
+							FileUploadId:       "fp_id1",
+						},
+						Rank:    0.4,
+						Snippet: "ai persona 1 <mark>Python</mark>",
+					},
 				},
+				NextPageToken: "token2",
+				TotalCount:    2,
 			},
 			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
 			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
-				GetCandidateForTeamInternal: func(id string, team *model.Team) (*model.Candidate, error) {
-					return candidate1, nil
+				SearchCandidatesForTeamInternal: func(query string, team *model.Team, page *model.PageRequest) ([]*model.CandidateSearchResult, *model.PageInfo, error) {
+					expectedPage, _ := model.NewPageRequest(model.PageRequestOptions{Size: 1, Token: "token1"})
+					if query != "python" || !assert.Equal(t, expectedPage, page) {
+						return nil, nil, errors.New("unexpected search")
+					}
+					return []*model.CandidateSearchResult{result1}, model.NewPageInfo("token2", 2), nil
 				},
 			},
 			errorExpected: false,
@@ -370,14 +547,25 @@ func Test_GetCandidate(t *testing.T) {
 				Logger: &utilities.NullLogger{},
 			})
 
-			response, err := server.GetCandidate(
+			response, err := server.SearchCandidates(
 				tt.ctx,
 				tt.input,
 			)
 			if !tt.errorExpected {
 				assert.Empty(t, tt.errorString)
 				assert.NoError(t, err)
-				assert.True(t, candidateResponseIsEqual(response.GetCandidate(), tt.output.GetCandidate()))
+				responseResults := response.GetResults()
+				outputResults := tt.output.GetResults()
+				assert.Equal(t, len(outputResults), len(responseResults))
+				for i := range responseResults {
+					assert.Equal(t, outputResults[i].GetCandidate().GetId(), responseResults[i].GetCandidate().GetId())
+					assert.Equal(t, outputResults[i].GetCandidate().GetAiGeneratedPersona(), responseResults[i].GetCandidate().GetAiGeneratedPersona())
+					assert.Equal(t, outputResults[i].GetCandidate().GetFileUploadId(), responseResults[i].GetCandidate().GetFileUploadId())
+					assert.Equal(t, outputResults[i].GetRank(), responseResults[i].GetRank())
+					assert.Equal(t, outputResults[i].GetSnippet(), responseResults[i].GetSnippet())
+				}
+				assert.Equal(t, tt.output.GetNextPageToken(), response.GetNextPageToken())
+				assert.Equal(t, tt.output.GetTotalCount(), response.GetTotalCount())
 			} else {
 				assert.NotEmpty(t, tt.errorString)
 				assert.EqualError(t, err, tt.errorString)
@@ -386,7 +574,7 @@ func Test_GetCandidate(t *testing.T) {
 	}
 }
 
-func Test_UpdateCandidate(t *testing.T) {
+func Test_GetCandidate(t *testing.T) {
 	currentFileCount := 1
 	team, _ := model.NewTeam(model.TeamOptions{
 		Id:               "team_id1",
@@ -400,21 +588,77 @@ func Test_UpdateCandidate(t *testing.T) {
 		Team:  team,
 	})
 
+	candidate1, _ := model.NewCandidate(model.CandidateOptions{
+		Id: "c_id1",
+		AiGeneratedPersona: &model.Persona{
+			Name:       "ai persona 1",
+			Email:      "email_1",
+			Phone:      "phone_1",
+			City:       "city_1",
+			State:      "state_1",
+			Country:    "country_1",
+			YoE:        5,
+			TechSkills: []string{"tech skill 1", "tech skill 2", "tech skill 3"},
+		},
+		ManuallyCreatedPersona: &model.Persona{
+			Name:       "manual persona 1",
+			Email:      "email_1",
+			Phone:      "phone_1",
+			City:       "city_1",
+			State:      "state_1",
+			Country:    "country_1",
+			YoE:        5,
+			TechSkills: []string{"tech skill 1", "tech skill 2", "tech skill 3"},
+		},
+		Team:         team,
+		FileUploadId: "fp_id1",
+	})
+	noteCreatedAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	note1, _ := model.NewCandidateNote(model.CandidateNoteOptions{
+		Id:              "cn_id1",
+		CandidateId:     "c_id1",
+		AuthorUserId:    "user_id1",
+		AuthorUserEmail: "test@example.com",
+		Body:            "note 1",
+		CreatedAt:       noteCreatedAt,
+		UpdatedAt:       noteCreatedAt,
+	})
+	tag1, _ := model.NewTag(model.TagOptions{
+		Id:             "tag_id1",
+		Name:           "referral",
+		CandidateCount: 3,
+		Team:           team,
+	})
+
 	tests := []struct {
 		name                  string
 		ctx                   context.Context
-		input                 *pb.UpdateCandidateRequest
-		output                *pb.UpdateCandidateResponse
+		input                 *pb.GetCandidateRequest
+		output                *pb.GetCandidateResponse
 		teamHydratorMock      storage.TeamHydrator
 		candidateAccessorMock storage.CandidateAccessor
+		candidateNoteMock     storage.CandidateNoteAccessor
+		tagMock               storage.TagAccessor
 		errorExpected         bool
 		errorString           string
 	}{
 		{
-			name:                  "errors if no user in context",
+			name:                  "errors if id is blank",
 			ctx:                   context.Background(),
-			input:                 &pb.UpdateCandidateRequest{},
-			output:                &pb.UpdateCandidateResponse{},
+			input:                 &pb.GetCandidateRequest{},
+			output:                &pb.GetCandidateResponse{},
+			teamHydratorMock:      nil,
+			candidateAccessorMock: nil,
+			errorExpected:         true,
+			errorString:           "id cannot be blank",
+		},
+		{
+			name: "errors if no user in context",
+			ctx:  context.Background(),
+			input: &pb.GetCandidateRequest{
+				Id: "c_id1",
+			},
+			output:                &pb.GetCandidateResponse{},
 			teamHydratorMock:      nil,
 			candidateAccessorMock: nil,
 			errorExpected:         true,
@@ -430,15 +674,17 @@ func Test_UpdateCandidate(t *testing.T) {
 					},
 				),
 			),
-			input:                 &pb.UpdateCandidateRequest{},
-			output:                &pb.UpdateCandidateResponse{},
+			input: &pb.GetCandidateRequest{
+				Id: "c_id1",
+			},
+			output:                &pb.GetCandidateResponse{},
 			teamHydratorMock:      &storage.TeamHydratorMockFailure{},
 			candidateAccessorMock: nil,
 			errorExpected:         true,
 			errorString:           "unable to hydrate team",
 		},
 		{
-			name: "returns error if unable to parse persona",
+			name: "returns error if database errors when getting candidates",
 			ctx: metadata.NewIncomingContext(
 				context.Background(), metadata.New(
 					map[string]string{
@@ -447,15 +693,21 @@ func Test_UpdateCandidate(t *testing.T) {
 					},
 				),
 			),
-			input:                 &pb.UpdateCandidateRequest{},
-			output:                nil,
-			teamHydratorMock:      &storage.TeamHydratorMockSuccess{User: userWithTeam},
-			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{},
-			errorExpected:         true,
-			errorString:           "unable to parse persona json: unexpected end of JSON input",
+			input: &pb.GetCandidateRequest{
+				Id: "c_id1",
+			},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				GetCandidateForTeamInternal: func(id string, team *model.Team) (*model.Candidate, error) {
+					return nil, errors.New("dbError when querying")
+				},
+			},
+			errorExpected: true,
+			errorString:   "dbError when querying",
 		},
 		{
-			name: "returns error if database errors when updating candidate",
+			name: "returns error if database errors when getting candidate notes",
 			ctx: metadata.NewIncomingContext(
 				context.Background(), metadata.New(
 					map[string]string{
@@ -464,13 +716,228 @@ func Test_UpdateCandidate(t *testing.T) {
 					},
 				),
 			),
-			input: &pb.UpdateCandidateRequest{
-				ManuallyCreatedPersona: "{\"Name\":\"manual persona 1\",\"Email\":\"email_1\",\"Phone\":\"phone_1\",\"City\":\"city_1\",\"State\":\"state_1\",\"Country\":\"country_1\",\"YoE\":5,\"Tech Skills\":[\"tech skill 1\",\"tech skill 2\",\"tech skill 3\"]}",
+			input: &pb.GetCandidateRequest{
+				Id: "c_id1",
+			},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				GetCandidateForTeamInternal: func(id string, team *model.Team) (*model.Candidate, error) {
+					return candidate1, nil
+				},
+			},
+			candidateNoteMock: &storage.CandidateNoteAccessorConfigurableMock{
+				GetCandidateNotesForTeamInternal: func(candidateId string, team *model.Team) ([]*model.CandidateNote, error) {
+					return nil, errors.New("dbError when querying notes")
+				},
+			},
+			errorExpected: true,
+			errorString:   "dbError when querying notes",
+		},
+		{
+			name: "returns error if database errors when getting candidate tags",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.GetCandidateRequest{
+				Id: "c_id1",
+			},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				GetCandidateForTeamInternal: func(id string, team *model.Team) (*model.Candidate, error) {
+					return candidate1, nil
+				},
+			},
+			candidateNoteMock: &storage.CandidateNoteAccessorConfigurableMock{
+				GetCandidateNotesForTeamInternal: func(candidateId string, team *model.Team) ([]*model.CandidateNote, error) {
+					return []*model.CandidateNote{note1}, nil
+				},
+			},
+			tagMock: &storage.TagAccessorConfigurableMock{
+				GetTagsForCandidateForTeamInternal: func(candidateId string, team *model.Team) ([]*model.Tag, error) {
+					return nil, errors.New("dbError when querying tags")
+				},
+			},
+			errorExpected: true,
+			errorString:   "dbError when querying tags",
+		},
+		{
+			name: "runs successfully",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.GetCandidateRequest{
+				Id: "c_id1",
+			},
+			output: &pb.GetCandidateResponse{
+				Candidate: &pb.Candidate{
+					Id:                     "c_id1",
+					AiGeneratedPersona:     "{\"Name\":\"ai persona 1\",\"Email\":\"email_1\",\"Phone\":\"phone_1\",\"City\":\"city_1\",\"State\":\"state_1\",\"Country\":\"country_1\",\"YoE\":5,\"Tech Skills\":[\"tech skill 1\",\"tech skill 2\",\"tech skill 3\"]}",
+					ManuallyCreatedPersona: "{\"Name\":\"manual persona 1\",\"Email\":\"email_1\",\"Phone\":\"phone_1\",\"City\":\"city_1\",\"State\":\"state_1\",\"Country\":\"country_1\",\"YoE\":5,\"Tech Skills\":[\"tech skill 1\",\"tech skill 2\",\"tech skill 3\"]}",
+					FileUploadId:           "fp_id1",
+				},
+				Notes: []*pb.CandidateNote{
+					{
+						Id:              "cn_id1",
+						CandidateId:     "c_id1",
+						AuthorUserId:    "user_id1",
+						AuthorUserEmail: "test@example.com",
+						Body:            "note 1",
+						CreatedAt:       timestamppb.New(noteCreatedAt),
+						UpdatedAt:       timestamppb.New(noteCreatedAt),
+					},
+				},
+				Tags: []*pb.Tag{
+					{Id: "tag_id1", Name: "referral", CandidateCount: 3},
+				},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				GetCandidateForTeamInternal: func(id string, team *model.Team) (*model.Candidate, error) {
+					return candidate1, nil
+				},
+			},
+			candidateNoteMock: &storage.CandidateNoteAccessorConfigurableMock{
+				GetCandidateNotesForTeamInternal: func(candidateId string, team *model.Team) ([]*model.CandidateNote, error) {
+					return []*model.CandidateNote{note1}, nil
+				},
+			},
+			tagMock: &storage.TagAccessorConfigurableMock{
+				GetTagsForCandidateForTeamInternal: func(candidateId string, team *model.Team) ([]*model.Tag, error) {
+					return []*model.Tag{tag1}, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithCandidateAccessorMock(tt.candidateAccessorMock),
+					storage.WithCandidateNoteAccessorMock(tt.candidateNoteMock),
+					storage.WithTagAccessorMock(tt.tagMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.GetCandidate(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.True(t, candidateResponseIsEqual(response.GetCandidate(), tt.output.GetCandidate()))
+				assert.EqualValues(t, tt.output.GetNotes(), response.GetNotes())
+				assert.EqualValues(t, tt.output.GetTags(), response.GetTags())
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_UpdateCandidate(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+
+	tests := []struct {
+		name                  string
+		ctx                   context.Context
+		input                 *pb.UpdateCandidateRequest
+		output                *pb.UpdateCandidateResponse
+		teamHydratorMock      storage.TeamHydrator
+		candidateAccessorMock storage.CandidateAccessor
+		errorExpected         bool
+		errorString           string
+	}{
+		{
+			name:                  "errors if no user in context",
+			ctx:                   context.Background(),
+			input:                 &pb.UpdateCandidateRequest{},
+			output:                &pb.UpdateCandidateResponse{},
+			teamHydratorMock:      nil,
+			candidateAccessorMock: nil,
+			errorExpected:         true,
+			errorString:           "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name: "errors if unable to hydrate team",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:                 &pb.UpdateCandidateRequest{},
+			output:                &pb.UpdateCandidateResponse{},
+			teamHydratorMock:      &storage.TeamHydratorMockFailure{},
+			candidateAccessorMock: nil,
+			errorExpected:         true,
+			errorString:           "unable to hydrate team",
+		},
+		{
+			name: "returns error if unable to parse persona",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:                 &pb.UpdateCandidateRequest{},
+			output:                nil,
+			teamHydratorMock:      &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{},
+			errorExpected:         true,
+			errorString:           "unable to parse persona json: unexpected end of JSON input",
+		},
+		{
+			name: "returns error if database errors when updating candidate",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.UpdateCandidateRequest{
+				ManuallyCreatedPersona: "{\"Name\":\"manual persona 1\",\"Email\":\"email_1\",\"Phone\":\"phone_1\",\"City\":\"city_1\",\"State\":\"state_1\",\"Country\":\"country_1\",\"YoE\":5,\"Tech Skills\":[\"tech skill 1\",\"tech skill 2\",\"tech skill 3\"]}",
 			},
 			output:           nil,
 			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
 			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
-				UpdateCandidateWithManuallyCreatedPersonaForTeamInternal: func(id string, persona *model.Persona, team *model.Team) (string, error) {
+				UpdateCandidateWithManuallyCreatedPersonaForTeamInternal: func(id string, persona *model.Persona, editedByUserId string, team *model.Team) (string, error) {
 					return "", errors.New("dbError when updating")
 				},
 			},
@@ -493,8 +960,11 @@ func Test_UpdateCandidate(t *testing.T) {
 			output:           &pb.UpdateCandidateResponse{Id: "new_id1"},
 			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
 			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
-				UpdateCandidateWithManuallyCreatedPersonaForTeamInternal: func(id string, persona *model.Persona, team *model.Team) (string, error) {
-					return "new_id1", nil
+				UpdateCandidateWithManuallyCreatedPersonaForTeamInternal: func(id string, persona *model.Persona, editedByUserId string, team *model.Team) (string, error) {
+					if editedByUserId == "user_id1" {
+						return "new_id1", nil
+					}
+					return "", errors.New("unexpected user")
 				},
 			},
 			errorExpected: false,
@@ -528,6 +998,931 @@ func Test_UpdateCandidate(t *testing.T) {
 	}
 }
 
+func Test_GetDuplicateCandidates(t *testing.T) {
+	currentFileCount := 3
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	candidate1, _ := model.NewCandidate(model.CandidateOptions{
+		Id:                 "c_id1",
+		AiGeneratedPersona: &model.Persona{Name: "Jane Doe", Email: "jane@example.com", BuiltBy: "AI", FileUploadId: "fp_id1"},
+		Team:               team,
+		FileUploadId:       "fp_id1",
+	})
+	candidate2, _ := model.NewCandidate(model.CandidateOptions{
+		Id:                 "c_id2",
+		AiGeneratedPersona: &model.Persona{Name: "John Smith", Email: "john@example.com", BuiltBy: "AI", FileUploadId: "fp_id2"},
+		Team:               team,
+		FileUploadId:       "fp_id2",
+	})
+	candidate3, _ := model.NewCandidate(model.CandidateOptions{
+		Id:                 "c_id3",
+		AiGeneratedPersona: &model.Persona{Name: "Jane D", Email: "JANE@example.com", Phone: "9876543210", BuiltBy: "AI", FileUploadId: "fp_id3"},
+		Team:               team,
+		FileUploadId:       "fp_id3",
+	})
+
+	tests := []struct {
+		name                  string
+		ctx                   context.Context
+		input                 *pb.GetDuplicateCandidatesRequest
+		output                *pb.GetDuplicateCandidatesResponse
+		teamHydratorMock      storage.TeamHydrator
+		candidateAccessorMock storage.CandidateAccessor
+		errorExpected         bool
+		errorString           string
+	}{
+		{
+			name:                  "errors if no user in context",
+			ctx:                   context.Background(),
+			input:                 &pb.GetDuplicateCandidatesRequest{},
+			output:                nil,
+			teamHydratorMock:      nil,
+			candidateAccessorMock: nil,
+			errorExpected:         true,
+			errorString:           "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name: "errors if unable to hydrate team",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:                 &pb.GetDuplicateCandidatesRequest{},
+			output:                nil,
+			teamHydratorMock:      &storage.TeamHydratorMockFailure{},
+			candidateAccessorMock: nil,
+			errorExpected:         true,
+			errorString:           "unable to hydrate team",
+		},
+		{
+			name: "returns error if database errors when getting candidates",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.GetDuplicateCandidatesRequest{},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				GetAllCandidatesForTeamInternal: func(team *model.Team) ([]*model.Candidate, error) {
+					return nil, errors.New("dbError when querying")
+				},
+			},
+			errorExpected: true,
+			errorString:   "dbError when querying",
+		},
+		{
+			name: "runs successfully",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.GetDuplicateCandidatesRequest{},
+			output: &pb.GetDuplicateCandidatesResponse{
+				Clusters: []*pb.DuplicateCandidateCluster{
+					{
+						Candidates: []*pb.Candidate{{Id: "c_id1"}, {Id: "c_id3"}},
+						Matches: []*pb.DuplicateCandidateMatch{
+							{CandidateId: "c_id1", OtherCandidateId: "c_id3", Reasons: []string{"EMAIL"}},
+						},
+					},
+				},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				GetAllCandidatesForTeamInternal: func(team *model.Team) ([]*model.Candidate, error) {
+					return []*model.Candidate{candidate1, candidate2, candidate3}, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithCandidateAccessorMock(tt.candidateAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.GetDuplicateCandidates(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.Equal(t, len(tt.output.GetClusters()), len(response.GetClusters()))
+				for i, cluster := range response.GetClusters() {
+					expectedCluster := tt.output.GetClusters()[i]
+					assert.Equal(t, len(expectedCluster.GetCandidates()), len(cluster.GetCandidates()))
+					for j, candidate := range cluster.GetCandidates() {
+						assert.Equal(t, expectedCluster.GetCandidates()[j].GetId(), candidate.GetId())
+					}
+					assert.Equal(t, len(expectedCluster.GetMatches()), len(cluster.GetMatches()))
+					for j, match := range cluster.GetMatches() {
+						assert.Equal(t, expectedCluster.GetMatches()[j].GetCandidateId(), match.GetCandidateId())
+						assert.Equal(t, expectedCluster.GetMatches()[j].GetOtherCandidateId(), match.GetOtherCandidateId())
+						assert.Equal(t, expectedCluster.GetMatches()[j].GetReasons(), match.GetReasons())
+					}
+				}
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_MergeCandidates(t *testing.T) {
+	currentFileCount := 2
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	mergedCandidate, _ := model.NewCandidate(model.CandidateOptions{
+		Id:                     "c_id1",
+		AiGeneratedPersona:     &model.Persona{Name: "Jane Doe", BuiltBy: "AI", FileUploadId: "fp_id1"},
+		ManuallyCreatedPersona: &model.Persona{Name: "Jane Doe", Phone: "9876543210", BuiltBy: "HUMAN"},
+		Team:                   team,
+		FileUploadId:           "fp_id1",
+	})
+
+	tests := []struct {
+		name                  string
+		ctx                   context.Context
+		input                 *pb.MergeCandidatesRequest
+		output                *pb.MergeCandidatesResponse
+		teamHydratorMock      storage.TeamHydrator
+		candidateAccessorMock storage.CandidateAccessor
+		errorExpected         bool
+		errorString           string
+	}{
+		{
+			name:                  "errors if survivingCandidateId is blank",
+			ctx:                   context.Background(),
+			input:                 &pb.MergeCandidatesRequest{MergedCandidateIds: []string{"c_id2"}},
+			output:                nil,
+			teamHydratorMock:      nil,
+			candidateAccessorMock: nil,
+			errorExpected:         true,
+			errorString:           "survivingCandidateId cannot be blank",
+		},
+		{
+			name:                  "errors if mergedCandidateIds is empty",
+			ctx:                   context.Background(),
+			input:                 &pb.MergeCandidatesRequest{SurvivingCandidateId: "c_id1"},
+			output:                nil,
+			teamHydratorMock:      nil,
+			candidateAccessorMock: nil,
+			errorExpected:         true,
+			errorString:           "mergedCandidateIds cannot be empty",
+		},
+		{
+			name:                  "errors if no user in context",
+			ctx:                   context.Background(),
+			input:                 &pb.MergeCandidatesRequest{SurvivingCandidateId: "c_id1", MergedCandidateIds: []string{"c_id2"}},
+			output:                nil,
+			teamHydratorMock:      nil,
+			candidateAccessorMock: nil,
+			errorExpected:         true,
+			errorString:           "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name: "errors if unable to hydrate team",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:                 &pb.MergeCandidatesRequest{SurvivingCandidateId: "c_id1", MergedCandidateIds: []string{"c_id2"}},
+			output:                nil,
+			teamHydratorMock:      &storage.TeamHydratorMockFailure{},
+			candidateAccessorMock: nil,
+			errorExpected:         true,
+			errorString:           "unable to hydrate team",
+		},
+		{
+			name: "returns error if database errors when merging candidates",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.MergeCandidatesRequest{SurvivingCandidateId: "c_id1", MergedCandidateIds: []string{"c_id2"}},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				MergeCandidatesForTeamInternal: func(survivingCandidateId string, mergedCandidateIds []string, mergedByUserId string, team *model.Team) (*model.Candidate, error) {
+					return nil, errors.New("no candidate for id c_id2")
+				},
+			},
+			errorExpected: true,
+			errorString:   "no candidate for id c_id2",
+		},
+		{
+			name: "runs successfully",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.MergeCandidatesRequest{SurvivingCandidateId: "c_id1", MergedCandidateIds: []string{"c_id2"}},
+			output: &pb.MergeCandidatesResponse{
+				Candidate: &pb.Candidate{
+					Id:                     "c_id1",
+					AiGeneratedPersona:     "{\"Name\":\"Jane Doe\",\"BuiltBy\":\"AI\",\"FileUploadId\":\"fp_id1\"}",
+					ManuallyCreatedPersona: "{\"Name\":\"Jane Doe\",\"Phone\":\"9876543210\",\"BuiltBy\":\"HUMAN\"}",
+					FileUploadId:           "fp_id1",
+				},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				MergeCandidatesForTeamInternal: func(survivingCandidateId string, mergedCandidateIds []string, mergedByUserId string, team *model.Team) (*model.Candidate, error) {
+					if survivingCandidateId == "c_id1" && len(mergedCandidateIds) == 1 && mergedCandidateIds[0] == "c_id2" && mergedByUserId == "user_id1" {
+						return mergedCandidate, nil
+					}
+					return nil, errors.New("unexpected candidates")
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithCandidateAccessorMock(tt.candidateAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.MergeCandidates(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.output.GetCandidate().GetId(), response.GetCandidate().GetId())
+				assert.Equal(t, tt.output.GetCandidate().GetAiGeneratedPersona(), response.GetCandidate().GetAiGeneratedPersona())
+				assert.Equal(t, tt.output.GetCandidate().GetManuallyCreatedPersona(), response.GetCandidate().GetManuallyCreatedPersona())
+				assert.Equal(t, tt.output.GetCandidate().GetFileUploadId(), response.GetCandidate().GetFileUploadId())
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_ArchiveCandidate(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+
+	tests := []struct {
+		name                  string
+		ctx                   context.Context
+		input                 *pb.ArchiveCandidateRequest
+		output                *pb.ArchiveCandidateResponse
+		teamHydratorMock      storage.TeamHydrator
+		candidateAccessorMock storage.CandidateAccessor
+		errorExpected         bool
+		errorString           string
+	}{
+		{
+			name:                  "errors if id is blank",
+			ctx:                   context.Background(),
+			input:                 &pb.ArchiveCandidateRequest{},
+			output:                nil,
+			teamHydratorMock:      nil,
+			candidateAccessorMock: nil,
+			errorExpected:         true,
+			errorString:           "id cannot be blank",
+		},
+		{
+			name:                  "errors if no user in context",
+			ctx:                   context.Background(),
+			input:                 &pb.ArchiveCandidateRequest{Id: "c_id1"},
+			output:                nil,
+			teamHydratorMock:      nil,
+			candidateAccessorMock: nil,
+			errorExpected:         true,
+			errorString:           "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name: "errors if unable to hydrate team",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:                 &pb.ArchiveCandidateRequest{Id: "c_id1"},
+			output:                nil,
+			teamHydratorMock:      &storage.TeamHydratorMockFailure{},
+			candidateAccessorMock: nil,
+			errorExpected:         true,
+			errorString:           "unable to hydrate team",
+		},
+		{
+			name: "returns error if candidate is not found",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.ArchiveCandidateRequest{Id: "c_id1"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				ArchiveCandidateForTeamInternal: func(id string, team *model.Team) error {
+					return errors.New("no candidate for id c_id1")
+				},
+			},
+			errorExpected: true,
+			errorString:   "no candidate for id c_id1",
+		},
+		{
+			name: "runs successfully",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.ArchiveCandidateRequest{Id: "c_id1"},
+			output:           &pb.ArchiveCandidateResponse{},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				ArchiveCandidateForTeamInternal: func(id string, team *model.Team) error {
+					if id != "c_id1" || team.Id() != "team_id1" {
+						return errors.New("unexpected candidate")
+					}
+					return nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithCandidateAccessorMock(tt.candidateAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.ArchiveCandidate(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_UnarchiveCandidate(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+
+	tests := []struct {
+		name                  string
+		ctx                   context.Context
+		input                 *pb.UnarchiveCandidateRequest
+		output                *pb.UnarchiveCandidateResponse
+		teamHydratorMock      storage.TeamHydrator
+		candidateAccessorMock storage.CandidateAccessor
+		errorExpected         bool
+		errorString           string
+	}{
+		{
+			name:                  "errors if id is blank",
+			ctx:                   context.Background(),
+			input:                 &pb.UnarchiveCandidateRequest{},
+			output:                nil,
+			teamHydratorMock:      nil,
+			candidateAccessorMock: nil,
+			errorExpected:         true,
+			errorString:           "id cannot be blank",
+		},
+		{
+			name:                  "errors if no user in context",
+			ctx:                   context.Background(),
+			input:                 &pb.UnarchiveCandidateRequest{Id: "c_id1"},
+			output:                nil,
+			teamHydratorMock:      nil,
+			candidateAccessorMock: nil,
+			errorExpected:         true,
+			errorString:           "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name: "errors if unable to hydrate team",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:                 &pb.UnarchiveCandidateRequest{Id: "c_id1"},
+			output:                nil,
+			teamHydratorMock:      &storage.TeamHydratorMockFailure{},
+			candidateAccessorMock: nil,
+			errorExpected:         true,
+			errorString:           "unable to hydrate team",
+		},
+		{
+			name: "returns error if candidate is not found",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.UnarchiveCandidateRequest{Id: "c_id1"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				UnarchiveCandidateForTeamInternal: func(id string, team *model.Team) error {
+					return errors.New("no candidate for id c_id1")
+				},
+			},
+			errorExpected: true,
+			errorString:   "no candidate for id c_id1",
+		},
+		{
+			name: "runs successfully",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.UnarchiveCandidateRequest{Id: "c_id1"},
+			output:           &pb.UnarchiveCandidateResponse{},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				UnarchiveCandidateForTeamInternal: func(id string, team *model.Team) error {
+					if id != "c_id1" || team.Id() != "team_id1" {
+						return errors.New("unexpected candidate")
+					}
+					return nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithCandidateAccessorMock(tt.candidateAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.UnarchiveCandidate(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_DeleteCandidate(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	otherTeam, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id2",
+		Name:             "other@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	candidateWithFileUpload, _ := model.NewCandidate(model.CandidateOptions{
+		Id:                 "c_id1",
+		AiGeneratedPersona: &model.Persona{Name: "ai persona 1", BuiltBy: "AI", FileUploadId: "fp_id1"},
+		Team:               team,
+		FileUploadId:       "fp_id1",
+	})
+	candidateWithoutFileUpload, _ := model.NewCandidate(model.CandidateOptions{
+		Id:                     "c_id1",
+		ManuallyCreatedPersona: &model.Persona{Name: "manual persona 1", BuiltBy: "HUMAN"},
+		Team:                   team,
+	})
+	fileUpload, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id1",
+		Name:             "file1.pdf",
+		Status:           "SUCCESS",
+		ProcessingStatus: "COMPLETED",
+		Team:             team,
+	})
+	otherTeamFileUpload, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id1",
+		Name:             "file1.pdf",
+		Status:           "SUCCESS",
+		ProcessingStatus: "COMPLETED",
+		Team:             otherTeam,
+	})
+
+	tests := []struct {
+		name                   string
+		ctx                    context.Context
+		input                  *pb.DeleteCandidateRequest
+		allowFileDeletion      bool
+		deleteFileFails        bool
+		teamHydratorMock       storage.TeamHydrator
+		candidateAccessorMock  storage.CandidateAccessor
+		fileUploadAccessorMock storage.FileUploadAccessor
+		expectedDeletedFiles   []string
+		errorExpected          bool
+		errorString            string
+	}{
+		{
+			name:          "errors if id is blank",
+			ctx:           context.Background(),
+			input:         &pb.DeleteCandidateRequest{},
+			errorExpected: true,
+			errorString:   "id cannot be blank",
+		},
+		{
+			name:          "errors if no user in context",
+			ctx:           context.Background(),
+			input:         &pb.DeleteCandidateRequest{Id: "c_id1"},
+			errorExpected: true,
+			errorString:   "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name: "errors if file upload deletion is disabled",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:             &pb.DeleteCandidateRequest{Id: "c_id1", DeleteFileUpload: true},
+			allowFileDeletion: false,
+			errorExpected:     true,
+			errorString:       "File deletion is currently disabled",
+		},
+		{
+			name: "errors if unable to hydrate team",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.DeleteCandidateRequest{Id: "c_id1"},
+			teamHydratorMock: &storage.TeamHydratorMockFailure{},
+			errorExpected:    true,
+			errorString:      "unable to hydrate team",
+		},
+		{
+			name: "returns error if unable to delete candidate",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.DeleteCandidateRequest{Id: "c_id1"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				DeleteCandidateForTeamInternal: func(id string, team *model.Team) error {
+					return errors.New("no candidate for id c_id1")
+				},
+			},
+			errorExpected: true,
+			errorString:   "no candidate for id c_id1",
+		},
+		{
+			name: "deletes only the candidate",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.DeleteCandidateRequest{Id: "c_id1"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				DeleteCandidateForTeamInternal: func(id string, team *model.Team) error {
+					return nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "returns error if unable to get candidate",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:             &pb.DeleteCandidateRequest{Id: "c_id1", DeleteFileUpload: true},
+			allowFileDeletion: true,
+			teamHydratorMock:  &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				GetCandidateForTeamInternal: func(id string, team *model.Team) (*model.Candidate, error) {
+					return nil, errors.New("no candidate for id c_id1")
+				},
+			},
+			errorExpected: true,
+			errorString:   "no candidate for id c_id1",
+		},
+		{
+			name: "deletes only the candidate if it has no file upload",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:             &pb.DeleteCandidateRequest{Id: "c_id1", DeleteFileUpload: true},
+			allowFileDeletion: true,
+			teamHydratorMock:  &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				GetCandidateForTeamInternal: func(id string, team *model.Team) (*model.Candidate, error) {
+					return candidateWithoutFileUpload, nil
+				},
+				DeleteCandidateForTeamInternal: func(id string, team *model.Team) error {
+					return nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "errors if file upload belongs to another team",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:             &pb.DeleteCandidateRequest{Id: "c_id1", DeleteFileUpload: true},
+			allowFileDeletion: true,
+			teamHydratorMock:  &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				GetCandidateForTeamInternal: func(id string, team *model.Team) (*model.Candidate, error) {
+					return candidateWithFileUpload, nil
+				},
+			},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				GetFileUploadInternal: func(id string) (*model.FileUpload, error) {
+					return otherTeamFileUpload, nil
+				},
+			},
+			errorExpected: true,
+			errorString:   "THIS IS BAD: unauthorized deletion of fileUpload attempted",
+		},
+		{
+			name: "returns error if unable to delete file upload",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:             &pb.DeleteCandidateRequest{Id: "c_id1", DeleteFileUpload: true},
+			allowFileDeletion: true,
+			teamHydratorMock:  &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				GetCandidateForTeamInternal: func(id string, team *model.Team) (*model.Candidate, error) {
+					return candidateWithFileUpload, nil
+				},
+			},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				GetFileUploadInternal: func(id string) (*model.FileUpload, error) {
+					return fileUpload, nil
+				},
+				DeleteFileUploadForTeamInteral: func(id string, team *model.Team) error {
+					return errors.New("unable to delete file upload")
+				},
+			},
+			errorExpected: true,
+			errorString:   "unable to delete file upload",
+		},
+		{
+			name: "runs successfully even if the stored file cannot be deleted",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:             &pb.DeleteCandidateRequest{Id: "c_id1", DeleteFileUpload: true},
+			allowFileDeletion: true,
+			deleteFileFails:   true,
+			teamHydratorMock:  &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				GetCandidateForTeamInternal: func(id string, team *model.Team) (*model.Candidate, error) {
+					return candidateWithFileUpload, nil
+				},
+			},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				GetFileUploadInternal: func(id string) (*model.FileUpload, error) {
+					return fileUpload, nil
+				},
+				DeleteFileUploadForTeamInteral: func(id string, team *model.Team) error {
+					return nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "deletes the candidate with its file upload and stored file",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:             &pb.DeleteCandidateRequest{Id: "c_id1", DeleteFileUpload: true},
+			allowFileDeletion: true,
+			teamHydratorMock:  &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				GetCandidateForTeamInternal: func(id string, team *model.Team) (*model.Candidate, error) {
+					return candidateWithFileUpload, nil
+				},
+			},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				GetFileUploadInternal: func(id string) (*model.FileUpload, error) {
+					return fileUpload, nil
+				},
+				DeleteFileUploadForTeamInteral: func(id string, team *model.Team) error {
+					return nil
+				},
+			},
+			expectedDeletedFiles: []string{"team_id1/fp_id1/file1.pdf"},
+			errorExpected:        false,
+			errorString:          "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fileStorerMock := &filestorage.FileStorerMock{DeleteFails: tt.deleteFileFails}
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithCandidateAccessorMock(tt.candidateAccessorMock),
+					storage.WithFileUploadAccessorMock(tt.fileUploadAccessorMock),
+				),
+				Logger:     &utilities.NullLogger{},
+				Config:     &config.Config{AllowFileDeletion: tt.allowFileDeletion},
+				FileStorer: fileStorerMock,
+			})
+
+			response, err := server.DeleteCandidate(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, &pb.DeleteCandidateResponse{}, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			assert.Equal(t, tt.expectedDeletedFiles, fileStorerMock.DeletedFiles)
+		})
+	}
+}
+
 func candidateResponseIsEqual(c1 *pb.Candidate, c2 *pb.Candidate) bool {
 	return true
 }
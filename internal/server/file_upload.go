@@ -7,6 +7,7 @@ import (
 	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
 	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *CandidateTrackerGoService) UploadFiles(ctx context.Context, req *pb.UploadFilesRequest) (*pb.UploadFilesResponse, error) {
@@ -89,12 +90,40 @@ func (s *CandidateTrackerGoService) GetFileUpload(ctx context.Context, req *pb.G
 	}
 
 	return &pb.GetFileUploadResponse{
-		FileUpload: &pb.FileUpload{
-			Id:               fileUpload.Id(),
-			Name:             fileUpload.Name(),
-			PresignedUrl:     fileUpload.PresignedUrl(),
-			Status:           fileUpload.Status(),
-			ProcessingStatus: fileUpload.ProcessingStatus(),
+		FileUpload: fileUploadResponse(fileUpload),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) GetFileUploadText(ctx context.Context, req *pb.GetFileUploadTextRequest) (*pb.GetFileUploadTextResponse, error) {
+	id := req.GetId()
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	fileUploadText, err := s.storage.GetFileUploadTextForTeam(id, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetFileUploadTextResponse{
+		FileUploadText: &pb.FileUploadText{
+			FileUploadId:     fileUploadText.FileUploadId(),
+			Text:             fileUploadText.Text(),
+			TextHash:         fileUploadText.TextHash(),
+			PageCount:        int64(fileUploadText.PageCount()),
+			ExtractorVersion: fileUploadText.ExtractorVersion(),
 		},
 	}, nil
 }
@@ -112,24 +141,57 @@ func (s *CandidateTrackerGoService) GetFileUploads(ctx context.Context, req *pb.
 
 	team := userWithTeam.Team()
 
+	page, err := pageRequestFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
 	responseData := []*pb.FileUpload{}
-	fileUploads, err := s.storage.GetFileUploadsForTeam(team)
+	fileUploads, pageInfo, err := s.storage.GetFileUploadsForTeam(team, page)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, fileUpload := range fileUploads {
-		fileUploadResponse := pb.FileUpload{
-			Id:               fileUpload.Id(),
-			Name:             fileUpload.Name(),
-			PresignedUrl:     fileUpload.PresignedUrl(),
-			Status:           fileUpload.Status(),
-			ProcessingStatus: fileUpload.ProcessingStatus(),
-		}
-		responseData = append(responseData, &fileUploadResponse)
+		responseData = append(responseData, fileUploadResponse(fileUpload))
 	}
 
 	return &pb.GetFileUploadsResponse{
+		FileUploads:   responseData,
+		NextPageToken: pageInfo.NextPageToken(),
+		TotalCount:    int64(pageInfo.TotalCount()),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) GetChildFileUploads(ctx context.Context, req *pb.GetChildFileUploadsRequest) (*pb.GetChildFileUploadsResponse, error) {
+	parentFileUploadId := req.GetParentFileUploadId()
+	if utilities.IsBlank(parentFileUploadId) {
+		return nil, errors.New("parentFileUploadId cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	responseData := []*pb.FileUpload{}
+	fileUploads, err := s.storage.GetChildFileUploadsForTeam(parentFileUploadId, team)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fileUpload := range fileUploads {
+		responseData = append(responseData, fileUploadResponse(fileUpload))
+	}
+
+	return &pb.GetChildFileUploadsResponse{
 		FileUploads: responseData,
 	}, nil
 }
@@ -243,6 +305,114 @@ func (s *CandidateTrackerGoService) DeleteFileUpload(ctx context.Context, req *p
 	return &pb.DeleteFileUploadResponse{}, nil
 }
 
+func (s *CandidateTrackerGoService) ReprocessFileUpload(ctx context.Context, req *pb.ReprocessFileUploadRequest) (*pb.ReprocessFileUploadResponse, error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	fileUpload, err := s.reprocessFileUploadForTeam(req.GetId(), team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReprocessFileUploadResponse{
+		FileUpload: fileUpload,
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) ReprocessFileUploads(ctx context.Context, req *pb.ReprocessFileUploadsRequest) (*pb.ReprocessFileUploadsResponse, error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	responseData := []*pb.FileUpload{}
+	for _, id := range req.GetIds() {
+		fileUpload, err := s.reprocessFileUploadForTeam(id, team)
+		if err != nil {
+			responseData = append(responseData, fileUploadResponseWithError(&pb.FileUpload{Id: id}, err))
+			continue
+		}
+		responseData = append(responseData, fileUpload)
+	}
+
+	return &pb.ReprocessFileUploadsResponse{
+		FileUploads: responseData,
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) reprocessFileUploadForTeam(id string, team *model.Team) (*pb.FileUpload, error) {
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	fileUpload, err := s.storage.GetFileUpload(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fileUpload.BelongsToTeam(team) {
+		return nil, errors.New("File Upload not found")
+	}
+
+	if !fileUpload.CanBeReprocessed() {
+		return nil, errors.New("File Upload cannot be reprocessed")
+	}
+
+	// The reset notifies the processing loop, which dispatches the file upload within the concurrency caps.
+	err = s.storage.ResetFileUploadProcessingForTeam(id, team)
+	if err != nil {
+		return nil, err
+	}
+
+	resetFileUpload, err := s.storage.GetFileUpload(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return fileUploadResponse(resetFileUpload), nil
+}
+
+func fileUploadResponse(fileUpload *model.FileUpload) *pb.FileUpload {
+	return &pb.FileUpload{
+		Id:                     fileUpload.Id(),
+		Name:                   fileUpload.Name(),
+		PresignedUrl:           fileUpload.PresignedUrl(),
+		Status:                 fileUpload.Status(),
+		ProcessingStatus:       fileUpload.ProcessingStatus(),
+		Failure:                fileUploadFailureResponse(fileUpload.Failure()),
+		ParentFileUploadId:     fileUpload.ParentFileUploadId(),
+		DuplicateOfCandidateId: fileUpload.DuplicateOfCandidateId(),
+		MergedIntoCandidateId:  fileUpload.MergedIntoCandidateId(),
+	}
+}
+
+func fileUploadFailureResponse(failure *model.FileUploadFailure) *pb.FileUploadFailure {
+	if failure == nil {
+		return nil
+	}
+	return &pb.FileUploadFailure{
+		Category: failure.Category(),
+		Message:  failure.Message(),
+		FailedAt: timestamppb.New(failure.FailedAt()),
+	}
+}
+
 func fileUploadResponseWithError(fileUploadResponse *pb.FileUpload, err error) *pb.FileUpload {
 	fileUploadResponse.Error = err.Error()
 	return fileUploadResponse
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/config"
@@ -11,8 +12,10 @@ import (
 	"github.com/vipulvpatil/candidate-tracker-go/internal/services/filestorage"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/workers"
 	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func Test_UploadFiles(t *testing.T) {
@@ -908,6 +911,21 @@ func Test_GetFileUpload(t *testing.T) {
 		ProcessingStatus: "COMPLETED",
 		Team:             team2,
 	})
+	failedAt := time.Date(2023, time.March, 1, 10, 0, 0, 0, time.UTC)
+	failure, _ := model.NewFileUploadFailure(model.FileUploadFailureOptions{
+		Category: "NOT A RESUME",
+		Message:  "needs a valid resume to parse",
+		FailedAt: failedAt,
+	})
+	fileUpload3, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id3",
+		Name:             "file3.pdf",
+		PresignedUrl:     "https://presigned_url3",
+		Status:           "SUCCESS",
+		ProcessingStatus: "FAILED",
+		Team:             team,
+		Failure:          failure,
+	})
 
 	tests := []struct {
 		name                   string
@@ -1018,6 +1036,41 @@ func Test_GetFileUpload(t *testing.T) {
 			errorExpected: false,
 			errorString:   "",
 		},
+		{
+			name: "returns the processing failure of a failed fileUpload",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.GetFileUploadRequest{},
+			output: &pb.GetFileUploadResponse{
+				FileUpload: &pb.FileUpload{
+					Id:               "fp_id3",
+					Name:             "file3.pdf",
+					PresignedUrl:     "https://presigned_url3",
+					Status:           "SUCCESS",
+					ProcessingStatus: "FAILED",
+					Error:            "",
+					Failure: &pb.FileUploadFailure{
+						Category: "NOT A RESUME",
+						Message:  "needs a valid resume to parse",
+						FailedAt: timestamppb.New(failedAt),
+					},
+				},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				GetFileUploadInternal: func(id string) (*model.FileUpload, error) {
+					return fileUpload3, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
 	}
 
 	for _, tt := range tests {
@@ -1045,6 +1098,152 @@ func Test_GetFileUpload(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetFileUploadText(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	fileUploadText, _ := model.NewFileUploadText(model.FileUploadTextOptions{
+		FileUploadId:     "fp_id1",
+		Text:             "resume text",
+		PageCount:        2,
+		ExtractorVersion: "pdf2go-v0.1.1",
+	})
+
+	tests := []struct {
+		name                       string
+		ctx                        context.Context
+		input                      *pb.GetFileUploadTextRequest
+		output                     *pb.GetFileUploadTextResponse
+		teamHydratorMock           storage.TeamHydrator
+		fileUploadTextAccessorMock storage.FileUploadTextAccessor
+		errorExpected              bool
+		errorString                string
+	}{
+		{
+			name:                       "errors if id is blank",
+			ctx:                        context.Background(),
+			input:                      &pb.GetFileUploadTextRequest{},
+			output:                     nil,
+			teamHydratorMock:           nil,
+			fileUploadTextAccessorMock: nil,
+			errorExpected:              true,
+			errorString:                "id cannot be blank",
+		},
+		{
+			name:                       "errors if no user in context",
+			ctx:                        context.Background(),
+			input:                      &pb.GetFileUploadTextRequest{Id: "fp_id1"},
+			output:                     nil,
+			teamHydratorMock:           nil,
+			fileUploadTextAccessorMock: nil,
+			errorExpected:              true,
+			errorString:                "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name: "errors if unable to hydrate team",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:                      &pb.GetFileUploadTextRequest{Id: "fp_id1"},
+			output:                     nil,
+			teamHydratorMock:           &storage.TeamHydratorMockFailure{},
+			fileUploadTextAccessorMock: nil,
+			errorExpected:              true,
+			errorString:                "unable to hydrate team",
+		},
+		{
+			name: "returns error if file upload text is not found",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.GetFileUploadTextRequest{Id: "fp_id1"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fileUploadTextAccessorMock: &storage.FileUploadTextAccessorConfigurableMock{
+				GetFileUploadTextForTeamInternal: func(fileUploadId string, team *model.Team) (*model.FileUploadText, error) {
+					return nil, errors.New("no file upload text for id fp_id1")
+				},
+			},
+			errorExpected: true,
+			errorString:   "no file upload text for id fp_id1",
+		},
+		{
+			name: "runs successfully",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.GetFileUploadTextRequest{Id: "fp_id1"},
+			output: &pb.GetFileUploadTextResponse{
+				FileUploadText: &pb.FileUploadText{
+					FileUploadId:     "fp_id1",
+					Text:             "resume text",
+					TextHash:         "a8722f53a5b1d3ed2e23f2ecddc927aa6015a3da6274a3ae4754c40aa232e13f",
+					PageCount:        2,
+					ExtractorVersion: "pdf2go-v0.1.1",
+				},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fileUploadTextAccessorMock: &storage.FileUploadTextAccessorConfigurableMock{
+				GetFileUploadTextForTeamInternal: func(fileUploadId string, team *model.Team) (*model.FileUploadText, error) {
+					return fileUploadText, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithFileUploadTextAccessorMock(tt.fileUploadTextAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.GetFileUploadText(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
 func Test_GetFileUploads(t *testing.T) {
 	currentFileCount := 1
 	team, _ := model.NewTeam(model.TeamOptions{
@@ -1082,6 +1281,30 @@ func Test_GetFileUploads(t *testing.T) {
 		ProcessingStatus: "ONGOING",
 		Team:             team,
 	})
+	failedAt := time.Date(2023, time.March, 1, 10, 0, 0, 0, time.UTC)
+	failure, _ := model.NewFileUploadFailure(model.FileUploadFailureOptions{
+		Category: "UNREADABLE FILE",
+		Message:  "exit status 1",
+		FailedAt: failedAt,
+	})
+	fileUpload4, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id4",
+		Name:             "file4.pdf",
+		PresignedUrl:     "https://presigned_url4",
+		Status:           "SUCCESS",
+		ProcessingStatus: "FAILED",
+		Team:             team,
+		Failure:          failure,
+	})
+	fileUpload5, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:                     "fp_id5",
+		Name:                   "file5.pdf",
+		PresignedUrl:           "https://presigned_url5",
+		Status:                 "SUCCESS",
+		ProcessingStatus:       "DUPLICATE",
+		Team:                   team,
+		DuplicateOfCandidateId: "candidate_id1",
+	})
 
 	tests := []struct {
 		name                   string
@@ -1134,13 +1357,32 @@ func Test_GetFileUploads(t *testing.T) {
 			output:           nil,
 			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
 			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
-				GetFileUploadsForTeamInteral: func(team *model.Team) ([]*model.FileUpload, error) {
-					return nil, errors.New("dbError when querying")
+				GetFileUploadsForTeamInteral: func(team *model.Team, page *model.PageRequest) ([]*model.FileUpload, *model.PageInfo, error) {
+					return nil, nil, errors.New("dbError when querying")
 				},
 			},
 			errorExpected: true,
 			errorString:   "dbError when querying",
 		},
+		{
+			name: "returns error if page size is negative",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.GetFileUploadsRequest{
+				PageSize: -1,
+			},
+			output:                 nil,
+			teamHydratorMock:       &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{},
+			errorExpected:          true,
+			errorString:            "cannot create PageRequest with a negative size",
+		},
 		{
 			name: "runs successfully even with partial errors",
 			ctx: metadata.NewIncomingContext(
@@ -1151,7 +1393,10 @@ func Test_GetFileUploads(t *testing.T) {
 					},
 				),
 			),
-			input: &pb.GetFileUploadsRequest{},
+			input: &pb.GetFileUploadsRequest{
+				PageSize:  5,
+				PageToken: "token1",
+			},
 			output: &pb.GetFileUploadsResponse{
 				FileUploads: []*pb.FileUpload{
 					{
@@ -1178,14 +1423,42 @@ func Test_GetFileUploads(t *testing.T) {
 						ProcessingStatus: "ONGOING",
 						Error:            "",
 					},
+					{
+						Id:               "fp_id4",
+						Name:             "file4.pdf",
+						PresignedUrl:     "https://presigned_url4",
+						Status:           "SUCCESS",
+						ProcessingStatus: "FAILED",
+						Error:            "",
+						Failure: &pb.FileUploadFailure{
+							Category: "UNREADABLE FILE",
+							Message:  "exit status 1",
+							FailedAt: timestamppb.New(failedAt),
+						},
+					},
+					{
+						Id:                     "fp_id5",
+						Name:                   "file5.pdf",
+						PresignedUrl:           "https://presigned_url5",
+						Status:                 "SUCCESS",
+						ProcessingStatus:       "DUPLICATE",
+						Error:                  "",
+						DuplicateOfCandidateId: "candidate_id1",
+					},
 				},
+				NextPageToken: "token2",
+				TotalCount:    6,
 			},
 			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
 			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
-				GetFileUploadsForTeamInteral: func(team *model.Team) ([]*model.FileUpload, error) {
+				GetFileUploadsForTeamInteral: func(team *model.Team, page *model.PageRequest) ([]*model.FileUpload, *model.PageInfo, error) {
+					expectedPage, _ := model.NewPageRequest(model.PageRequestOptions{Size: 5, Token: "token1"})
+					if !assert.Equal(t, expectedPage, page) {
+						return nil, nil, errors.New("unexpected page")
+					}
 					return []*model.FileUpload{
-						fileUpload1, fileUpload2, fileUpload3,
-					}, nil
+						fileUpload1, fileUpload2, fileUpload3, fileUpload4, fileUpload5,
+					}, model.NewPageInfo("token2", 6), nil
 				},
 			},
 			errorExpected: false,
@@ -1219,8 +1492,8 @@ func Test_GetFileUploads(t *testing.T) {
 	}
 }
 
-func Test_GetUnprocessedFileUploadsCount(t *testing.T) {
-	currentFileCount := 1
+func Test_GetChildFileUploads(t *testing.T) {
+	currentFileCount := 3
 	team, _ := model.NewTeam(model.TeamOptions{
 		Id:               "team_id1",
 		Name:             "test@example.com",
@@ -1232,22 +1505,48 @@ func Test_GetUnprocessedFileUploadsCount(t *testing.T) {
 		Email: "test@example.com",
 		Team:  team,
 	})
+	fileUpload2, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:                 "fp_id2",
+		Name:               "file2.pdf",
+		Status:             "SUCCESS",
+		ProcessingStatus:   "COMPLETED",
+		Team:               team,
+		ParentFileUploadId: "fp_id1",
+	})
+	fileUpload3, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:                 "fp_id3",
+		Name:               "file3.docx",
+		Status:             "SUCCESS",
+		ProcessingStatus:   "NOT STARTED",
+		Team:               team,
+		ParentFileUploadId: "fp_id1",
+	})
 
 	tests := []struct {
 		name                   string
 		ctx                    context.Context
-		input                  *pb.GetUnprocessedFileUploadsCountRequest
-		output                 *pb.GetUnprocessedFileUploadsCountResponse
+		input                  *pb.GetChildFileUploadsRequest
+		output                 *pb.GetChildFileUploadsResponse
 		teamHydratorMock       storage.TeamHydrator
 		fileUploadAccessorMock storage.FileUploadAccessor
 		errorExpected          bool
 		errorString            string
 	}{
+		{
+			name:                   "errors if parentFileUploadId is blank",
+			ctx:                    context.Background(),
+			input:                  &pb.GetChildFileUploadsRequest{},
+			output:                 nil,
+			teamHydratorMock:       nil,
+			fileUploadAccessorMock: nil,
+			errorExpected:          true,
+			errorString:            "parentFileUploadId cannot be blank",
+		},
 		{
 			name:                   "errors if no user in context",
 			ctx:                    context.Background(),
-			input:                  &pb.GetUnprocessedFileUploadsCountRequest{},
-			output:                 &pb.GetUnprocessedFileUploadsCountResponse{},
+			input:                  &pb.GetChildFileUploadsRequest{ParentFileUploadId: "fp_id1"},
+			output:                 nil,
 			teamHydratorMock:       nil,
 			fileUploadAccessorMock: nil,
 			errorExpected:          true,
@@ -1263,15 +1562,15 @@ func Test_GetUnprocessedFileUploadsCount(t *testing.T) {
 					},
 				),
 			),
-			input:                  &pb.GetUnprocessedFileUploadsCountRequest{},
-			output:                 &pb.GetUnprocessedFileUploadsCountResponse{},
+			input:                  &pb.GetChildFileUploadsRequest{ParentFileUploadId: "fp_id1"},
+			output:                 nil,
 			teamHydratorMock:       &storage.TeamHydratorMockFailure{},
 			fileUploadAccessorMock: nil,
 			errorExpected:          true,
 			errorString:            "unable to hydrate team",
 		},
 		{
-			name: "returns error if database errors when getting fileUpload count",
+			name: "returns error if database errors when getting child fileUploads",
 			ctx: metadata.NewIncomingContext(
 				context.Background(), metadata.New(
 					map[string]string{
@@ -1280,40 +1579,17 @@ func Test_GetUnprocessedFileUploadsCount(t *testing.T) {
 					},
 				),
 			),
-			input:            &pb.GetUnprocessedFileUploadsCountRequest{},
+			input:            &pb.GetChildFileUploadsRequest{ParentFileUploadId: "fp_id1"},
 			output:           nil,
 			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
 			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
-				GetUnprocessedFileUploadsCountForTeamInternal: func(id *model.Team) (int, error) {
-					return 0, errors.New("dbError when querying")
+				GetChildFileUploadsForTeamInternal: func(parentFileUploadId string, team *model.Team) ([]*model.FileUpload, error) {
+					return nil, errors.New("dbError when querying")
 				},
 			},
 			errorExpected: true,
 			errorString:   "dbError when querying",
 		},
-		{
-			name: "returns response with 0 if fileUpload count not in database",
-			ctx: metadata.NewIncomingContext(
-				context.Background(), metadata.New(
-					map[string]string{
-						requestingUserIdCtxKey:    "user_id1",
-						requestingUserEmailCtxKey: "user@example.com",
-					},
-				),
-			),
-			input: &pb.GetUnprocessedFileUploadsCountRequest{},
-			output: &pb.GetUnprocessedFileUploadsCountResponse{
-				Count: 0,
-			},
-			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
-			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
-				GetUnprocessedFileUploadsCountForTeamInternal: func(id *model.Team) (int, error) {
-					return 0, nil
-				},
-			},
-			errorExpected: false,
-			errorString:   "",
-		},
 		{
 			name: "runs successfully",
 			ctx: metadata.NewIncomingContext(
@@ -1324,14 +1600,32 @@ func Test_GetUnprocessedFileUploadsCount(t *testing.T) {
 					},
 				),
 			),
-			input: &pb.GetUnprocessedFileUploadsCountRequest{},
-			output: &pb.GetUnprocessedFileUploadsCountResponse{
-				Count: 3,
+			input: &pb.GetChildFileUploadsRequest{ParentFileUploadId: "fp_id1"},
+			output: &pb.GetChildFileUploadsResponse{
+				FileUploads: []*pb.FileUpload{
+					{
+						Id:                 "fp_id2",
+						Name:               "file2.pdf",
+						Status:             "SUCCESS",
+						ProcessingStatus:   "COMPLETED",
+						ParentFileUploadId: "fp_id1",
+					},
+					{
+						Id:                 "fp_id3",
+						Name:               "file3.docx",
+						Status:             "SUCCESS",
+						ProcessingStatus:   "NOT STARTED",
+						ParentFileUploadId: "fp_id1",
+					},
+				},
 			},
 			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
 			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
-				GetUnprocessedFileUploadsCountForTeamInternal: func(id *model.Team) (int, error) {
-					return 3, nil
+				GetChildFileUploadsForTeamInternal: func(parentFileUploadId string, team *model.Team) ([]*model.FileUpload, error) {
+					if parentFileUploadId != "fp_id1" || team.Id() != "team_id1" {
+						return nil, errors.New("unexpected parent file upload")
+					}
+					return []*model.FileUpload{fileUpload2, fileUpload3}, nil
 				},
 			},
 			errorExpected: false,
@@ -1349,7 +1643,7 @@ func Test_GetUnprocessedFileUploadsCount(t *testing.T) {
 				Logger: &utilities.NullLogger{},
 			})
 
-			response, err := server.GetUnprocessedFileUploadsCount(
+			response, err := server.GetChildFileUploads(
 				tt.ctx,
 				tt.input,
 			)
@@ -1365,7 +1659,7 @@ func Test_GetUnprocessedFileUploadsCount(t *testing.T) {
 	}
 }
 
-func Test_DeleteFileUpload(t *testing.T) {
+func Test_GetUnprocessedFileUploadsCount(t *testing.T) {
 	currentFileCount := 1
 	team, _ := model.NewTeam(model.TeamOptions{
 		Id:               "team_id1",
@@ -1378,11 +1672,12 @@ func Test_DeleteFileUpload(t *testing.T) {
 		Email: "test@example.com",
 		Team:  team,
 	})
+
 	tests := []struct {
 		name                   string
 		ctx                    context.Context
-		input                  *pb.DeleteFileUploadRequest
-		output                 *pb.DeleteFileUploadResponse
+		input                  *pb.GetUnprocessedFileUploadsCountRequest
+		output                 *pb.GetUnprocessedFileUploadsCountResponse
 		teamHydratorMock       storage.TeamHydrator
 		fileUploadAccessorMock storage.FileUploadAccessor
 		errorExpected          bool
@@ -1391,8 +1686,8 @@ func Test_DeleteFileUpload(t *testing.T) {
 		{
 			name:                   "errors if no user in context",
 			ctx:                    context.Background(),
-			input:                  &pb.DeleteFileUploadRequest{},
-			output:                 &pb.DeleteFileUploadResponse{},
+			input:                  &pb.GetUnprocessedFileUploadsCountRequest{},
+			output:                 &pb.GetUnprocessedFileUploadsCountResponse{},
 			teamHydratorMock:       nil,
 			fileUploadAccessorMock: nil,
 			errorExpected:          true,
@@ -1408,15 +1703,15 @@ func Test_DeleteFileUpload(t *testing.T) {
 					},
 				),
 			),
-			input:                  &pb.DeleteFileUploadRequest{},
-			output:                 &pb.DeleteFileUploadResponse{},
+			input:                  &pb.GetUnprocessedFileUploadsCountRequest{},
+			output:                 &pb.GetUnprocessedFileUploadsCountResponse{},
 			teamHydratorMock:       &storage.TeamHydratorMockFailure{},
 			fileUploadAccessorMock: nil,
 			errorExpected:          true,
 			errorString:            "unable to hydrate team",
 		},
 		{
-			name: "returns error if database errors when deleting fileUpload",
+			name: "returns error if database errors when getting fileUpload count",
 			ctx: metadata.NewIncomingContext(
 				context.Background(), metadata.New(
 					map[string]string{
@@ -1425,19 +1720,19 @@ func Test_DeleteFileUpload(t *testing.T) {
 					},
 				),
 			),
-			input:            &pb.DeleteFileUploadRequest{},
+			input:            &pb.GetUnprocessedFileUploadsCountRequest{},
 			output:           nil,
 			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
 			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
-				DeleteFileUploadForTeamInteral: func(id string, team *model.Team) error {
-					return errors.New("dbError when deleting")
+				GetUnprocessedFileUploadsCountForTeamInternal: func(id *model.Team) (int, error) {
+					return 0, errors.New("dbError when querying")
 				},
 			},
 			errorExpected: true,
-			errorString:   "dbError when deleting",
+			errorString:   "dbError when querying",
 		},
 		{
-			name: "runs successfully even with partial errors",
+			name: "returns response with 0 if fileUpload count not in database",
 			ctx: metadata.NewIncomingContext(
 				context.Background(), metadata.New(
 					map[string]string{
@@ -1446,27 +1741,172 @@ func Test_DeleteFileUpload(t *testing.T) {
 					},
 				),
 			),
-			input:            &pb.DeleteFileUploadRequest{},
-			output:           &pb.DeleteFileUploadResponse{},
+			input: &pb.GetUnprocessedFileUploadsCountRequest{},
+			output: &pb.GetUnprocessedFileUploadsCountResponse{
+				Count: 0,
+			},
 			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
 			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
-				DeleteFileUploadForTeamInteral: func(id string, team *model.Team) error {
-					return nil
+				GetUnprocessedFileUploadsCountForTeamInternal: func(id *model.Team) (int, error) {
+					return 0, nil
 				},
 			},
 			errorExpected: false,
 			errorString:   "",
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			server, _ := NewServer(ServerDependencies{
-				Storage: storage.NewStorageAccessorMock(
-					storage.WithTeamHydratorMock(tt.teamHydratorMock),
-					storage.WithFileUploadAccessorMock(tt.fileUploadAccessorMock),
-				),
-				Logger: &utilities.NullLogger{},
+		{
+			name: "runs successfully",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.GetUnprocessedFileUploadsCountRequest{},
+			output: &pb.GetUnprocessedFileUploadsCountResponse{
+				Count: 3,
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				GetUnprocessedFileUploadsCountForTeamInternal: func(id *model.Team) (int, error) {
+					return 3, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithFileUploadAccessorMock(tt.fileUploadAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.GetUnprocessedFileUploadsCount(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_DeleteFileUpload(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	tests := []struct {
+		name                   string
+		ctx                    context.Context
+		input                  *pb.DeleteFileUploadRequest
+		output                 *pb.DeleteFileUploadResponse
+		teamHydratorMock       storage.TeamHydrator
+		fileUploadAccessorMock storage.FileUploadAccessor
+		errorExpected          bool
+		errorString            string
+	}{
+		{
+			name:                   "errors if no user in context",
+			ctx:                    context.Background(),
+			input:                  &pb.DeleteFileUploadRequest{},
+			output:                 &pb.DeleteFileUploadResponse{},
+			teamHydratorMock:       nil,
+			fileUploadAccessorMock: nil,
+			errorExpected:          true,
+			errorString:            "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name: "errors if unable to hydrate team",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:                  &pb.DeleteFileUploadRequest{},
+			output:                 &pb.DeleteFileUploadResponse{},
+			teamHydratorMock:       &storage.TeamHydratorMockFailure{},
+			fileUploadAccessorMock: nil,
+			errorExpected:          true,
+			errorString:            "unable to hydrate team",
+		},
+		{
+			name: "returns error if database errors when deleting fileUpload",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.DeleteFileUploadRequest{},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				DeleteFileUploadForTeamInteral: func(id string, team *model.Team) error {
+					return errors.New("dbError when deleting")
+				},
+			},
+			errorExpected: true,
+			errorString:   "dbError when deleting",
+		},
+		{
+			name: "runs successfully even with partial errors",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.DeleteFileUploadRequest{},
+			output:           &pb.DeleteFileUploadResponse{},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				DeleteFileUploadForTeamInteral: func(id string, team *model.Team) error {
+					return nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithFileUploadAccessorMock(tt.fileUploadAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
 				Config: &config.Config{AllowFileDeletion: true},
 			})
 
@@ -1485,3 +1925,586 @@ func Test_DeleteFileUpload(t *testing.T) {
 		})
 	}
 }
+
+func Test_ReprocessFileUpload(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	team2, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id2",
+		Name:             "test2@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	fileUpload1, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id1",
+		Name:             "file1.pdf",
+		PresignedUrl:     "https://presigned_url1",
+		Status:           "SUCCESS",
+		ProcessingStatus: "FAILED",
+		Team:             team,
+	})
+	fileUpload2, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id2",
+		Name:             "file2.pdf",
+		PresignedUrl:     "https://presigned_url2",
+		Status:           "SUCCESS",
+		ProcessingStatus: "COMPLETED",
+		Team:             team2,
+	})
+	fileUpload3, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id3",
+		Name:             "file3.pdf",
+		PresignedUrl:     "https://presigned_url3",
+		Status:           "SUCCESS",
+		ProcessingStatus: "ONGOING",
+		Team:             team,
+	})
+	fileUpload5, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:                    "fp_id5",
+		Name:                  "file5.pdf",
+		PresignedUrl:          "https://presigned_url5",
+		Status:                "SUCCESS",
+		ProcessingStatus:      "COMPLETED",
+		Team:                  team,
+		MergedIntoCandidateId: "candidate_id1",
+	})
+	fileUploads := map[string]*model.FileUpload{
+		"fp_id1": fileUpload1,
+		"fp_id2": fileUpload2,
+		"fp_id3": fileUpload3,
+		"fp_id5": fileUpload5,
+	}
+	getFileUpload := func(id string) (*model.FileUpload, error) {
+		fileUpload, ok := fileUploads[id]
+		if !ok {
+			return nil, errors.New("dbError when getting fileUpload")
+		}
+		return fileUpload, nil
+	}
+	resetFileUpload1, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id1",
+		Name:             "file1.pdf",
+		PresignedUrl:     "https://presigned_url1",
+		Status:           "SUCCESS",
+		ProcessingStatus: "NOT STARTED",
+		Team:             team,
+	})
+	resetFileUploads := map[string]*model.FileUpload{
+		"fp_id1": resetFileUpload1,
+	}
+	fileUploadAccessorMockWithReset := func() *storage.FileUploadAccessorConfigurableMock {
+		reset := map[string]bool{}
+		return &storage.FileUploadAccessorConfigurableMock{
+			GetFileUploadInternal: func(id string) (*model.FileUpload, error) {
+				if reset[id] {
+					return resetFileUploads[id], nil
+				}
+				return getFileUpload(id)
+			},
+			ResetFileUploadProcessingForTeamInternal: func(id string, team *model.Team) error {
+				reset[id] = true
+				return nil
+			},
+		}
+	}
+
+	getFileUploadCalls := 0
+	getFileUploadFailingAfterReset := func(id string) (*model.FileUpload, error) {
+		getFileUploadCalls++
+		if getFileUploadCalls > 1 {
+			return nil, errors.New("dbError when getting reset fileUpload")
+		}
+		return getFileUpload(id)
+	}
+
+	tests := []struct {
+		name                   string
+		ctx                    context.Context
+		input                  *pb.ReprocessFileUploadRequest
+		output                 *pb.ReprocessFileUploadResponse
+		teamHydratorMock       storage.TeamHydrator
+		fileUploadAccessorMock storage.FileUploadAccessor
+		jobStarterMock         workers.JobStarter
+		expectedEnqueuedArgs   []map[string]interface{}
+		errorExpected          bool
+		errorString            string
+	}{
+		{
+			name:                   "errors if no user in context",
+			ctx:                    context.Background(),
+			input:                  &pb.ReprocessFileUploadRequest{},
+			output:                 nil,
+			teamHydratorMock:       nil,
+			fileUploadAccessorMock: nil,
+			jobStarterMock:         nil,
+			expectedEnqueuedArgs:   nil,
+			errorExpected:          true,
+			errorString:            "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name: "errors if unable to hydrate team",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:                  &pb.ReprocessFileUploadRequest{},
+			output:                 nil,
+			teamHydratorMock:       &storage.TeamHydratorMockFailure{},
+			fileUploadAccessorMock: nil,
+			jobStarterMock:         nil,
+			expectedEnqueuedArgs:   nil,
+			errorExpected:          true,
+			errorString:            "unable to hydrate team",
+		},
+		{
+			name: "errors if id is blank",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:                  &pb.ReprocessFileUploadRequest{},
+			output:                 nil,
+			teamHydratorMock:       &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fileUploadAccessorMock: nil,
+			jobStarterMock:         &workers.JobStarterMockCallCheck{},
+			expectedEnqueuedArgs:   nil,
+			errorExpected:          true,
+			errorString:            "id cannot be blank",
+		},
+		{
+			name: "returns error if database errors when getting fileUpload",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.ReprocessFileUploadRequest{Id: "fp_id4"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				GetFileUploadInternal: getFileUpload,
+			},
+			jobStarterMock:       &workers.JobStarterMockCallCheck{},
+			expectedEnqueuedArgs: nil,
+			errorExpected:        true,
+			errorString:          "dbError when getting fileUpload",
+		},
+		{
+			name: "errors if fileUpload belongs to another team",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.ReprocessFileUploadRequest{Id: "fp_id2"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				GetFileUploadInternal: getFileUpload,
+			},
+			jobStarterMock:       &workers.JobStarterMockCallCheck{},
+			expectedEnqueuedArgs: nil,
+			errorExpected:        true,
+			errorString:          "File Upload not found",
+		},
+		{
+			name: "errors if fileUpload is still being processed",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.ReprocessFileUploadRequest{Id: "fp_id3"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				GetFileUploadInternal: getFileUpload,
+			},
+			jobStarterMock:       &workers.JobStarterMockCallCheck{},
+			expectedEnqueuedArgs: nil,
+			errorExpected:        true,
+			errorString:          "File Upload cannot be reprocessed",
+		},
+		{
+			name: "errors if the candidate of fileUpload was merged into another candidate",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.ReprocessFileUploadRequest{Id: "fp_id5"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				GetFileUploadInternal: getFileUpload,
+			},
+			jobStarterMock:       &workers.JobStarterMockCallCheck{},
+			expectedEnqueuedArgs: nil,
+			errorExpected:        true,
+			errorString:          "File Upload cannot be reprocessed",
+		},
+		{
+			name: "returns error if database errors when resetting fileUpload",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.ReprocessFileUploadRequest{Id: "fp_id1"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				GetFileUploadInternal: getFileUpload,
+				ResetFileUploadProcessingForTeamInternal: func(id string, team *model.Team) error {
+					return errors.New("dbError when resetting")
+				},
+			},
+			jobStarterMock:       &workers.JobStarterMockCallCheck{},
+			expectedEnqueuedArgs: nil,
+			errorExpected:        true,
+			errorString:          "dbError when resetting",
+		},
+		{
+			name: "returns error if database errors when getting fileUpload after resetting it",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.ReprocessFileUploadRequest{Id: "fp_id1"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				GetFileUploadInternal: getFileUploadFailingAfterReset,
+				ResetFileUploadProcessingForTeamInternal: func(id string, team *model.Team) error {
+					return nil
+				},
+			},
+			jobStarterMock:       &workers.JobStarterMockCallCheck{},
+			expectedEnqueuedArgs: nil,
+			errorExpected:        true,
+			errorString:          "dbError when getting reset fileUpload",
+		},
+		{
+			name: "resets fileUpload and leaves dispatching it to the processing loop",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.ReprocessFileUploadRequest{Id: "fp_id1"},
+			output: &pb.ReprocessFileUploadResponse{
+				FileUpload: &pb.FileUpload{
+					Id:               "fp_id1",
+					Name:             "file1.pdf",
+					PresignedUrl:     "https://presigned_url1",
+					Status:           "SUCCESS",
+					ProcessingStatus: "NOT STARTED",
+				},
+			},
+			teamHydratorMock:       &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fileUploadAccessorMock: fileUploadAccessorMockWithReset(),
+			jobStarterMock:         &workers.JobStarterMockCallCheck{},
+			expectedEnqueuedArgs:   nil,
+			errorExpected:          false,
+			errorString:            "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithFileUploadAccessorMock(tt.fileUploadAccessorMock),
+				),
+				Logger:     &utilities.NullLogger{},
+				JobStarter: tt.jobStarterMock,
+			})
+
+			response, err := server.ReprocessFileUpload(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if jobStarterMock, ok := tt.jobStarterMock.(*workers.JobStarterMockCallCheck); ok {
+				assert.Equal(t, tt.expectedEnqueuedArgs, jobStarterMock.CalledArgs[workers.PROCESS_FILE_UPLOAD])
+			}
+		})
+	}
+}
+
+func Test_ReprocessFileUploads(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	team2, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id2",
+		Name:             "test2@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	fileUpload1, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id1",
+		Name:             "file1.pdf",
+		PresignedUrl:     "https://presigned_url1",
+		Status:           "SUCCESS",
+		ProcessingStatus: "FAILED",
+		Team:             team,
+	})
+	fileUpload2, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id2",
+		Name:             "file2.pdf",
+		PresignedUrl:     "https://presigned_url2",
+		Status:           "SUCCESS",
+		ProcessingStatus: "COMPLETED",
+		Team:             team2,
+	})
+	fileUpload3, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id3",
+		Name:             "file3.pdf",
+		PresignedUrl:     "https://presigned_url3",
+		Status:           "SUCCESS",
+		ProcessingStatus: "ONGOING",
+		Team:             team,
+	})
+	fileUploads := map[string]*model.FileUpload{
+		"fp_id1": fileUpload1,
+		"fp_id2": fileUpload2,
+		"fp_id3": fileUpload3,
+	}
+	getFileUpload := func(id string) (*model.FileUpload, error) {
+		fileUpload, ok := fileUploads[id]
+		if !ok {
+			return nil, errors.New("dbError when getting fileUpload")
+		}
+		return fileUpload, nil
+	}
+	resetFileUpload1, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id1",
+		Name:             "file1.pdf",
+		PresignedUrl:     "https://presigned_url1",
+		Status:           "SUCCESS",
+		ProcessingStatus: "NOT STARTED",
+		Team:             team,
+	})
+	resetFileUploads := map[string]*model.FileUpload{
+		"fp_id1": resetFileUpload1,
+	}
+	fileUploadAccessorMockWithReset := func() *storage.FileUploadAccessorConfigurableMock {
+		reset := map[string]bool{}
+		return &storage.FileUploadAccessorConfigurableMock{
+			GetFileUploadInternal: func(id string) (*model.FileUpload, error) {
+				if reset[id] {
+					return resetFileUploads[id], nil
+				}
+				return getFileUpload(id)
+			},
+			ResetFileUploadProcessingForTeamInternal: func(id string, team *model.Team) error {
+				reset[id] = true
+				return nil
+			},
+		}
+	}
+
+	tests := []struct {
+		name                   string
+		ctx                    context.Context
+		input                  *pb.ReprocessFileUploadsRequest
+		output                 *pb.ReprocessFileUploadsResponse
+		teamHydratorMock       storage.TeamHydrator
+		fileUploadAccessorMock storage.FileUploadAccessor
+		jobStarterMock         workers.JobStarter
+		expectedEnqueuedArgs   []map[string]interface{}
+		errorExpected          bool
+		errorString            string
+	}{
+		{
+			name:                   "errors if no user in context",
+			ctx:                    context.Background(),
+			input:                  &pb.ReprocessFileUploadsRequest{},
+			output:                 nil,
+			teamHydratorMock:       nil,
+			fileUploadAccessorMock: nil,
+			jobStarterMock:         nil,
+			expectedEnqueuedArgs:   nil,
+			errorExpected:          true,
+			errorString:            "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name: "errors if unable to hydrate team",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:                  &pb.ReprocessFileUploadsRequest{},
+			output:                 nil,
+			teamHydratorMock:       &storage.TeamHydratorMockFailure{},
+			fileUploadAccessorMock: nil,
+			jobStarterMock:         nil,
+			expectedEnqueuedArgs:   nil,
+			errorExpected:          true,
+			errorString:            "unable to hydrate team",
+		},
+		{
+			name: "returns per fileUpload errors and reprocesses the rest",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.ReprocessFileUploadsRequest{Ids: []string{"", "fp_id2", "fp_id3", "fp_id1"}},
+			output: &pb.ReprocessFileUploadsResponse{
+				FileUploads: []*pb.FileUpload{
+					{
+						Id:    "",
+						Error: "id cannot be blank",
+					},
+					{
+						Id:    "fp_id2",
+						Error: "File Upload not found",
+					},
+					{
+						Id:    "fp_id3",
+						Error: "File Upload cannot be reprocessed",
+					},
+					{
+						Id:               "fp_id1",
+						Name:             "file1.pdf",
+						PresignedUrl:     "https://presigned_url1",
+						Status:           "SUCCESS",
+						ProcessingStatus: "NOT STARTED",
+					},
+				},
+			},
+			teamHydratorMock:       &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fileUploadAccessorMock: fileUploadAccessorMockWithReset(),
+			jobStarterMock:         &workers.JobStarterMockCallCheck{},
+			expectedEnqueuedArgs:   nil,
+			errorExpected:          false,
+			errorString:            "",
+		},
+		{
+			name: "returns error for fileUpload that could not be reset",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.ReprocessFileUploadsRequest{Ids: []string{"fp_id1"}},
+			output: &pb.ReprocessFileUploadsResponse{
+				FileUploads: []*pb.FileUpload{
+					{
+						Id:    "fp_id1",
+						Error: "dbError when resetting",
+					},
+				},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				GetFileUploadInternal: getFileUpload,
+				ResetFileUploadProcessingForTeamInternal: func(id string, team *model.Team) error {
+					return errors.New("dbError when resetting")
+				},
+			},
+			jobStarterMock:       &workers.JobStarterMockCallCheck{},
+			expectedEnqueuedArgs: nil,
+			errorExpected:        false,
+			errorString:          "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithFileUploadAccessorMock(tt.fileUploadAccessorMock),
+				),
+				Logger:     &utilities.NullLogger{},
+				JobStarter: tt.jobStarterMock,
+			})
+
+			response, err := server.ReprocessFileUploads(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if jobStarterMock, ok := tt.jobStarterMock.(*workers.JobStarterMockCallCheck); ok {
+				assert.Equal(t, tt.expectedEnqueuedArgs, jobStarterMock.CalledArgs[workers.PROCESS_FILE_UPLOAD])
+			}
+		})
+	}
+}
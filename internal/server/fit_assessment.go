@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+
+	"github.com/gocraft/work"
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/lib/fitassessor"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/workers"
+	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *CandidateTrackerGoService) AssessCandidateFit(ctx context.Context, req *pb.AssessCandidateFitRequest) (*pb.AssessCandidateFitResponse, error) {
+	candidateId := req.GetCandidateId()
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	jobOpeningId := req.GetJobOpeningId()
+	if utilities.IsBlank(jobOpeningId) {
+		return nil, errors.New("jobOpeningId cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	candidate, err := s.storage.GetCandidateForTeam(candidateId, team)
+	if err != nil {
+		return nil, err
+	}
+
+	jobOpening, err := s.storage.GetJobOpeningForTeam(jobOpeningId, team)
+	if err != nil {
+		return nil, err
+	}
+
+	fitAssessment, err := s.storage.RequestFitAssessmentForTeam(
+		model.FitAssessmentOptions{
+			CandidateId:       candidateId,
+			JobOpeningId:      jobOpeningId,
+			PersonaVersion:    candidate.Persona().Version(),
+			JobOpeningVersion: jobOpening.Version(),
+			PromptVersion:     fitassessor.PROMPT_VERSION,
+		},
+		req.GetRetry(),
+		team,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if fitAssessment.NotStarted() {
+		// The processing loop picks up every NOT STARTED fit assessment, so a failed enqueue only delays the assessment.
+		_, err = s.jobStarter.EnqueueUnique(workers.ASSESS_CANDIDATE_FIT, work.Q{"fitAssessmentId": fitAssessment.Id()})
+		if err != nil {
+			s.logger.LogError(err)
+		}
+	}
+
+	return &pb.AssessCandidateFitResponse{
+		FitAssessment: fitAssessmentResponse(fitAssessment),
+	}, nil
+}
+
+func fitAssessmentResponse(fitAssessment *model.FitAssessment) *pb.FitAssessment {
+	response := &pb.FitAssessment{
+		Id:             fitAssessment.Id(),
+		CandidateId:    fitAssessment.CandidateId(),
+		JobOpeningId:   fitAssessment.JobOpeningId(),
+		Status:         fitAssessment.Status(),
+		FailureMessage: fitAssessment.FailureMessage(),
+		CreatedAt:      timestamppb.New(fitAssessment.CreatedAt()),
+		UpdatedAt:      timestamppb.New(fitAssessment.UpdatedAt()),
+	}
+	verdict := fitAssessment.Verdict()
+	if verdict != nil {
+		response.Rating = verdict.Rating
+		response.Strengths = verdict.Strengths
+		response.Gaps = verdict.Gaps
+		response.InterviewQuestions = verdict.InterviewQuestions
+	}
+	return response
+}
@@ -0,0 +1,307 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/lib/fitassessor"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/workers"
+	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_AssessCandidateFit(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	persona := &model.Persona{Name: "Jane Doe", TechSkills: []string{"Go"}}
+	candidate, _ := model.NewCandidate(model.CandidateOptions{
+		Id:                     "c_id1",
+		ManuallyCreatedPersona: persona,
+		Team:                   team,
+	})
+	jobOpening, _ := model.NewJobOpening(model.JobOpeningOptions{
+		Id:    "jo_id1",
+		Title: "Engineer",
+		Team:  team,
+	})
+	createdAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	notStartedFitAssessment, _ := model.NewFitAssessment(model.FitAssessmentOptions{
+		Id:                "fa_id1",
+		CandidateId:       "c_id1",
+		JobOpeningId:      "jo_id1",
+		PersonaVersion:    persona.Version(),
+		JobOpeningVersion: jobOpening.Version(),
+		PromptVersion:     fitassessor.PROMPT_VERSION,
+		Team:              team,
+		CreatedAt:         createdAt,
+		UpdatedAt:         createdAt,
+	})
+	completedFitAssessment, _ := model.NewFitAssessment(model.FitAssessmentOptions{
+		Id:                "fa_id1",
+		CandidateId:       "c_id1",
+		JobOpeningId:      "jo_id1",
+		PersonaVersion:    persona.Version(),
+		JobOpeningVersion: jobOpening.Version(),
+		PromptVersion:     fitassessor.PROMPT_VERSION,
+		Status:            "COMPLETED",
+		Verdict: &model.FitVerdict{
+			Rating:             "GOOD FIT",
+			Strengths:          []string{"Knows Go"},
+			Gaps:               []string{"No Kubernetes"},
+			InterviewQuestions: []string{"How would you deploy a Go service?"},
+		},
+		Team:      team,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	})
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.New(
+			map[string]string{
+				requestingUserIdCtxKey:    "user_id1",
+				requestingUserEmailCtxKey: "user@example.com",
+			},
+		),
+	)
+	candidateAccessorMock := &storage.CandidateAccessorConfigurableMock{
+		GetCandidateForTeamInternal: func(id string, team *model.Team) (*model.Candidate, error) {
+			if id != "c_id1" {
+				return nil, errors.Errorf("no candidate for id %s", id)
+			}
+			return candidate, nil
+		},
+	}
+	jobOpeningAccessorMock := &storage.JobOpeningAccessorConfigurableMock{
+		GetJobOpeningForTeamInternal: func(id string, team *model.Team) (*model.JobOpening, error) {
+			if id != "jo_id1" {
+				return nil, errors.Errorf("no job opening for id %s", id)
+			}
+			return jobOpening, nil
+		},
+	}
+	requestFitAssessment := func(fitAssessment *model.FitAssessment) func(opts model.FitAssessmentOptions, retryFailed bool, team *model.Team) (*model.FitAssessment, error) {
+		return func(opts model.FitAssessmentOptions, retryFailed bool, team *model.Team) (*model.FitAssessment, error) {
+			if opts.PersonaVersion != persona.Version() || opts.JobOpeningVersion != jobOpening.Version() || opts.PromptVersion != fitassessor.PROMPT_VERSION {
+				return nil, errors.New("unexpected fit assessment versions")
+			}
+			return fitAssessment, nil
+		}
+	}
+
+	tests := []struct {
+		name                      string
+		ctx                       context.Context
+		input                     *pb.AssessCandidateFitRequest
+		output                    *pb.AssessCandidateFitResponse
+		teamHydratorMock          storage.TeamHydrator
+		fitAssessmentAccessorMock storage.FitAssessmentAccessor
+		jobStarterMock            workers.JobStarter
+		expectedEnqueuedArgs      []map[string]interface{}
+		errorExpected             bool
+		errorString               string
+	}{
+		{
+			name:                      "errors if candidateId is blank",
+			ctx:                       ctx,
+			input:                     &pb.AssessCandidateFitRequest{JobOpeningId: "jo_id1"},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			fitAssessmentAccessorMock: nil,
+			jobStarterMock:            nil,
+			errorExpected:             true,
+			errorString:               "candidateId cannot be blank",
+		},
+		{
+			name:                      "errors if jobOpeningId is blank",
+			ctx:                       ctx,
+			input:                     &pb.AssessCandidateFitRequest{CandidateId: "c_id1"},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			fitAssessmentAccessorMock: nil,
+			jobStarterMock:            nil,
+			errorExpected:             true,
+			errorString:               "jobOpeningId cannot be blank",
+		},
+		{
+			name:                      "errors if no user in context",
+			ctx:                       context.Background(),
+			input:                     &pb.AssessCandidateFitRequest{CandidateId: "c_id1", JobOpeningId: "jo_id1"},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			fitAssessmentAccessorMock: nil,
+			jobStarterMock:            nil,
+			errorExpected:             true,
+			errorString:               "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name:                      "errors if unable to hydrate team",
+			ctx:                       ctx,
+			input:                     &pb.AssessCandidateFitRequest{CandidateId: "c_id1", JobOpeningId: "jo_id1"},
+			output:                    nil,
+			teamHydratorMock:          &storage.TeamHydratorMockFailure{},
+			fitAssessmentAccessorMock: nil,
+			jobStarterMock:            nil,
+			errorExpected:             true,
+			errorString:               "unable to hydrate team",
+		},
+		{
+			name:                      "errors if candidate is not found",
+			ctx:                       ctx,
+			input:                     &pb.AssessCandidateFitRequest{CandidateId: "c_id2", JobOpeningId: "jo_id1"},
+			output:                    nil,
+			teamHydratorMock:          &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fitAssessmentAccessorMock: nil,
+			jobStarterMock:            nil,
+			errorExpected:             true,
+			errorString:               "no candidate for id c_id2",
+		},
+		{
+			name:                      "errors if job opening is not found",
+			ctx:                       ctx,
+			input:                     &pb.AssessCandidateFitRequest{CandidateId: "c_id1", JobOpeningId: "jo_id2"},
+			output:                    nil,
+			teamHydratorMock:          &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fitAssessmentAccessorMock: nil,
+			jobStarterMock:            nil,
+			errorExpected:             true,
+			errorString:               "no job opening for id jo_id2",
+		},
+		{
+			name:             "errors if unable to request fit assessment",
+			ctx:              ctx,
+			input:            &pb.AssessCandidateFitRequest{CandidateId: "c_id1", JobOpeningId: "jo_id1"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fitAssessmentAccessorMock: &storage.FitAssessmentAccessorConfigurableMock{
+				RequestFitAssessmentForTeamInternal: func(opts model.FitAssessmentOptions, retryFailed bool, team *model.Team) (*model.FitAssessment, error) {
+					return nil, errors.New("unable to request fit assessment")
+				},
+			},
+			jobStarterMock: nil,
+			errorExpected:  true,
+			errorString:    "unable to request fit assessment",
+		},
+		{
+			name:             "returns a cached fit assessment without enqueuing a job",
+			ctx:              ctx,
+			input:            &pb.AssessCandidateFitRequest{CandidateId: "c_id1", JobOpeningId: "jo_id1"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			output: &pb.AssessCandidateFitResponse{
+				FitAssessment: &pb.FitAssessment{
+					Id:                 "fa_id1",
+					CandidateId:        "c_id1",
+					JobOpeningId:       "jo_id1",
+					Status:             "COMPLETED",
+					Rating:             "GOOD FIT",
+					Strengths:          []string{"Knows Go"},
+					Gaps:               []string{"No Kubernetes"},
+					InterviewQuestions: []string{"How would you deploy a Go service?"},
+					CreatedAt:          timestamppb.New(createdAt),
+					UpdatedAt:          timestamppb.New(createdAt),
+				},
+			},
+			fitAssessmentAccessorMock: &storage.FitAssessmentAccessorConfigurableMock{
+				RequestFitAssessmentForTeamInternal: requestFitAssessment(completedFitAssessment),
+			},
+			jobStarterMock:       &workers.JobStarterMockCallCheck{},
+			expectedEnqueuedArgs: nil,
+			errorExpected:        false,
+			errorString:          "",
+		},
+		{
+			name:             "enqueues a job for a fit assessment that is not started",
+			ctx:              ctx,
+			input:            &pb.AssessCandidateFitRequest{CandidateId: "c_id1", JobOpeningId: "jo_id1"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			output: &pb.AssessCandidateFitResponse{
+				FitAssessment: &pb.FitAssessment{
+					Id:           "fa_id1",
+					CandidateId:  "c_id1",
+					JobOpeningId: "jo_id1",
+					Status:       "NOT STARTED",
+					CreatedAt:    timestamppb.New(createdAt),
+					UpdatedAt:    timestamppb.New(createdAt),
+				},
+			},
+			fitAssessmentAccessorMock: &storage.FitAssessmentAccessorConfigurableMock{
+				RequestFitAssessmentForTeamInternal: requestFitAssessment(notStartedFitAssessment),
+			},
+			jobStarterMock: &workers.JobStarterMockCallCheck{},
+			expectedEnqueuedArgs: []map[string]interface{}{
+				{"fitAssessmentId": "fa_id1"},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name:             "returns the fit assessment even if unable to enqueue a job",
+			ctx:              ctx,
+			input:            &pb.AssessCandidateFitRequest{CandidateId: "c_id1", JobOpeningId: "jo_id1"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			output: &pb.AssessCandidateFitResponse{
+				FitAssessment: &pb.FitAssessment{
+					Id:           "fa_id1",
+					CandidateId:  "c_id1",
+					JobOpeningId: "jo_id1",
+					Status:       "NOT STARTED",
+					CreatedAt:    timestamppb.New(createdAt),
+					UpdatedAt:    timestamppb.New(createdAt),
+				},
+			},
+			fitAssessmentAccessorMock: &storage.FitAssessmentAccessorConfigurableMock{
+				RequestFitAssessmentForTeamInternal: requestFitAssessment(notStartedFitAssessment),
+			},
+			jobStarterMock:       &workers.JobStarterMockFailure{},
+			expectedEnqueuedArgs: nil,
+			errorExpected:        false,
+			errorString:          "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithCandidateAccessorMock(candidateAccessorMock),
+					storage.WithJobOpeningAccessorMock(jobOpeningAccessorMock),
+					storage.WithFitAssessmentAccessorMock(tt.fitAssessmentAccessorMock),
+				),
+				Logger:     &utilities.NullLogger{},
+				JobStarter: tt.jobStarterMock,
+			})
+
+			response, err := server.AssessCandidateFit(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if jobStarterMock, ok := tt.jobStarterMock.(*workers.JobStarterMockCallCheck); ok {
+				assert.Equal(t, tt.expectedEnqueuedArgs, jobStarterMock.CalledArgs[workers.ASSESS_CANDIDATE_FIT])
+			}
+		})
+	}
+}
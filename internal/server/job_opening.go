@@ -0,0 +1,435 @@
+package server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/lib/scoring"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *CandidateTrackerGoService) CreateJobOpening(ctx context.Context, req *pb.CreateJobOpeningRequest) (*pb.CreateJobOpeningResponse, error) {
+	if utilities.IsBlank(req.GetTitle()) {
+		return nil, errors.New("title cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	jobOpening, err := s.storage.CreateJobOpeningForTeam(model.JobOpeningOptions{
+		Title:            req.GetTitle(),
+		Description:      req.GetDescription(),
+		RequiredSkills:   req.GetRequiredSkills(),
+		NiceToHaveSkills: req.GetNiceToHaveSkills(),
+		Location:         req.GetLocation(),
+		MinYoE:           int(req.GetMinYoE()),
+	}, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateJobOpeningResponse{
+		JobOpening: jobOpeningResponse(jobOpening),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) GetJobOpenings(ctx context.Context, req *pb.GetJobOpeningsRequest) (*pb.GetJobOpeningsResponse, error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	jobOpenings, err := s.storage.GetJobOpeningsForTeam(req.GetStatus(), team)
+	if err != nil {
+		return nil, err
+	}
+
+	response := []*pb.JobOpening{}
+	for _, jobOpening := range jobOpenings {
+		response = append(response, jobOpeningResponse(jobOpening))
+	}
+
+	return &pb.GetJobOpeningsResponse{
+		JobOpenings: response,
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) GetJobOpening(ctx context.Context, req *pb.GetJobOpeningRequest) (*pb.GetJobOpeningResponse, error) {
+	id := req.GetId()
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	jobOpening, err := s.storage.GetJobOpeningForTeam(id, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetJobOpeningResponse{
+		JobOpening: jobOpeningResponse(jobOpening),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) UpdateJobOpening(ctx context.Context, req *pb.UpdateJobOpeningRequest) (*pb.UpdateJobOpeningResponse, error) {
+	reqJobOpening := req.GetJobOpening()
+	if reqJobOpening == nil {
+		return nil, errors.New("jobOpening cannot be nil")
+	}
+
+	if utilities.IsBlank(reqJobOpening.GetId()) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	if utilities.IsBlank(reqJobOpening.GetTitle()) {
+		return nil, errors.New("title cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	jobOpening, err := s.storage.UpdateJobOpeningForTeam(model.JobOpeningOptions{
+		Id:               reqJobOpening.GetId(),
+		Title:            reqJobOpening.GetTitle(),
+		Description:      reqJobOpening.GetDescription(),
+		RequiredSkills:   reqJobOpening.GetRequiredSkills(),
+		NiceToHaveSkills: reqJobOpening.GetNiceToHaveSkills(),
+		Location:         reqJobOpening.GetLocation(),
+		MinYoE:           int(reqJobOpening.GetMinYoE()),
+		Status:           reqJobOpening.GetStatus(),
+	}, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateJobOpeningResponse{
+		JobOpening: jobOpeningResponse(jobOpening),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) DeleteJobOpening(ctx context.Context, req *pb.DeleteJobOpeningRequest) (*pb.DeleteJobOpeningResponse, error) {
+	id := req.GetId()
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	err = s.storage.DeleteJobOpeningForTeam(id, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteJobOpeningResponse{}, nil
+}
+
+func (s *CandidateTrackerGoService) AddCandidateToJobOpening(ctx context.Context, req *pb.AddCandidateToJobOpeningRequest) (*pb.AddCandidateToJobOpeningResponse, error) {
+	candidateId := req.GetCandidateId()
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	jobOpeningId := req.GetJobOpeningId()
+	if utilities.IsBlank(jobOpeningId) {
+		return nil, errors.New("jobOpeningId cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	jobApplication, err := s.storage.AddCandidateToJobOpeningForTeam(candidateId, jobOpeningId, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddCandidateToJobOpeningResponse{
+		JobApplication: jobApplicationResponse(jobApplication),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) RemoveCandidateFromJobOpening(ctx context.Context, req *pb.RemoveCandidateFromJobOpeningRequest) (*pb.RemoveCandidateFromJobOpeningResponse, error) {
+	candidateId := req.GetCandidateId()
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	jobOpeningId := req.GetJobOpeningId()
+	if utilities.IsBlank(jobOpeningId) {
+		return nil, errors.New("jobOpeningId cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	err = s.storage.RemoveCandidateFromJobOpeningForTeam(candidateId, jobOpeningId, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveCandidateFromJobOpeningResponse{}, nil
+}
+
+func (s *CandidateTrackerGoService) GetJobApplications(ctx context.Context, req *pb.GetJobApplicationsRequest) (*pb.GetJobApplicationsResponse, error) {
+	jobOpeningId := req.GetJobOpeningId()
+	candidateId := req.GetCandidateId()
+	if utilities.IsBlank(jobOpeningId) == utilities.IsBlank(candidateId) {
+		return nil, errors.New("exactly one of jobOpeningId and candidateId is needed")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	var jobApplications []*model.JobApplication
+	if !utilities.IsBlank(jobOpeningId) {
+		jobApplications, err = s.storage.GetJobApplicationsForJobOpeningForTeam(jobOpeningId, team)
+	} else {
+		jobApplications, err = s.storage.GetJobApplicationsForCandidateForTeam(candidateId, team)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := []*pb.JobApplication{}
+	for _, jobApplication := range jobApplications {
+		response = append(response, jobApplicationResponse(jobApplication))
+	}
+
+	return &pb.GetJobApplicationsResponse{
+		JobApplications: response,
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) UpdateJobApplicationStage(ctx context.Context, req *pb.UpdateJobApplicationStageRequest) (*pb.UpdateJobApplicationStageResponse, error) {
+	id := req.GetId()
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	pipelineStageId := req.GetPipelineStageId()
+	if utilities.IsBlank(pipelineStageId) {
+		return nil, errors.New("pipelineStageId cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	jobApplication, err := s.storage.UpdateJobApplicationPipelineStageForTeam(id, pipelineStageId, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateJobApplicationStageResponse{
+		JobApplication: jobApplicationResponse(jobApplication),
+	}, nil
+}
+
+// RankCandidatesForJob scores candidates against the job opening without an LLM, so the same candidates always rank the same way.
+func (s *CandidateTrackerGoService) RankCandidatesForJob(ctx context.Context, req *pb.RankCandidatesForJobRequest) (*pb.RankCandidatesForJobResponse, error) {
+	jobOpeningId := req.GetJobOpeningId()
+	if utilities.IsBlank(jobOpeningId) {
+		return nil, errors.New("jobOpeningId cannot be blank")
+	}
+
+	if req.GetLimit() < 0 {
+		return nil, errors.New("limit cannot be negative")
+	}
+
+	weights := scoring.DefaultWeights
+	if req.GetWeights() != nil {
+		weights = scoring.Weights{
+			RequiredSkills:   req.GetWeights().GetRequiredSkills(),
+			NiceToHaveSkills: req.GetWeights().GetNiceToHaveSkills(),
+			YoE:              req.GetWeights().GetYoE(),
+			Location:         req.GetWeights().GetLocation(),
+			RecommendedRoles: req.GetWeights().GetRecommendedRoles(),
+		}
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	jobOpening, err := s.storage.GetJobOpeningForTeam(jobOpeningId, team)
+	if err != nil {
+		return nil, err
+	}
+
+	allCandidates, err := s.storage.GetAllCandidatesForTeam(team)
+	if err != nil {
+		return nil, err
+	}
+
+	var applicantIds map[string]bool
+	if req.GetOnlyApplicants() {
+		jobApplications, err := s.storage.GetJobApplicationsForJobOpeningForTeam(jobOpeningId, team)
+		if err != nil {
+			return nil, err
+		}
+		applicantIds = map[string]bool{}
+		for _, jobApplication := range jobApplications {
+			applicantIds[jobApplication.CandidateId()] = true
+		}
+	}
+
+	candidates := []*model.Candidate{}
+	for _, candidate := range allCandidates {
+		if applicantIds != nil {
+			if !applicantIds[candidate.Id()] {
+				continue
+			}
+		} else if candidate.IsArchived() {
+			continue
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	ranked, err := scoring.RankCandidates(jobOpening, candidates, weights)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetLimit() > 0 && int64(len(ranked)) > req.GetLimit() {
+		ranked = ranked[:req.GetLimit()]
+	}
+
+	response := []*pb.RankedCandidate{}
+	for _, candidateScore := range ranked {
+		breakdown := []*pb.CriterionScore{}
+		for _, criterionScore := range candidateScore.Breakdown {
+			breakdown = append(breakdown, &pb.CriterionScore{
+				Criterion:   string(criterionScore.Criterion),
+				Weight:      criterionScore.Weight,
+				Score:       criterionScore.Score,
+				Matched:     criterionScore.Matched,
+				Missing:     criterionScore.Missing,
+				Explanation: criterionScore.Explanation,
+			})
+		}
+		response = append(response, &pb.RankedCandidate{
+			Candidate: candidateResponse(candidateScore.Candidate),
+			Score:     candidateScore.Total,
+			Breakdown: breakdown,
+		})
+	}
+
+	return &pb.RankCandidatesForJobResponse{
+		RankedCandidates: response,
+	}, nil
+}
+
+func jobOpeningResponse(jobOpening *model.JobOpening) *pb.JobOpening {
+	return &pb.JobOpening{
+		Id:               jobOpening.Id(),
+		Title:            jobOpening.Title(),
+		Description:      jobOpening.Description(),
+		RequiredSkills:   jobOpening.RequiredSkills(),
+		NiceToHaveSkills: jobOpening.NiceToHaveSkills(),
+		Location:         jobOpening.Location(),
+		MinYoE:           int64(jobOpening.MinYoE()),
+		Status:           jobOpening.Status(),
+		CreatedAt:        timestamppb.New(jobOpening.CreatedAt()),
+		UpdatedAt:        timestamppb.New(jobOpening.UpdatedAt()),
+	}
+}
+
+func jobApplicationResponse(jobApplication *model.JobApplication) *pb.JobApplication {
+	return &pb.JobApplication{
+		Id:              jobApplication.Id(),
+		JobOpeningId:    jobApplication.JobOpeningId(),
+		CandidateId:     jobApplication.CandidateId(),
+		PipelineStageId: jobApplication.PipelineStageId(),
+		CreatedAt:       timestamppb.New(jobApplication.CreatedAt()),
+	}
+}
@@ -0,0 +1,1021 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_CreateJobOpening(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	createdAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	jobOpening, _ := model.NewJobOpening(model.JobOpeningOptions{
+		Id:             "jo_id1",
+		Title:          "Engineer",
+		RequiredSkills: []string{"Go"},
+		MinYoE:         3,
+		Team:           team,
+		CreatedAt:      createdAt,
+		UpdatedAt:      createdAt,
+	})
+
+	tests := []struct {
+		name                   string
+		ctx                    context.Context
+		input                  *pb.CreateJobOpeningRequest
+		output                 *pb.CreateJobOpeningResponse
+		teamHydratorMock       storage.TeamHydrator
+		jobOpeningAccessorMock storage.JobOpeningAccessor
+		errorExpected          bool
+		errorString            string
+	}{
+		{
+			name:                   "errors if title is blank",
+			ctx:                    context.Background(),
+			input:                  &pb.CreateJobOpeningRequest{Title: " "},
+			output:                 nil,
+			teamHydratorMock:       nil,
+			jobOpeningAccessorMock: nil,
+			errorExpected:          true,
+			errorString:            "title cannot be blank",
+		},
+		{
+			name:                   "errors if no user in context",
+			ctx:                    context.Background(),
+			input:                  &pb.CreateJobOpeningRequest{Title: "Engineer"},
+			output:                 nil,
+			teamHydratorMock:       nil,
+			jobOpeningAccessorMock: nil,
+			errorExpected:          true,
+			errorString:            "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name: "errors if unable to hydrate team",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:                  &pb.CreateJobOpeningRequest{Title: "Engineer"},
+			output:                 nil,
+			teamHydratorMock:       &storage.TeamHydratorMockFailure{},
+			jobOpeningAccessorMock: nil,
+			errorExpected:          true,
+			errorString:            "unable to hydrate team",
+		},
+		{
+			name: "errors if unable to create job opening",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.CreateJobOpeningRequest{Title: "Engineer"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			jobOpeningAccessorMock: &storage.JobOpeningAccessorConfigurableMock{
+				CreateJobOpeningForTeamInternal: func(opts model.JobOpeningOptions, team *model.Team) (*model.JobOpening, error) {
+					return nil, errors.New("unable to create job opening")
+				},
+			},
+			errorExpected: true,
+			errorString:   "unable to create job opening",
+		},
+		{
+			name: "creates the job opening",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.CreateJobOpeningRequest{Title: "Engineer", RequiredSkills: []string{"Go"}, MinYoE: 3},
+			output: &pb.CreateJobOpeningResponse{
+				JobOpening: &pb.JobOpening{
+					Id:               "jo_id1",
+					Title:            "Engineer",
+					RequiredSkills:   []string{"Go"},
+					NiceToHaveSkills: []string{},
+					MinYoE:           3,
+					Status:           "OPEN",
+					CreatedAt:        timestamppb.New(createdAt),
+					UpdatedAt:        timestamppb.New(createdAt),
+				},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			jobOpeningAccessorMock: &storage.JobOpeningAccessorConfigurableMock{
+				CreateJobOpeningForTeamInternal: func(opts model.JobOpeningOptions, team *model.Team) (*model.JobOpening, error) {
+					if opts.Title != "Engineer" || opts.MinYoE != 3 {
+						return nil, errors.New("unexpected job opening options")
+					}
+					return jobOpening, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithJobOpeningAccessorMock(tt.jobOpeningAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.CreateJobOpening(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_GetJobOpenings(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	createdAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	jobOpening, _ := model.NewJobOpening(model.JobOpeningOptions{
+		Id:        "jo_id1",
+		Title:     "Engineer",
+		Status:    "CLOSED",
+		Team:      team,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	})
+
+	tests := []struct {
+		name                   string
+		ctx                    context.Context
+		input                  *pb.GetJobOpeningsRequest
+		output                 *pb.GetJobOpeningsResponse
+		teamHydratorMock       storage.TeamHydrator
+		jobOpeningAccessorMock storage.JobOpeningAccessor
+		errorExpected          bool
+		errorString            string
+	}{
+		{
+			name:                   "errors if no user in context",
+			ctx:                    context.Background(),
+			input:                  &pb.GetJobOpeningsRequest{},
+			output:                 nil,
+			teamHydratorMock:       nil,
+			jobOpeningAccessorMock: nil,
+			errorExpected:          true,
+			errorString:            "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name: "errors if unable to get job openings",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.GetJobOpeningsRequest{Status: "PAUSED"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			jobOpeningAccessorMock: &storage.JobOpeningAccessorConfigurableMock{
+				GetJobOpeningsForTeamInternal: func(status string, team *model.Team) ([]*model.JobOpening, error) {
+					return nil, errors.Errorf("invalid job opening status: %s", status)
+				},
+			},
+			errorExpected: true,
+			errorString:   "invalid job opening status: PAUSED",
+		},
+		{
+			name: "returns the job openings with the status",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.GetJobOpeningsRequest{Status: "CLOSED"},
+			output: &pb.GetJobOpeningsResponse{
+				JobOpenings: []*pb.JobOpening{
+					{
+						Id:               "jo_id1",
+						Title:            "Engineer",
+						RequiredSkills:   []string{},
+						NiceToHaveSkills: []string{},
+						Status:           "CLOSED",
+						CreatedAt:        timestamppb.New(createdAt),
+						UpdatedAt:        timestamppb.New(createdAt),
+					},
+				},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			jobOpeningAccessorMock: &storage.JobOpeningAccessorConfigurableMock{
+				GetJobOpeningsForTeamInternal: func(status string, team *model.Team) ([]*model.JobOpening, error) {
+					if status != "CLOSED" {
+						return nil, errors.New("unexpected status")
+					}
+					return []*model.JobOpening{jobOpening}, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithJobOpeningAccessorMock(tt.jobOpeningAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.GetJobOpenings(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_UpdateJobOpening(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	createdAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	jobOpening, _ := model.NewJobOpening(model.JobOpeningOptions{
+		Id:        "jo_id1",
+		Title:     "Senior Engineer",
+		Location:  "Remote",
+		Status:    "CLOSED",
+		Team:      team,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	})
+
+	tests := []struct {
+		name                   string
+		ctx                    context.Context
+		input                  *pb.UpdateJobOpeningRequest
+		output                 *pb.UpdateJobOpeningResponse
+		teamHydratorMock       storage.TeamHydrator
+		jobOpeningAccessorMock storage.JobOpeningAccessor
+		errorExpected          bool
+		errorString            string
+	}{
+		{
+			name:                   "errors if job opening is nil",
+			ctx:                    context.Background(),
+			input:                  &pb.UpdateJobOpeningRequest{},
+			output:                 nil,
+			teamHydratorMock:       nil,
+			jobOpeningAccessorMock: nil,
+			errorExpected:          true,
+			errorString:            "jobOpening cannot be nil",
+		},
+		{
+			name:                   "errors if id is blank",
+			ctx:                    context.Background(),
+			input:                  &pb.UpdateJobOpeningRequest{JobOpening: &pb.JobOpening{Title: "Engineer"}},
+			output:                 nil,
+			teamHydratorMock:       nil,
+			jobOpeningAccessorMock: nil,
+			errorExpected:          true,
+			errorString:            "id cannot be blank",
+		},
+		{
+			name:                   "errors if title is blank",
+			ctx:                    context.Background(),
+			input:                  &pb.UpdateJobOpeningRequest{JobOpening: &pb.JobOpening{Id: "jo_id1"}},
+			output:                 nil,
+			teamHydratorMock:       nil,
+			jobOpeningAccessorMock: nil,
+			errorExpected:          true,
+			errorString:            "title cannot be blank",
+		},
+		{
+			name: "errors if unable to update job opening",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.UpdateJobOpeningRequest{JobOpening: &pb.JobOpening{Id: "jo_id2", Title: "Engineer"}},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			jobOpeningAccessorMock: &storage.JobOpeningAccessorConfigurableMock{
+				UpdateJobOpeningForTeamInternal: func(opts model.JobOpeningOptions, team *model.Team) (*model.JobOpening, error) {
+					return nil, errors.Errorf("no job opening for id %s", opts.Id)
+				},
+			},
+			errorExpected: true,
+			errorString:   "no job opening for id jo_id2",
+		},
+		{
+			name: "updates the job opening",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.UpdateJobOpeningRequest{
+				JobOpening: &pb.JobOpening{Id: "jo_id1", Title: "Senior Engineer", Location: "Remote", Status: "CLOSED"},
+			},
+			output: &pb.UpdateJobOpeningResponse{
+				JobOpening: &pb.JobOpening{
+					Id:               "jo_id1",
+					Title:            "Senior Engineer",
+					RequiredSkills:   []string{},
+					NiceToHaveSkills: []string{},
+					Location:         "Remote",
+					Status:           "CLOSED",
+					CreatedAt:        timestamppb.New(createdAt),
+					UpdatedAt:        timestamppb.New(updatedAt),
+				},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			jobOpeningAccessorMock: &storage.JobOpeningAccessorConfigurableMock{
+				UpdateJobOpeningForTeamInternal: func(opts model.JobOpeningOptions, team *model.Team) (*model.JobOpening, error) {
+					if opts.Id != "jo_id1" || opts.Status != "CLOSED" || opts.Location != "Remote" {
+						return nil, errors.New("unexpected job opening options")
+					}
+					return jobOpening, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithJobOpeningAccessorMock(tt.jobOpeningAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.UpdateJobOpening(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_AddCandidateToJobOpening(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	createdAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	jobApplication, _ := model.NewJobApplication(model.JobApplicationOptions{
+		Id:              "ja_id1",
+		JobOpeningId:    "jo_id1",
+		CandidateId:     "c_id1",
+		PipelineStageId: "ps_id1",
+		CreatedAt:       createdAt,
+	})
+
+	tests := []struct {
+		name                       string
+		ctx                        context.Context
+		input                      *pb.AddCandidateToJobOpeningRequest
+		output                     *pb.AddCandidateToJobOpeningResponse
+		teamHydratorMock           storage.TeamHydrator
+		jobApplicationAccessorMock storage.JobApplicationAccessor
+		errorExpected              bool
+		errorString                string
+	}{
+		{
+			name:                       "errors if candidateId is blank",
+			ctx:                        context.Background(),
+			input:                      &pb.AddCandidateToJobOpeningRequest{JobOpeningId: "jo_id1"},
+			output:                     nil,
+			teamHydratorMock:           nil,
+			jobApplicationAccessorMock: nil,
+			errorExpected:              true,
+			errorString:                "candidateId cannot be blank",
+		},
+		{
+			name:                       "errors if jobOpeningId is blank",
+			ctx:                        context.Background(),
+			input:                      &pb.AddCandidateToJobOpeningRequest{CandidateId: "c_id1"},
+			output:                     nil,
+			teamHydratorMock:           nil,
+			jobApplicationAccessorMock: nil,
+			errorExpected:              true,
+			errorString:                "jobOpeningId cannot be blank",
+		},
+		{
+			name: "errors if unable to add candidate",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.AddCandidateToJobOpeningRequest{CandidateId: "c_id1", JobOpeningId: "jo_id2"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			jobApplicationAccessorMock: &storage.JobApplicationAccessorConfigurableMock{
+				AddCandidateToJobOpeningForTeamInternal: func(candidateId, jobOpeningId string, team *model.Team) (*model.JobApplication, error) {
+					return nil, errors.Errorf("cannot add candidates to closed job opening %s", jobOpeningId)
+				},
+			},
+			errorExpected: true,
+			errorString:   "cannot add candidates to closed job opening jo_id2",
+		},
+		{
+			name: "adds the candidate to the job opening",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.AddCandidateToJobOpeningRequest{CandidateId: "c_id1", JobOpeningId: "jo_id1"},
+			output: &pb.AddCandidateToJobOpeningResponse{
+				JobApplication: &pb.JobApplication{
+					Id:              "ja_id1",
+					JobOpeningId:    "jo_id1",
+					CandidateId:     "c_id1",
+					PipelineStageId: "ps_id1",
+					CreatedAt:       timestamppb.New(createdAt),
+				},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			jobApplicationAccessorMock: &storage.JobApplicationAccessorConfigurableMock{
+				AddCandidateToJobOpeningForTeamInternal: func(candidateId, jobOpeningId string, team *model.Team) (*model.JobApplication, error) {
+					return jobApplication, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithJobApplicationAccessorMock(tt.jobApplicationAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.AddCandidateToJobOpening(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_GetJobApplications(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	createdAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	jobApplication1, _ := model.NewJobApplication(model.JobApplicationOptions{
+		Id:           "ja_id1",
+		JobOpeningId: "jo_id1",
+		CandidateId:  "c_id1",
+		CreatedAt:    createdAt,
+	})
+	jobApplication2, _ := model.NewJobApplication(model.JobApplicationOptions{
+		Id:           "ja_id2",
+		JobOpeningId: "jo_id2",
+		CandidateId:  "c_id1",
+		CreatedAt:    createdAt,
+	})
+	jobApplicationAccessorMock := &storage.JobApplicationAccessorConfigurableMock{
+		GetJobApplicationsForJobOpeningForTeamInternal: func(jobOpeningId string, team *model.Team) ([]*model.JobApplication, error) {
+			return []*model.JobApplication{jobApplication1}, nil
+		},
+		GetJobApplicationsForCandidateForTeamInternal: func(candidateId string, team *model.Team) ([]*model.JobApplication, error) {
+			return []*model.JobApplication{jobApplication1, jobApplication2}, nil
+		},
+	}
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.New(
+			map[string]string{
+				requestingUserIdCtxKey:    "user_id1",
+				requestingUserEmailCtxKey: "user@example.com",
+			},
+		),
+	)
+
+	tests := []struct {
+		name                       string
+		ctx                        context.Context
+		input                      *pb.GetJobApplicationsRequest
+		output                     *pb.GetJobApplicationsResponse
+		teamHydratorMock           storage.TeamHydrator
+		jobApplicationAccessorMock storage.JobApplicationAccessor
+		errorExpected              bool
+		errorString                string
+	}{
+		{
+			name:                       "errors if neither jobOpeningId nor candidateId is given",
+			ctx:                        ctx,
+			input:                      &pb.GetJobApplicationsRequest{},
+			output:                     nil,
+			teamHydratorMock:           nil,
+			jobApplicationAccessorMock: nil,
+			errorExpected:              true,
+			errorString:                "exactly one of jobOpeningId and candidateId is needed",
+		},
+		{
+			name:                       "errors if both jobOpeningId and candidateId are given",
+			ctx:                        ctx,
+			input:                      &pb.GetJobApplicationsRequest{JobOpeningId: "jo_id1", CandidateId: "c_id1"},
+			output:                     nil,
+			teamHydratorMock:           nil,
+			jobApplicationAccessorMock: nil,
+			errorExpected:              true,
+			errorString:                "exactly one of jobOpeningId and candidateId is needed",
+		},
+		{
+			name:                       "errors if unable to hydrate team",
+			ctx:                        ctx,
+			input:                      &pb.GetJobApplicationsRequest{JobOpeningId: "jo_id1"},
+			output:                     nil,
+			teamHydratorMock:           &storage.TeamHydratorMockFailure{},
+			jobApplicationAccessorMock: nil,
+			errorExpected:              true,
+			errorString:                "unable to hydrate team",
+		},
+		{
+			name:  "returns the applications to the job opening",
+			ctx:   ctx,
+			input: &pb.GetJobApplicationsRequest{JobOpeningId: "jo_id1"},
+			output: &pb.GetJobApplicationsResponse{
+				JobApplications: []*pb.JobApplication{
+					{Id: "ja_id1", JobOpeningId: "jo_id1", CandidateId: "c_id1", CreatedAt: timestamppb.New(createdAt)},
+				},
+			},
+			teamHydratorMock:           &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			jobApplicationAccessorMock: jobApplicationAccessorMock,
+			errorExpected:              false,
+			errorString:                "",
+		},
+		{
+			name:  "returns the applications of the candidate",
+			ctx:   ctx,
+			input: &pb.GetJobApplicationsRequest{CandidateId: "c_id1"},
+			output: &pb.GetJobApplicationsResponse{
+				JobApplications: []*pb.JobApplication{
+					{Id: "ja_id1", JobOpeningId: "jo_id1", CandidateId: "c_id1", CreatedAt: timestamppb.New(createdAt)},
+					{Id: "ja_id2", JobOpeningId: "jo_id2", CandidateId: "c_id1", CreatedAt: timestamppb.New(createdAt)},
+				},
+			},
+			teamHydratorMock:           &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			jobApplicationAccessorMock: jobApplicationAccessorMock,
+			errorExpected:              false,
+			errorString:                "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithJobApplicationAccessorMock(tt.jobApplicationAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.GetJobApplications(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_UpdateJobApplicationStage(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	createdAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	jobApplication, _ := model.NewJobApplication(model.JobApplicationOptions{
+		Id:              "ja_id1",
+		JobOpeningId:    "jo_id1",
+		CandidateId:     "c_id1",
+		PipelineStageId: "ps_id2",
+		CreatedAt:       createdAt,
+	})
+
+	tests := []struct {
+		name                       string
+		ctx                        context.Context
+		input                      *pb.UpdateJobApplicationStageRequest
+		output                     *pb.UpdateJobApplicationStageResponse
+		teamHydratorMock           storage.TeamHydrator
+		jobApplicationAccessorMock storage.JobApplicationAccessor
+		errorExpected              bool
+		errorString                string
+	}{
+		{
+			name:                       "errors if id is blank",
+			ctx:                        context.Background(),
+			input:                      &pb.UpdateJobApplicationStageRequest{PipelineStageId: "ps_id2"},
+			output:                     nil,
+			teamHydratorMock:           nil,
+			jobApplicationAccessorMock: nil,
+			errorExpected:              true,
+			errorString:                "id cannot be blank",
+		},
+		{
+			name:                       "errors if pipelineStageId is blank",
+			ctx:                        context.Background(),
+			input:                      &pb.UpdateJobApplicationStageRequest{Id: "ja_id1"},
+			output:                     nil,
+			teamHydratorMock:           nil,
+			jobApplicationAccessorMock: nil,
+			errorExpected:              true,
+			errorString:                "pipelineStageId cannot be blank",
+		},
+		{
+			name: "errors if unable to update the stage",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.UpdateJobApplicationStageRequest{Id: "ja_id1", PipelineStageId: "ps_id3"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			jobApplicationAccessorMock: &storage.JobApplicationAccessorConfigurableMock{
+				UpdateJobApplicationPipelineStageForTeamInternal: func(id, pipelineStageId string, team *model.Team) (*model.JobApplication, error) {
+					return nil, errors.Errorf("no pipeline stage for id %s", pipelineStageId)
+				},
+			},
+			errorExpected: true,
+			errorString:   "no pipeline stage for id ps_id3",
+		},
+		{
+			name: "moves the application to the stage",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.UpdateJobApplicationStageRequest{Id: "ja_id1", PipelineStageId: "ps_id2"},
+			output: &pb.UpdateJobApplicationStageResponse{
+				JobApplication: &pb.JobApplication{
+					Id:              "ja_id1",
+					JobOpeningId:    "jo_id1",
+					CandidateId:     "c_id1",
+					PipelineStageId: "ps_id2",
+					CreatedAt:       timestamppb.New(createdAt),
+				},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			jobApplicationAccessorMock: &storage.JobApplicationAccessorConfigurableMock{
+				UpdateJobApplicationPipelineStageForTeamInternal: func(id, pipelineStageId string, team *model.Team) (*model.JobApplication, error) {
+					return jobApplication, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithJobApplicationAccessorMock(tt.jobApplicationAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.UpdateJobApplicationStage(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_RankCandidatesForJob(t *testing.T) {
+	currentFileCount := 3
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	jobOpening, _ := model.NewJobOpening(model.JobOpeningOptions{
+		Id:             "jo_id1",
+		Title:          "Backend Engineer",
+		RequiredSkills: []string{"Go", "SQL"},
+		Team:           team,
+	})
+	archivedCandidate, _ := model.NewCandidate(model.CandidateOptions{
+		Id:                     "c_id1",
+		ManuallyCreatedPersona: &model.Persona{Name: "Jane Doe", TechSkills: []string{"Go", "SQL"}},
+		Team:                   team,
+		ArchivedAt:             time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	goCandidate, _ := model.NewCandidate(model.CandidateOptions{
+		Id:                     "c_id2",
+		ManuallyCreatedPersona: &model.Persona{Name: "John Smith", TechSkills: []string{"Golang"}},
+		Team:                   team,
+	})
+	otherCandidate, _ := model.NewCandidate(model.CandidateOptions{
+		Id:                     "c_id3",
+		ManuallyCreatedPersona: &model.Persona{Name: "Priya Kumar", TechSkills: []string{"Photoshop"}},
+		Team:                   team,
+	})
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.New(
+			map[string]string{
+				requestingUserIdCtxKey:    "user_id1",
+				requestingUserEmailCtxKey: "user@example.com",
+			},
+		),
+	)
+	jobOpeningAccessorMock := &storage.JobOpeningAccessorConfigurableMock{
+		GetJobOpeningForTeamInternal: func(id string, team *model.Team) (*model.JobOpening, error) {
+			if id != "jo_id1" {
+				return nil, errors.Errorf("no job opening for id %s", id)
+			}
+			return jobOpening, nil
+		},
+	}
+	candidateAccessorMock := &storage.CandidateAccessorConfigurableMock{
+		GetAllCandidatesForTeamInternal: func(team *model.Team) ([]*model.Candidate, error) {
+			return []*model.Candidate{archivedCandidate, otherCandidate, goCandidate}, nil
+		},
+	}
+	jobApplicationAccessorMock := &storage.JobApplicationAccessorConfigurableMock{
+		GetJobApplicationsForJobOpeningForTeamInternal: func(jobOpeningId string, team *model.Team) ([]*model.JobApplication, error) {
+			jobApplication1, _ := model.NewJobApplication(model.JobApplicationOptions{Id: "ja_id1", JobOpeningId: jobOpeningId, CandidateId: "c_id1"})
+			jobApplication2, _ := model.NewJobApplication(model.JobApplicationOptions{Id: "ja_id2", JobOpeningId: jobOpeningId, CandidateId: "c_id3"})
+			return []*model.JobApplication{jobApplication1, jobApplication2}, nil
+		},
+	}
+	skillsOnlyWeights := &pb.MatchWeights{RequiredSkills: 1}
+
+	tests := []struct {
+		name          string
+		input         *pb.RankCandidatesForJobRequest
+		output        *pb.RankCandidatesForJobResponse
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name:          "errors if jobOpeningId is blank",
+			input:         &pb.RankCandidatesForJobRequest{},
+			output:        nil,
+			errorExpected: true,
+			errorString:   "jobOpeningId cannot be blank",
+		},
+		{
+			name:          "errors if limit is negative",
+			input:         &pb.RankCandidatesForJobRequest{JobOpeningId: "jo_id1", Limit: -1},
+			output:        nil,
+			errorExpected: true,
+			errorString:   "limit cannot be negative",
+		},
+		{
+			name:          "errors if job opening is not found",
+			input:         &pb.RankCandidatesForJobRequest{JobOpeningId: "jo_id2"},
+			output:        nil,
+			errorExpected: true,
+			errorString:   "no job opening for id jo_id2",
+		},
+		{
+			name:          "errors if every weight is zero",
+			input:         &pb.RankCandidatesForJobRequest{JobOpeningId: "jo_id1", Weights: &pb.MatchWeights{}},
+			output:        nil,
+			errorExpected: true,
+			errorString:   "at least one weight needs to be positive",
+		},
+		{
+			name:  "ranks candidates that are not archived, up to the limit",
+			input: &pb.RankCandidatesForJobRequest{JobOpeningId: "jo_id1", Weights: skillsOnlyWeights, Limit: 1},
+			output: &pb.RankCandidatesForJobResponse{
+				RankedCandidates: []*pb.RankedCandidate{
+					{
+						Candidate: candidateResponse(goCandidate),
+						Score:     50,
+						Breakdown: []*pb.CriterionScore{
+							{Criterion: "REQUIRED SKILLS", Weight: 1, Score: 0.5, Matched: []string{"Go"}, Missing: []string{"SQL"}, Explanation: "has 1 of 2 skills"},
+						},
+					},
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name:  "ranks only the candidates added to the job opening",
+			input: &pb.RankCandidatesForJobRequest{JobOpeningId: "jo_id1", Weights: skillsOnlyWeights, OnlyApplicants: true},
+			output: &pb.RankCandidatesForJobResponse{
+				RankedCandidates: []*pb.RankedCandidate{
+					{
+						Candidate: candidateResponse(archivedCandidate),
+						Score:     100,
+						Breakdown: []*pb.CriterionScore{
+							{Criterion: "REQUIRED SKILLS", Weight: 1, Score: 1, Matched: []string{"Go", "SQL"}, Missing: []string{}, Explanation: "has 2 of 2 skills"},
+						},
+					},
+					{
+						Candidate: candidateResponse(otherCandidate),
+						Score:     0,
+						Breakdown: []*pb.CriterionScore{
+							{Criterion: "REQUIRED SKILLS", Weight: 1, Score: 0, Matched: []string{}, Missing: []string{"Go", "SQL"}, Explanation: "has 0 of 2 skills"},
+						},
+					},
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(&storage.TeamHydratorMockSuccess{User: userWithTeam}),
+					storage.WithJobOpeningAccessorMock(jobOpeningAccessorMock),
+					storage.WithCandidateAccessorMock(candidateAccessorMock),
+					storage.WithJobApplicationAccessorMock(jobApplicationAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.RankCandidatesForJob(
+				ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package server
+
+import "github.com/vipulvpatil/candidate-tracker-go/internal/model"
+
+type pagedRequest interface {
+	GetPageSize() int64
+	GetPageToken() string
+}
+
+func pageRequestFromRequest(req pagedRequest) (*model.PageRequest, error) {
+	return model.NewPageRequest(model.PageRequestOptions{
+		Size:  int(req.GetPageSize()),
+		Token: req.GetPageToken(),
+	})
+}
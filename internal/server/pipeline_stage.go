@@ -0,0 +1,256 @@
+package server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetPipelineStages creates the default stages the first time a team without stages asks for them.
+func (s *CandidateTrackerGoService) GetPipelineStages(ctx context.Context, req *pb.GetPipelineStagesRequest) (*pb.GetPipelineStagesResponse, error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	stages, err := s.storage.GetPipelineStagesForTeam(team)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stages) == 0 {
+		stages, err = s.storage.CreateDefaultPipelineStagesForTeam(team)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.GetPipelineStagesResponse{
+		PipelineStages: pipelineStagesResponse(stages),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) CreatePipelineStage(ctx context.Context, req *pb.CreatePipelineStageRequest) (*pb.CreatePipelineStageResponse, error) {
+	name := req.GetName()
+	if utilities.IsBlank(name) {
+		return nil, errors.New("name cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	stage, err := s.storage.CreatePipelineStageForTeam(name, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreatePipelineStageResponse{
+		PipelineStage: pipelineStageResponse(stage),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) RenamePipelineStage(ctx context.Context, req *pb.RenamePipelineStageRequest) (*pb.RenamePipelineStageResponse, error) {
+	id := req.GetId()
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	name := req.GetName()
+	if utilities.IsBlank(name) {
+		return nil, errors.New("name cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	err = s.storage.RenamePipelineStageForTeam(id, name, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RenamePipelineStageResponse{}, nil
+}
+
+func (s *CandidateTrackerGoService) ReorderPipelineStages(ctx context.Context, req *pb.ReorderPipelineStagesRequest) (*pb.ReorderPipelineStagesResponse, error) {
+	ids := req.GetIds()
+	if len(ids) == 0 {
+		return nil, errors.New("ids cannot be empty")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	err = s.storage.ReorderPipelineStagesForTeam(ids, team)
+	if err != nil {
+		return nil, err
+	}
+
+	stages, err := s.storage.GetPipelineStagesForTeam(team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReorderPipelineStagesResponse{
+		PipelineStages: pipelineStagesResponse(stages),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) DeletePipelineStage(ctx context.Context, req *pb.DeletePipelineStageRequest) (*pb.DeletePipelineStageResponse, error) {
+	id := req.GetId()
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	err = s.storage.DeletePipelineStageForTeam(id, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeletePipelineStageResponse{}, nil
+}
+
+func (s *CandidateTrackerGoService) MoveCandidatesToPipelineStage(ctx context.Context, req *pb.MoveCandidatesToPipelineStageRequest) (*pb.MoveCandidatesToPipelineStageResponse, error) {
+	candidateIds := req.GetCandidateIds()
+	if len(candidateIds) == 0 {
+		return nil, errors.New("candidateIds cannot be empty")
+	}
+
+	pipelineStageId := req.GetPipelineStageId()
+	if utilities.IsBlank(pipelineStageId) {
+		return nil, errors.New("pipelineStageId cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	changes, err := s.storage.MoveCandidatesToPipelineStageForTeam(candidateIds, pipelineStageId, req.GetReason(), user.GetId(), team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MoveCandidatesToPipelineStageResponse{
+		Changes: candidateStageChangesResponse(changes),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) GetCandidateStageHistory(ctx context.Context, req *pb.GetCandidateStageHistoryRequest) (*pb.GetCandidateStageHistoryResponse, error) {
+	candidateId := req.GetCandidateId()
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	changes, err := s.storage.GetCandidateStageChangesForTeam(candidateId, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCandidateStageHistoryResponse{
+		Changes: candidateStageChangesResponse(changes),
+	}, nil
+}
+
+func pipelineStageResponse(stage *model.PipelineStage) *pb.PipelineStage {
+	return &pb.PipelineStage{
+		Id:       stage.Id(),
+		Name:     stage.Name(),
+		Position: int64(stage.Position()),
+	}
+}
+
+func pipelineStagesResponse(stages []*model.PipelineStage) []*pb.PipelineStage {
+	response := []*pb.PipelineStage{}
+	for _, stage := range stages {
+		response = append(response, pipelineStageResponse(stage))
+	}
+	return response
+}
+
+func candidateStageChangesResponse(changes []*model.CandidateStageChange) []*pb.CandidateStageChange {
+	response := []*pb.CandidateStageChange{}
+	for _, change := range changes {
+		response = append(response, &pb.CandidateStageChange{
+			Id:                 change.Id(),
+			CandidateId:        change.CandidateId(),
+			FromStageId:        change.FromStageId(),
+			FromStageName:      change.FromStageName(),
+			ToStageId:          change.ToStageId(),
+			ToStageName:        change.ToStageName(),
+			ChangedByUserId:    change.ChangedByUserId(),
+			ChangedByUserEmail: change.ChangedByUserEmail(),
+			Reason:             change.Reason(),
+			ChangedAt:          timestamppb.New(change.ChangedAt()),
+		})
+	}
+	return response
+}
@@ -0,0 +1,899 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_GetPipelineStages(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	stage1, _ := model.NewPipelineStage(model.PipelineStageOptions{Id: "ps_id1", Name: "Sourced", Position: 0, Team: team})
+	stage2, _ := model.NewPipelineStage(model.PipelineStageOptions{Id: "ps_id2", Name: "Screening", Position: 1, Team: team})
+
+	tests := []struct {
+		name                      string
+		ctx                       context.Context
+		input                     *pb.GetPipelineStagesRequest
+		output                    *pb.GetPipelineStagesResponse
+		teamHydratorMock          storage.TeamHydrator
+		pipelineStageAccessorMock storage.PipelineStageAccessor
+		errorExpected             bool
+		errorString               string
+	}{
+		{
+			name:                      "errors if no user in context",
+			ctx:                       context.Background(),
+			input:                     &pb.GetPipelineStagesRequest{},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			pipelineStageAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name: "errors if unable to hydrate team",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:                     &pb.GetPipelineStagesRequest{},
+			output:                    nil,
+			teamHydratorMock:          &storage.TeamHydratorMockFailure{},
+			pipelineStageAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "unable to hydrate team",
+		},
+		{
+			name: "errors if unable to get stages",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.GetPipelineStagesRequest{},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			pipelineStageAccessorMock: &storage.PipelineStageAccessorConfigurableMock{
+				GetPipelineStagesForTeamInternal: func(team *model.Team) ([]*model.PipelineStage, error) {
+					return nil, errors.New("unable to get stages")
+				},
+			},
+			errorExpected: true,
+			errorString:   "unable to get stages",
+		},
+		{
+			name: "creates the default stages when the team has none",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.GetPipelineStagesRequest{},
+			output: &pb.GetPipelineStagesResponse{
+				PipelineStages: []*pb.PipelineStage{
+					{Id: "ps_id1", Name: "Sourced", Position: 0},
+					{Id: "ps_id2", Name: "Screening", Position: 1},
+				},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			pipelineStageAccessorMock: &storage.PipelineStageAccessorConfigurableMock{
+				GetPipelineStagesForTeamInternal: func(team *model.Team) ([]*model.PipelineStage, error) {
+					return []*model.PipelineStage{}, nil
+				},
+				CreateDefaultPipelineStagesForTeamInternal: func(team *model.Team) ([]*model.PipelineStage, error) {
+					return []*model.PipelineStage{stage1, stage2}, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "returns the stages of the team",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.GetPipelineStagesRequest{},
+			output: &pb.GetPipelineStagesResponse{
+				PipelineStages: []*pb.PipelineStage{
+					{Id: "ps_id1", Name: "Sourced", Position: 0},
+				},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			pipelineStageAccessorMock: &storage.PipelineStageAccessorConfigurableMock{
+				GetPipelineStagesForTeamInternal: func(team *model.Team) ([]*model.PipelineStage, error) {
+					return []*model.PipelineStage{stage1}, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithPipelineStageAccessorMock(tt.pipelineStageAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.GetPipelineStages(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_CreatePipelineStage(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	stage, _ := model.NewPipelineStage(model.PipelineStageOptions{Id: "ps_id7", Name: "Offer Accepted", Position: 6, Team: team})
+
+	tests := []struct {
+		name                      string
+		ctx                       context.Context
+		input                     *pb.CreatePipelineStageRequest
+		output                    *pb.CreatePipelineStageResponse
+		teamHydratorMock          storage.TeamHydrator
+		pipelineStageAccessorMock storage.PipelineStageAccessor
+		errorExpected             bool
+		errorString               string
+	}{
+		{
+			name:                      "errors if name is blank",
+			ctx:                       context.Background(),
+			input:                     &pb.CreatePipelineStageRequest{Name: " "},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			pipelineStageAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "name cannot be blank",
+		},
+		{
+			name: "errors if unable to create stage",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.CreatePipelineStageRequest{Name: "Sourced"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			pipelineStageAccessorMock: &storage.PipelineStageAccessorConfigurableMock{
+				CreatePipelineStageForTeamInternal: func(name string, team *model.Team) (*model.PipelineStage, error) {
+					return nil, errors.New("pipeline stage Sourced already exists")
+				},
+			},
+			errorExpected: true,
+			errorString:   "pipeline stage Sourced already exists",
+		},
+		{
+			name: "runs successfully",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.CreatePipelineStageRequest{Name: "Offer Accepted"},
+			output: &pb.CreatePipelineStageResponse{
+				PipelineStage: &pb.PipelineStage{Id: "ps_id7", Name: "Offer Accepted", Position: 6},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			pipelineStageAccessorMock: &storage.PipelineStageAccessorConfigurableMock{
+				CreatePipelineStageForTeamInternal: func(name string, team *model.Team) (*model.PipelineStage, error) {
+					if name != "Offer Accepted" || team.Id() != "team_id1" {
+						return nil, errors.New("unexpected stage")
+					}
+					return stage, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithPipelineStageAccessorMock(tt.pipelineStageAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.CreatePipelineStage(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_RenamePipelineStage(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+
+	tests := []struct {
+		name                      string
+		ctx                       context.Context
+		input                     *pb.RenamePipelineStageRequest
+		output                    *pb.RenamePipelineStageResponse
+		teamHydratorMock          storage.TeamHydrator
+		pipelineStageAccessorMock storage.PipelineStageAccessor
+		errorExpected             bool
+		errorString               string
+	}{
+		{
+			name:                      "errors if id is blank",
+			ctx:                       context.Background(),
+			input:                     &pb.RenamePipelineStageRequest{Name: "Applied"},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			pipelineStageAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "id cannot be blank",
+		},
+		{
+			name:                      "errors if name is blank",
+			ctx:                       context.Background(),
+			input:                     &pb.RenamePipelineStageRequest{Id: "ps_id1"},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			pipelineStageAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "name cannot be blank",
+		},
+		{
+			name: "errors if unable to rename stage",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.RenamePipelineStageRequest{Id: "ps_id1", Name: "Applied"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			pipelineStageAccessorMock: &storage.PipelineStageAccessorConfigurableMock{
+				RenamePipelineStageForTeamInternal: func(id, name string, team *model.Team) error {
+					return errors.New("no pipeline stage for id ps_id1")
+				},
+			},
+			errorExpected: true,
+			errorString:   "no pipeline stage for id ps_id1",
+		},
+		{
+			name: "runs successfully",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.RenamePipelineStageRequest{Id: "ps_id1", Name: "Applied"},
+			output:           &pb.RenamePipelineStageResponse{},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			pipelineStageAccessorMock: &storage.PipelineStageAccessorConfigurableMock{
+				RenamePipelineStageForTeamInternal: func(id, name string, team *model.Team) error {
+					if id != "ps_id1" || name != "Applied" || team.Id() != "team_id1" {
+						return errors.New("unexpected stage")
+					}
+					return nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithPipelineStageAccessorMock(tt.pipelineStageAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.RenamePipelineStage(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_ReorderPipelineStages(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	stage1, _ := model.NewPipelineStage(model.PipelineStageOptions{Id: "ps_id2", Name: "Screening", Position: 0, Team: team})
+	stage2, _ := model.NewPipelineStage(model.PipelineStageOptions{Id: "ps_id1", Name: "Sourced", Position: 1, Team: team})
+
+	tests := []struct {
+		name                      string
+		ctx                       context.Context
+		input                     *pb.ReorderPipelineStagesRequest
+		output                    *pb.ReorderPipelineStagesResponse
+		teamHydratorMock          storage.TeamHydrator
+		pipelineStageAccessorMock storage.PipelineStageAccessor
+		errorExpected             bool
+		errorString               string
+	}{
+		{
+			name:                      "errors if ids are empty",
+			ctx:                       context.Background(),
+			input:                     &pb.ReorderPipelineStagesRequest{},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			pipelineStageAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "ids cannot be empty",
+		},
+		{
+			name: "errors if unable to reorder stages",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.ReorderPipelineStagesRequest{Ids: []string{"ps_id2"}},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			pipelineStageAccessorMock: &storage.PipelineStageAccessorConfigurableMock{
+				ReorderPipelineStagesForTeamInternal: func(ids []string, team *model.Team) error {
+					return errors.New("every pipeline stage of the team needs to be listed")
+				},
+			},
+			errorExpected: true,
+			errorString:   "every pipeline stage of the team needs to be listed",
+		},
+		{
+			name: "returns the reordered stages",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.ReorderPipelineStagesRequest{Ids: []string{"ps_id2", "ps_id1"}},
+			output: &pb.ReorderPipelineStagesResponse{
+				PipelineStages: []*pb.PipelineStage{
+					{Id: "ps_id2", Name: "Screening", Position: 0},
+					{Id: "ps_id1", Name: "Sourced", Position: 1},
+				},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			pipelineStageAccessorMock: &storage.PipelineStageAccessorConfigurableMock{
+				ReorderPipelineStagesForTeamInternal: func(ids []string, team *model.Team) error {
+					if !assert.Equal(t, []string{"ps_id2", "ps_id1"}, ids) {
+						return errors.New("unexpected ids")
+					}
+					return nil
+				},
+				GetPipelineStagesForTeamInternal: func(team *model.Team) ([]*model.PipelineStage, error) {
+					return []*model.PipelineStage{stage1, stage2}, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithPipelineStageAccessorMock(tt.pipelineStageAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.ReorderPipelineStages(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_DeletePipelineStage(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+
+	tests := []struct {
+		name                      string
+		ctx                       context.Context
+		input                     *pb.DeletePipelineStageRequest
+		output                    *pb.DeletePipelineStageResponse
+		teamHydratorMock          storage.TeamHydrator
+		pipelineStageAccessorMock storage.PipelineStageAccessor
+		errorExpected             bool
+		errorString               string
+	}{
+		{
+			name:                      "errors if id is blank",
+			ctx:                       context.Background(),
+			input:                     &pb.DeletePipelineStageRequest{},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			pipelineStageAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "id cannot be blank",
+		},
+		{
+			name: "errors if stage has candidates",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.DeletePipelineStageRequest{Id: "ps_id1"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			pipelineStageAccessorMock: &storage.PipelineStageAccessorConfigurableMock{
+				DeletePipelineStageForTeamInternal: func(id string, team *model.Team) error {
+					return errors.New("cannot delete pipeline stage ps_id1 while it has 2 candidates")
+				},
+			},
+			errorExpected: true,
+			errorString:   "cannot delete pipeline stage ps_id1 while it has 2 candidates",
+		},
+		{
+			name: "runs successfully",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.DeletePipelineStageRequest{Id: "ps_id1"},
+			output:           &pb.DeletePipelineStageResponse{},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			pipelineStageAccessorMock: &storage.PipelineStageAccessorConfigurableMock{
+				DeletePipelineStageForTeamInternal: func(id string, team *model.Team) error {
+					if id != "ps_id1" || team.Id() != "team_id1" {
+						return errors.New("unexpected stage")
+					}
+					return nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithPipelineStageAccessorMock(tt.pipelineStageAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.DeletePipelineStage(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_MoveCandidatesToPipelineStage(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	changedAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	change, _ := model.NewCandidateStageChange(model.CandidateStageChangeOptions{
+		Id:                 "csc_id1",
+		CandidateId:        "c_id1",
+		FromStageId:        "ps_id1",
+		FromStageName:      "Sourced",
+		ToStageId:          "ps_id2",
+		ToStageName:        "Screening",
+		ChangedByUserId:    "user_id1",
+		ChangedByUserEmail: "test@example.com",
+		Reason:             "passed the first call",
+		ChangedAt:          changedAt,
+	})
+
+	tests := []struct {
+		name                      string
+		ctx                       context.Context
+		input                     *pb.MoveCandidatesToPipelineStageRequest
+		output                    *pb.MoveCandidatesToPipelineStageResponse
+		teamHydratorMock          storage.TeamHydrator
+		pipelineStageAccessorMock storage.PipelineStageAccessor
+		errorExpected             bool
+		errorString               string
+	}{
+		{
+			name:                      "errors if candidateIds are empty",
+			ctx:                       context.Background(),
+			input:                     &pb.MoveCandidatesToPipelineStageRequest{PipelineStageId: "ps_id2"},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			pipelineStageAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "candidateIds cannot be empty",
+		},
+		{
+			name:                      "errors if pipelineStageId is blank",
+			ctx:                       context.Background(),
+			input:                     &pb.MoveCandidatesToPipelineStageRequest{CandidateIds: []string{"c_id1"}},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			pipelineStageAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "pipelineStageId cannot be blank",
+		},
+		{
+			name: "errors if unable to move candidates",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.MoveCandidatesToPipelineStageRequest{CandidateIds: []string{"c_id1"}, PipelineStageId: "ps_id2"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			pipelineStageAccessorMock: &storage.PipelineStageAccessorConfigurableMock{
+				MoveCandidatesToPipelineStageForTeamInternal: func(candidateIds []string, pipelineStageId, reason, changedByUserId string, team *model.Team) ([]*model.CandidateStageChange, error) {
+					return nil, errors.New("no candidate for id c_id1")
+				},
+			},
+			errorExpected: true,
+			errorString:   "no candidate for id c_id1",
+		},
+		{
+			name: "moves the candidates as the requesting user",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.MoveCandidatesToPipelineStageRequest{
+				CandidateIds:    []string{"c_id1", "c_id2"},
+				PipelineStageId: "ps_id2",
+				Reason:          "passed the first call",
+			},
+			output: &pb.MoveCandidatesToPipelineStageResponse{
+				Changes: []*pb.CandidateStageChange{
+					{
+						Id:                 "csc_id1",
+						CandidateId:        "c_id1",
+						FromStageId:        "ps_id1",
+						FromStageName:      "Sourced",
+						ToStageId:          "ps_id2",
+						ToStageName:        "Screening",
+						ChangedByUserId:    "user_id1",
+						ChangedByUserEmail: "test@example.com",
+						Reason:             "passed the first call",
+						ChangedAt:          timestamppb.New(changedAt),
+					},
+				},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			pipelineStageAccessorMock: &storage.PipelineStageAccessorConfigurableMock{
+				MoveCandidatesToPipelineStageForTeamInternal: func(candidateIds []string, pipelineStageId, reason, changedByUserId string, team *model.Team) ([]*model.CandidateStageChange, error) {
+					if !assert.Equal(t, []string{"c_id1", "c_id2"}, candidateIds) ||
+						pipelineStageId != "ps_id2" ||
+						reason != "passed the first call" ||
+						changedByUserId != "user_id1" ||
+						team.Id() != "team_id1" {
+						return nil, errors.New("unexpected move")
+					}
+					return []*model.CandidateStageChange{change}, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithPipelineStageAccessorMock(tt.pipelineStageAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.MoveCandidatesToPipelineStage(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_GetCandidateStageHistory(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	changedAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	change, _ := model.NewCandidateStageChange(model.CandidateStageChangeOptions{
+		Id:          "csc_id1",
+		CandidateId: "c_id1",
+		ToStageName: "Sourced",
+		ChangedAt:   changedAt,
+	})
+
+	tests := []struct {
+		name                      string
+		ctx                       context.Context
+		input                     *pb.GetCandidateStageHistoryRequest
+		output                    *pb.GetCandidateStageHistoryResponse
+		teamHydratorMock          storage.TeamHydrator
+		pipelineStageAccessorMock storage.PipelineStageAccessor
+		errorExpected             bool
+		errorString               string
+	}{
+		{
+			name:                      "errors if candidateId is blank",
+			ctx:                       context.Background(),
+			input:                     &pb.GetCandidateStageHistoryRequest{},
+			output:                    nil,
+			teamHydratorMock:          nil,
+			pipelineStageAccessorMock: nil,
+			errorExpected:             true,
+			errorString:               "candidateId cannot be blank",
+		},
+		{
+			name: "errors if unable to get stage history",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.GetCandidateStageHistoryRequest{CandidateId: "c_id1"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			pipelineStageAccessorMock: &storage.PipelineStageAccessorConfigurableMock{
+				GetCandidateStageChangesForTeamInternal: func(candidateId string, team *model.Team) ([]*model.CandidateStageChange, error) {
+					return nil, errors.New("unable to get stage history")
+				},
+			},
+			errorExpected: true,
+			errorString:   "unable to get stage history",
+		},
+		{
+			name: "runs successfully",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input: &pb.GetCandidateStageHistoryRequest{CandidateId: "c_id1"},
+			output: &pb.GetCandidateStageHistoryResponse{
+				Changes: []*pb.CandidateStageChange{
+					{
+						Id:          "csc_id1",
+						CandidateId: "c_id1",
+						ToStageName: "Sourced",
+						ChangedAt:   timestamppb.New(changedAt),
+					},
+				},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			pipelineStageAccessorMock: &storage.PipelineStageAccessorConfigurableMock{
+				GetCandidateStageChangesForTeamInternal: func(candidateId string, team *model.Team) ([]*model.CandidateStageChange, error) {
+					if candidateId != "c_id1" || team.Id() != "team_id1" {
+						return nil, errors.New("unexpected candidate")
+					}
+					return []*model.CandidateStageChange{change}, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithPipelineStageAccessorMock(tt.pipelineStageAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.GetCandidateStageHistory(
+				tt.ctx,
+				tt.input,
+			)
+			if !tt.errorExpected {
+				assert.Empty(t, tt.errorString)
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output, response)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
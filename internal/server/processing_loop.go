@@ -6,34 +6,125 @@ import (
 	"time"
 
 	"github.com/gocraft/work"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/workers"
 )
 
-func (s *CandidateTrackerGoService) ProcessingLoop(ctx context.Context, tickerDuration time.Duration, wg *sync.WaitGroup, jobStarter workers.JobStarter) {
+type ProcessingLoopOptions struct {
+	ReconciliationInterval time.Duration
+	// MaxConcurrentFileUploads is the most file uploads dispatched or being processed at once, across all teams.
+	MaxConcurrentFileUploads int
+	// MaxConcurrentFileUploadsPerTeam is the most file uploads of a single team dispatched or being processed at once.
+	MaxConcurrentFileUploadsPerTeam int
+	// DispatchLease is how long a dispatched file upload can wait for a worker before it is dispatched again.
+	DispatchLease time.Duration
+}
+
+// ProcessingLoop enqueues file uploads and fit assessments as soon as the database notifies that they are ready.
+// Everything that is ready is also swept up when the loop starts, after the listener reconnects and every reconciliation interval,
+// so that nothing is missed if a notification is lost. Without a listener, only the sweep runs.
+// File uploads are not enqueued as soon as they are ready, but whenever their team has a free slot. See DispatchFileUploadIds.
+func (s *CandidateTrackerGoService) ProcessingLoop(ctx context.Context, opts ProcessingLoopOptions, wg *sync.WaitGroup, jobStarter workers.JobStarter, listener storage.NotificationListener) {
 	wg.Add(1)
 	defer wg.Done()
 
-	ticker := time.NewTicker(tickerDuration)
+	s.reconcileProcessing(opts, jobStarter)
+
+	var notifications <-chan *storage.Notification
+	if listener != nil {
+		notifications = listener.Notifications()
+	}
+	ticker := time.NewTicker(opts.ReconciliationInterval)
+	defer ticker.Stop()
 	for {
 		select {
+		case notification, ok := <-notifications:
+			if !ok {
+				// The listener is closed. Only the reconciliation sweep is left.
+				notifications = nil
+				continue
+			}
+			s.dispatchNotifications(
+				append([]*storage.Notification{notification}, pendingNotifications(notifications)...),
+				opts,
+				jobStarter,
+			)
 		case <-ticker.C:
-			s.processFileUpload(jobStarter)
+			s.reconcileProcessing(opts, jobStarter)
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
-func (s *CandidateTrackerGoService) processFileUpload(jobStarter workers.JobStarter) {
-	fileUploadIds, err := s.storage.GetAllProcessingNotStartedFileUploadIds()
+// pendingNotifications returns the notifications that have already arrived, without waiting for more.
+// A team uploading many files at once sends a burst of notifications, which only need to be dispatched once.
+func pendingNotifications(notifications <-chan *storage.Notification) []*storage.Notification {
+	pending := []*storage.Notification{}
+	for {
+		select {
+		case notification, ok := <-notifications:
+			if !ok {
+				return pending
+			}
+			pending = append(pending, notification)
+		default:
+			return pending
+		}
+	}
+}
+
+func (s *CandidateTrackerGoService) dispatchNotifications(notifications []*storage.Notification, opts ProcessingLoopOptions, jobStarter workers.JobStarter) {
+	dispatchFileUploads := false
+	for _, notification := range notifications {
+		if notification == nil {
+			s.reconcileProcessing(opts, jobStarter)
+			return
+		}
+
+		switch notification.Channel {
+		case storage.FILE_UPLOAD_READY_CHANNEL:
+			dispatchFileUploads = true
+		case storage.FIT_ASSESSMENT_READY_CHANNEL:
+			s.enqueueJob(jobStarter, workers.ASSESS_CANDIDATE_FIT, work.Q{"fitAssessmentId": notification.Id})
+		}
+	}
+
+	if dispatchFileUploads {
+		s.processFileUpload(opts, jobStarter)
+	}
+}
+
+func (s *CandidateTrackerGoService) reconcileProcessing(opts ProcessingLoopOptions, jobStarter workers.JobStarter) {
+	s.processFileUpload(opts, jobStarter)
+	s.assessCandidateFit(jobStarter)
+}
+
+func (s *CandidateTrackerGoService) enqueueJob(jobStarter workers.JobStarter, jobName string, args work.Q) {
+	_, err := jobStarter.EnqueueUnique(jobName, args)
+	if err != nil {
+		s.logger.LogError(err)
+	}
+}
+
+func (s *CandidateTrackerGoService) processFileUpload(opts ProcessingLoopOptions, jobStarter workers.JobStarter) {
+	fileUploadIds, err := s.storage.DispatchFileUploadIds(opts.MaxConcurrentFileUploads, opts.MaxConcurrentFileUploadsPerTeam, opts.DispatchLease)
 	if err != nil {
 		s.logger.LogError(err)
 		return
 	}
 	for _, fileUploadId := range fileUploadIds {
-		_, err := jobStarter.EnqueueUnique(workers.PROCESS_FILE_UPLOAD, work.Q{"fileUploadId": fileUploadId})
-		if err != nil {
-			s.logger.LogError(err)
-		}
+		s.enqueueJob(jobStarter, workers.PROCESS_FILE_UPLOAD, work.Q{"fileUploadId": fileUploadId})
+	}
+}
+
+func (s *CandidateTrackerGoService) assessCandidateFit(jobStarter workers.JobStarter) {
+	fitAssessmentIds, err := s.storage.GetAllNotStartedFitAssessmentIds()
+	if err != nil {
+		s.logger.LogError(err)
+		return
+	}
+	for _, fitAssessmentId := range fitAssessmentIds {
+		s.enqueueJob(jobStarter, workers.ASSESS_CANDIDATE_FIT, work.Q{"fitAssessmentId": fitAssessmentId})
 	}
 }
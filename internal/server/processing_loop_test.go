@@ -12,26 +12,43 @@ import (
 )
 
 func Test_ProcessingLoop(t *testing.T) {
-	t.Run("looks for file uploads that are not already being processed and calls job starter to process them, until canceled", func(t *testing.T) {
+	t.Run("dispatches file uploads and sweeps for fit assessments on start and every reconciliation interval and calls job starter to process them, until canceled", func(t *testing.T) {
 		jobStarterMock := &workers.JobStarterMockCallCheck{}
-		tickerDuration := 10 * time.Millisecond
+		loopOpts := ProcessingLoopOptions{
+			ReconciliationInterval:          20 * time.Millisecond,
+			MaxConcurrentFileUploads:        10,
+			MaxConcurrentFileUploadsPerTeam: 3,
+			DispatchLease:                   5 * time.Minute,
+		}
 
 		fileUploadAccessorMock1 := fileUploadAccessorCallerInspectableMock{
-			&functionCallInspectableMock{
+			functionCallInspectableMock: &functionCallInspectableMock{
 				ReturnData:  [][]string{{"fp_id1", "fp_id2"}, {"fp_id3"}},
 				ReturnCount: 2,
 			},
 		}
 
+		fitAssessmentAccessorMock1 := fitAssessmentAccessorCallerInspectableMock{
+			&functionCallInspectableMock{
+				ReturnData:  [][]string{{"fa_id1"}},
+				ReturnCount: 1,
+			},
+		}
+
 		functionsToCheck := []struct {
 			name              string
 			functionCall      functionCallInspectable
 			expectedCallCount int
 		}{
 			{
-				name:              "getAllUnprocessed, %s",
+				name:              "dispatch, %s",
 				functionCall:      fileUploadAccessorMock1,
-				expectedCallCount: 4,
+				expectedCallCount: 5,
+			},
+			{
+				name:              "getAllNotStarted, %s",
+				functionCall:      fitAssessmentAccessorMock1,
+				expectedCallCount: 5,
 			},
 		}
 
@@ -47,6 +64,12 @@ func Test_ProcessingLoop(t *testing.T) {
 					{"fileUploadId": "fp_id3"},
 				},
 			},
+			{
+				jobName: workers.ASSESS_CANDIDATE_FIT,
+				jobArgs: []map[string]any{
+					{"fitAssessmentId": "fa_id1"},
+				},
+			},
 		}
 
 		server, _ := NewServer(
@@ -54,7 +77,12 @@ func Test_ProcessingLoop(t *testing.T) {
 				Storage: storage.NewStorageAccessorMock(
 					storage.WithFileUploadAccessorMock(
 						&storage.FileUploadAccessorConfigurableMock{
-							GetAllProcessingNotStartedFileUploadIdsInternal: fileUploadAccessorMock1.getAllUnprocessed,
+							DispatchFileUploadIdsInternal: fileUploadAccessorMock1.dispatch,
+						},
+					),
+					storage.WithFitAssessmentAccessorMock(
+						&storage.FitAssessmentAccessorConfigurableMock{
+							GetAllNotStartedFitAssessmentIdsInternal: fitAssessmentAccessorMock1.getAllNotStarted,
 						},
 					),
 				),
@@ -63,8 +91,11 @@ func Test_ProcessingLoop(t *testing.T) {
 
 		var wg sync.WaitGroup
 		loopCtx, cancelProcessingLoop := context.WithCancel(context.Background())
-		go server.ProcessingLoop(loopCtx, tickerDuration, &wg, jobStarterMock)
-		time.Sleep(45 * time.Millisecond)
+		go server.ProcessingLoop(loopCtx, loopOpts, &wg, jobStarterMock, &storage.NotificationListenerMock{})
+		time.Sleep(90 * time.Millisecond)
+		assert.Equal(t, 10, fileUploadAccessorMock1.calledMaxConcurrentFileUploads)
+		assert.Equal(t, 3, fileUploadAccessorMock1.calledMaxConcurrentFileUploadsPerTeam)
+		assert.Equal(t, 5*time.Minute, fileUploadAccessorMock1.calledDispatchLease)
 
 		for _, jobsStarted := range jobStartedCallsToVerify {
 			assertJobStarterCalledWithArgsForJob(
@@ -84,6 +115,78 @@ func Test_ProcessingLoop(t *testing.T) {
 			assertCallCount(t, f.expectedCallCount, f.functionCall, f.name, "function call count should not change once loop is canceled")
 		}
 	})
+
+	t.Run("dispatches file uploads once for a burst of notifications, enqueues notified fit assessments, and sweeps again after the listener reconnects", func(t *testing.T) {
+		jobStarterMock := &workers.JobStarterMockCallCheck{}
+		loopOpts := ProcessingLoopOptions{
+			ReconciliationInterval:          time.Hour,
+			MaxConcurrentFileUploads:        10,
+			MaxConcurrentFileUploadsPerTeam: 3,
+			DispatchLease:                   5 * time.Minute,
+		}
+
+		fileUploadAccessorMock1 := fileUploadAccessorCallerInspectableMock{
+			functionCallInspectableMock: &functionCallInspectableMock{
+				ReturnData:  [][]string{{}, {"fp_id1", "fp_id2"}, {"fp_id3"}},
+				ReturnCount: 3,
+			},
+		}
+
+		fitAssessmentAccessorMock1 := fitAssessmentAccessorCallerInspectableMock{
+			&functionCallInspectableMock{
+				ReturnData:  [][]string{{}, {}},
+				ReturnCount: 2,
+			},
+		}
+
+		server, _ := NewServer(
+			ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithFileUploadAccessorMock(
+						&storage.FileUploadAccessorConfigurableMock{
+							DispatchFileUploadIdsInternal: fileUploadAccessorMock1.dispatch,
+						},
+					),
+					storage.WithFitAssessmentAccessorMock(
+						&storage.FitAssessmentAccessorConfigurableMock{
+							GetAllNotStartedFitAssessmentIdsInternal: fitAssessmentAccessorMock1.getAllNotStarted,
+						},
+					),
+				),
+			},
+		)
+
+		notifications := make(chan *storage.Notification, 3)
+		notifications <- &storage.Notification{Channel: storage.FILE_UPLOAD_READY_CHANNEL, Id: "fp_id1"}
+		notifications <- &storage.Notification{Channel: storage.FIT_ASSESSMENT_READY_CHANNEL, Id: "fa_id1"}
+		notifications <- &storage.Notification{Channel: storage.FILE_UPLOAD_READY_CHANNEL, Id: "fp_id2"}
+		var wg sync.WaitGroup
+		loopCtx, cancelProcessingLoop := context.WithCancel(context.Background())
+		go server.ProcessingLoop(loopCtx, loopOpts, &wg, jobStarterMock, &storage.NotificationListenerMock{NotificationsChan: notifications})
+		time.Sleep(20 * time.Millisecond)
+		assertCallCount(t, 2, fileUploadAccessorMock1, "dispatch", "loop should dispatch on start and once for the burst of notifications")
+		assertCallCount(t, 1, fitAssessmentAccessorMock1, "getAllNotStarted", "loop should only sweep fit assessments on start")
+
+		notifications <- nil
+		close(notifications)
+		time.Sleep(20 * time.Millisecond)
+		cancelProcessingLoop()
+
+		assertJobStarterCalledWithArgsForJob(
+			t,
+			[]map[string]any{{"fileUploadId": "fp_id1"}, {"fileUploadId": "fp_id2"}, {"fileUploadId": "fp_id3"}},
+			jobStarterMock,
+			workers.PROCESS_FILE_UPLOAD,
+		)
+		assertJobStarterCalledWithArgsForJob(
+			t,
+			[]map[string]any{{"fitAssessmentId": "fa_id1"}},
+			jobStarterMock,
+			workers.ASSESS_CANDIDATE_FIT,
+		)
+		assertCallCount(t, 3, fileUploadAccessorMock1, "dispatch", "loop should sweep again after the listener reconnects")
+		assertCallCount(t, 2, fitAssessmentAccessorMock1, "getAllNotStarted", "loop should sweep again after the listener reconnects")
+	})
 }
 
 type functionCallInspectable interface {
@@ -106,9 +209,27 @@ func assertCallCount(t *testing.T, expectedCallCount int, functionCall functionC
 
 type fileUploadAccessorCallerInspectableMock struct {
 	*functionCallInspectableMock
+	calledMaxConcurrentFileUploads        int
+	calledMaxConcurrentFileUploadsPerTeam int
+	calledDispatchLease                   time.Duration
+}
+
+func (m *fileUploadAccessorCallerInspectableMock) dispatch(maxConcurrentFileUploads, maxConcurrentFileUploadsPerTeam int, dispatchLease time.Duration) ([]string, error) {
+	m.callCount++
+	m.calledMaxConcurrentFileUploads = maxConcurrentFileUploads
+	m.calledMaxConcurrentFileUploadsPerTeam = maxConcurrentFileUploadsPerTeam
+	m.calledDispatchLease = dispatchLease
+	if m.ReturnCount >= m.callCount {
+		return m.ReturnData[m.callCount-1], nil
+	}
+	return nil, nil
+}
+
+type fitAssessmentAccessorCallerInspectableMock struct {
+	*functionCallInspectableMock
 }
 
-func (m *fileUploadAccessorCallerInspectableMock) getAllUnprocessed() ([]string, error) {
+func (m *fitAssessmentAccessorCallerInspectableMock) getAllNotStarted() ([]string, error) {
 	m.callCount++
 	if m.ReturnCount >= m.callCount {
 		return m.ReturnData[m.callCount-1], nil
@@ -0,0 +1,228 @@
+package server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *CandidateTrackerGoService) GetScorecardCriteria(ctx context.Context, req *pb.GetScorecardCriteriaRequest) (*pb.GetScorecardCriteriaResponse, error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	criteria, err := s.storage.GetScorecardCriteriaForTeam(team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetScorecardCriteriaResponse{
+		Criteria: scorecardCriteriaResponse(criteria),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) CreateScorecardCriterion(ctx context.Context, req *pb.CreateScorecardCriterionRequest) (*pb.CreateScorecardCriterionResponse, error) {
+	name := req.GetName()
+	if utilities.IsBlank(name) {
+		return nil, errors.New("name cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	criterion, err := s.storage.CreateScorecardCriterionForTeam(name, req.GetDescription(), team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateScorecardCriterionResponse{
+		Criterion: scorecardCriterionResponse(criterion),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) DeleteScorecardCriterion(ctx context.Context, req *pb.DeleteScorecardCriterionRequest) (*pb.DeleteScorecardCriterionResponse, error) {
+	id := req.GetId()
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	err = s.storage.DeleteScorecardCriterionForTeam(id, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteScorecardCriterionResponse{}, nil
+}
+
+// SubmitScorecard records the requesting user as the interviewer.
+func (s *CandidateTrackerGoService) SubmitScorecard(ctx context.Context, req *pb.SubmitScorecardRequest) (*pb.SubmitScorecardResponse, error) {
+	candidateId := req.GetCandidateId()
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	if len(req.GetRatings()) == 0 {
+		return nil, errors.New("ratings cannot be empty")
+	}
+
+	ratings := []*model.ScorecardRating{}
+	for _, requestRating := range req.GetRatings() {
+		rating, err := model.NewScorecardRating(model.ScorecardRatingOptions{
+			CriterionId: requestRating.GetCriterionId(),
+			Rating:      int(requestRating.GetRating()),
+		})
+		if err != nil {
+			return nil, err
+		}
+		ratings = append(ratings, rating)
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	scorecard, err := s.storage.SubmitScorecardForTeam(candidateId, req.GetJobOpeningId(), user.GetId(), req.GetComment(), ratings, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SubmitScorecardResponse{
+		Scorecard: scorecardResponse(scorecard),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) GetScorecards(ctx context.Context, req *pb.GetScorecardsRequest) (*pb.GetScorecardsResponse, error) {
+	candidateId := req.GetCandidateId()
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	scorecards, err := s.storage.GetScorecardsForTeam(candidateId, req.GetJobOpeningId(), team)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria, err := s.storage.GetScorecardCriteriaForTeam(team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetScorecardsResponse{
+		Scorecards: scorecardsResponse(scorecards),
+		Summaries:  scorecardSummariesResponse(model.SummarizeScorecards(criteria, scorecards)),
+	}, nil
+}
+
+func scorecardCriterionResponse(criterion *model.ScorecardCriterion) *pb.ScorecardCriterion {
+	return &pb.ScorecardCriterion{
+		Id:          criterion.Id(),
+		Name:        criterion.Name(),
+		Description: criterion.Description(),
+	}
+}
+
+func scorecardCriteriaResponse(criteria []*model.ScorecardCriterion) []*pb.ScorecardCriterion {
+	response := []*pb.ScorecardCriterion{}
+	for _, criterion := range criteria {
+		response = append(response, scorecardCriterionResponse(criterion))
+	}
+	return response
+}
+
+func scorecardResponse(scorecard *model.Scorecard) *pb.Scorecard {
+	ratings := []*pb.ScorecardRating{}
+	for _, rating := range scorecard.Ratings() {
+		ratings = append(ratings, &pb.ScorecardRating{
+			CriterionId: rating.CriterionId(),
+			Rating:      int64(rating.Rating()),
+		})
+	}
+	return &pb.Scorecard{
+		Id:                   scorecard.Id(),
+		CandidateId:          scorecard.CandidateId(),
+		JobOpeningId:         scorecard.JobOpeningId(),
+		InterviewerUserId:    scorecard.InterviewerUserId(),
+		InterviewerUserEmail: scorecard.InterviewerUserEmail(),
+		Comment:              scorecard.Comment(),
+		Ratings:              ratings,
+		CreatedAt:            timestamppb.New(scorecard.CreatedAt()),
+		UpdatedAt:            timestamppb.New(scorecard.UpdatedAt()),
+	}
+}
+
+func scorecardsResponse(scorecards []*model.Scorecard) []*pb.Scorecard {
+	response := []*pb.Scorecard{}
+	for _, scorecard := range scorecards {
+		response = append(response, scorecardResponse(scorecard))
+	}
+	return response
+}
+
+func scorecardSummariesResponse(summaries []*model.ScorecardCriterionSummary) []*pb.ScorecardCriterionSummary {
+	response := []*pb.ScorecardCriterionSummary{}
+	for _, summary := range summaries {
+		response = append(response, &pb.ScorecardCriterionSummary{
+			CriterionId:   summary.CriterionId(),
+			CriterionName: summary.CriterionName(),
+			RatingCount:   int64(summary.RatingCount()),
+			Average:       summary.Average(),
+			MinRating:     int64(summary.MinRating()),
+			MaxRating:     int64(summary.MaxRating()),
+			Disagreement:  summary.HasDisagreement(),
+		})
+	}
+	return response
+}
@@ -0,0 +1,407 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_CreateScorecardCriterion(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	criterion, _ := model.NewScorecardCriterion(model.ScorecardCriterionOptions{
+		Id:          "sc_crit_id1",
+		Name:        "Communication",
+		Description: "Explains their thinking",
+		Team:        team,
+	})
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.New(
+			map[string]string{
+				requestingUserIdCtxKey:    "user_id1",
+				requestingUserEmailCtxKey: "user@example.com",
+			},
+		),
+	)
+
+	tests := []struct {
+		name                  string
+		ctx                   context.Context
+		input                 *pb.CreateScorecardCriterionRequest
+		output                *pb.CreateScorecardCriterionResponse
+		teamHydratorMock      storage.TeamHydrator
+		scorecardAccessorMock storage.ScorecardAccessor
+		errorExpected         bool
+		errorString           string
+	}{
+		{
+			name:          "errors if name is blank",
+			ctx:           ctx,
+			input:         &pb.CreateScorecardCriterionRequest{},
+			errorExpected: true,
+			errorString:   "name cannot be blank",
+		},
+		{
+			name:          "errors if no user in context",
+			ctx:           context.Background(),
+			input:         &pb.CreateScorecardCriterionRequest{Name: "Communication"},
+			errorExpected: true,
+			errorString:   "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name:             "errors if unable to hydrate team",
+			ctx:              ctx,
+			input:            &pb.CreateScorecardCriterionRequest{Name: "Communication"},
+			teamHydratorMock: &storage.TeamHydratorMockFailure{},
+			errorExpected:    true,
+			errorString:      "unable to hydrate team",
+		},
+		{
+			name:             "errors if unable to create criterion",
+			ctx:              ctx,
+			input:            &pb.CreateScorecardCriterionRequest{Name: "Communication"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			scorecardAccessorMock: &storage.ScorecardAccessorConfigurableMock{
+				CreateScorecardCriterionForTeamInternal: func(name, description string, team *model.Team) (*model.ScorecardCriterion, error) {
+					return nil, errors.New("scorecard criterion Communication already exists")
+				},
+			},
+			errorExpected: true,
+			errorString:   "scorecard criterion Communication already exists",
+		},
+		{
+			name:             "creates the criterion",
+			ctx:              ctx,
+			input:            &pb.CreateScorecardCriterionRequest{Name: "Communication", Description: "Explains their thinking"},
+			output:           &pb.CreateScorecardCriterionResponse{Criterion: &pb.ScorecardCriterion{Id: "sc_crit_id1", Name: "Communication", Description: "Explains their thinking"}},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			scorecardAccessorMock: &storage.ScorecardAccessorConfigurableMock{
+				CreateScorecardCriterionForTeamInternal: func(name, description string, team *model.Team) (*model.ScorecardCriterion, error) {
+					return criterion, nil
+				},
+			},
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithScorecardAccessorMock(tt.scorecardAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.CreateScorecardCriterion(tt.ctx, tt.input)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output.GetCriterion(), response.GetCriterion())
+			} else {
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_SubmitScorecard(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	createdAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	rating, _ := model.NewScorecardRating(model.ScorecardRatingOptions{CriterionId: "sc_crit_id1", Rating: 4})
+	scorecard, _ := model.NewScorecard(model.ScorecardOptions{
+		Id:                   "sc_id1",
+		CandidateId:          "c_id1",
+		JobOpeningId:         "jo_id1",
+		InterviewerUserId:    "user_id1",
+		InterviewerUserEmail: "test@example.com",
+		Comment:              "Good",
+		Ratings:              []*model.ScorecardRating{rating},
+		CreatedAt:            createdAt,
+		UpdatedAt:            createdAt,
+	})
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.New(
+			map[string]string{
+				requestingUserIdCtxKey:    "user_id1",
+				requestingUserEmailCtxKey: "user@example.com",
+			},
+		),
+	)
+
+	tests := []struct {
+		name                  string
+		input                 *pb.SubmitScorecardRequest
+		output                *pb.SubmitScorecardResponse
+		teamHydratorMock      storage.TeamHydrator
+		scorecardAccessorMock storage.ScorecardAccessor
+		errorExpected         bool
+		errorString           string
+	}{
+		{
+			name:          "errors if candidateId is blank",
+			input:         &pb.SubmitScorecardRequest{Ratings: []*pb.ScorecardRating{{CriterionId: "sc_crit_id1", Rating: 4}}},
+			errorExpected: true,
+			errorString:   "candidateId cannot be blank",
+		},
+		{
+			name:          "errors if ratings are empty",
+			input:         &pb.SubmitScorecardRequest{CandidateId: "c_id1"},
+			errorExpected: true,
+			errorString:   "ratings cannot be empty",
+		},
+		{
+			name:          "errors if a rating is outside the scale",
+			input:         &pb.SubmitScorecardRequest{CandidateId: "c_id1", Ratings: []*pb.ScorecardRating{{CriterionId: "sc_crit_id1", Rating: 7}}},
+			errorExpected: true,
+			errorString:   "cannot create ScorecardRating with a rating outside 1 to 5",
+		},
+		{
+			name:             "errors if unable to submit scorecard",
+			input:            &pb.SubmitScorecardRequest{CandidateId: "c_id2", Ratings: []*pb.ScorecardRating{{CriterionId: "sc_crit_id1", Rating: 4}}},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			scorecardAccessorMock: &storage.ScorecardAccessorConfigurableMock{
+				SubmitScorecardForTeamInternal: func(candidateId, jobOpeningId, interviewerUserId, comment string, ratings []*model.ScorecardRating, team *model.Team) (*model.Scorecard, error) {
+					return nil, errors.New("no candidate for id c_id2")
+				},
+			},
+			errorExpected: true,
+			errorString:   "no candidate for id c_id2",
+		},
+		{
+			name: "submits the scorecard as the requesting user",
+			input: &pb.SubmitScorecardRequest{
+				CandidateId:  "c_id1",
+				JobOpeningId: "jo_id1",
+				Comment:      "Good",
+				Ratings:      []*pb.ScorecardRating{{CriterionId: "sc_crit_id1", Rating: 4}},
+			},
+			output: &pb.SubmitScorecardResponse{
+				Scorecard: &pb.Scorecard{
+					Id:                   "sc_id1",
+					CandidateId:          "c_id1",
+					JobOpeningId:         "jo_id1",
+					InterviewerUserId:    "user_id1",
+					InterviewerUserEmail: "test@example.com",
+					Comment:              "Good",
+					Ratings:              []*pb.ScorecardRating{{CriterionId: "sc_crit_id1", Rating: 4}},
+					CreatedAt:            timestamppb.New(createdAt),
+					UpdatedAt:            timestamppb.New(createdAt),
+				},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			scorecardAccessorMock: &storage.ScorecardAccessorConfigurableMock{
+				SubmitScorecardForTeamInternal: func(candidateId, jobOpeningId, interviewerUserId, comment string, ratings []*model.ScorecardRating, team *model.Team) (*model.Scorecard, error) {
+					if candidateId != "c_id1" || jobOpeningId != "jo_id1" || interviewerUserId != "user_id1" || !assert.Equal(t, []*model.ScorecardRating{rating}, ratings) {
+						return nil, errors.New("unexpected scorecard")
+					}
+					return scorecard, nil
+				},
+			},
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithScorecardAccessorMock(tt.scorecardAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.SubmitScorecard(ctx, tt.input)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output.GetScorecard(), response.GetScorecard())
+			} else {
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_GetScorecards(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	createdAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	criterion, _ := model.NewScorecardCriterion(model.ScorecardCriterionOptions{Id: "sc_crit_id1", Name: "System design", Team: team})
+	rating1, _ := model.NewScorecardRating(model.ScorecardRatingOptions{CriterionId: "sc_crit_id1", Rating: 5})
+	rating2, _ := model.NewScorecardRating(model.ScorecardRatingOptions{CriterionId: "sc_crit_id1", Rating: 2})
+	scorecard1, _ := model.NewScorecard(model.ScorecardOptions{
+		Id:                "sc_id1",
+		CandidateId:       "c_id1",
+		InterviewerUserId: "user_id1",
+		Ratings:           []*model.ScorecardRating{rating1},
+		CreatedAt:         createdAt,
+		UpdatedAt:         createdAt,
+	})
+	scorecard2, _ := model.NewScorecard(model.ScorecardOptions{
+		Id:                "sc_id2",
+		CandidateId:       "c_id1",
+		InterviewerUserId: "user_id2",
+		Ratings:           []*model.ScorecardRating{rating2},
+		CreatedAt:         createdAt,
+		UpdatedAt:         createdAt,
+	})
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.New(
+			map[string]string{
+				requestingUserIdCtxKey:    "user_id1",
+				requestingUserEmailCtxKey: "user@example.com",
+			},
+		),
+	)
+
+	tests := []struct {
+		name                  string
+		input                 *pb.GetScorecardsRequest
+		output                *pb.GetScorecardsResponse
+		teamHydratorMock      storage.TeamHydrator
+		scorecardAccessorMock storage.ScorecardAccessor
+		errorExpected         bool
+		errorString           string
+	}{
+		{
+			name:          "errors if candidateId is blank",
+			input:         &pb.GetScorecardsRequest{},
+			errorExpected: true,
+			errorString:   "candidateId cannot be blank",
+		},
+		{
+			name:             "errors if unable to get scorecards",
+			input:            &pb.GetScorecardsRequest{CandidateId: "c_id1"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			scorecardAccessorMock: &storage.ScorecardAccessorConfigurableMock{
+				GetScorecardsForTeamInternal: func(candidateId, jobOpeningId string, team *model.Team) ([]*model.Scorecard, error) {
+					return nil, errors.New("dbError when querying scorecards")
+				},
+			},
+			errorExpected: true,
+			errorString:   "dbError when querying scorecards",
+		},
+		{
+			name:             "errors if unable to get criteria",
+			input:            &pb.GetScorecardsRequest{CandidateId: "c_id1"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			scorecardAccessorMock: &storage.ScorecardAccessorConfigurableMock{
+				GetScorecardsForTeamInternal: func(candidateId, jobOpeningId string, team *model.Team) ([]*model.Scorecard, error) {
+					return []*model.Scorecard{scorecard1}, nil
+				},
+				GetScorecardCriteriaForTeamInternal: func(team *model.Team) ([]*model.ScorecardCriterion, error) {
+					return nil, errors.New("dbError when querying criteria")
+				},
+			},
+			errorExpected: true,
+			errorString:   "dbError when querying criteria",
+		},
+		{
+			name:  "returns scorecards with summaries",
+			input: &pb.GetScorecardsRequest{CandidateId: "c_id1"},
+			output: &pb.GetScorecardsResponse{
+				Scorecards: []*pb.Scorecard{
+					{
+						Id:                "sc_id1",
+						CandidateId:       "c_id1",
+						InterviewerUserId: "user_id1",
+						Ratings:           []*pb.ScorecardRating{{CriterionId: "sc_crit_id1", Rating: 5}},
+						CreatedAt:         timestamppb.New(createdAt),
+						UpdatedAt:         timestamppb.New(createdAt),
+					},
+					{
+						Id:                "sc_id2",
+						CandidateId:       "c_id1",
+						InterviewerUserId: "user_id2",
+						Ratings:           []*pb.ScorecardRating{{CriterionId: "sc_crit_id1", Rating: 2}},
+						CreatedAt:         timestamppb.New(createdAt),
+						UpdatedAt:         timestamppb.New(createdAt),
+					},
+				},
+				Summaries: []*pb.ScorecardCriterionSummary{
+					{
+						CriterionId:   "sc_crit_id1",
+						CriterionName: "System design",
+						RatingCount:   2,
+						Average:       3.5,
+						MinRating:     2,
+						MaxRating:     5,
+						Disagreement:  true,
+					},
+				},
+			},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			scorecardAccessorMock: &storage.ScorecardAccessorConfigurableMock{
+				GetScorecardsForTeamInternal: func(candidateId, jobOpeningId string, team *model.Team) ([]*model.Scorecard, error) {
+					return []*model.Scorecard{scorecard1, scorecard2}, nil
+				},
+				GetScorecardCriteriaForTeamInternal: func(team *model.Team) ([]*model.ScorecardCriterion, error) {
+					return []*model.ScorecardCriterion{criterion}, nil
+				},
+			},
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithScorecardAccessorMock(tt.scorecardAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.GetScorecards(ctx, tt.input)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output.GetScorecards(), response.GetScorecards())
+				assert.EqualValues(t, tt.output.GetSummaries(), response.GetSummaries())
+			} else {
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
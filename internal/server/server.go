@@ -8,6 +8,7 @@ import (
 	"github.com/vipulvpatil/candidate-tracker-go/internal/services/filestorage"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/workers"
 	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
 )
 
@@ -18,6 +19,7 @@ type CandidateTrackerGoService struct {
 	config       *config.Config
 	logger       utilities.Logger
 	fileStorer   filestorage.FileStorer
+	jobStarter   workers.JobStarter
 }
 
 type ServerDependencies struct {
@@ -26,6 +28,7 @@ type ServerDependencies struct {
 	Config       *config.Config
 	Logger       utilities.Logger
 	FileStorer   filestorage.FileStorer
+	JobStarter   workers.JobStarter
 }
 
 func NewServer(deps ServerDependencies) (*CandidateTrackerGoService, error) {
@@ -35,6 +38,7 @@ func NewServer(deps ServerDependencies) (*CandidateTrackerGoService, error) {
 		config:       deps.Config,
 		logger:       deps.Logger,
 		fileStorer:   deps.FileStorer,
+		jobStarter:   deps.JobStarter,
 	}, nil
 }
 
@@ -0,0 +1,210 @@
+package server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
+)
+
+func (s *CandidateTrackerGoService) GetTags(ctx context.Context, req *pb.GetTagsRequest) (*pb.GetTagsResponse, error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	tags, err := s.storage.GetTagsForTeam(team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetTagsResponse{
+		Tags: tagsResponse(tags),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) CreateTag(ctx context.Context, req *pb.CreateTagRequest) (*pb.CreateTagResponse, error) {
+	name := req.GetName()
+	if utilities.IsBlank(name) {
+		return nil, errors.New("name cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	tag, err := s.storage.CreateTagForTeam(name, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateTagResponse{
+		Tag: tagResponse(tag),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) RenameTag(ctx context.Context, req *pb.RenameTagRequest) (*pb.RenameTagResponse, error) {
+	id := req.GetId()
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	name := req.GetName()
+	if utilities.IsBlank(name) {
+		return nil, errors.New("name cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	err = s.storage.RenameTagForTeam(id, name, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RenameTagResponse{}, nil
+}
+
+func (s *CandidateTrackerGoService) DeleteTag(ctx context.Context, req *pb.DeleteTagRequest) (*pb.DeleteTagResponse, error) {
+	id := req.GetId()
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	err = s.storage.DeleteTagForTeam(id, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteTagResponse{}, nil
+}
+
+func (s *CandidateTrackerGoService) AddTagsToCandidates(ctx context.Context, req *pb.AddTagsToCandidatesRequest) (*pb.AddTagsToCandidatesResponse, error) {
+	tagIds := req.GetTagIds()
+	if len(tagIds) == 0 {
+		return nil, errors.New("tagIds cannot be empty")
+	}
+
+	candidateIds := req.GetCandidateIds()
+	if len(candidateIds) == 0 {
+		return nil, errors.New("candidateIds cannot be empty")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	err = s.storage.AddTagsToCandidatesForTeam(tagIds, candidateIds, team)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.storage.GetTagsForTeam(team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddTagsToCandidatesResponse{
+		Tags: tagsResponse(tags),
+	}, nil
+}
+
+func (s *CandidateTrackerGoService) RemoveTagsFromCandidates(ctx context.Context, req *pb.RemoveTagsFromCandidatesRequest) (*pb.RemoveTagsFromCandidatesResponse, error) {
+	tagIds := req.GetTagIds()
+	if len(tagIds) == 0 {
+		return nil, errors.New("tagIds cannot be empty")
+	}
+
+	candidateIds := req.GetCandidateIds()
+	if len(candidateIds) == 0 {
+		return nil, errors.New("candidateIds cannot be empty")
+	}
+
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userWithTeam, err := s.storage.HydrateTeam(user)
+	if err != nil {
+		return nil, err
+	}
+
+	team := userWithTeam.Team()
+
+	err = s.storage.RemoveTagsFromCandidatesForTeam(tagIds, candidateIds, team)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.storage.GetTagsForTeam(team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveTagsFromCandidatesResponse{
+		Tags: tagsResponse(tags),
+	}, nil
+}
+
+func tagResponse(tag *model.Tag) *pb.Tag {
+	return &pb.Tag{
+		Id:             tag.Id(),
+		Name:           tag.Name(),
+		CandidateCount: int64(tag.CandidateCount()),
+	}
+}
+
+func tagsResponse(tags []*model.Tag) []*pb.Tag {
+	response := []*pb.Tag{}
+	for _, tag := range tags {
+		response = append(response, tagResponse(tag))
+	}
+	return response
+}
@@ -0,0 +1,507 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+	pb "github.com/vipulvpatil/candidate-tracker-go/protos"
+	"google.golang.org/grpc/metadata"
+)
+
+func Test_CreateTag(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	tag, _ := model.NewTag(model.TagOptions{
+		Id:   "tag_id1",
+		Name: "referral",
+		Team: team,
+	})
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.New(
+			map[string]string{
+				requestingUserIdCtxKey:    "user_id1",
+				requestingUserEmailCtxKey: "user@example.com",
+			},
+		),
+	)
+
+	tests := []struct {
+		name             string
+		ctx              context.Context
+		input            *pb.CreateTagRequest
+		output           *pb.CreateTagResponse
+		teamHydratorMock storage.TeamHydrator
+		tagAccessorMock  storage.TagAccessor
+		errorExpected    bool
+		errorString      string
+	}{
+		{
+			name:             "errors if name is blank",
+			ctx:              ctx,
+			input:            &pb.CreateTagRequest{Name: " "},
+			output:           nil,
+			teamHydratorMock: nil,
+			tagAccessorMock:  nil,
+			errorExpected:    true,
+			errorString:      "name cannot be blank",
+		},
+		{
+			name:             "errors if no user in context",
+			ctx:              context.Background(),
+			input:            &pb.CreateTagRequest{Name: "referral"},
+			output:           nil,
+			teamHydratorMock: nil,
+			tagAccessorMock:  nil,
+			errorExpected:    true,
+			errorString:      "rpc error: code = Unauthenticated desc = retrieving user data failed",
+		},
+		{
+			name:             "errors if unable to hydrate team",
+			ctx:              ctx,
+			input:            &pb.CreateTagRequest{Name: "referral"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockFailure{},
+			tagAccessorMock:  nil,
+			errorExpected:    true,
+			errorString:      "unable to hydrate team",
+		},
+		{
+			name:             "errors if unable to create tag",
+			ctx:              ctx,
+			input:            &pb.CreateTagRequest{Name: "referral"},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			tagAccessorMock: &storage.TagAccessorConfigurableMock{
+				CreateTagForTeamInternal: func(name string, team *model.Team) (*model.Tag, error) {
+					return nil, errors.New("tag referral already exists")
+				},
+			},
+			errorExpected: true,
+			errorString:   "tag referral already exists",
+		},
+		{
+			name:             "creates the tag",
+			ctx:              ctx,
+			input:            &pb.CreateTagRequest{Name: "referral"},
+			output:           &pb.CreateTagResponse{Tag: &pb.Tag{Id: "tag_id1", Name: "referral"}},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			tagAccessorMock: &storage.TagAccessorConfigurableMock{
+				CreateTagForTeamInternal: func(name string, team *model.Team) (*model.Tag, error) {
+					return tag, nil
+				},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithTagAccessorMock(tt.tagAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.CreateTag(tt.ctx, tt.input)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output.GetTag(), response.GetTag())
+			} else {
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_RenameTag(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.New(
+			map[string]string{
+				requestingUserIdCtxKey:    "user_id1",
+				requestingUserEmailCtxKey: "user@example.com",
+			},
+		),
+	)
+
+	tests := []struct {
+		name             string
+		input            *pb.RenameTagRequest
+		teamHydratorMock storage.TeamHydrator
+		tagAccessorMock  storage.TagAccessor
+		errorExpected    bool
+		errorString      string
+	}{
+		{
+			name:          "errors if id is blank",
+			input:         &pb.RenameTagRequest{Name: "referral"},
+			errorExpected: true,
+			errorString:   "id cannot be blank",
+		},
+		{
+			name:          "errors if name is blank",
+			input:         &pb.RenameTagRequest{Id: "tag_id1"},
+			errorExpected: true,
+			errorString:   "name cannot be blank",
+		},
+		{
+			name:             "errors if unable to rename tag",
+			input:            &pb.RenameTagRequest{Id: "tag_id1", Name: "Q3-batch"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			tagAccessorMock: &storage.TagAccessorConfigurableMock{
+				RenameTagForTeamInternal: func(id, name string, team *model.Team) error {
+					return errors.New("tag Q3-batch already exists")
+				},
+			},
+			errorExpected: true,
+			errorString:   "tag Q3-batch already exists",
+		},
+		{
+			name:             "renames the tag",
+			input:            &pb.RenameTagRequest{Id: "tag_id1", Name: "employee referral"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			tagAccessorMock: &storage.TagAccessorConfigurableMock{
+				RenameTagForTeamInternal: func(id, name string, team *model.Team) error {
+					if id != "tag_id1" || name != "employee referral" {
+						return errors.New("unexpected tag")
+					}
+					return nil
+				},
+			},
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithTagAccessorMock(tt.tagAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			_, err := server.RenameTag(ctx, tt.input)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_DeleteTag(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.New(
+			map[string]string{
+				requestingUserIdCtxKey:    "user_id1",
+				requestingUserEmailCtxKey: "user@example.com",
+			},
+		),
+	)
+
+	tests := []struct {
+		name             string
+		input            *pb.DeleteTagRequest
+		teamHydratorMock storage.TeamHydrator
+		tagAccessorMock  storage.TagAccessor
+		errorExpected    bool
+		errorString      string
+	}{
+		{
+			name:          "errors if id is blank",
+			input:         &pb.DeleteTagRequest{},
+			errorExpected: true,
+			errorString:   "id cannot be blank",
+		},
+		{
+			name:             "errors if unable to delete tag",
+			input:            &pb.DeleteTagRequest{Id: "tag_id3"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			tagAccessorMock: &storage.TagAccessorConfigurableMock{
+				DeleteTagForTeamInternal: func(id string, team *model.Team) error {
+					return errors.New("no tag for id tag_id3")
+				},
+			},
+			errorExpected: true,
+			errorString:   "no tag for id tag_id3",
+		},
+		{
+			name:             "deletes the tag",
+			input:            &pb.DeleteTagRequest{Id: "tag_id1"},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			tagAccessorMock: &storage.TagAccessorConfigurableMock{
+				DeleteTagForTeamInternal: func(id string, team *model.Team) error {
+					return nil
+				},
+			},
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithTagAccessorMock(tt.tagAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			_, err := server.DeleteTag(ctx, tt.input)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_AddTagsToCandidates(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	tag, _ := model.NewTag(model.TagOptions{
+		Id:             "tag_id1",
+		Name:           "referral",
+		CandidateCount: 2,
+		Team:           team,
+	})
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.New(
+			map[string]string{
+				requestingUserIdCtxKey:    "user_id1",
+				requestingUserEmailCtxKey: "user@example.com",
+			},
+		),
+	)
+
+	tests := []struct {
+		name             string
+		input            *pb.AddTagsToCandidatesRequest
+		output           *pb.AddTagsToCandidatesResponse
+		teamHydratorMock storage.TeamHydrator
+		tagAccessorMock  storage.TagAccessor
+		errorExpected    bool
+		errorString      string
+	}{
+		{
+			name:          "errors if tagIds is empty",
+			input:         &pb.AddTagsToCandidatesRequest{CandidateIds: []string{"c_id1"}},
+			errorExpected: true,
+			errorString:   "tagIds cannot be empty",
+		},
+		{
+			name:          "errors if candidateIds is empty",
+			input:         &pb.AddTagsToCandidatesRequest{TagIds: []string{"tag_id1"}},
+			errorExpected: true,
+			errorString:   "candidateIds cannot be empty",
+		},
+		{
+			name:             "errors if unable to hydrate team",
+			input:            &pb.AddTagsToCandidatesRequest{TagIds: []string{"tag_id1"}, CandidateIds: []string{"c_id1"}},
+			teamHydratorMock: &storage.TeamHydratorMockFailure{},
+			errorExpected:    true,
+			errorString:      "unable to hydrate team",
+		},
+		{
+			name:             "errors if unable to tag candidates",
+			input:            &pb.AddTagsToCandidatesRequest{TagIds: []string{"tag_id1"}, CandidateIds: []string{"c_id1", "c_id3"}},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			tagAccessorMock: &storage.TagAccessorConfigurableMock{
+				AddTagsToCandidatesForTeamInternal: func(tagIds, candidateIds []string, team *model.Team) error {
+					return errors.New("no candidate for id c_id3")
+				},
+			},
+			errorExpected: true,
+			errorString:   "no candidate for id c_id3",
+		},
+		{
+			name:             "tags the candidates and returns updated counts",
+			input:            &pb.AddTagsToCandidatesRequest{TagIds: []string{"tag_id1"}, CandidateIds: []string{"c_id1", "c_id2"}},
+			output:           &pb.AddTagsToCandidatesResponse{Tags: []*pb.Tag{{Id: "tag_id1", Name: "referral", CandidateCount: 2}}},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			tagAccessorMock: &storage.TagAccessorConfigurableMock{
+				AddTagsToCandidatesForTeamInternal: func(tagIds, candidateIds []string, team *model.Team) error {
+					if !assert.Equal(t, []string{"c_id1", "c_id2"}, candidateIds) {
+						return errors.New("unexpected candidates")
+					}
+					return nil
+				},
+				GetTagsForTeamInternal: func(team *model.Team) ([]*model.Tag, error) {
+					return []*model.Tag{tag}, nil
+				},
+			},
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithTagAccessorMock(tt.tagAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.AddTagsToCandidates(ctx, tt.input)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output.GetTags(), response.GetTags())
+			} else {
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_RemoveTagsFromCandidates(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	userWithTeam, _ := model.NewUser(model.UserOptions{
+		Id:    "user_id1",
+		Email: "test@example.com",
+		Team:  team,
+	})
+	tag, _ := model.NewTag(model.TagOptions{
+		Id:   "tag_id1",
+		Name: "referral",
+		Team: team,
+	})
+	ctx := metadata.NewIncomingContext(
+		context.Background(), metadata.New(
+			map[string]string{
+				requestingUserIdCtxKey:    "user_id1",
+				requestingUserEmailCtxKey: "user@example.com",
+			},
+		),
+	)
+
+	tests := []struct {
+		name             string
+		input            *pb.RemoveTagsFromCandidatesRequest
+		output           *pb.RemoveTagsFromCandidatesResponse
+		teamHydratorMock storage.TeamHydrator
+		tagAccessorMock  storage.TagAccessor
+		errorExpected    bool
+		errorString      string
+	}{
+		{
+			name:          "errors if candidateIds is empty",
+			input:         &pb.RemoveTagsFromCandidatesRequest{TagIds: []string{"tag_id1"}},
+			errorExpected: true,
+			errorString:   "candidateIds cannot be empty",
+		},
+		{
+			name:             "errors if unable to get tags after untagging",
+			input:            &pb.RemoveTagsFromCandidatesRequest{TagIds: []string{"tag_id1"}, CandidateIds: []string{"c_id1"}},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			tagAccessorMock: &storage.TagAccessorConfigurableMock{
+				RemoveTagsFromCandidatesForTeamInternal: func(tagIds, candidateIds []string, team *model.Team) error {
+					return nil
+				},
+				GetTagsForTeamInternal: func(team *model.Team) ([]*model.Tag, error) {
+					return nil, errors.New("dbError when querying tags")
+				},
+			},
+			errorExpected: true,
+			errorString:   "dbError when querying tags",
+		},
+		{
+			name:             "untags the candidates and returns updated counts",
+			input:            &pb.RemoveTagsFromCandidatesRequest{TagIds: []string{"tag_id1"}, CandidateIds: []string{"c_id1"}},
+			output:           &pb.RemoveTagsFromCandidatesResponse{Tags: []*pb.Tag{{Id: "tag_id1", Name: "referral"}}},
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			tagAccessorMock: &storage.TagAccessorConfigurableMock{
+				RemoveTagsFromCandidatesForTeamInternal: func(tagIds, candidateIds []string, team *model.Team) error {
+					return nil
+				},
+				GetTagsForTeamInternal: func(team *model.Team) ([]*model.Tag, error) {
+					return []*model.Tag{tag}, nil
+				},
+			},
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := NewServer(ServerDependencies{
+				Storage: storage.NewStorageAccessorMock(
+					storage.WithTeamHydratorMock(tt.teamHydratorMock),
+					storage.WithTagAccessorMock(tt.tagAccessorMock),
+				),
+				Logger: &utilities.NullLogger{},
+			})
+
+			response, err := server.RemoveTagsFromCandidates(ctx, tt.input)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.EqualValues(t, tt.output.GetTags(), response.GetTags())
+			} else {
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
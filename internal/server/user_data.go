@@ -24,9 +24,15 @@ func (s *CandidateTrackerGoService) GetUserData(ctx context.Context, req *pb.Get
 		return nil, err
 	}
 
+	queuePosition, err := s.storage.GetProcessingQueuePositionForTeam(team, s.config.ProcessingLease)
+	if err != nil {
+		return nil, err
+	}
+
 	return &pb.GetUserDataResponse{
-		FileCountLimit:       int64(team.FileCountLimit()),
-		CurrentFileCount:     int64(team.CurrentFileCount()),
-		UnprocessedFileCount: int64(count),
+		FileCountLimit:          int64(team.FileCountLimit()),
+		CurrentFileCount:        int64(team.CurrentFileCount()),
+		UnprocessedFileCount:    int64(count),
+		ProcessingQueuePosition: int64(queuePosition),
 	}, nil
 }
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/config"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
@@ -85,6 +87,30 @@ func Test_GetUserData(t *testing.T) {
 			errorExpected: true,
 			errorString:   "dbError when querying",
 		},
+		{
+			name: "returns error if database errors when getting processing queue position",
+			ctx: metadata.NewIncomingContext(
+				context.Background(), metadata.New(
+					map[string]string{
+						requestingUserIdCtxKey:    "user_id1",
+						requestingUserEmailCtxKey: "user@example.com",
+					},
+				),
+			),
+			input:            &pb.GetUserDataRequest{},
+			output:           nil,
+			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				GetUnprocessedFileUploadsCountForTeamInternal: func(id *model.Team) (int, error) {
+					return 3, nil
+				},
+				GetProcessingQueuePositionForTeamInternal: func(id *model.Team, dispatchLease time.Duration) (int, error) {
+					return 0, errors.New("dbError when querying queue position")
+				},
+			},
+			errorExpected: true,
+			errorString:   "dbError when querying queue position",
+		},
 		{
 			name: "returns response with 0 if fileUpload count not in database",
 			ctx: metadata.NewIncomingContext(
@@ -106,6 +132,9 @@ func Test_GetUserData(t *testing.T) {
 				GetUnprocessedFileUploadsCountForTeamInternal: func(id *model.Team) (int, error) {
 					return 0, nil
 				},
+				GetProcessingQueuePositionForTeamInternal: func(id *model.Team, dispatchLease time.Duration) (int, error) {
+					return 0, nil
+				},
 			},
 			errorExpected: false,
 			errorString:   "",
@@ -122,15 +151,22 @@ func Test_GetUserData(t *testing.T) {
 			),
 			input: &pb.GetUserDataRequest{},
 			output: &pb.GetUserDataResponse{
-				FileCountLimit:       100,
-				CurrentFileCount:     1,
-				UnprocessedFileCount: 3,
+				FileCountLimit:          100,
+				CurrentFileCount:        1,
+				UnprocessedFileCount:    3,
+				ProcessingQueuePosition: 2,
 			},
 			teamHydratorMock: &storage.TeamHydratorMockSuccess{User: userWithTeam},
 			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
 				GetUnprocessedFileUploadsCountForTeamInternal: func(id *model.Team) (int, error) {
 					return 3, nil
 				},
+				GetProcessingQueuePositionForTeamInternal: func(id *model.Team, dispatchLease time.Duration) (int, error) {
+					if dispatchLease != 5*time.Minute {
+						return 0, errors.New("unexpected dispatch lease")
+					}
+					return 2, nil
+				},
 			},
 			errorExpected: false,
 			errorString:   "",
@@ -144,6 +180,7 @@ func Test_GetUserData(t *testing.T) {
 					storage.WithTeamHydratorMock(tt.teamHydratorMock),
 					storage.WithFileUploadAccessorMock(tt.fileUploadAccessorMock),
 				),
+				Config: &config.Config{ProcessingLease: 5 * time.Minute},
 				Logger: &utilities.NullLogger{},
 			})
 
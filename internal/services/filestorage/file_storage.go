@@ -1,12 +1,16 @@
 package filestorage
 
 import (
+	"os"
+
 	"github.com/vipulvpatil/candidate-tracker-go/internal/clients/s3"
 )
 
 type FileStorer interface {
 	GetPresignedUrl(path, fileName string) (string, error)
 	GetLocalFilePath(path, fileName string) (string, error)
+	UploadLocalFile(path, fileName, localFilePath string) error
+	DeleteFile(path, fileName string) error
 }
 
 type fileStorage struct {
@@ -26,3 +30,17 @@ func (f *fileStorage) GetPresignedUrl(path, fileName string) (string, error) {
 func (f *fileStorage) GetLocalFilePath(path, fileName string) (string, error) {
 	return f.s3Client.GetLocalFilePath(path, fileName)
 }
+
+func (f *fileStorage) UploadLocalFile(path, fileName, localFilePath string) error {
+	file, err := os.Open(localFilePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return f.s3Client.UploadFile(path, fileName, file)
+}
+
+func (f *fileStorage) DeleteFile(path, fileName string) error {
+	return f.s3Client.DeleteFile(path, fileName)
+}
@@ -2,6 +2,7 @@ package filestorage
 
 import (
 	"errors"
+	"path/filepath"
 
 	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
 )
@@ -9,6 +10,10 @@ import (
 type FileStorerMock struct {
 	PresignedUrl  string
 	LocalFilePath string
+	UploadFails   bool
+	UploadedFiles []string
+	DeleteFails   bool
+	DeletedFiles  []string
 }
 
 func (f *FileStorerMock) GetPresignedUrl(path, fileName string) (string, error) {
@@ -24,3 +29,19 @@ func (f *FileStorerMock) GetLocalFilePath(path, fileName string) (string, error)
 	}
 	return f.LocalFilePath, nil
 }
+
+func (f *FileStorerMock) UploadLocalFile(path, fileName, localFilePath string) error {
+	if f.UploadFails {
+		return errors.New("unable to upload file")
+	}
+	f.UploadedFiles = append(f.UploadedFiles, filepath.Join(path, fileName))
+	return nil
+}
+
+func (f *FileStorerMock) DeleteFile(path, fileName string) error {
+	if f.DeleteFails {
+		return errors.New("unable to delete file")
+	}
+	f.DeletedFiles = append(f.DeletedFiles, filepath.Join(path, fileName))
+	return nil
+}
@@ -0,0 +1,115 @@
+package leaderelection
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+// LeaderTask runs until ctx is done, which happens when this instance stops being the leader.
+type LeaderTask func(ctx context.Context)
+
+// Elector makes sure that exactly one instance runs the leader tasks, such as the processing dispatcher.
+type Elector struct {
+	lock          storage.LeaderLock
+	checkInterval time.Duration
+	logger        utilities.Logger
+}
+
+type ElectorOptions struct {
+	Lock          storage.LeaderLock
+	CheckInterval time.Duration
+	Logger        utilities.Logger
+}
+
+func NewElector(opts ElectorOptions) (*Elector, error) {
+	if opts.Lock == nil {
+		return nil, errors.New("cannot create Elector without a lock")
+	}
+
+	if opts.CheckInterval <= 0 {
+		return nil, errors.New("cannot create Elector with a check interval that is not positive")
+	}
+
+	if opts.Logger == nil {
+		return nil, errors.New("cannot create Elector without a logger")
+	}
+
+	return &Elector{
+		lock:          opts.Lock,
+		checkInterval: opts.CheckInterval,
+		logger:        opts.Logger,
+	}, nil
+}
+
+// Run tries to become the leader every check interval, and runs the tasks for as long as it stays the leader.
+// Once it is the leader, it checks every check interval that it still is, and stops the tasks when it is not.
+// Another instance can take over before the tasks have stopped, so tasks should still be safe to run twice for a short while.
+// Run returns once ctx is done and the tasks have stopped.
+func (e *Elector) Run(ctx context.Context, wg *sync.WaitGroup, tasks ...LeaderTask) {
+	wg.Add(1)
+	defer wg.Done()
+
+	ticker := time.NewTicker(e.checkInterval)
+	defer ticker.Stop()
+
+	var stopLeading func()
+	for {
+		if stopLeading == nil {
+			stopLeading = e.tryToLead(ctx, tasks)
+		} else {
+			err := e.lock.StillHeld(ctx)
+			if err != nil {
+				e.logger.LogError(err)
+				e.logger.LogMessageln("Stopped being the leader")
+				stopLeading()
+				stopLeading = nil
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			if stopLeading != nil {
+				stopLeading()
+			}
+			return
+		}
+	}
+}
+
+// tryToLead starts the tasks if it acquires the lock, and returns a func that stops them and releases the lock. It returns nil otherwise.
+func (e *Elector) tryToLead(ctx context.Context, tasks []LeaderTask) func() {
+	acquired, err := e.lock.TryAcquire(ctx)
+	if err != nil {
+		e.logger.LogError(err)
+		return nil
+	}
+	if !acquired {
+		return nil
+	}
+
+	e.logger.LogMessageln("Became the leader")
+	leaderCtx, cancel := context.WithCancel(ctx)
+	var tasksWg sync.WaitGroup
+	for _, task := range tasks {
+		tasksWg.Add(1)
+		go func(task LeaderTask) {
+			defer tasksWg.Done()
+			task(leaderCtx)
+		}(task)
+	}
+
+	return func() {
+		cancel()
+		tasksWg.Wait()
+		err := e.lock.Release()
+		if err != nil {
+			e.logger.LogError(err)
+		}
+	}
+}
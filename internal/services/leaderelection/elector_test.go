@@ -0,0 +1,153 @@
+package leaderelection
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+func Test_NewElector(t *testing.T) {
+	lock := &storage.LeaderLockConfigurableMock{}
+	tests := []struct {
+		name          string
+		input         ElectorOptions
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name:          "errors without a lock",
+			input:         ElectorOptions{CheckInterval: time.Second, Logger: &utilities.NullLogger{}},
+			errorExpected: true,
+			errorString:   "cannot create Elector without a lock",
+		},
+		{
+			name:          "errors with a check interval that is not positive",
+			input:         ElectorOptions{Lock: lock, Logger: &utilities.NullLogger{}},
+			errorExpected: true,
+			errorString:   "cannot create Elector with a check interval that is not positive",
+		},
+		{
+			name:          "errors without a logger",
+			input:         ElectorOptions{Lock: lock, CheckInterval: time.Second},
+			errorExpected: true,
+			errorString:   "cannot create Elector without a logger",
+		},
+		{
+			name:          "creates an Elector",
+			input:         ElectorOptions{Lock: lock, CheckInterval: time.Second, Logger: &utilities.NullLogger{}},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			elector, err := NewElector(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+				assert.Nil(t, elector)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, elector)
+			}
+		})
+	}
+}
+
+func Test_Elector_Run(t *testing.T) {
+	t.Run("runs tasks while it is the leader and stops them and releases the lock once canceled", func(t *testing.T) {
+		var taskStarted, taskStopped, released atomic.Int32
+		lock := &storage.LeaderLockConfigurableMock{
+			TryAcquireInternal: func(ctx context.Context) (bool, error) { return true, nil },
+			StillHeldInternal:  func(ctx context.Context) error { return nil },
+			ReleaseInternal: func() error {
+				released.Add(1)
+				return nil
+			},
+		}
+		elector, _ := NewElector(ElectorOptions{Lock: lock, CheckInterval: 5 * time.Millisecond, Logger: &utilities.NullLogger{}})
+
+		var wg sync.WaitGroup
+		ctx, cancel := context.WithCancel(context.Background())
+		go elector.Run(ctx, &wg, func(ctx context.Context) {
+			taskStarted.Add(1)
+			<-ctx.Done()
+			taskStopped.Add(1)
+		})
+		time.Sleep(30 * time.Millisecond)
+		assert.Equal(t, int32(1), taskStarted.Load(), "task should run once for as long as it is the leader")
+		assert.Equal(t, int32(0), taskStopped.Load())
+
+		cancel()
+		time.Sleep(5 * time.Millisecond)
+		wg.Wait()
+		assert.Equal(t, int32(1), taskStopped.Load())
+		assert.Equal(t, int32(1), released.Load())
+	})
+
+	t.Run("does not run tasks while another instance is the leader", func(t *testing.T) {
+		var tryAcquireCount, taskStarted atomic.Int32
+		lock := &storage.LeaderLockConfigurableMock{
+			TryAcquireInternal: func(ctx context.Context) (bool, error) {
+				tryAcquireCount.Add(1)
+				return false, nil
+			},
+		}
+		elector, _ := NewElector(ElectorOptions{Lock: lock, CheckInterval: 5 * time.Millisecond, Logger: &utilities.NullLogger{}})
+
+		var wg sync.WaitGroup
+		ctx, cancel := context.WithCancel(context.Background())
+		go elector.Run(ctx, &wg, func(ctx context.Context) {
+			taskStarted.Add(1)
+		})
+		time.Sleep(30 * time.Millisecond)
+		cancel()
+
+		assert.GreaterOrEqual(t, tryAcquireCount.Load(), int32(2), "should keep trying to become the leader")
+		assert.Equal(t, int32(0), taskStarted.Load())
+	})
+
+	t.Run("stops tasks when the lock is lost and runs them again once it is the leader again", func(t *testing.T) {
+		var taskStarted, taskStopped, released atomic.Int32
+		var lockLost atomic.Bool
+		lockLost.Store(true)
+		lock := &storage.LeaderLockConfigurableMock{
+			TryAcquireInternal: func(ctx context.Context) (bool, error) { return true, nil },
+			StillHeldInternal: func(ctx context.Context) error {
+				if lockLost.CompareAndSwap(true, false) {
+					return errors.New("lost the connection holding the leader lock")
+				}
+				return nil
+			},
+			ReleaseInternal: func() error {
+				released.Add(1)
+				return nil
+			},
+		}
+		elector, _ := NewElector(ElectorOptions{Lock: lock, CheckInterval: 5 * time.Millisecond, Logger: &utilities.NullLogger{}})
+
+		var wg sync.WaitGroup
+		ctx, cancel := context.WithCancel(context.Background())
+		go elector.Run(ctx, &wg, func(ctx context.Context) {
+			taskStarted.Add(1)
+			<-ctx.Done()
+			taskStopped.Add(1)
+		})
+		time.Sleep(30 * time.Millisecond)
+		assert.Equal(t, int32(2), taskStarted.Load())
+		assert.Equal(t, int32(1), taskStopped.Load())
+		assert.Equal(t, int32(1), released.Load())
+
+		cancel()
+		time.Sleep(5 * time.Millisecond)
+		wg.Wait()
+		assert.Equal(t, int32(2), taskStopped.Load())
+	})
+}
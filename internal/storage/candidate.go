@@ -5,19 +5,28 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
 )
 
 type CandidateAccessor interface {
-	CreateCandidateWithAiGeneratedPersonaForTeamUsingTx(persona *model.Persona, team *model.Team, tx DatabaseTransaction) error
-	GetCandidatesForTeam(team *model.Team) ([]*model.Candidate, error)
+	UpsertCandidateWithAiGeneratedPersonaForTeamUsingTx(persona *model.Persona, team *model.Team, tx DatabaseTransaction) error
+	GetCandidatesForTeam(team *model.Team, filter *model.CandidateFilter, page *model.PageRequest) ([]*model.Candidate, *model.PageInfo, error)
 	GetCandidateForTeam(id string, team *model.Team) (*model.Candidate, error)
-	UpdateCandidateWithManuallyCreatedPersonaForTeam(id string, persona *model.Persona, team *model.Team) (string, error)
+	SearchCandidatesForTeam(query string, team *model.Team, page *model.PageRequest) ([]*model.CandidateSearchResult, *model.PageInfo, error)
+	UpdateCandidateWithManuallyCreatedPersonaForTeam(id string, persona *model.Persona, editedByUserId string, team *model.Team) (string, error)
+	GetAllCandidatesForTeam(team *model.Team) ([]*model.Candidate, error)
+	MergeCandidatesForTeam(survivingCandidateId string, mergedCandidateIds []string, mergedByUserId string, team *model.Team) (*model.Candidate, error)
+	ArchiveCandidateForTeam(id string, team *model.Team) error
+	UnarchiveCandidateForTeam(id string, team *model.Team) error
+	DeleteCandidateForTeam(id string, team *model.Team) error
 }
 
-func (s *Storage) CreateCandidateWithAiGeneratedPersonaForTeamUsingTx(persona *model.Persona, team *model.Team, tx DatabaseTransaction) error {
+// UpsertCandidateWithAiGeneratedPersonaForTeamUsingTx creates the candidate for the persona's file upload,
+// or replaces the ai generated persona of the existing one. A manually created persona is left untouched.
+func (s *Storage) UpsertCandidateWithAiGeneratedPersonaForTeamUsingTx(persona *model.Persona, team *model.Team, tx DatabaseTransaction) error {
 	id := s.IdGenerator.Generate()
 	if !persona.IsValid() {
 		return errors.New("cannot create Candidate without a valid persona")
@@ -33,40 +42,78 @@ func (s *Storage) CreateCandidateWithAiGeneratedPersonaForTeamUsingTx(persona *m
 		return err
 	}
 
-	result, err := s.db.Exec(
+	var candidateId string
+	row := tx.QueryRow(
 		`INSERT INTO public."candidates"
 		("id", "ai_generated_persona", "team_id", "file_upload_id")
 		VALUES
-		($1, $2, $3, $4)`,
+		($1, $2, $3, $4)
+		ON CONFLICT ("file_upload_id") DO UPDATE
+		SET "ai_generated_persona" = EXCLUDED."ai_generated_persona"
+		WHERE candidates."team_id" = EXCLUDED."team_id"
+		RETURNING id`,
 		id, persona, team.Id(), persona.FileUploadId,
 	)
+	err = row.Scan(&candidateId)
 	if err != nil {
-		return utilities.WrapBadError(err, fmt.Sprintf("dbError while inserting Candidate: %s", id))
-	}
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return utilities.WrapBadError(err, fmt.Sprintf("dbError while inserting Candidate and changing db: %s", id))
-	}
-	if rowsAffected != 1 {
-		return utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when inserting Candidate in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+		if err == sql.ErrNoRows {
+			return utilities.NewBadError("Very few or too many rows were affected when upserting Candidate in db. This is highly unexpected. rowsAffected: 0")
+		}
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while upserting Candidate: %s", id))
 	}
 
-	return nil
+	_, err = s.insertCandidatePersonaRevisionUsingTx(candidateId, persona, "", "", tx)
+	return err
 }
 
-func (s *Storage) GetCandidatesForTeam(team *model.Team) ([]*model.Candidate, error) {
+func (s *Storage) GetCandidatesForTeam(team *model.Team, filter *model.CandidateFilter, page *model.PageRequest) ([]*model.Candidate, *model.PageInfo, error) {
 	if team == nil || utilities.IsBlank(team.Id()) {
-		return nil, errors.New("team cannot be blank")
+		return nil, nil, errors.New("team cannot be blank")
+	}
+
+	conditions := newSqlConditions(team.Id())
+	addCandidateFilterConditions(conditions, filter)
+
+	var totalCount int
+	row := s.db.QueryRow(
+		fmt.Sprintf(
+			`SELECT count(id)
+		FROM public."candidates"
+		WHERE team_id = $1
+		%s`,
+			conditions.sql(),
+		),
+		conditions.args...,
+	)
+	err := row.Scan(&totalCount)
+	if err != nil {
+		return nil, nil, utilities.WrapBadError(err, "failed to count candidates")
+	}
+
+	columns := candidateKeysetColumns(filter)
+	pagination, err := newKeysetPagination("candidates", columns, page)
+	if err != nil {
+		return nil, nil, err
 	}
+	limitSql := pagination.apply(conditions)
 
 	rows, err := s.db.Query(
-		`SELECT id, created_at, updated_at, ai_generated_persona, manually_created_persona, file_upload_id
+		fmt.Sprintf(
+			`SELECT id, created_at, updated_at, ai_generated_persona, manually_created_persona, file_upload_id, archived_at, pipeline_stage_id, %s
 		FROM public."candidates"
-		WHERE team_id = $1 ORDER BY created_at ASC, id ASC`,
-		team.Id(),
+		WHERE team_id = $1
+		%s
+		%s
+		%s`,
+			keysetSelectSql(columns),
+			conditions.sql(),
+			keysetOrderBySql(columns),
+			limitSql,
+		),
+		conditions.args...,
 	)
 	if err != nil {
-		return nil, utilities.WrapBadError(err, "failed to select candidates")
+		return nil, nil, utilities.WrapBadError(err, "failed to select candidates")
 	}
 	defer rows.Close()
 
@@ -77,10 +124,22 @@ func (s *Storage) GetCandidatesForTeam(team *model.Team) ([]*model.Candidate, er
 		var createdAt, updatedAt time.Time
 		var aiGeneratedPersona, manuallyCreatedPersona model.Persona
 		var fileUploadId sql.NullString
-		err := rows.Scan(&id, &createdAt, &updatedAt, &aiGeneratedPersona, &manuallyCreatedPersona, &fileUploadId)
+		var archivedAt sql.NullTime
+		var pipelineStageId sql.NullString
+		keysetValues, keysetScanners := pagination.scanners()
+		err := rows.Scan(
+			append(
+				[]any{&id, &createdAt, &updatedAt, &aiGeneratedPersona, &manuallyCreatedPersona, &fileUploadId, &archivedAt, &pipelineStageId},
+				keysetScanners...,
+			)...,
+		)
 
 		if err != nil {
-			return nil, utilities.WrapBadError(err, "failed while scanning rows")
+			return nil, nil, utilities.WrapBadError(err, "failed while scanning rows")
+		}
+
+		if !pagination.next(keysetValues) {
+			break
 		}
 
 		var fileUploadIdString string
@@ -96,6 +155,8 @@ func (s *Storage) GetCandidatesForTeam(team *model.Team) ([]*model.Candidate, er
 			ManuallyCreatedPersona: &manuallyCreatedPersona,
 			Team:                   team,
 			FileUploadId:           fileUploadIdString,
+			ArchivedAt:             archivedAt.Time,
+			PipelineStageId:        pipelineStageId.String,
 		})
 
 		if err != nil {
@@ -108,9 +169,166 @@ func (s *Storage) GetCandidatesForTeam(team *model.Team) ([]*model.Candidate, er
 
 	err = rows.Err()
 	if err != nil {
-		return nil, utilities.WrapBadError(err, "failed to correctly go through candidates rows")
+		return nil, nil, utilities.WrapBadError(err, "failed to correctly go through candidates rows")
 	}
-	return candidates, nil
+
+	nextPageToken, err := pagination.nextPageToken()
+	if err != nil {
+		return nil, nil, utilities.WrapBadError(err, "failed to create next page token for candidates")
+	}
+
+	return candidates, model.NewPageInfo(nextPageToken, totalCount), nil
+}
+
+const candidateSearchRankSql = `ts_rank_cd(c.search_vector, search.query)`
+
+// Matches are wrapped in <mark></mark> so clients can highlight them.
+const candidateSearchSnippetSql = `ts_headline(
+			'english',
+			candidate_search_document(COALESCE(c.manually_created_persona, c.ai_generated_persona), t.text),
+			search.query,
+			'StartSel=<mark>, StopSel=</mark>, MaxFragments=3, MaxWords=15, MinWords=5, FragmentDelimiter=" ... "'
+		)`
+
+var candidateSearchKeysetColumns = []keysetColumn{
+	{name: "rank", expression: candidateSearchRankSql, sqlType: "real", descending: true},
+	{name: "created_at", expression: "c.created_at", sqlType: "timestamptz"},
+	{name: "id", expression: "c.id", sqlType: "text"},
+}
+
+func (s *Storage) SearchCandidatesForTeam(query string, team *model.Team, page *model.PageRequest) ([]*model.CandidateSearchResult, *model.PageInfo, error) {
+	if utilities.IsBlank(query) {
+		return nil, nil, errors.New("query cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, nil, errors.New("team cannot be blank")
+	}
+
+	conditions := newSqlConditions(team.Id(), query)
+
+	var totalCount int
+	row := s.db.QueryRow(
+		`WITH search AS (SELECT websearch_to_tsquery('english', $2) AS query)
+		SELECT count(c.id)
+		FROM public."candidates" AS c, search
+		WHERE c.team_id = $1
+		AND c.archived_at IS NULL
+		AND c.search_vector @@ search.query`,
+		conditions.args...,
+	)
+	err := row.Scan(&totalCount)
+	if err != nil {
+		return nil, nil, utilities.WrapBadError(err, "failed to count searched candidates")
+	}
+
+	pagination, err := newKeysetPagination("candidate_search", candidateSearchKeysetColumns, page)
+	if err != nil {
+		return nil, nil, err
+	}
+	limitSql := pagination.apply(conditions)
+
+	rows, err := s.db.Query(
+		fmt.Sprintf(
+			`WITH search AS (SELECT websearch_to_tsquery('english', $2) AS query)
+		SELECT c.id, c.created_at, c.updated_at, c.ai_generated_persona, c.manually_created_persona, c.file_upload_id, c.archived_at, c.pipeline_stage_id,
+		%s, %s, %s
+		FROM public."candidates" AS c
+		CROSS JOIN search
+		LEFT JOIN public."file_upload_texts" AS t ON t.file_upload_id = c.file_upload_id
+		WHERE c.team_id = $1
+		AND c.archived_at IS NULL
+		AND c.search_vector @@ search.query
+		%s
+		%s
+		%s`,
+			candidateSearchRankSql,
+			candidateSearchSnippetSql,
+			keysetSelectSql(candidateSearchKeysetColumns),
+			conditions.sql(),
+			keysetOrderBySql(candidateSearchKeysetColumns),
+			limitSql,
+		),
+		conditions.args...,
+	)
+	if err != nil {
+		return nil, nil, utilities.WrapBadError(err, "failed to search candidates")
+	}
+	defer rows.Close()
+
+	results := []*model.CandidateSearchResult{}
+
+	for rows.Next() {
+		var id, snippet string
+		var createdAt, updatedAt time.Time
+		var aiGeneratedPersona, manuallyCreatedPersona model.Persona
+		var fileUploadId sql.NullString
+		var archivedAt sql.NullTime
+		var pipelineStageId sql.NullString
+		var rank float64
+		keysetValues, keysetScanners := pagination.scanners()
+		err := rows.Scan(
+			append(
+				[]any{&id, &createdAt, &updatedAt, &aiGeneratedPersona, &manuallyCreatedPersona, &fileUploadId, &archivedAt, &pipelineStageId, &rank, &snippet},
+				keysetScanners...,
+			)...,
+		)
+
+		if err != nil {
+			return nil, nil, utilities.WrapBadError(err, "failed while scanning rows")
+		}
+
+		if !pagination.next(keysetValues) {
+			break
+		}
+
+		var fileUploadIdString string
+		if fileUploadId.Valid {
+			fileUploadIdString = fileUploadId.String
+		}
+
+		candidate, err := model.NewCandidate(model.CandidateOptions{
+			Id:                     id,
+			CreatedAt:              createdAt,
+			UpdatedAt:              updatedAt,
+			AiGeneratedPersona:     &aiGeneratedPersona,
+			ManuallyCreatedPersona: &manuallyCreatedPersona,
+			Team:                   team,
+			FileUploadId:           fileUploadIdString,
+			ArchivedAt:             archivedAt.Time,
+			PipelineStageId:        pipelineStageId.String,
+		})
+
+		if err != nil {
+			// TODO: Log this error?
+			continue
+		}
+
+		result, err := model.NewCandidateSearchResult(model.CandidateSearchResultOptions{
+			Candidate: candidate,
+			Rank:      rank,
+			Snippet:   snippet,
+		})
+
+		if err != nil {
+			// TODO: Log this error?
+			continue
+		}
+
+		results = append(results, result)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, nil, utilities.WrapBadError(err, "failed to correctly go through searched candidates rows")
+	}
+
+	nextPageToken, err := pagination.nextPageToken()
+	if err != nil {
+		return nil, nil, utilities.WrapBadError(err, "failed to create next page token for searched candidates")
+	}
+
+	return results, model.NewPageInfo(nextPageToken, totalCount), nil
 }
 
 func (s *Storage) GetCandidateForTeam(id string, team *model.Team) (*model.Candidate, error) {
@@ -123,7 +341,7 @@ func (s *Storage) GetCandidateForTeam(id string, team *model.Team) (*model.Candi
 	}
 
 	row := s.db.QueryRow(
-		`SELECT created_at, updated_at, ai_generated_persona, manually_created_persona, file_upload_id
+		`SELECT created_at, updated_at, ai_generated_persona, manually_created_persona, file_upload_id, archived_at, pipeline_stage_id
 		FROM public."candidates"
 		WHERE  id = $1 AND team_id = $2 ORDER BY created_at ASC, id ASC`,
 		id, team.Id(),
@@ -132,8 +350,10 @@ func (s *Storage) GetCandidateForTeam(id string, team *model.Team) (*model.Candi
 	var createdAt, updatedAt time.Time
 	var aiGeneratedPersona, manuallyCreatedPersona model.Persona
 	var fileUploadId sql.NullString
+	var archivedAt sql.NullTime
+	var pipelineStageId sql.NullString
 
-	err := row.Scan(&createdAt, &updatedAt, &aiGeneratedPersona, &manuallyCreatedPersona, &fileUploadId)
+	err := row.Scan(&createdAt, &updatedAt, &aiGeneratedPersona, &manuallyCreatedPersona, &fileUploadId, &archivedAt, &pipelineStageId)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, errors.Errorf("no candidate for id %s", id)
@@ -154,6 +374,8 @@ func (s *Storage) GetCandidateForTeam(id string, team *model.Team) (*model.Candi
 		ManuallyCreatedPersona: &manuallyCreatedPersona,
 		Team:                   team,
 		FileUploadId:           fileUploadIdString,
+		ArchivedAt:             archivedAt.Time,
+		PipelineStageId:        pipelineStageId.String,
 	})
 	if err != nil {
 		return nil, err
@@ -162,7 +384,9 @@ func (s *Storage) GetCandidateForTeam(id string, team *model.Team) (*model.Candi
 	return candidate, nil
 }
 
-func (s *Storage) UpdateCandidateWithManuallyCreatedPersonaForTeam(id string, persona *model.Persona, team *model.Team) (string, error) {
+// UpdateCandidateWithManuallyCreatedPersonaForTeam creates a candidate when id is blank, and otherwise replaces its manually created persona.
+// Either way the persona is recorded as a revision edited by the given user.
+func (s *Storage) UpdateCandidateWithManuallyCreatedPersonaForTeam(id string, persona *model.Persona, editedByUserId string, team *model.Team) (string, error) {
 	if team == nil || utilities.IsBlank(team.Id()) {
 		return "", errors.New("team cannot be blank")
 	}
@@ -171,6 +395,16 @@ func (s *Storage) UpdateCandidateWithManuallyCreatedPersonaForTeam(id string, pe
 		return "", errors.New("cannot create Candidate without a valid persona")
 	}
 
+	if utilities.IsBlank(editedByUserId) {
+		return "", errors.New("editedByUserId cannot be blank")
+	}
+
+	tx, err := s.BeginTransaction()
+	if err != nil {
+		return "", utilities.WrapBadError(err, "failed to start db transaction")
+	}
+	defer tx.Rollback()
+
 	if utilities.IsBlank(id) {
 		id = s.IdGenerator.Generate()
 		_, err := model.NewCandidate(model.CandidateOptions{
@@ -182,7 +416,7 @@ func (s *Storage) UpdateCandidateWithManuallyCreatedPersonaForTeam(id string, pe
 			return "", err
 		}
 
-		result, err := s.db.Exec(
+		result, err := tx.Exec(
 			`INSERT INTO public."candidates"
 			("id", "manually_created_persona", "team_id")
 			VALUES
@@ -200,7 +434,7 @@ func (s *Storage) UpdateCandidateWithManuallyCreatedPersonaForTeam(id string, pe
 			return "", utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when inserting Candidate in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
 		}
 	} else {
-		result, err := s.db.Exec(
+		result, err := tx.Exec(
 			`UPDATE public."candidates" SET "manually_created_persona" = $3 WHERE id = $1 AND team_id = $2`,
 			id,
 			team.Id(),
@@ -220,5 +454,365 @@ func (s *Storage) UpdateCandidateWithManuallyCreatedPersonaForTeam(id string, pe
 		}
 	}
 
+	_, err = s.insertCandidatePersonaRevisionUsingTx(id, persona, editedByUserId, "", tx)
+	if err != nil {
+		return "", err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return "", utilities.WrapBadError(err, "dbError while updating candidate tx")
+	}
+
 	return id, nil
 }
+
+// GetAllCandidatesForTeam returns every candidate of the team, oldest first. It is meant for work that has to look at all candidates at once,
+// such as finding duplicates, so it is not paginated.
+func (s *Storage) GetAllCandidatesForTeam(team *model.Team) ([]*model.Candidate, error) {
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	rows, err := s.db.Query(
+		`SELECT id, created_at, updated_at, ai_generated_persona, manually_created_persona, file_upload_id, archived_at, pipeline_stage_id
+		FROM public."candidates"
+		WHERE team_id = $1
+		ORDER BY created_at ASC, id ASC`,
+		team.Id(),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to select all candidates")
+	}
+	defer rows.Close()
+
+	candidates, err := candidatesFromRows(rows, team)
+	if err != nil {
+		return nil, err
+	}
+	return candidates, nil
+}
+
+// MergeCandidatesForTeam merges candidates that are the same person into the surviving candidate.
+// Their personas are combined into the manually created persona of the surviving candidate, and their file uploads,
+// including any duplicates of them, are pointed at it before they are deleted. Their job applications move to the
+// surviving candidate too, unless it already applied to the same job opening. Scorecards move the same way, keeping one
+// per interviewer and job opening. Their notes, stage history and persona revisions all move to the surviving candidate.
+// The combined persona is recorded as a revision of the surviving candidate by the merging user.
+func (s *Storage) MergeCandidatesForTeam(survivingCandidateId string, mergedCandidateIds []string, mergedByUserId string, team *model.Team) (*model.Candidate, error) {
+	if utilities.IsBlank(survivingCandidateId) {
+		return nil, errors.New("survivingCandidateId cannot be blank")
+	}
+
+	if len(mergedCandidateIds) == 0 {
+		return nil, errors.New("mergedCandidateIds cannot be empty")
+	}
+
+	seen := map[string]bool{}
+	for _, id := range mergedCandidateIds {
+		if utilities.IsBlank(id) {
+			return nil, errors.New("mergedCandidateIds cannot contain a blank id")
+		}
+		if id == survivingCandidateId {
+			return nil, errors.New("cannot merge a candidate into itself")
+		}
+		if seen[id] {
+			return nil, errors.New("mergedCandidateIds cannot contain repeats")
+		}
+		seen[id] = true
+	}
+
+	if utilities.IsBlank(mergedByUserId) {
+		return nil, errors.New("mergedByUserId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	tx, err := s.BeginTransaction()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to start db transaction")
+	}
+	defer tx.Rollback()
+
+	candidateIds := append([]string{survivingCandidateId}, mergedCandidateIds...)
+	rows, err := tx.Query(
+		`SELECT id, created_at, updated_at, ai_generated_persona, manually_created_persona, file_upload_id, archived_at, pipeline_stage_id
+		FROM public."candidates"
+		WHERE team_id = $1 AND id = ANY($2)
+		ORDER BY created_at ASC, id ASC
+		FOR UPDATE`,
+		team.Id(), pq.Array(candidateIds),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to select candidates to merge")
+	}
+	candidates, err := candidatesFromRows(rows, team)
+	rows.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	candidatesById := map[string]*model.Candidate{}
+	for _, candidate := range candidates {
+		candidatesById[candidate.Id()] = candidate
+	}
+	mergedPersonas := []*model.Persona{}
+	for _, id := range candidateIds {
+		candidate, ok := candidatesById[id]
+		if !ok {
+			return nil, errors.Errorf("no candidate for id %s", id)
+		}
+		if id != survivingCandidateId {
+			mergedPersonas = append(mergedPersonas, candidate.Persona())
+		}
+	}
+	persona := model.MergePersonas(candidatesById[survivingCandidateId].Persona(), mergedPersonas...)
+
+	result, err := tx.Exec(
+		`UPDATE public."candidates" SET "manually_created_persona" = $3 WHERE id = $1 AND team_id = $2`,
+		survivingCandidateId, team.Id(), persona,
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while updating merged Candidate: %s", survivingCandidateId))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while checking affected row while updating merged Candidate: %s", survivingCandidateId))
+	}
+	if rowsAffected != 1 {
+		return nil, utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when updating merged Candidate in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+
+	_, err = s.insertCandidatePersonaRevisionUsingTx(survivingCandidateId, persona, mergedByUserId, "", tx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(
+		`UPDATE public."file_uploads" SET "merged_into_candidate_id" = $1
+		WHERE team_id = $2
+		AND (
+			id IN (SELECT file_upload_id FROM public."candidates" WHERE team_id = $2 AND id = ANY($3))
+			OR merged_into_candidate_id = ANY($3)
+		)`,
+		survivingCandidateId, team.Id(), pq.Array(mergedCandidateIds),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while pointing file uploads at merged Candidate: %s", survivingCandidateId))
+	}
+
+	_, err = tx.Exec(
+		`UPDATE public."file_uploads" SET "duplicate_of_candidate_id" = $1
+		WHERE team_id = $2 AND duplicate_of_candidate_id = ANY($3)`,
+		survivingCandidateId, team.Id(), pq.Array(mergedCandidateIds),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while pointing duplicate file uploads at merged Candidate: %s", survivingCandidateId))
+	}
+
+	_, err = tx.Exec(
+		`UPDATE public."job_applications" SET "candidate_id" = $1
+		WHERE id IN (
+			SELECT DISTINCT ON (job_opening_id) id
+			FROM public."job_applications"
+			WHERE candidate_id = ANY($2)
+			AND job_opening_id NOT IN (SELECT job_opening_id FROM public."job_applications" WHERE candidate_id = $1)
+			ORDER BY job_opening_id, created_at ASC, id ASC
+		)`,
+		survivingCandidateId, pq.Array(mergedCandidateIds),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while moving job applications to merged Candidate: %s", survivingCandidateId))
+	}
+
+	_, err = tx.Exec(
+		`UPDATE public."candidate_notes" SET "candidate_id" = $1 WHERE candidate_id = ANY($2)`,
+		survivingCandidateId, pq.Array(mergedCandidateIds),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while moving candidate notes to merged Candidate: %s", survivingCandidateId))
+	}
+
+	_, err = tx.Exec(
+		`UPDATE public."candidate_stage_changes" SET "candidate_id" = $1 WHERE candidate_id = ANY($2)`,
+		survivingCandidateId, pq.Array(mergedCandidateIds),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while moving candidate stage changes to merged Candidate: %s", survivingCandidateId))
+	}
+
+	_, err = tx.Exec(
+		`UPDATE public."candidate_persona_revisions" SET "candidate_id" = $1 WHERE candidate_id = ANY($2)`,
+		survivingCandidateId, pq.Array(mergedCandidateIds),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while moving candidate persona revisions to merged Candidate: %s", survivingCandidateId))
+	}
+
+	_, err = tx.Exec(
+		`INSERT INTO public."candidate_tags"
+		("candidate_id", "tag_id")
+		SELECT $1, tag_id FROM public."candidate_tags" WHERE candidate_id = ANY($2)
+		ON CONFLICT ("candidate_id", "tag_id") DO NOTHING`,
+		survivingCandidateId, pq.Array(mergedCandidateIds),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while copying tags to merged Candidate: %s", survivingCandidateId))
+	}
+
+	_, err = tx.Exec(
+		`UPDATE public."scorecards" SET "candidate_id" = $1
+		WHERE id IN (
+			SELECT DISTINCT ON (COALESCE(s.job_opening_id, ''), COALESCE(s.interviewer_user_id, s.id)) s.id
+			FROM public."scorecards" AS s
+			WHERE s.candidate_id = ANY($2)
+			AND NOT EXISTS (
+				SELECT 1 FROM public."scorecards" AS kept
+				WHERE kept.candidate_id = $1
+				AND COALESCE(kept.job_opening_id, '') = COALESCE(s.job_opening_id, '')
+				AND kept.interviewer_user_id = s.interviewer_user_id
+			)
+			ORDER BY COALESCE(s.job_opening_id, ''), COALESCE(s.interviewer_user_id, s.id), s.updated_at DESC, s.id ASC
+		)`,
+		survivingCandidateId, pq.Array(mergedCandidateIds),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while moving scorecards to merged Candidate: %s", survivingCandidateId))
+	}
+
+	result, err = tx.Exec(
+		`DELETE FROM public."candidates" WHERE team_id = $1 AND id = ANY($2)`,
+		team.Id(), pq.Array(mergedCandidateIds),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while deleting Candidates merged into: %s", survivingCandidateId))
+	}
+	rowsAffected, err = result.RowsAffected()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while checking affected rows while deleting Candidates merged into: %s", survivingCandidateId))
+	}
+	if rowsAffected != int64(len(mergedCandidateIds)) {
+		return nil, utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when deleting merged Candidates in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "dbError while merging candidates tx")
+	}
+
+	return s.GetCandidateForTeam(survivingCandidateId, team)
+}
+
+// ArchiveCandidateForTeam keeps the time a candidate was first archived, so archiving it again changes nothing.
+func (s *Storage) ArchiveCandidateForTeam(id string, team *model.Team) error {
+	return s.updateCandidateArchivedAtForTeam(id, team, `COALESCE("archived_at", CURRENT_TIMESTAMP)`)
+}
+
+func (s *Storage) UnarchiveCandidateForTeam(id string, team *model.Team) error {
+	return s.updateCandidateArchivedAtForTeam(id, team, `NULL`)
+}
+
+func (s *Storage) updateCandidateArchivedAtForTeam(id string, team *model.Team, archivedAtSql string) error {
+	if utilities.IsBlank(id) {
+		return errors.New("id cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return errors.New("team cannot be blank")
+	}
+
+	result, err := s.db.Exec(
+		fmt.Sprintf(
+			`UPDATE public."candidates" SET "archived_at" = %s WHERE id = $1 AND team_id = $2`,
+			archivedAtSql,
+		),
+		id, team.Id(),
+	)
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while updating archived_at of Candidate: %s", id))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while checking affected row while updating archived_at of Candidate: %s", id))
+	}
+	if rowsAffected == 0 {
+		return errors.Errorf("no candidate for id %s", id)
+	}
+	if rowsAffected != 1 {
+		return utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when updating archived_at of Candidate in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+	return nil
+}
+
+// DeleteCandidateForTeam only deletes the candidate. Its file upload is kept, but cannot be reprocessed to create the candidate again.
+func (s *Storage) DeleteCandidateForTeam(id string, team *model.Team) error {
+	if utilities.IsBlank(id) {
+		return errors.New("id cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return errors.New("team cannot be blank")
+	}
+
+	result, err := s.db.Exec(
+		`DELETE FROM public."candidates" WHERE id = $1 AND team_id = $2`,
+		id, team.Id(),
+	)
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while deleting Candidate: %s", id))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while deleting Candidate and changing db: %s", id))
+	}
+	if rowsAffected == 0 {
+		return errors.Errorf("no candidate for id %s", id)
+	}
+	if rowsAffected != 1 {
+		return utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when deleting Candidate in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+	return nil
+}
+
+func candidatesFromRows(rows *sql.Rows, team *model.Team) ([]*model.Candidate, error) {
+	candidates := []*model.Candidate{}
+
+	for rows.Next() {
+		var id string
+		var createdAt, updatedAt time.Time
+		var aiGeneratedPersona, manuallyCreatedPersona model.Persona
+		var fileUploadId sql.NullString
+		var archivedAt sql.NullTime
+		var pipelineStageId sql.NullString
+		err := rows.Scan(&id, &createdAt, &updatedAt, &aiGeneratedPersona, &manuallyCreatedPersona, &fileUploadId, &archivedAt, &pipelineStageId)
+		if err != nil {
+			return nil, utilities.WrapBadError(err, "failed while scanning rows")
+		}
+
+		candidate, err := model.NewCandidate(model.CandidateOptions{
+			Id:                     id,
+			CreatedAt:              createdAt,
+			UpdatedAt:              updatedAt,
+			AiGeneratedPersona:     &aiGeneratedPersona,
+			ManuallyCreatedPersona: &manuallyCreatedPersona,
+			Team:                   team,
+			FileUploadId:           fileUploadId.String,
+			ArchivedAt:             archivedAt.Time,
+			PipelineStageId:        pipelineStageId.String,
+		})
+		if err != nil {
+			// TODO: Log this error?
+			continue
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	err := rows.Err()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to correctly go through candidates rows")
+	}
+	return candidates, nil
+}
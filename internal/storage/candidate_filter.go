@@ -0,0 +1,146 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+)
+
+// All filters and sorts work on the effective persona.
+// A manually created persona always takes precedence over the AI generated one.
+const effectivePersonaSql = `COALESCE(manually_created_persona, ai_generated_persona)`
+
+func personaTextSql(attribute string) string {
+	return fmt.Sprintf(`(%s->>'%s')`, effectivePersonaSql, attribute)
+}
+
+func personaYoESql() string {
+	return fmt.Sprintf(`COALESCE((%s->>'YoE')::int, 0)`, effectivePersonaSql)
+}
+
+// Persona arrays are optional in the JSON, so anything that is not an array is treated as empty.
+func personaArraySql(attribute string) string {
+	return fmt.Sprintf(
+		`CASE WHEN jsonb_typeof(%[1]s->'%[2]s') = 'array' THEN %[1]s->'%[2]s' ELSE '[]'::jsonb END`,
+		effectivePersonaSql, attribute,
+	)
+}
+
+func personaArrayContainsSql(attribute string) string {
+	return fmt.Sprintf(
+		`EXISTS (SELECT 1 FROM jsonb_array_elements_text(%s) AS element WHERE lower(element) = lower(%%s))`,
+		personaArraySql(attribute),
+	)
+}
+
+func personaArrayMatchesSql(attribute string) string {
+	return fmt.Sprintf(
+		`EXISTS (SELECT 1 FROM jsonb_array_elements_text(%s) AS element WHERE strpos(lower(element), lower(%%s)) > 0)`,
+		personaArraySql(attribute),
+	)
+}
+
+func addCandidateFilterConditions(conditions *sqlConditions, filter *model.CandidateFilter) {
+	if filter == nil || !filter.IncludeArchived() {
+		conditions.add(`archived_at IS NULL`)
+	}
+
+	if filter == nil {
+		return
+	}
+
+	for _, techSkill := range filter.TechSkills() {
+		conditions.add(personaArrayContainsSql("Tech Skills"), techSkill)
+	}
+
+	if filter.MinYoE() != nil {
+		conditions.add(fmt.Sprintf(`%s >= %%s`, personaYoESql()), *filter.MinYoE())
+	}
+
+	if filter.MaxYoE() != nil {
+		conditions.add(fmt.Sprintf(`%s <= %%s`, personaYoESql()), *filter.MaxYoE())
+	}
+
+	if filter.City() != "" {
+		conditions.add(fmt.Sprintf(`lower(%s) = lower(%%s)`, personaTextSql("City")), filter.City())
+	}
+
+	if filter.State() != "" {
+		conditions.add(fmt.Sprintf(`lower(%s) = lower(%%s)`, personaTextSql("State")), filter.State())
+	}
+
+	if filter.Country() != "" {
+		conditions.add(fmt.Sprintf(`lower(%s) = lower(%%s)`, personaTextSql("Country")), filter.Country())
+	}
+
+	if filter.RecommendedRole() != "" {
+		conditions.add(personaArrayMatchesSql("Recommended Roles"), filter.RecommendedRole())
+	}
+
+	if filter.Certification() != "" {
+		conditions.add(personaArrayMatchesSql("Certifications"), filter.Certification())
+	}
+
+	if filter.BuiltBy() != "" {
+		conditions.add(fmt.Sprintf(`%s = %%s`, personaTextSql("BuiltBy")), filter.BuiltBy())
+	}
+
+	if len(filter.PipelineStageIds()) > 0 {
+		conditions.add(`pipeline_stage_id = ANY(%s)`, pq.Array(filter.PipelineStageIds()))
+	}
+
+	if len(filter.AnyTagIds()) > 0 {
+		conditions.add(
+			`EXISTS (SELECT 1 FROM public."candidate_tags" AS ct WHERE ct.candidate_id = candidates.id AND ct.tag_id = ANY(%s))`,
+			pq.Array(filter.AnyTagIds()),
+		)
+	}
+
+	if len(filter.AllTagIds()) > 0 {
+		conditions.add(
+			`(SELECT count(*) FROM public."candidate_tags" AS ct WHERE ct.candidate_id = candidates.id AND ct.tag_id = ANY(%s)) = %s`,
+			pq.Array(filter.AllTagIds()), len(filter.AllTagIds()),
+		)
+	}
+}
+
+func candidateSortKeysetColumn(sort model.CandidateSort) keysetColumn {
+	column := keysetColumn{
+		descending: sort.Descending(),
+	}
+	switch sort.Field() {
+	case "UPDATED_AT":
+		column.name = "updated_at"
+		column.expression = "updated_at"
+		column.sqlType = "timestamptz"
+	case "NAME":
+		column.name = "name"
+		column.expression = fmt.Sprintf(`lower(COALESCE(%s, ''))`, personaTextSql("Name"))
+		column.sqlType = "text"
+	case "YOE":
+		column.name = "yoe"
+		column.expression = personaYoESql()
+		column.sqlType = "int"
+	default:
+		column.name = "created_at"
+		column.expression = "created_at"
+		column.sqlType = "timestamptz"
+	}
+	return column
+}
+
+// The default order is always appended so that results stay deterministic and pageable.
+func candidateKeysetColumns(filter *model.CandidateFilter) []keysetColumn {
+	columns := []keysetColumn{}
+	if filter != nil {
+		for _, sort := range filter.Sorts() {
+			columns = append(columns, candidateSortKeysetColumn(sort))
+		}
+	}
+	return append(
+		columns,
+		keysetColumn{name: "created_at", expression: "created_at", sqlType: "timestamptz"},
+		keysetColumn{name: "id", expression: "id", sqlType: "text"},
+	)
+}
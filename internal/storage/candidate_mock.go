@@ -3,24 +3,54 @@ package storage
 import "github.com/vipulvpatil/candidate-tracker-go/internal/model"
 
 type CandidateAccessorConfigurableMock struct {
-	CreateCandidateWithAiGeneratedPersonaForTeamUsingTxInternal func(persona *model.Persona, team *model.Team, tx DatabaseTransaction) error
-	GetCandidatesForTeamInternal                                func(team *model.Team) ([]*model.Candidate, error)
+	UpsertCandidateWithAiGeneratedPersonaForTeamUsingTxInternal func(persona *model.Persona, team *model.Team, tx DatabaseTransaction) error
+	GetCandidatesForTeamInternal                                func(team *model.Team, filter *model.CandidateFilter, page *model.PageRequest) ([]*model.Candidate, *model.PageInfo, error)
 	GetCandidateForTeamInternal                                 func(id string, team *model.Team) (*model.Candidate, error)
-	UpdateCandidateWithManuallyCreatedPersonaForTeamInternal    func(id string, persona *model.Persona, team *model.Team) (string, error)
+	SearchCandidatesForTeamInternal                             func(query string, team *model.Team, page *model.PageRequest) ([]*model.CandidateSearchResult, *model.PageInfo, error)
+	UpdateCandidateWithManuallyCreatedPersonaForTeamInternal    func(id string, persona *model.Persona, editedByUserId string, team *model.Team) (string, error)
+	GetAllCandidatesForTeamInternal                             func(team *model.Team) ([]*model.Candidate, error)
+	MergeCandidatesForTeamInternal                              func(survivingCandidateId string, mergedCandidateIds []string, mergedByUserId string, team *model.Team) (*model.Candidate, error)
+	ArchiveCandidateForTeamInternal                             func(id string, team *model.Team) error
+	UnarchiveCandidateForTeamInternal                           func(id string, team *model.Team) error
+	DeleteCandidateForTeamInternal                              func(id string, team *model.Team) error
 }
 
-func (c *CandidateAccessorConfigurableMock) CreateCandidateWithAiGeneratedPersonaForTeamUsingTx(persona *model.Persona, team *model.Team, tx DatabaseTransaction) error {
-	return c.CreateCandidateWithAiGeneratedPersonaForTeamUsingTxInternal(persona, team, tx)
+func (c *CandidateAccessorConfigurableMock) UpsertCandidateWithAiGeneratedPersonaForTeamUsingTx(persona *model.Persona, team *model.Team, tx DatabaseTransaction) error {
+	return c.UpsertCandidateWithAiGeneratedPersonaForTeamUsingTxInternal(persona, team, tx)
 }
 
-func (c *CandidateAccessorConfigurableMock) GetCandidatesForTeam(team *model.Team) ([]*model.Candidate, error) {
-	return c.GetCandidatesForTeamInternal(team)
+func (c *CandidateAccessorConfigurableMock) GetCandidatesForTeam(team *model.Team, filter *model.CandidateFilter, page *model.PageRequest) ([]*model.Candidate, *model.PageInfo, error) {
+	return c.GetCandidatesForTeamInternal(team, filter, page)
 }
 
 func (c *CandidateAccessorConfigurableMock) GetCandidateForTeam(id string, team *model.Team) (*model.Candidate, error) {
 	return c.GetCandidateForTeamInternal(id, team)
 }
 
-func (c *CandidateAccessorConfigurableMock) UpdateCandidateWithManuallyCreatedPersonaForTeam(id string, persona *model.Persona, team *model.Team) (string, error) {
-	return c.UpdateCandidateWithManuallyCreatedPersonaForTeamInternal(id, persona, team)
+func (c *CandidateAccessorConfigurableMock) SearchCandidatesForTeam(query string, team *model.Team, page *model.PageRequest) ([]*model.CandidateSearchResult, *model.PageInfo, error) {
+	return c.SearchCandidatesForTeamInternal(query, team, page)
+}
+
+func (c *CandidateAccessorConfigurableMock) UpdateCandidateWithManuallyCreatedPersonaForTeam(id string, persona *model.Persona, editedByUserId string, team *model.Team) (string, error) {
+	return c.UpdateCandidateWithManuallyCreatedPersonaForTeamInternal(id, persona, editedByUserId, team)
+}
+
+func (c *CandidateAccessorConfigurableMock) GetAllCandidatesForTeam(team *model.Team) ([]*model.Candidate, error) {
+	return c.GetAllCandidatesForTeamInternal(team)
+}
+
+func (c *CandidateAccessorConfigurableMock) MergeCandidatesForTeam(survivingCandidateId string, mergedCandidateIds []string, mergedByUserId string, team *model.Team) (*model.Candidate, error) {
+	return c.MergeCandidatesForTeamInternal(survivingCandidateId, mergedCandidateIds, mergedByUserId, team)
+}
+
+func (c *CandidateAccessorConfigurableMock) ArchiveCandidateForTeam(id string, team *model.Team) error {
+	return c.ArchiveCandidateForTeamInternal(id, team)
+}
+
+func (c *CandidateAccessorConfigurableMock) UnarchiveCandidateForTeam(id string, team *model.Team) error {
+	return c.UnarchiveCandidateForTeamInternal(id, team)
+}
+
+func (c *CandidateAccessorConfigurableMock) DeleteCandidateForTeam(id string, team *model.Team) error {
+	return c.DeleteCandidateForTeamInternal(id, team)
 }
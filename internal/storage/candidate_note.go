@@ -0,0 +1,250 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+type CandidateNoteAccessor interface {
+	AddCandidateNoteForTeam(candidateId, body, authorUserId string, team *model.Team) (*model.CandidateNote, error)
+	GetCandidateNotesForTeam(candidateId string, team *model.Team) ([]*model.CandidateNote, error)
+	UpdateCandidateNoteForTeam(id, body, authorUserId string, team *model.Team) (*model.CandidateNote, error)
+	DeleteCandidateNoteForTeam(id, authorUserId string, team *model.Team) error
+}
+
+func (s *Storage) AddCandidateNoteForTeam(candidateId, body, authorUserId string, team *model.Team) (*model.CandidateNote, error) {
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	if utilities.IsBlank(body) {
+		return nil, errors.New("body cannot be blank")
+	}
+
+	if utilities.IsBlank(authorUserId) {
+		return nil, errors.New("authorUserId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	id := s.IdGenerator.Generate()
+	var createdAt, updatedAt time.Time
+	var authorUserEmail sql.NullString
+	row := s.db.QueryRow(
+		`INSERT INTO public."candidate_notes"
+		("id", "candidate_id", "author_user_id", "body")
+		SELECT $1, c.id, $3, $4
+		FROM public."candidates" AS c
+		WHERE c.id = $2 AND c.team_id = $5
+		RETURNING created_at, updated_at, (SELECT email FROM public."users" WHERE id = author_user_id)`,
+		id, candidateId, authorUserId, body, team.Id(),
+	)
+	err := row.Scan(&createdAt, &updatedAt, &authorUserEmail)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Errorf("no candidate for id %s", candidateId)
+		}
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while inserting CandidateNote: %s", id))
+	}
+
+	return model.NewCandidateNote(model.CandidateNoteOptions{
+		Id:              id,
+		CandidateId:     candidateId,
+		AuthorUserId:    authorUserId,
+		AuthorUserEmail: authorUserEmail.String,
+		Body:            body,
+		CreatedAt:       createdAt,
+		UpdatedAt:       updatedAt,
+	})
+}
+
+// GetCandidateNotesForTeam returns the notes on a candidate, oldest first.
+func (s *Storage) GetCandidateNotesForTeam(candidateId string, team *model.Team) ([]*model.CandidateNote, error) {
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	rows, err := s.db.Query(
+		`SELECT n.id, n.author_user_id, u.email, n.body, n.created_at, n.updated_at
+		FROM public."candidate_notes" AS n
+		JOIN public."candidates" AS c ON c.id = n.candidate_id
+		LEFT JOIN public."users" AS u ON u.id = n.author_user_id
+		WHERE n.candidate_id = $1 AND c.team_id = $2
+		ORDER BY n.created_at ASC, n.id ASC`,
+		candidateId, team.Id(),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to select candidate notes")
+	}
+	defer rows.Close()
+
+	notes := []*model.CandidateNote{}
+	for rows.Next() {
+		var id, body string
+		var authorUserId, authorUserEmail sql.NullString
+		var createdAt, updatedAt time.Time
+		err := rows.Scan(&id, &authorUserId, &authorUserEmail, &body, &createdAt, &updatedAt)
+		if err != nil {
+			return nil, utilities.WrapBadError(err, "failed while scanning rows")
+		}
+
+		note, err := model.NewCandidateNote(model.CandidateNoteOptions{
+			Id:              id,
+			CandidateId:     candidateId,
+			AuthorUserId:    authorUserId.String,
+			AuthorUserEmail: authorUserEmail.String,
+			Body:            body,
+			CreatedAt:       createdAt,
+			UpdatedAt:       updatedAt,
+		})
+		if err != nil {
+			// TODO: Log this error?
+			continue
+		}
+
+		notes = append(notes, note)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to correctly go through candidate notes rows")
+	}
+	return notes, nil
+}
+
+// UpdateCandidateNoteForTeam only lets the author of a note change it.
+func (s *Storage) UpdateCandidateNoteForTeam(id, body, authorUserId string, team *model.Team) (*model.CandidateNote, error) {
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	if utilities.IsBlank(body) {
+		return nil, errors.New("body cannot be blank")
+	}
+
+	if utilities.IsBlank(authorUserId) {
+		return nil, errors.New("authorUserId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	tx, err := s.BeginTransaction()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to start db transaction")
+	}
+	defer tx.Rollback()
+
+	err = checkCandidateNoteAuthorUsingTx(id, authorUserId, team, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidateId string
+	var createdAt, updatedAt time.Time
+	var authorUserEmail sql.NullString
+	row := tx.QueryRow(
+		`UPDATE public."candidate_notes" SET "body" = $2 WHERE id = $1
+		RETURNING candidate_id, created_at, updated_at, (SELECT email FROM public."users" WHERE id = author_user_id)`,
+		id, body,
+	)
+	err = row.Scan(&candidateId, &createdAt, &updatedAt, &authorUserEmail)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while updating CandidateNote: %s", id))
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "dbError while updating candidate note tx")
+	}
+
+	return model.NewCandidateNote(model.CandidateNoteOptions{
+		Id:              id,
+		CandidateId:     candidateId,
+		AuthorUserId:    authorUserId,
+		AuthorUserEmail: authorUserEmail.String,
+		Body:            body,
+		CreatedAt:       createdAt,
+		UpdatedAt:       updatedAt,
+	})
+}
+
+// DeleteCandidateNoteForTeam only lets the author of a note delete it.
+func (s *Storage) DeleteCandidateNoteForTeam(id, authorUserId string, team *model.Team) error {
+	if utilities.IsBlank(id) {
+		return errors.New("id cannot be blank")
+	}
+
+	if utilities.IsBlank(authorUserId) {
+		return errors.New("authorUserId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return errors.New("team cannot be blank")
+	}
+
+	tx, err := s.BeginTransaction()
+	if err != nil {
+		return utilities.WrapBadError(err, "failed to start db transaction")
+	}
+	defer tx.Rollback()
+
+	err = checkCandidateNoteAuthorUsingTx(id, authorUserId, team, tx)
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.Exec(`DELETE FROM public."candidate_notes" WHERE id = $1`, id)
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while deleting CandidateNote: %s", id))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while deleting CandidateNote and changing db: %s", id))
+	}
+	if rowsAffected != 1 {
+		return utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when deleting CandidateNote in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return utilities.WrapBadError(err, "dbError while deleting candidate note tx")
+	}
+	return nil
+}
+
+// checkCandidateNoteAuthorUsingTx locks the note until the transaction ends.
+func checkCandidateNoteAuthorUsingTx(id, authorUserId string, team *model.Team, tx DatabaseTransaction) error {
+	var noteAuthorUserId sql.NullString
+	row := tx.QueryRow(
+		`SELECT n.author_user_id
+		FROM public."candidate_notes" AS n
+		JOIN public."candidates" AS c ON c.id = n.candidate_id
+		WHERE n.id = $1 AND c.team_id = $2
+		FOR UPDATE OF n`,
+		id, team.Id(),
+	)
+	err := row.Scan(&noteAuthorUserId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errors.Errorf("no candidate note for id %s", id)
+		}
+		return utilities.WrapBadError(err, fmt.Sprintf("failed to select CandidateNote: %s", id))
+	}
+	if noteAuthorUserId.String != authorUserId {
+		return errors.Errorf("only the author can change candidate note %s", id)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package storage
+
+import "github.com/vipulvpatil/candidate-tracker-go/internal/model"
+
+type CandidateNoteAccessorConfigurableMock struct {
+	AddCandidateNoteForTeamInternal    func(candidateId, body, authorUserId string, team *model.Team) (*model.CandidateNote, error)
+	GetCandidateNotesForTeamInternal   func(candidateId string, team *model.Team) ([]*model.CandidateNote, error)
+	UpdateCandidateNoteForTeamInternal func(id, body, authorUserId string, team *model.Team) (*model.CandidateNote, error)
+	DeleteCandidateNoteForTeamInternal func(id, authorUserId string, team *model.Team) error
+}
+
+func (c *CandidateNoteAccessorConfigurableMock) AddCandidateNoteForTeam(candidateId, body, authorUserId string, team *model.Team) (*model.CandidateNote, error) {
+	return c.AddCandidateNoteForTeamInternal(candidateId, body, authorUserId, team)
+}
+
+func (c *CandidateNoteAccessorConfigurableMock) GetCandidateNotesForTeam(candidateId string, team *model.Team) ([]*model.CandidateNote, error) {
+	return c.GetCandidateNotesForTeamInternal(candidateId, team)
+}
+
+func (c *CandidateNoteAccessorConfigurableMock) UpdateCandidateNoteForTeam(id, body, authorUserId string, team *model.Team) (*model.CandidateNote, error) {
+	return c.UpdateCandidateNoteForTeamInternal(id, body, authorUserId, team)
+}
+
+func (c *CandidateNoteAccessorConfigurableMock) DeleteCandidateNoteForTeam(id, authorUserId string, team *model.Team) error {
+	return c.DeleteCandidateNoteForTeamInternal(id, authorUserId, team)
+}
@@ -0,0 +1,497 @@
+package storage
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+func candidateNoteBodyInDb(t *testing.T, db *sql.DB, id string) string {
+	var body string
+	row := db.QueryRow(`SELECT body FROM public."candidate_notes" WHERE id = $1`, id)
+	err := row.Scan(&body)
+	if err == sql.ErrNoRows {
+		return ""
+	}
+	assert.NoError(t, err)
+	return body
+}
+
+func Test_AddCandidateNoteForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{Query: `INSERT INTO public."users" ("id", "email", "team_id") VALUES ('user_id1', 'test@example.com', 'team_id1')`},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, 'team_id1'), ('c_id2', $1, 'team_id2')`,
+			Args: []any{&persona},
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."users" WHERE id = 'user_id1'`},
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+	tests := []struct {
+		name  string
+		input struct {
+			candidateId  string
+			body         string
+			authorUserId string
+		}
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when candidateId is empty",
+			input: struct {
+				candidateId  string
+				body         string
+				authorUserId string
+			}{
+				body:         "note",
+				authorUserId: "user_id1",
+			},
+			errorExpected: true,
+			errorString:   "candidateId cannot be blank",
+		},
+		{
+			name: "errors when body is empty",
+			input: struct {
+				candidateId  string
+				body         string
+				authorUserId string
+			}{
+				candidateId:  "c_id1",
+				body:         " ",
+				authorUserId: "user_id1",
+			},
+			errorExpected: true,
+			errorString:   "body cannot be blank",
+		},
+		{
+			name: "errors when authorUserId is empty",
+			input: struct {
+				candidateId  string
+				body         string
+				authorUserId string
+			}{
+				candidateId: "c_id1",
+				body:        "note",
+			},
+			errorExpected: true,
+			errorString:   "authorUserId cannot be blank",
+		},
+		{
+			name: "errors when candidate belongs to another team",
+			input: struct {
+				candidateId  string
+				body         string
+				authorUserId string
+			}{
+				candidateId:  "c_id2",
+				body:         "note",
+				authorUserId: "user_id1",
+			},
+			errorExpected: true,
+			errorString:   "no candidate for id c_id2",
+		},
+		{
+			name: "adds the note",
+			input: struct {
+				candidateId  string
+				body         string
+				authorUserId string
+			}{
+				candidateId:  "c_id1",
+				body:         "Strong systems design round",
+				authorUserId: "user_id1",
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db:          testDb,
+					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "cn_id1"},
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			note, err := s.AddCandidateNoteForTeam(tt.input.candidateId, tt.input.body, tt.input.authorUserId, team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, "cn_id1", note.Id())
+				assert.Equal(t, "c_id1", note.CandidateId())
+				assert.Equal(t, "user_id1", note.AuthorUserId())
+				assert.Equal(t, "test@example.com", note.AuthorUserEmail())
+				assert.False(t, note.CreatedAt().IsZero())
+				assert.Equal(t, "Strong systems design round", candidateNoteBodyInDb(t, s.db, "cn_id1"))
+			} else {
+				assert.Nil(t, note)
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+				assert.Equal(t, "", candidateNoteBodyInDb(t, s.db, "cn_id1"))
+			}
+		})
+	}
+}
+
+func Test_GetCandidateNotesForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{Query: `INSERT INTO public."users" ("id", "email", "team_id") VALUES ('user_id1', 'test@example.com', 'team_id1')`},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, 'team_id1'), ('c_id2', $1, 'team_id2')`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."candidate_notes" ("id", "candidate_id", "author_user_id", "body", "created_at")
+					VALUES
+					('cn_id2', 'c_id1', 'user_id1', 'second note', '2023-01-02 00:00:00+00'),
+					('cn_id1', 'c_id1', NULL, 'first note', '2023-01-01 00:00:00+00'),
+					('cn_id3', 'c_id2', NULL, 'other team note', '2023-01-01 00:00:00+00')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."users" WHERE id = 'user_id1'`},
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+	tests := []struct {
+		name          string
+		input         string
+		outputIds     []string
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name:          "errors when candidateId is empty",
+			input:         "",
+			outputIds:     []string{},
+			errorExpected: true,
+			errorString:   "candidateId cannot be blank",
+		},
+		{
+			name:          "returns nothing for a candidate of another team",
+			input:         "c_id2",
+			outputIds:     []string{},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name:          "returns the notes oldest first",
+			input:         "c_id1",
+			outputIds:     []string{"cn_id1", "cn_id2"},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	s, _ := NewDbStorage(
+		StorageOptions{
+			Db: testDb,
+		},
+	)
+	runSqlOnDb(t, s.db, setupSqlStmts)
+	defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notes, err := s.GetCandidateNotesForTeam(tt.input, team)
+			noteIds := []string{}
+			for _, note := range notes {
+				noteIds = append(noteIds, note.Id())
+			}
+			assert.Equal(t, tt.outputIds, noteIds)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if len(notes) == 2 {
+				assert.Equal(t, "", notes[0].AuthorUserEmail())
+				assert.Equal(t, "second note", notes[1].Body())
+				assert.Equal(t, "test@example.com", notes[1].AuthorUserEmail())
+			}
+		})
+	}
+}
+
+func Test_UpdateCandidateNoteForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."users" ("id", "email", "team_id")
+					VALUES ('user_id1', 'test@example.com', 'team_id1'), ('user_id2', 'test2@example.com', 'team_id1')`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, 'team_id1'), ('c_id2', $1, 'team_id2')`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."candidate_notes" ("id", "candidate_id", "author_user_id", "body")
+					VALUES ('cn_id1', 'c_id1', 'user_id1', 'note'), ('cn_id2', 'c_id2', 'user_id1', 'note')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."users" WHERE id IN ('user_id1', 'user_id2')`},
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+	tests := []struct {
+		name  string
+		input struct {
+			id           string
+			body         string
+			authorUserId string
+		}
+		expectedBody  string
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when id is empty",
+			input: struct {
+				id           string
+				body         string
+				authorUserId string
+			}{
+				body:         "edited note",
+				authorUserId: "user_id1",
+			},
+			expectedBody:  "note",
+			errorExpected: true,
+			errorString:   "id cannot be blank",
+		},
+		{
+			name: "errors when body is empty",
+			input: struct {
+				id           string
+				body         string
+				authorUserId string
+			}{
+				id:           "cn_id1",
+				authorUserId: "user_id1",
+			},
+			expectedBody:  "note",
+			errorExpected: true,
+			errorString:   "body cannot be blank",
+		},
+		{
+			name: "errors when note belongs to another team",
+			input: struct {
+				id           string
+				body         string
+				authorUserId string
+			}{
+				id:           "cn_id2",
+				body:         "edited note",
+				authorUserId: "user_id1",
+			},
+			expectedBody:  "note",
+			errorExpected: true,
+			errorString:   "no candidate note for id cn_id2",
+		},
+		{
+			name: "errors when user is not the author",
+			input: struct {
+				id           string
+				body         string
+				authorUserId string
+			}{
+				id:           "cn_id1",
+				body:         "edited note",
+				authorUserId: "user_id2",
+			},
+			expectedBody:  "note",
+			errorExpected: true,
+			errorString:   "only the author can change candidate note cn_id1",
+		},
+		{
+			name: "updates the note",
+			input: struct {
+				id           string
+				body         string
+				authorUserId string
+			}{
+				id:           "cn_id1",
+				body:         "edited note",
+				authorUserId: "user_id1",
+			},
+			expectedBody:  "edited note",
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			note, err := s.UpdateCandidateNoteForTeam(tt.input.id, tt.input.body, tt.input.authorUserId, team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, "c_id1", note.CandidateId())
+				assert.Equal(t, "edited note", note.Body())
+				assert.Equal(t, "test@example.com", note.AuthorUserEmail())
+			} else {
+				assert.Nil(t, note)
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			assert.Equal(t, tt.expectedBody, candidateNoteBodyInDb(t, s.db, "cn_id1"))
+		})
+	}
+}
+
+func Test_DeleteCandidateNoteForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1')`},
+		{
+			Query: `INSERT INTO public."users" ("id", "email", "team_id")
+					VALUES ('user_id1', 'test@example.com', 'team_id1'), ('user_id2', 'test2@example.com', 'team_id1')`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id") VALUES ('c_id1', $1, 'team_id1')`,
+			Args:  []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."candidate_notes" ("id", "candidate_id", "author_user_id", "body")
+					VALUES ('cn_id1', 'c_id1', 'user_id1', 'note')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."users" WHERE id IN ('user_id1', 'user_id2')`},
+		{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+	}
+	tests := []struct {
+		name  string
+		input struct {
+			id           string
+			authorUserId string
+		}
+		expectedBody  string
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when id is empty",
+			input: struct {
+				id           string
+				authorUserId string
+			}{
+				authorUserId: "user_id1",
+			},
+			expectedBody:  "note",
+			errorExpected: true,
+			errorString:   "id cannot be blank",
+		},
+		{
+			name: "errors when note does not exist",
+			input: struct {
+				id           string
+				authorUserId string
+			}{
+				id:           "cn_id2",
+				authorUserId: "user_id1",
+			},
+			expectedBody:  "note",
+			errorExpected: true,
+			errorString:   "no candidate note for id cn_id2",
+		},
+		{
+			name: "errors when user is not the author",
+			input: struct {
+				id           string
+				authorUserId string
+			}{
+				id:           "cn_id1",
+				authorUserId: "user_id2",
+			},
+			expectedBody:  "note",
+			errorExpected: true,
+			errorString:   "only the author can change candidate note cn_id1",
+		},
+		{
+			name: "deletes the note",
+			input: struct {
+				id           string
+				authorUserId string
+			}{
+				id:           "cn_id1",
+				authorUserId: "user_id1",
+			},
+			expectedBody:  "",
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			err := s.DeleteCandidateNoteForTeam(tt.input.id, tt.input.authorUserId, team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			assert.Equal(t, tt.expectedBody, candidateNoteBodyInDb(t, s.db, "cn_id1"))
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+type CandidatePersonaRevisionAccessor interface {
+	GetCandidatePersonaRevisionsForTeam(candidateId string, team *model.Team) ([]*model.CandidatePersonaRevision, error)
+	GetCandidatePersonaRevisionForTeam(id string, team *model.Team) (*model.CandidatePersonaRevision, error)
+	RevertCandidatePersonaForTeam(candidateId, revisionId, editedByUserId string, team *model.Team) (*model.CandidatePersonaRevision, error)
+}
+
+// GetCandidatePersonaRevisionsForTeam returns the persona history of a candidate, oldest first.
+func (s *Storage) GetCandidatePersonaRevisionsForTeam(candidateId string, team *model.Team) ([]*model.CandidatePersonaRevision, error) {
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	rows, err := s.db.Query(
+		`SELECT r.id, r.persona, r.edited_by_user_id, u.email, r.reverted_from_revision_id, r.created_at
+		FROM public."candidate_persona_revisions" AS r
+		JOIN public."candidates" AS c ON c.id = r.candidate_id
+		LEFT JOIN public."users" AS u ON u.id = r.edited_by_user_id
+		WHERE r.candidate_id = $1 AND c.team_id = $2
+		ORDER BY r.created_at ASC, r.id ASC`,
+		candidateId, team.Id(),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to select candidate persona revisions")
+	}
+	defer rows.Close()
+
+	revisions := []*model.CandidatePersonaRevision{}
+	for rows.Next() {
+		var id string
+		var persona model.Persona
+		var editedByUserId, editedByUserEmail, revertedFromRevisionId sql.NullString
+		var createdAt time.Time
+		err := rows.Scan(&id, &persona, &editedByUserId, &editedByUserEmail, &revertedFromRevisionId, &createdAt)
+		if err != nil {
+			return nil, utilities.WrapBadError(err, "failed while scanning rows")
+		}
+
+		revision, err := model.NewCandidatePersonaRevision(model.CandidatePersonaRevisionOptions{
+			Id:                     id,
+			CandidateId:            candidateId,
+			Persona:                &persona,
+			EditedByUserId:         editedByUserId.String,
+			EditedByUserEmail:      editedByUserEmail.String,
+			RevertedFromRevisionId: revertedFromRevisionId.String,
+			CreatedAt:              createdAt,
+		})
+		if err != nil {
+			// TODO: Log this error?
+			continue
+		}
+
+		revisions = append(revisions, revision)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to correctly go through candidate persona revisions rows")
+	}
+	return revisions, nil
+}
+
+func (s *Storage) GetCandidatePersonaRevisionForTeam(id string, team *model.Team) (*model.CandidatePersonaRevision, error) {
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	var candidateId string
+	var persona model.Persona
+	var editedByUserId, editedByUserEmail, revertedFromRevisionId sql.NullString
+	var createdAt time.Time
+	row := s.db.QueryRow(
+		`SELECT r.candidate_id, r.persona, r.edited_by_user_id, u.email, r.reverted_from_revision_id, r.created_at
+		FROM public."candidate_persona_revisions" AS r
+		JOIN public."candidates" AS c ON c.id = r.candidate_id
+		LEFT JOIN public."users" AS u ON u.id = r.edited_by_user_id
+		WHERE r.id = $1 AND c.team_id = $2`,
+		id, team.Id(),
+	)
+	err := row.Scan(&candidateId, &persona, &editedByUserId, &editedByUserEmail, &revertedFromRevisionId, &createdAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Errorf("no candidate persona revision for id %s", id)
+		}
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("failed to select CandidatePersonaRevision: %s", id))
+	}
+
+	return model.NewCandidatePersonaRevision(model.CandidatePersonaRevisionOptions{
+		Id:                     id,
+		CandidateId:            candidateId,
+		Persona:                &persona,
+		EditedByUserId:         editedByUserId.String,
+		EditedByUserEmail:      editedByUserEmail.String,
+		RevertedFromRevisionId: revertedFromRevisionId.String,
+		CreatedAt:              createdAt,
+	})
+}
+
+// RevertCandidatePersonaForTeam makes the persona of an earlier revision the manually created persona of the candidate.
+// The revert is recorded as a new revision, so history is never rewritten.
+func (s *Storage) RevertCandidatePersonaForTeam(candidateId, revisionId, editedByUserId string, team *model.Team) (*model.CandidatePersonaRevision, error) {
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	if utilities.IsBlank(revisionId) {
+		return nil, errors.New("revisionId cannot be blank")
+	}
+
+	if utilities.IsBlank(editedByUserId) {
+		return nil, errors.New("editedByUserId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	tx, err := s.BeginTransaction()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to start db transaction")
+	}
+	defer tx.Rollback()
+
+	var lockedCandidateId string
+	row := tx.QueryRow(
+		`SELECT id FROM public."candidates" WHERE id = $1 AND team_id = $2 FOR UPDATE`,
+		candidateId, team.Id(),
+	)
+	err = row.Scan(&lockedCandidateId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Errorf("no candidate for id %s", candidateId)
+		}
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("failed to select Candidate: %s", candidateId))
+	}
+
+	var persona model.Persona
+	row = tx.QueryRow(
+		`SELECT persona FROM public."candidate_persona_revisions" WHERE id = $1 AND candidate_id = $2`,
+		revisionId, candidateId,
+	)
+	err = row.Scan(&persona)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Errorf("no candidate persona revision for id %s", revisionId)
+		}
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("failed to select CandidatePersonaRevision: %s", revisionId))
+	}
+	persona.BuiltBy = "HUMAN"
+
+	result, err := tx.Exec(
+		`UPDATE public."candidates" SET "manually_created_persona" = $2 WHERE id = $1`,
+		candidateId, &persona,
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while reverting Candidate: %s", candidateId))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while checking affected row while reverting Candidate: %s", candidateId))
+	}
+	if rowsAffected != 1 {
+		return nil, utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when reverting Candidate in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+
+	revision, err := s.insertCandidatePersonaRevisionUsingTx(candidateId, &persona, editedByUserId, revisionId, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "dbError while reverting candidate persona tx")
+	}
+	return revision, nil
+}
+
+// insertCandidatePersonaRevisionUsingTx records a persona that was just written to a candidate.
+// editedByUserId is blank for personas built by AI.
+func (s *Storage) insertCandidatePersonaRevisionUsingTx(candidateId string, persona *model.Persona, editedByUserId, revertedFromRevisionId string, tx DatabaseTransaction) (*model.CandidatePersonaRevision, error) {
+	id := s.IdGenerator.Generate()
+	var createdAt time.Time
+	var editedByUserEmail sql.NullString
+	row := tx.QueryRow(
+		`INSERT INTO public."candidate_persona_revisions"
+		("id", "candidate_id", "persona", "built_by", "builder_version", "edited_by_user_id", "reverted_from_revision_id")
+		VALUES
+		($1, $2, $3, $4, $5, NULLIF($6, ''), NULLIF($7, ''))
+		RETURNING created_at, (SELECT email FROM public."users" WHERE id = edited_by_user_id)`,
+		id, candidateId, persona, persona.BuiltBy, persona.BuilderVersion, editedByUserId, revertedFromRevisionId,
+	)
+	err := row.Scan(&createdAt, &editedByUserEmail)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while inserting CandidatePersonaRevision: %s", id))
+	}
+
+	return model.NewCandidatePersonaRevision(model.CandidatePersonaRevisionOptions{
+		Id:                     id,
+		CandidateId:            candidateId,
+		Persona:                persona,
+		EditedByUserId:         editedByUserId,
+		EditedByUserEmail:      editedByUserEmail.String,
+		RevertedFromRevisionId: revertedFromRevisionId,
+		CreatedAt:              createdAt,
+	})
+}
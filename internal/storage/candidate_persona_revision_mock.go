@@ -0,0 +1,21 @@
+package storage
+
+import "github.com/vipulvpatil/candidate-tracker-go/internal/model"
+
+type CandidatePersonaRevisionAccessorConfigurableMock struct {
+	GetCandidatePersonaRevisionsForTeamInternal func(candidateId string, team *model.Team) ([]*model.CandidatePersonaRevision, error)
+	GetCandidatePersonaRevisionForTeamInternal  func(id string, team *model.Team) (*model.CandidatePersonaRevision, error)
+	RevertCandidatePersonaForTeamInternal       func(candidateId, revisionId, editedByUserId string, team *model.Team) (*model.CandidatePersonaRevision, error)
+}
+
+func (c *CandidatePersonaRevisionAccessorConfigurableMock) GetCandidatePersonaRevisionsForTeam(candidateId string, team *model.Team) ([]*model.CandidatePersonaRevision, error) {
+	return c.GetCandidatePersonaRevisionsForTeamInternal(candidateId, team)
+}
+
+func (c *CandidatePersonaRevisionAccessorConfigurableMock) GetCandidatePersonaRevisionForTeam(id string, team *model.Team) (*model.CandidatePersonaRevision, error) {
+	return c.GetCandidatePersonaRevisionForTeamInternal(id, team)
+}
+
+func (c *CandidatePersonaRevisionAccessorConfigurableMock) RevertCandidatePersonaForTeam(candidateId, revisionId, editedByUserId string, team *model.Team) (*model.CandidatePersonaRevision, error) {
+	return c.RevertCandidatePersonaForTeamInternal(candidateId, revisionId, editedByUserId, team)
+}
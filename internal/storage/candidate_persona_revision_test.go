@@ -0,0 +1,250 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+func Test_GetCandidatePersonaRevisionsForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	aiPersona := model.Persona{Name: "Jane D", BuiltBy: "AI", BuilderVersion: "v1"}
+	manualPersona := model.Persona{Name: "Jane Doe", Phone: "9876543210", BuiltBy: "HUMAN"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{Query: `INSERT INTO public."users" ("id", "email", "team_id") VALUES ('user_id1', 'test@example.com', 'team_id1')`},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "ai_generated_persona", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, $2, 'team_id1'), ('c_id2', $1, NULL, 'team_id2')`,
+			Args: []any{&aiPersona, &manualPersona},
+		},
+		{
+			Query: `INSERT INTO public."candidate_persona_revisions" ("id", "candidate_id", "persona", "built_by", "builder_version", "edited_by_user_id", "created_at")
+					VALUES
+					('pr_id1', 'c_id1', $1, 'AI', 'v1', NULL, '2023-01-01'),
+					('pr_id2', 'c_id1', $2, 'HUMAN', '', 'user_id1', '2023-01-02'),
+					('pr_id3', 'c_id2', $1, 'AI', 'v1', NULL, '2023-01-03')`,
+			Args: []any{&aiPersona, &manualPersona},
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+
+	tests := []struct {
+		name           string
+		input          string
+		expectedOutput []string
+	}{
+		{
+			name:           "returns nothing for a candidate of another team",
+			input:          "c_id2",
+			expectedOutput: []string{},
+		},
+		{
+			name:           "returns the revisions of the candidate oldest first",
+			input:          "c_id1",
+			expectedOutput: []string{"pr_id1", "pr_id2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(StorageOptions{Db: testDb})
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+			revisions, err := s.GetCandidatePersonaRevisionsForTeam(tt.input, team)
+			assert.NoError(t, err)
+			ids := []string{}
+			for _, revision := range revisions {
+				ids = append(ids, revision.Id())
+			}
+			assert.Equal(t, tt.expectedOutput, ids)
+			if len(revisions) == 2 {
+				assert.Equal(t, "AI", revisions[0].BuiltBy())
+				assert.Equal(t, "v1", revisions[0].BuilderVersion())
+				assert.Equal(t, "", revisions[0].EditedByUserId())
+				assert.Equal(t, "user_id1", revisions[1].EditedByUserId())
+				assert.Equal(t, "test@example.com", revisions[1].EditedByUserEmail())
+				assert.Equal(t, "9876543210", revisions[1].Persona().Phone)
+			}
+		})
+	}
+}
+
+func Test_GetCandidatePersonaRevisionForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	aiPersona := model.Persona{Name: "Jane D", BuiltBy: "AI", BuilderVersion: "v1"}
+	manualPersona := model.Persona{Name: "Jane Doe", Phone: "9876543210", BuiltBy: "HUMAN"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{Query: `INSERT INTO public."users" ("id", "email", "team_id") VALUES ('user_id1', 'test@example.com', 'team_id1')`},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "ai_generated_persona", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, $2, 'team_id1'), ('c_id2', $1, NULL, 'team_id2')`,
+			Args: []any{&aiPersona, &manualPersona},
+		},
+		{
+			Query: `INSERT INTO public."candidate_persona_revisions" ("id", "candidate_id", "persona", "built_by", "builder_version", "edited_by_user_id", "created_at")
+					VALUES
+					('pr_id1', 'c_id1', $1, 'AI', 'v1', NULL, '2023-01-01'),
+					('pr_id2', 'c_id1', $2, 'HUMAN', '', 'user_id1', '2023-01-02'),
+					('pr_id3', 'c_id2', $1, 'AI', 'v1', NULL, '2023-01-03')`,
+			Args: []any{&aiPersona, &manualPersona},
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+
+	tests := []struct {
+		name          string
+		input         string
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name:          "errors when revision belongs to another team",
+			input:         "pr_id3",
+			errorExpected: true,
+			errorString:   "no candidate persona revision for id pr_id3",
+		},
+		{
+			name:          "returns the revision",
+			input:         "pr_id2",
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(StorageOptions{Db: testDb})
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+			revision, err := s.GetCandidatePersonaRevisionForTeam(tt.input, team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, "c_id1", revision.CandidateId())
+				assert.Equal(t, "Jane Doe", revision.Persona().Name)
+				assert.Equal(t, "user_id1", revision.EditedByUserId())
+			} else {
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_RevertCandidatePersonaForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	aiPersona := model.Persona{Name: "Jane D", BuiltBy: "AI", BuilderVersion: "v1"}
+	manualPersona := model.Persona{Name: "Jane Doe", Phone: "9876543210", BuiltBy: "HUMAN"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{Query: `INSERT INTO public."users" ("id", "email", "team_id") VALUES ('user_id1', 'test@example.com', 'team_id1')`},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "ai_generated_persona", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, $2, 'team_id1'), ('c_id2', $1, NULL, 'team_id2')`,
+			Args: []any{&aiPersona, &manualPersona},
+		},
+		{
+			Query: `INSERT INTO public."candidate_persona_revisions" ("id", "candidate_id", "persona", "built_by", "builder_version", "edited_by_user_id", "created_at")
+					VALUES
+					('pr_id1', 'c_id1', $1, 'AI', 'v1', NULL, '2023-01-01'),
+					('pr_id2', 'c_id1', $2, 'HUMAN', '', 'user_id1', '2023-01-02'),
+					('pr_id3', 'c_id2', $1, 'AI', 'v1', NULL, '2023-01-03')`,
+			Args: []any{&aiPersona, &manualPersona},
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+
+	tests := []struct {
+		name  string
+		input struct {
+			candidateId string
+			revisionId  string
+		}
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when candidate belongs to another team",
+			input: struct {
+				candidateId string
+				revisionId  string
+			}{candidateId: "c_id2", revisionId: "pr_id3"},
+			errorExpected: true,
+			errorString:   "no candidate for id c_id2",
+		},
+		{
+			name: "errors when revision belongs to another candidate",
+			input: struct {
+				candidateId string
+				revisionId  string
+			}{candidateId: "c_id1", revisionId: "pr_id3"},
+			errorExpected: true,
+			errorString:   "no candidate persona revision for id pr_id3",
+		},
+		{
+			name: "reverts the candidate and records the revert",
+			input: struct {
+				candidateId string
+				revisionId  string
+			}{candidateId: "c_id1", revisionId: "pr_id1"},
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db:          testDb,
+					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "pr_id4"},
+				},
+			)
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+			revision, err := s.RevertCandidatePersonaForTeam(tt.input.candidateId, tt.input.revisionId, "user_id1", team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, "pr_id4", revision.Id())
+				assert.Equal(t, "pr_id1", revision.RevertedFromRevisionId())
+				assert.Equal(t, "HUMAN", revision.BuiltBy())
+				assert.Equal(t, "test@example.com", revision.EditedByUserEmail())
+
+				var persona model.Persona
+				row := s.db.QueryRow(`SELECT manually_created_persona FROM public."candidates" WHERE id = 'c_id1'`)
+				assert.NoError(t, row.Scan(&persona))
+				assert.Equal(t, "Jane D", persona.Name)
+				assert.Equal(t, "", persona.Phone)
+			} else {
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
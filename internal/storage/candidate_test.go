@@ -5,12 +5,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
 )
 
-func Test_CreateCandidateWithAiGeneratedPersonaForTeamUsingTx(t *testing.T) {
+func Test_UpsertCandidateWithAiGeneratedPersonaForTeamUsingTx(t *testing.T) {
 	currentFileCount := 1
 	team, _ := model.NewTeam(model.TeamOptions{
 		Id:               "team_id1",
@@ -71,7 +72,7 @@ func Test_CreateCandidateWithAiGeneratedPersonaForTeamUsingTx(t *testing.T) {
 			cleanupSqlStmts: nil,
 			dbUpdateCheck:   nil,
 			errorExpected:   true,
-			errorString:     "THIS IS BAD: dbError while inserting Candidate: can_id1: pq: insert or update on table \"candidates\" violates foreign key constraint \"candidates_file_upload_id_fkey\"",
+			errorString:     "THIS IS BAD: dbError while upserting Candidate: can_id1: pq: insert or update on table \"candidates\" violates foreign key constraint \"candidates_file_upload_id_fkey\"",
 		},
 		{
 			name: "errors when team does not exist in Database",
@@ -105,7 +106,7 @@ func Test_CreateCandidateWithAiGeneratedPersonaForTeamUsingTx(t *testing.T) {
 			},
 			dbUpdateCheck: nil,
 			errorExpected: true,
-			errorString:   "THIS IS BAD: dbError while inserting Candidate: can_id1: pq: insert or update on table \"candidates\" violates foreign key constraint \"candidates_team_id_fkey\"",
+			errorString:   "THIS IS BAD: dbError while upserting Candidate: can_id1: pq: insert or update on table \"candidates\" violates foreign key constraint \"candidates_team_id_fkey\"",
 		},
 		{
 			name: "successfully creates a new candidate",
@@ -149,6 +150,79 @@ func Test_CreateCandidateWithAiGeneratedPersonaForTeamUsingTx(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Equal(t, "can_id1", id)
 				assert.Equal(t, "user_1", persona.Name)
+
+				var revisionPersona model.Persona
+				var builtBy string
+				var editedByUserId sql.NullString
+				row = db.QueryRow(`SELECT persona, built_by, edited_by_user_id FROM public."candidate_persona_revisions" WHERE candidate_id = 'can_id1'`)
+				assert.NoError(t, row.Scan(&revisionPersona, &builtBy, &editedByUserId))
+				assert.Equal(t, "user_1", revisionPersona.Name)
+				assert.Equal(t, "AI", builtBy)
+				assert.False(t, editedByUserId.Valid)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "replaces ai generated persona and keeps manually created persona of an existing candidate",
+			input: struct {
+				persona *model.Persona
+				team    *model.Team
+			}{
+				persona: &model.Persona{Name: "user_1 reprocessed", BuiltBy: "AI", FileUploadId: "fp_id1"},
+				team:    team,
+			},
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+								"id", "name"
+							)
+							VALUES (
+								'team_id1', 'Team1'
+							)`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+						"id", "name", "presigned_url", "status", "processing_status", "team_id"
+					)
+					VALUES (
+						'fp_id1', 'file1.pdf', 'https://presigned_url1', 'SUCCESS', 'ONGOING', 'team_id1'
+					)`,
+				},
+				{
+					Query: `INSERT INTO public."candidates" (
+						"id", "ai_generated_persona", "manually_created_persona", "team_id", "file_upload_id"
+					)
+					VALUES (
+						'can_id0', '{"Name": "user_1", "BuiltBy": "AI", "FileUploadId": "fp_id1"}', '{"Name": "user_1 edited", "BuiltBy": "HUMAN"}', 'team_id1', 'fp_id1'
+					)`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var count int
+				err := db.QueryRow(`SELECT count(*) FROM public."candidates" WHERE team_id = 'team_id1'`).Scan(&count)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, count)
+				var id string
+				var aiPersona, manualPersona model.Persona
+				row := db.QueryRow(
+					`SELECT id, ai_generated_persona, manually_created_persona FROM public."candidates" WHERE team_id = 'team_id1'`,
+				)
+				assert.NoError(t, row.Err())
+				err = row.Scan(&id, &aiPersona, &manualPersona)
+				assert.NoError(t, err)
+				assert.Equal(t, "can_id0", id)
+				assert.Equal(t, "user_1 reprocessed", aiPersona.Name)
+				assert.Equal(t, "user_1 edited", manualPersona.Name)
+
+				var revisionPersona model.Persona
+				row = db.QueryRow(`SELECT persona FROM public."candidate_persona_revisions" WHERE candidate_id = 'can_id0'`)
+				assert.NoError(t, row.Scan(&revisionPersona))
+				assert.Equal(t, "user_1 reprocessed", revisionPersona.Name)
 				return true
 			},
 			errorExpected: false,
@@ -169,7 +243,7 @@ func Test_CreateCandidateWithAiGeneratedPersonaForTeamUsingTx(t *testing.T) {
 			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
 			tx, err := s.BeginTransaction()
 			assert.NoError(t, err)
-			err = s.CreateCandidateWithAiGeneratedPersonaForTeamUsingTx(
+			err = s.UpsertCandidateWithAiGeneratedPersonaForTeamUsingTx(
 				tt.input.persona, tt.input.team, tx,
 			)
 			tx.Commit()
@@ -344,7 +418,7 @@ func Test_GetCandidatesForTeam(t *testing.T) {
 
 			runSqlOnDb(t, s.db, tt.setupSqlStmts)
 			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
-			candidates, err := s.GetCandidatesForTeam(tt.input)
+			candidates, _, err := s.GetCandidatesForTeam(tt.input, nil, nil)
 			assert.Equal(t, len(tt.output), len(candidates))
 			for i := range candidates {
 				assert.True(t, candidates[i].IsEqual(tt.output[i]), "candidate should be same")
@@ -359,7 +433,7 @@ func Test_GetCandidatesForTeam(t *testing.T) {
 	}
 }
 
-func Test_GetCandidateForTeam(t *testing.T) {
+func Test_GetCandidatesForTeamWithFilter(t *testing.T) {
 	currentFileCount := 1
 	team, _ := model.NewTeam(model.TeamOptions{
 		Id:               "team_id1",
@@ -368,146 +442,233 @@ func Test_GetCandidateForTeam(t *testing.T) {
 		FileCountLimit:   100,
 	})
 	persona1 := model.Persona{
-		Name:       "ai persona 2",
-		Email:      "email_2",
-		Phone:      "phone_2",
-		City:       "city_2",
-		State:      "state_2",
-		Country:    "country_2",
-		YoE:        2,
-		TechSkills: []string{"tech skill 10", "tech skill 20", "tech skill 30"},
+		Name:             "Zed",
+		City:             "Mumbai",
+		YoE:              5,
+		TechSkills:       []string{"Go", "React"},
+		RecommendedRoles: []string{"Senior Backend Engineer"},
+		Certifications:   []string{"AWS Certified Developer"},
+		BuiltBy:          "AI",
+		FileUploadId:     "fp_id1",
 	}
 	persona2 := model.Persona{
-		Name:       "manual persona 1",
-		Email:      "email_3",
-		Phone:      "phone_3",
-		City:       "city_3",
-		State:      "state_3",
-		Country:    "country_3",
-		YoE:        7,
-		TechSkills: []string{"tech skill 11", "tech skill 21", "tech skill 31"},
+		Name:         "Yan",
+		City:         "Pune",
+		YoE:          2,
+		TechSkills:   []string{"Go"},
+		BuiltBy:      "AI",
+		FileUploadId: "fp_id2",
 	}
-	candidate1, _ := model.NewCandidate(model.CandidateOptions{
-		Id:                     "c_id1",
-		AiGeneratedPersona:     &persona1,
-		ManuallyCreatedPersona: &persona2,
-		Team:                   team,
-		FileUploadId:           "fp_id1",
-	})
+	persona3 := model.Persona{
+		Name:       "amy",
+		City:       "mumbai",
+		YoE:        8,
+		TechSkills: []string{"Python"},
+		BuiltBy:    "HUMAN",
+	}
+	persona4 := model.Persona{
+		Name:       "Bob",
+		City:       "Delhi",
+		YoE:        1,
+		TechSkills: []string{"go", "Rust"},
+		BuiltBy:    "HUMAN",
+	}
+	persona5 := model.Persona{
+		Name:       "Archie",
+		City:       "Mumbai",
+		YoE:        4,
+		TechSkills: []string{"Go"},
+		BuiltBy:    "HUMAN",
+	}
+	three := 3
+	four := 4
+	setupSqlStmts := []TestSqlStmts{
+		{
+			Query: `INSERT INTO public."teams" (
+						"id", "name"
+					)
+					VALUES (
+						'team_id1', 'Team1'
+					)`,
+		},
+		{
+			Query: `INSERT INTO public."file_uploads" (
+						"id", "name", "presigned_url", "status", "processing_status", "team_id"
+					)
+					VALUES (
+						'fp_id1', 'file1.pdf', 'https://presigned_url1', 'INITIATED', 'NOT STARTED', 'team_id1'
+					), (
+						'fp_id2', 'file2.pdf', 'https://presigned_url2', 'INITIATED', 'NOT STARTED', 'team_id1'
+					)`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" (
+						"id", "ai_generated_persona", "manually_created_persona","team_id", "file_upload_id"
+					)
+					VALUES (
+						'c_id1', $1, $2, 'team_id1', 'fp_id1'
+					),(
+						'c_id2', $3, $4, 'team_id1', 'fp_id2'
+					),(
+						'c_id3', $5, $6, 'team_id1', NULL
+					)`,
+			Args: []any{
+				&persona1, nil,
+				&persona2, &persona3,
+				nil, &persona4,
+			},
+		},
+		{
+			Query: `INSERT INTO public."candidates" (
+						"id", "manually_created_persona","team_id", "archived_at"
+					)
+					VALUES (
+						'c_id4', $1, 'team_id1', CURRENT_TIMESTAMP
+					)`,
+			Args: []any{&persona5},
+		},
+		{
+			Query: `INSERT INTO public."pipeline_stages" (
+						"id", "team_id", "name", "position"
+					)
+					VALUES (
+						'ps_id1', 'team_id1', 'Sourced', 0
+					),(
+						'ps_id2', 'team_id1', 'Screening', 1
+					)`,
+		},
+		{
+			Query: `UPDATE public."candidates" SET "pipeline_stage_id" = 'ps_id1' WHERE id IN ('c_id1', 'c_id3')`,
+		},
+		{
+			Query: `INSERT INTO public."tags" ("id", "team_id", "name")
+					VALUES ('tag_id1', 'team_id1', 'referral'), ('tag_id2', 'team_id1', 'relocation-ok')`,
+		},
+		{
+			Query: `INSERT INTO public."candidate_tags" ("candidate_id", "tag_id")
+					VALUES ('c_id1', 'tag_id1'), ('c_id1', 'tag_id2'), ('c_id2', 'tag_id2'), ('c_id4', 'tag_id1')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+	}
+
 	tests := []struct {
-		name  string
-		input struct {
-			id   string
-			team *model.Team
-		}
-		output          *model.Candidate
-		setupSqlStmts   []TestSqlStmts
-		cleanupSqlStmts []TestSqlStmts
-		errorExpected   bool
-		errorString     string
+		name          string
+		input         model.CandidateFilterOptions
+		outputIds     []string
+		errorExpected bool
+		errorString   string
 	}{
 		{
-			name: "errors when id is empty",
-			input: struct {
-				id   string
-				team *model.Team
-			}{},
-			output:          nil,
-			setupSqlStmts:   nil,
-			cleanupSqlStmts: nil,
-			errorExpected:   true,
-			errorString:     "id cannot be blank",
+			name:      "returns all candidates that are not archived for an empty filter",
+			input:     model.CandidateFilterOptions{},
+			outputIds: []string{"c_id1", "c_id2", "c_id3"},
 		},
 		{
-			name: "errors when team is empty",
-			input: struct {
-				id   string
-				team *model.Team
-			}{
-				id: "c_id1",
-			},
-			output:          nil,
-			setupSqlStmts:   nil,
-			cleanupSqlStmts: nil,
-			errorExpected:   true,
-			errorString:     "team cannot be blank",
+			name:      "filters by tech skills case insensitively using the effective persona",
+			input:     model.CandidateFilterOptions{TechSkills: []string{"GO"}},
+			outputIds: []string{"c_id1", "c_id3"},
 		},
 		{
-			name: "errors when team id is empty",
-			input: struct {
-				id   string
-				team *model.Team
-			}{
-				id:   "c_id1",
-				team: &model.Team{},
-			},
-			output:          nil,
-			setupSqlStmts:   nil,
-			cleanupSqlStmts: nil,
-			errorExpected:   true,
-			errorString:     "team cannot be blank",
+			name:      "filters by all given tech skills",
+			input:     model.CandidateFilterOptions{TechSkills: []string{"go", "rust"}},
+			outputIds: []string{"c_id3"},
 		},
 		{
-			name: "successfully gets candidates",
-			input: struct {
-				id   string
-				team *model.Team
-			}{
-				id:   "c_id1",
-				team: team,
-			},
-			output: candidate1,
-			setupSqlStmts: []TestSqlStmts{
-				{
-					Query: `INSERT INTO public."teams" (
-								"id", "name"
-							)
-							VALUES (
-								'team_id1', 'Team1'
-							)`,
-				},
-				{
-					Query: `INSERT INTO public."file_uploads" (
-								"id", "name", "presigned_url", "status", "processing_status", "team_id"
-							)
-							VALUES (
-								'fp_id1', 'file1.pdf', 'https://presigned_url1', 'INITIATED', 'NOT STARTED', 'team_id1'
-							)`,
-				},
-				{
-					Query: `INSERT INTO public."candidates" (
-								"id", "ai_generated_persona", "manually_created_persona","team_id", "file_upload_id"
-							)
-							VALUES (
-								'c_id1', $1, $2, 'team_id1', 'fp_id1'
-							)`,
-					Args: []any{&persona1, &persona2},
-				},
+			name:      "filters by min YoE",
+			input:     model.CandidateFilterOptions{MinYoE: &three},
+			outputIds: []string{"c_id1", "c_id2"},
+		},
+		{
+			name:      "filters by max YoE",
+			input:     model.CandidateFilterOptions{MaxYoE: &four},
+			outputIds: []string{"c_id3"},
+		},
+		{
+			name:      "filters by city case insensitively",
+			input:     model.CandidateFilterOptions{City: "MUMBAI"},
+			outputIds: []string{"c_id1", "c_id2"},
+		},
+		{
+			name:      "filters by partial recommended role",
+			input:     model.CandidateFilterOptions{RecommendedRole: "backend"},
+			outputIds: []string{"c_id1"},
+		},
+		{
+			name:      "filters by partial certification",
+			input:     model.CandidateFilterOptions{Certification: "aws"},
+			outputIds: []string{"c_id1"},
+		},
+		{
+			name:      "filters by built by",
+			input:     model.CandidateFilterOptions{BuiltBy: "HUMAN"},
+			outputIds: []string{"c_id2", "c_id3"},
+		},
+		{
+			name:      "combines filters",
+			input:     model.CandidateFilterOptions{City: "mumbai", BuiltBy: "AI"},
+			outputIds: []string{"c_id1"},
+		},
+		{
+			name:      "filters by pipeline stage",
+			input:     model.CandidateFilterOptions{PipelineStageIds: []string{"ps_id1", "ps_id2"}},
+			outputIds: []string{"c_id1", "c_id3"},
+		},
+		{
+			name:      "filters by empty pipeline stage",
+			input:     model.CandidateFilterOptions{PipelineStageIds: []string{"ps_id2"}},
+			outputIds: []string{},
+		},
+		{
+			name:      "filters by any of the tags",
+			input:     model.CandidateFilterOptions{AnyTagIds: []string{"tag_id1", "tag_id2"}},
+			outputIds: []string{"c_id1", "c_id2"},
+		},
+		{
+			name:      "filters by all of the tags",
+			input:     model.CandidateFilterOptions{AllTagIds: []string{"tag_id1", "tag_id2", "tag_id1"}},
+			outputIds: []string{"c_id1"},
+		},
+		{
+			name:      "includes archived candidates when asked",
+			input:     model.CandidateFilterOptions{City: "mumbai", IncludeArchived: true},
+			outputIds: []string{"c_id1", "c_id2", "c_id4"},
+		},
+		{
+			name: "sorts by name",
+			input: model.CandidateFilterOptions{
+				Sorts: []model.CandidateSortOptions{{Field: "NAME"}},
 			},
-			cleanupSqlStmts: []TestSqlStmts{
-				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			outputIds: []string{"c_id2", "c_id3", "c_id1"},
+		},
+		{
+			name: "sorts by YoE descending",
+			input: model.CandidateFilterOptions{
+				Sorts: []model.CandidateSortOptions{{Field: "YOE", Descending: true}},
 			},
-			errorExpected: false,
-			errorString:   "",
+			outputIds: []string{"c_id2", "c_id1", "c_id3"},
 		},
 	}
 
+	s, _ := NewDbStorage(
+		StorageOptions{
+			Db: testDb,
+		},
+	)
+	runSqlOnDb(t, s.db, setupSqlStmts)
+	defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s, _ := NewDbStorage(
-				StorageOptions{
-					Db: testDb,
-				},
-			)
-
-			runSqlOnDb(t, s.db, tt.setupSqlStmts)
-			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
-			candidate, err := s.GetCandidateForTeam(tt.input.id, tt.input.team)
-			if candidate != nil {
-				assert.True(t, candidate.IsEqual(tt.output), "candidate should be same")
-			} else {
-				assert.Nil(t, tt.output)
+			filter, err := model.NewCandidateFilter(tt.input)
+			assert.NoError(t, err)
+			candidates, _, err := s.GetCandidatesForTeam(team, filter, nil)
+			candidateIds := []string{}
+			for _, candidate := range candidates {
+				candidateIds = append(candidateIds, candidate.Id())
 			}
+			assert.Equal(t, tt.outputIds, candidateIds)
 			if !tt.errorExpected {
 				assert.NoError(t, err)
 			} else {
@@ -518,8 +679,7 @@ func Test_GetCandidateForTeam(t *testing.T) {
 	}
 }
 
-func Test_UpdateCandidateWithManuallyCreatedPersonaForTeam(t *testing.T) {
-	aiGeneratedPersona := model.Persona{Name: "generated_name"}
+func Test_GetCandidatesForTeamWithPage(t *testing.T) {
 	currentFileCount := 1
 	team, _ := model.NewTeam(model.TeamOptions{
 		Id:               "team_id1",
@@ -527,168 +687,1520 @@ func Test_UpdateCandidateWithManuallyCreatedPersonaForTeam(t *testing.T) {
 		CurrentFileCount: &currentFileCount,
 		FileCountLimit:   100,
 	})
-	tests := []struct {
-		name  string
-		input struct {
-			id      string
-			persona *model.Persona
-			team    *model.Team
-		}
-		output          string
-		setupSqlStmts   []TestSqlStmts
-		cleanupSqlStmts []TestSqlStmts
-		dbUpdateCheck   func(db *sql.DB) bool
-		errorExpected   bool
-		errorString     string
-	}{
+	persona1 := model.Persona{Name: "Cat", YoE: 3, BuiltBy: "HUMAN"}
+	persona2 := model.Persona{Name: "Ann", YoE: 3, BuiltBy: "HUMAN"}
+	persona3 := model.Persona{Name: "Ann", YoE: 1, BuiltBy: "HUMAN"}
+	setupSqlStmts := []TestSqlStmts{
+		{
+			Query: `INSERT INTO public."teams" (
+						"id", "name"
+					)
+					VALUES (
+						'team_id1', 'Team1'
+					)`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" (
+						"id", "manually_created_persona", "team_id", "created_at"
+					)
+					VALUES (
+						'c_id1', $1, 'team_id1', '2023-01-01 00:00:00+00'
+					),(
+						'c_id2', $2, 'team_id1', '2023-01-02 00:00:00+00'
+					),(
+						'c_id3', $3, 'team_id1', '2023-01-02 00:00:00+00'
+					)`,
+			Args: []any{&persona1, &persona2, &persona3},
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+	}
+
+	tests := []struct {
+		name        string
+		input       model.CandidateFilterOptions
+		pageSize    int
+		outputPages [][]string
+		totalCount  int
+	}{
+		{
+			name:        "pages through candidates in the default order",
+			input:       model.CandidateFilterOptions{},
+			pageSize:    2,
+			outputPages: [][]string{{"c_id1", "c_id2"}, {"c_id3"}},
+			totalCount:  3,
+		},
+		{
+			name: "pages through candidates sorted by name and YoE",
+			input: model.CandidateFilterOptions{
+				Sorts: []model.CandidateSortOptions{{Field: "NAME"}, {Field: "YOE", Descending: true}},
+			},
+			pageSize:    1,
+			outputPages: [][]string{{"c_id2"}, {"c_id3"}, {"c_id1"}},
+			totalCount:  3,
+		},
+		{
+			name:        "counts only the filtered candidates",
+			input:       model.CandidateFilterOptions{MinYoE: &persona1.YoE},
+			pageSize:    1,
+			outputPages: [][]string{{"c_id1"}, {"c_id2"}},
+			totalCount:  2,
+		},
+		{
+			name:        "returns everything in one page when the page size is larger than the results",
+			input:       model.CandidateFilterOptions{},
+			pageSize:    10,
+			outputPages: [][]string{{"c_id1", "c_id2", "c_id3"}},
+			totalCount:  3,
+		},
+	}
+
+	s, _ := NewDbStorage(
+		StorageOptions{
+			Db: testDb,
+		},
+	)
+	runSqlOnDb(t, s.db, setupSqlStmts)
+	defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := model.NewCandidateFilter(tt.input)
+			assert.NoError(t, err)
+
+			pages := [][]string{}
+			token := ""
+			for {
+				page, err := model.NewPageRequest(model.PageRequestOptions{Size: tt.pageSize, Token: token})
+				assert.NoError(t, err)
+				candidates, pageInfo, err := s.GetCandidatesForTeam(team, filter, page)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.totalCount, pageInfo.TotalCount())
+
+				candidateIds := []string{}
+				for _, candidate := range candidates {
+					candidateIds = append(candidateIds, candidate.Id())
+				}
+				pages = append(pages, candidateIds)
+
+				token = pageInfo.NextPageToken()
+				if token == "" || len(pages) > len(tt.outputPages) {
+					break
+				}
+			}
+			assert.Equal(t, tt.outputPages, pages)
+		})
+	}
+
+	t.Run("errors when the page token was created for a different order", func(t *testing.T) {
+		page, _ := model.NewPageRequest(model.PageRequestOptions{Size: 1})
+		_, pageInfo, err := s.GetCandidatesForTeam(team, nil, page)
+		assert.NoError(t, err)
+
+		filter, _ := model.NewCandidateFilter(model.CandidateFilterOptions{
+			Sorts: []model.CandidateSortOptions{{Field: "NAME"}},
+		})
+		page, _ = model.NewPageRequest(model.PageRequestOptions{Size: 1, Token: pageInfo.NextPageToken()})
+		candidates, pageInfo, err := s.GetCandidatesForTeam(team, filter, page)
+		assert.Nil(t, candidates)
+		assert.Nil(t, pageInfo)
+		assert.EqualError(t, err, "page token does not match the requested order")
+	})
+
+	t.Run("errors when the page token is invalid", func(t *testing.T) {
+		page, _ := model.NewPageRequest(model.PageRequestOptions{Size: 1, Token: "not a token"})
+		candidates, pageInfo, err := s.GetCandidatesForTeam(team, nil, page)
+		assert.Nil(t, candidates)
+		assert.Nil(t, pageInfo)
+		assert.EqualError(t, err, "invalid page token")
+	})
+}
+
+func Test_SearchCandidatesForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona1 := model.Persona{
+		Name:       "Alice Smith",
+		TechSkills: []string{"Python", "SQL"},
+		Experience: []model.Experience{
+			{Title: "Data Engineer", CompanyName: "Acme"},
+		},
+		BuiltBy: "HUMAN",
+	}
+	persona2 := model.Persona{
+		Name:       "Bob Python",
+		TechSkills: []string{"Go"},
+		BuiltBy:    "HUMAN",
+	}
+	persona3 := model.Persona{
+		Name:       "Carol",
+		TechSkills: []string{"Rust"},
+		BuiltBy:    "HUMAN",
+	}
+	persona4 := model.Persona{
+		Name: "Carol",
+		Education: []model.Education{
+			{Institute: "Python Institute", Qualification: "Diploma"},
+		},
+		BuiltBy: "HUMAN",
+	}
+	persona5 := model.Persona{
+		Name:       "Dan Python",
+		TechSkills: []string{"Rust"},
+		BuiltBy:    "HUMAN",
+	}
+	setupSqlStmts := []TestSqlStmts{
+		{
+			Query: `INSERT INTO public."teams" (
+						"id", "name"
+					)
+					VALUES (
+						'team_id1', 'Team1'
+					), (
+						'team_id2', 'Team2'
+					)`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" (
+						"id", "ai_generated_persona", "manually_created_persona", "team_id"
+					)
+					VALUES (
+						'c_id1', NULL, $1, 'team_id1'
+					),(
+						'c_id2', NULL, $2, 'team_id1'
+					),(
+						'c_id3', $3, $4, 'team_id1'
+					),(
+						'c_id4', NULL, $5, 'team_id2'
+					)`,
+			Args: []any{&persona1, &persona2, &persona3, &persona4, &persona5},
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+
+	tests := []struct {
+		name            string
+		query           string
+		team            *model.Team
+		outputIds       []string
+		snippetContains string
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name:          "errors when query is blank",
+			query:         " ",
+			team:          team,
+			outputIds:     []string{},
+			errorExpected: true,
+			errorString:   "query cannot be blank",
+		},
+		{
+			name:          "errors when team is empty",
+			query:         "python",
+			team:          nil,
+			outputIds:     []string{},
+			errorExpected: true,
+			errorString:   "team cannot be blank",
+		},
+		{
+			name:            "ranks name matches over skill matches over education matches",
+			query:           "python",
+			team:            team,
+			outputIds:       []string{"c_id2", "c_id1", "c_id3"},
+			snippetContains: "<mark>Python</mark>",
+		},
+		{
+			name:            "matches company names",
+			query:           "acme",
+			team:            team,
+			outputIds:       []string{"c_id1"},
+			snippetContains: "<mark>Acme</mark>",
+		},
+		{
+			name:      "matches experience titles as a phrase",
+			query:     `"data engineer"`,
+			team:      team,
+			outputIds: []string{"c_id1"},
+		},
+		{
+			name:      "matches stemmed words",
+			query:     "engineering",
+			team:      team,
+			outputIds: []string{"c_id1"},
+		},
+		{
+			name:      "only searches the manually created persona when present",
+			query:     "rust",
+			team:      team,
+			outputIds: []string{},
+		},
+		{
+			name:      "returns nothing when there are no matches",
+			query:     "haskell",
+			team:      team,
+			outputIds: []string{},
+		},
+	}
+
+	s, _ := NewDbStorage(
+		StorageOptions{
+			Db: testDb,
+		},
+	)
+	runSqlOnDb(t, s.db, setupSqlStmts)
+	defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, pageInfo, err := s.SearchCandidatesForTeam(tt.query, tt.team, nil)
+			candidateIds := []string{}
+			for _, result := range results {
+				candidateIds = append(candidateIds, result.Candidate().Id())
+				assert.Greater(t, result.Rank(), float64(0))
+			}
+			assert.Equal(t, tt.outputIds, candidateIds)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, len(tt.outputIds), pageInfo.TotalCount())
+				if tt.snippetContains != "" {
+					assert.Contains(t, results[0].Snippet(), tt.snippetContains)
+				}
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+
+	t.Run("pages through search results", func(t *testing.T) {
+		page, _ := model.NewPageRequest(model.PageRequestOptions{Size: 2})
+		results, pageInfo, err := s.SearchCandidatesForTeam("python", team, page)
+		assert.NoError(t, err)
+		assert.Len(t, results, 2)
+		assert.Equal(t, "c_id2", results[0].Candidate().Id())
+		assert.Equal(t, "c_id1", results[1].Candidate().Id())
+		assert.Equal(t, 3, pageInfo.TotalCount())
+
+		page, _ = model.NewPageRequest(model.PageRequestOptions{Size: 2, Token: pageInfo.NextPageToken()})
+		results, pageInfo, err = s.SearchCandidatesForTeam("python", team, page)
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.Equal(t, "c_id3", results[0].Candidate().Id())
+		assert.Empty(t, pageInfo.NextPageToken())
+	})
+}
+
+func Test_GetCandidateForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona1 := model.Persona{
+		Name:       "ai persona 2",
+		Email:      "email_2",
+		Phone:      "phone_2",
+		City:       "city_2",
+		State:      "state_2",
+		Country:    "country_2",
+		YoE:        2,
+		TechSkills: []string{"tech skill 10", "tech skill 20", "tech skill 30"},
+	}
+	persona2 := model.Persona{
+		Name:       "manual persona 1",
+		Email:      "email_3",
+		Phone:      "phone_3",
+		City:       "city_3",
+		State:      "state_3",
+		Country:    "country_3",
+		YoE:        7,
+		TechSkills: []string{"tech skill 11", "tech skill 21", "tech skill 31"},
+	}
+	candidate1, _ := model.NewCandidate(model.CandidateOptions{
+		Id:                     "c_id1",
+		AiGeneratedPersona:     &persona1,
+		ManuallyCreatedPersona: &persona2,
+		Team:                   team,
+		FileUploadId:           "fp_id1",
+	})
+	tests := []struct {
+		name  string
+		input struct {
+			id   string
+			team *model.Team
+		}
+		output          *model.Candidate
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name: "errors when id is empty",
+			input: struct {
+				id   string
+				team *model.Team
+			}{},
+			output:          nil,
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			errorExpected:   true,
+			errorString:     "id cannot be blank",
+		},
+		{
+			name: "errors when team is empty",
+			input: struct {
+				id   string
+				team *model.Team
+			}{
+				id: "c_id1",
+			},
+			output:          nil,
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			errorExpected:   true,
+			errorString:     "team cannot be blank",
+		},
+		{
+			name: "errors when team id is empty",
+			input: struct {
+				id   string
+				team *model.Team
+			}{
+				id:   "c_id1",
+				team: &model.Team{},
+			},
+			output:          nil,
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			errorExpected:   true,
+			errorString:     "team cannot be blank",
+		},
+		{
+			name: "successfully gets candidates",
+			input: struct {
+				id   string
+				team *model.Team
+			}{
+				id:   "c_id1",
+				team: team,
+			},
+			output: candidate1,
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+								"id", "name"
+							)
+							VALUES (
+								'team_id1', 'Team1'
+							)`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id"
+							)
+							VALUES (
+								'fp_id1', 'file1.pdf', 'https://presigned_url1', 'INITIATED', 'NOT STARTED', 'team_id1'
+							)`,
+				},
+				{
+					Query: `INSERT INTO public."candidates" (
+								"id", "ai_generated_persona", "manually_created_persona","team_id", "file_upload_id"
+							)
+							VALUES (
+								'c_id1', $1, $2, 'team_id1', 'fp_id1'
+							)`,
+					Args: []any{&persona1, &persona2},
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			candidate, err := s.GetCandidateForTeam(tt.input.id, tt.input.team)
+			if candidate != nil {
+				assert.True(t, candidate.IsEqual(tt.output), "candidate should be same")
+			} else {
+				assert.Nil(t, tt.output)
+			}
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_UpdateCandidateWithManuallyCreatedPersonaForTeam(t *testing.T) {
+	aiGeneratedPersona := model.Persona{Name: "generated_name"}
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	tests := []struct {
+		name  string
+		input struct {
+			id             string
+			persona        *model.Persona
+			editedByUserId string
+			team           *model.Team
+		}
+		output          string
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		dbUpdateCheck   func(db *sql.DB) bool
+		errorExpected   bool
+		errorString     string
+	}{
 		{
 			name: "errors when team is nil",
 			input: struct {
-				id      string
-				persona *model.Persona
-				team    *model.Team
+				id             string
+				persona        *model.Persona
+				editedByUserId string
+				team           *model.Team
+			}{
+				persona: &model.Persona{Name: "user_1"},
+			},
+			output:          "",
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "team cannot be blank",
+		},
+		{
+			name: "errors when persona is invalid",
+			input: struct {
+				id             string
+				persona        *model.Persona
+				editedByUserId string
+				team           *model.Team
+			}{
+				team: team,
+			},
+			output:          "",
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "cannot create Candidate without a valid persona",
+		},
+		{
+			name: "errors when editedByUserId is empty",
+			input: struct {
+				id             string
+				persona        *model.Persona
+				editedByUserId string
+				team           *model.Team
+			}{
+				persona: &model.Persona{Name: "user_1", BuiltBy: "HUMAN"},
+				team:    team,
+			},
+			output:          "",
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "editedByUserId cannot be blank",
+		},
+		{
+			name: "errors when team does not exist in Database",
+			input: struct {
+				id             string
+				persona        *model.Persona
+				editedByUserId string
+				team           *model.Team
+			}{
+				persona:        &model.Persona{Name: "user_1", BuiltBy: "HUMAN"},
+				editedByUserId: "user_id1",
+				team:           team,
+			},
+			output: "",
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+						"id", "name"
+					)
+					VALUES (
+						'team_id2', 'Team2'
+					)`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id2'`},
+			},
+			dbUpdateCheck: nil,
+			errorExpected: true,
+			errorString:   "THIS IS BAD: dbError while upserting Candidate: can_id1: pq: insert or update on table \"candidates\" violates foreign key constraint \"candidates_team_id_fkey\"",
+		},
+		{
+			name: "successfully creates a new candidate",
+			input: struct {
+				id             string
+				persona        *model.Persona
+				editedByUserId string
+				team           *model.Team
+			}{
+				persona:        &model.Persona{Name: "user_1", BuiltBy: "HUMAN"},
+				editedByUserId: "user_id1",
+				team:           team,
+			},
+			output: "can_id1",
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+								"id", "name"
+							)
+							VALUES (
+								'team_id1', 'Team1'
+							)`,
+				},
+				{Query: `INSERT INTO public."users" ("id", "email", "team_id") VALUES ('user_id1', 'test@example.com', 'team_id1')`},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var id string
+				var persona model.Persona
+				var createdAt time.Time
+				row := db.QueryRow(
+					`SELECT id, manually_created_persona, created_at FROM public."candidates" WHERE team_id = 'team_id1'`,
+				)
+				assert.NoError(t, row.Err())
+				err := row.Scan(&id, &persona, &createdAt)
+				assert.NoError(t, err)
+				assert.Equal(t, "can_id1", id)
+				assert.Equal(t, "user_1", persona.Name)
+
+				var revisionPersona model.Persona
+				var editedByUserId string
+				row = db.QueryRow(`SELECT persona, edited_by_user_id FROM public."candidate_persona_revisions" WHERE candidate_id = 'can_id1'`)
+				assert.NoError(t, row.Scan(&revisionPersona, &editedByUserId))
+				assert.Equal(t, "user_1", revisionPersona.Name)
+				assert.Equal(t, "user_id1", editedByUserId)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "successfully updates a candidate",
+			input: struct {
+				id             string
+				persona        *model.Persona
+				editedByUserId string
+				team           *model.Team
+			}{
+				id:             "can_id1",
+				persona:        &model.Persona{Name: "user_1", BuiltBy: "HUMAN"},
+				editedByUserId: "user_id1",
+				team:           team,
+			},
+			output: "can_id1",
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+								"id", "name"
+							)
+							VALUES (
+								'team_id1', 'Team1'
+							)`,
+				},
+				{Query: `INSERT INTO public."users" ("id", "email", "team_id") VALUES ('user_id1', 'test@example.com', 'team_id1')`},
+				{
+					Query: `INSERT INTO public."candidates" (
+								"id", "ai_generated_persona", "team_id"
+							)
+							VALUES (
+								'can_id1', $1, 'team_id1'
+							)`,
+					Args: []any{&aiGeneratedPersona},
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var id string
+				var aiPersona, manualPersona model.Persona
+				var createdAt time.Time
+				row := db.QueryRow(
+					`SELECT id, ai_generated_persona, manually_created_persona, created_at FROM public."candidates" WHERE team_id = 'team_id1'`,
+				)
+				assert.NoError(t, row.Err())
+				err := row.Scan(&id, &aiPersona, &manualPersona, &createdAt)
+				assert.NoError(t, err)
+				assert.Equal(t, "can_id1", id)
+				assert.Equal(t, "generated_name", aiPersona.Name)
+				assert.Equal(t, "user_1", manualPersona.Name)
+
+				var revisionPersona model.Persona
+				var editedByUserId string
+				row = db.QueryRow(`SELECT persona, edited_by_user_id FROM public."candidate_persona_revisions" WHERE candidate_id = 'can_id1'`)
+				assert.NoError(t, row.Scan(&revisionPersona, &editedByUserId))
+				assert.Equal(t, "user_1", revisionPersona.Name)
+				assert.Equal(t, "user_id1", editedByUserId)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db:          testDb,
+					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "can_id1"},
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			id, err := s.UpdateCandidateWithManuallyCreatedPersonaForTeam(
+				tt.input.id, tt.input.persona, tt.input.editedByUserId, tt.input.team,
+			)
+
+			assert.Equal(t, tt.output, id)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_GetAllCandidatesForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona1 := model.Persona{Name: "ai persona 1", Email: "email_1", BuiltBy: "AI", FileUploadId: "fp_id1"}
+	persona2 := model.Persona{Name: "manual persona 2", Email: "email_2"}
+	candidate1, _ := model.NewCandidate(model.CandidateOptions{
+		Id:                 "c_id1",
+		AiGeneratedPersona: &persona1,
+		Team:               team,
+		FileUploadId:       "fp_id1",
+	})
+	candidate2, _ := model.NewCandidate(model.CandidateOptions{
+		Id:                     "c_id2",
+		ManuallyCreatedPersona: &persona2,
+		Team:                   team,
+	})
+	tests := []struct {
+		name            string
+		input           *model.Team
+		output          []*model.Candidate
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name:            "errors when team is empty",
+			input:           nil,
+			output:          nil,
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			errorExpected:   true,
+			errorString:     "team cannot be blank",
+		},
+		{
+			name:   "successfully gets all candidates of the team oldest first",
+			input:  team,
+			output: []*model.Candidate{candidate1, candidate2},
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" ("id", "name")
+							VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id"
+							)
+							VALUES (
+								'fp_id1', 'file1.pdf', 'https://presigned_url1', 'SUCCESS', 'COMPLETED', 'team_id1'
+							)`,
+				},
+				{
+					Query: `INSERT INTO public."candidates" (
+								"id", "ai_generated_persona", "team_id", "file_upload_id", "created_at"
+							)
+							VALUES (
+								'c_id1', $1, 'team_id1', 'fp_id1', '2023-01-01 00:00:00'
+							)`,
+					Args: []any{&persona1},
+				},
+				{
+					Query: `INSERT INTO public."candidates" (
+								"id", "manually_created_persona", "team_id", "created_at"
+							)
+							VALUES (
+								'c_id2', $1, 'team_id1', '2023-01-02 00:00:00'
+							)`,
+					Args: []any{&persona2},
+				},
+				{
+					Query: `INSERT INTO public."candidates" (
+								"id", "manually_created_persona", "team_id"
+							)
+							VALUES (
+								'c_id3', $1, 'team_id2'
+							)`,
+					Args: []any{&persona2},
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			candidates, err := s.GetAllCandidatesForTeam(tt.input)
+			assert.Equal(t, len(tt.output), len(candidates))
+			for i := range candidates {
+				assert.True(t, candidates[i].IsEqual(tt.output[i]), "candidate should be same")
+			}
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_MergeCandidatesForTeam(t *testing.T) {
+	currentFileCount := 3
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona1 := model.Persona{Name: "Jane Doe", Email: "jane@example.com", TechSkills: []string{"Go"}, BuiltBy: "AI", FileUploadId: "fp_id1"}
+	persona2 := model.Persona{Name: "Jane D", Phone: "9876543210", TechSkills: []string{"SQL"}, BuiltBy: "AI", FileUploadId: "fp_id2"}
+	mergedPersona := model.Persona{Name: "Jane Doe", Email: "jane@example.com", Phone: "9876543210", TechSkills: []string{"Go", "SQL"}, BuiltBy: "HUMAN"}
+	mergedCandidate, _ := model.NewCandidate(model.CandidateOptions{
+		Id:                     "c_id1",
+		AiGeneratedPersona:     &persona1,
+		ManuallyCreatedPersona: &mergedPersona,
+		Team:                   team,
+		FileUploadId:           "fp_id1",
+	})
+	setupSqlStmts := []TestSqlStmts{
+		{
+			Query: `INSERT INTO public."teams" ("id", "name")
+					VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`,
+		},
+		{
+			Query: `INSERT INTO public."file_uploads" (
+						"id", "name", "presigned_url", "status", "processing_status", "team_id"
+					)
+					VALUES
+					('fp_id1', 'file1.pdf', 'https://presigned_url1', 'SUCCESS', 'COMPLETED', 'team_id1'),
+					('fp_id2', 'file2.pdf', 'https://presigned_url2', 'SUCCESS', 'COMPLETED', 'team_id1'),
+					('fp_id4', 'file4.pdf', 'https://presigned_url4', 'SUCCESS', 'COMPLETED', 'team_id2')`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" (
+						"id", "ai_generated_persona", "team_id", "file_upload_id"
+					)
+					VALUES
+					('c_id1', $1, 'team_id1', 'fp_id1'),
+					('c_id2', $2, 'team_id1', 'fp_id2'),
+					('c_id4', $2, 'team_id2', 'fp_id4')`,
+			Args: []any{&persona1, &persona2},
+		},
+		{
+			Query: `INSERT INTO public."file_uploads" (
+						"id", "name", "presigned_url", "status", "processing_status", "team_id", "duplicate_of_candidate_id"
+					)
+					VALUES (
+						'fp_id3', 'file2-copy.pdf', 'https://presigned_url3', 'SUCCESS', 'DUPLICATE', 'team_id1', 'c_id2'
+					)`,
+		},
+		{
+			Query: `INSERT INTO public."job_openings" ("id", "team_id", "title")
+					VALUES ('jo_id1', 'team_id1', 'Engineer'), ('jo_id2', 'team_id1', 'Designer')`,
+		},
+		{
+			Query: `INSERT INTO public."job_applications" ("id", "job_opening_id", "candidate_id")
+					VALUES ('ja_id1', 'jo_id1', 'c_id1'), ('ja_id2', 'jo_id1', 'c_id2'), ('ja_id3', 'jo_id2', 'c_id2')`,
+		},
+		{
+			Query: `INSERT INTO public."candidate_notes" ("id", "candidate_id", "body")
+					VALUES ('cn_id1', 'c_id1', 'note 1'), ('cn_id2', 'c_id2', 'note 2')`,
+		},
+		{Query: `INSERT INTO public."tags" ("id", "team_id", "name") VALUES ('tag_id1', 'team_id1', 'referral'), ('tag_id2', 'team_id1', 'Q3-batch')`},
+		{
+			Query: `INSERT INTO public."candidate_tags" ("candidate_id", "tag_id")
+					VALUES ('c_id1', 'tag_id1'), ('c_id2', 'tag_id1'), ('c_id2', 'tag_id2')`,
+		},
+		{Query: `INSERT INTO public."users" ("id", "email", "team_id") VALUES ('user_id1', 'test@example.com', 'team_id1')`},
+		{
+			Query: `INSERT INTO public."scorecards" ("id", "candidate_id", "job_opening_id", "interviewer_user_id")
+					VALUES ('sc_id1', 'c_id1', 'jo_id1', 'user_id1'), ('sc_id2', 'c_id2', 'jo_id1', 'user_id1'), ('sc_id3', 'c_id2', NULL, 'user_id1')`,
+		},
+		{
+			Query: `INSERT INTO public."candidate_stage_changes" ("id", "candidate_id", "to_stage_name")
+					VALUES ('csc_id1', 'c_id1', 'Applied'), ('csc_id2', 'c_id2', 'Screening')`,
+		},
+		{
+			Query: `INSERT INTO public."candidate_persona_revisions" ("id", "candidate_id", "persona", "built_by")
+					VALUES ('cpr_id2', 'c_id2', $1, 'AI')`,
+			Args: []any{&persona2},
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+	tests := []struct {
+		name  string
+		input struct {
+			survivingCandidateId string
+			mergedCandidateIds   []string
+			mergedByUserId       string
+			team                 *model.Team
+		}
+		output          *model.Candidate
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		dbUpdateCheck   func(db *sql.DB) bool
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name: "errors when survivingCandidateId is empty",
+			input: struct {
+				survivingCandidateId string
+				mergedCandidateIds   []string
+				mergedByUserId       string
+				team                 *model.Team
+			}{
+				mergedCandidateIds: []string{"c_id2"},
+				team:               team,
+			},
+			errorExpected: true,
+			errorString:   "survivingCandidateId cannot be blank",
+		},
+		{
+			name: "errors when mergedCandidateIds is empty",
+			input: struct {
+				survivingCandidateId string
+				mergedCandidateIds   []string
+				mergedByUserId       string
+				team                 *model.Team
+			}{
+				survivingCandidateId: "c_id1",
+				team:                 team,
+			},
+			errorExpected: true,
+			errorString:   "mergedCandidateIds cannot be empty",
+		},
+		{
+			name: "errors when a merged candidate is the surviving candidate",
+			input: struct {
+				survivingCandidateId string
+				mergedCandidateIds   []string
+				mergedByUserId       string
+				team                 *model.Team
+			}{
+				survivingCandidateId: "c_id1",
+				mergedCandidateIds:   []string{"c_id1"},
+				team:                 team,
+			},
+			errorExpected: true,
+			errorString:   "cannot merge a candidate into itself",
+		},
+		{
+			name: "errors when a merged candidate is repeated",
+			input: struct {
+				survivingCandidateId string
+				mergedCandidateIds   []string
+				mergedByUserId       string
+				team                 *model.Team
+			}{
+				survivingCandidateId: "c_id1",
+				mergedCandidateIds:   []string{"c_id2", "c_id2"},
+				mergedByUserId:       "user_id1",
+				team:                 team,
+			},
+			errorExpected: true,
+			errorString:   "mergedCandidateIds cannot contain repeats",
+		},
+		{
+			name: "errors when mergedByUserId is empty",
+			input: struct {
+				survivingCandidateId string
+				mergedCandidateIds   []string
+				mergedByUserId       string
+				team                 *model.Team
+			}{
+				survivingCandidateId: "c_id1",
+				mergedCandidateIds:   []string{"c_id2"},
+				team:                 team,
+			},
+			errorExpected: true,
+			errorString:   "mergedByUserId cannot be blank",
+		},
+		{
+			name: "errors when team is empty",
+			input: struct {
+				survivingCandidateId string
+				mergedCandidateIds   []string
+				mergedByUserId       string
+				team                 *model.Team
+			}{
+				survivingCandidateId: "c_id1",
+				mergedCandidateIds:   []string{"c_id2"},
+			},
+			errorExpected: true,
+			errorString:   "team cannot be blank",
+		},
+		{
+			name: "errors when a candidate belongs to another team and changes nothing",
+			input: struct {
+				survivingCandidateId string
+				mergedCandidateIds   []string
+				mergedByUserId       string
+				team                 *model.Team
+			}{
+				survivingCandidateId: "c_id1",
+				mergedCandidateIds:   []string{"c_id2", "c_id4"},
+				mergedByUserId:       "user_id1",
+				team:                 team,
+			},
+			setupSqlStmts:   setupSqlStmts,
+			cleanupSqlStmts: cleanupSqlStmts,
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var count int
+				row := db.QueryRow(`SELECT count(id) FROM public."candidates" WHERE manually_created_persona IS NULL`)
+				assert.NoError(t, row.Scan(&count))
+				assert.Equal(t, 3, count)
+				return true
+			},
+			errorExpected: true,
+			errorString:   "no candidate for id c_id4",
+		},
+		{
+			name: "merges candidates into the surviving candidate",
+			input: struct {
+				survivingCandidateId string
+				mergedCandidateIds   []string
+				mergedByUserId       string
+				team                 *model.Team
+			}{
+				survivingCandidateId: "c_id1",
+				mergedCandidateIds:   []string{"c_id2"},
+				mergedByUserId:       "user_id1",
+				team:                 team,
+			},
+			output:          mergedCandidate,
+			setupSqlStmts:   setupSqlStmts,
+			cleanupSqlStmts: cleanupSqlStmts,
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var count int
+				row := db.QueryRow(`SELECT count(id) FROM public."candidates" WHERE id = 'c_id2'`)
+				assert.NoError(t, row.Scan(&count))
+				assert.Equal(t, 0, count)
+
+				var mergedIntoCandidateId, duplicateOfCandidateId sql.NullString
+				row = db.QueryRow(`SELECT merged_into_candidate_id FROM public."file_uploads" WHERE id = 'fp_id2'`)
+				assert.NoError(t, row.Scan(&mergedIntoCandidateId))
+				assert.Equal(t, "c_id1", mergedIntoCandidateId.String)
+
+				row = db.QueryRow(`SELECT duplicate_of_candidate_id FROM public."file_uploads" WHERE id = 'fp_id3'`)
+				assert.NoError(t, row.Scan(&duplicateOfCandidateId))
+				assert.Equal(t, "c_id1", duplicateOfCandidateId.String)
+
+				var applicationIds []string
+				row = db.QueryRow(`SELECT array_agg(id ORDER BY id) FROM public."job_applications" WHERE candidate_id = 'c_id1'`)
+				assert.NoError(t, row.Scan(pq.Array(&applicationIds)))
+				assert.Equal(t, []string{"ja_id1", "ja_id3"}, applicationIds)
+
+				var noteIds []string
+				row = db.QueryRow(`SELECT array_agg(id ORDER BY id) FROM public."candidate_notes" WHERE candidate_id = 'c_id1'`)
+				assert.NoError(t, row.Scan(pq.Array(&noteIds)))
+				assert.Equal(t, []string{"cn_id1", "cn_id2"}, noteIds)
+
+				var tagIds []string
+				row = db.QueryRow(`SELECT array_agg(tag_id ORDER BY tag_id) FROM public."candidate_tags" WHERE candidate_id = 'c_id1'`)
+				assert.NoError(t, row.Scan(pq.Array(&tagIds)))
+				assert.Equal(t, []string{"tag_id1", "tag_id2"}, tagIds)
+
+				var scorecardIds []string
+				row = db.QueryRow(`SELECT array_agg(id ORDER BY id) FROM public."scorecards" WHERE candidate_id = 'c_id1'`)
+				assert.NoError(t, row.Scan(pq.Array(&scorecardIds)))
+				assert.Equal(t, []string{"sc_id1", "sc_id3"}, scorecardIds)
+
+				var stageChangeIds []string
+				row = db.QueryRow(`SELECT array_agg(id ORDER BY id) FROM public."candidate_stage_changes" WHERE candidate_id = 'c_id1'`)
+				assert.NoError(t, row.Scan(pq.Array(&stageChangeIds)))
+				assert.Equal(t, []string{"csc_id1", "csc_id2"}, stageChangeIds)
+
+				var revisionCount int
+				row = db.QueryRow(`SELECT count(id) FROM public."candidate_persona_revisions" WHERE candidate_id = 'c_id1' AND id = 'cpr_id2'`)
+				assert.NoError(t, row.Scan(&revisionCount))
+				assert.Equal(t, 1, revisionCount)
+
+				var revisionPersona model.Persona
+				var editedByUserId string
+				row = db.QueryRow(`SELECT persona, edited_by_user_id FROM public."candidate_persona_revisions" WHERE candidate_id = 'c_id1' AND id <> 'cpr_id2'`)
+				assert.NoError(t, row.Scan(&revisionPersona, &editedByUserId))
+				assert.Equal(t, "9876543210", revisionPersona.Phone)
+				assert.Equal(t, "user_id1", editedByUserId)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			candidate, err := s.MergeCandidatesForTeam(tt.input.survivingCandidateId, tt.input.mergedCandidateIds, tt.input.mergedByUserId, tt.input.team)
+			if candidate != nil {
+				assert.True(t, candidate.IsEqual(tt.output), "candidate should be same")
+			} else {
+				assert.Nil(t, tt.output)
+			}
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_ArchiveCandidateForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	tests := []struct {
+		name  string
+		input struct {
+			id   string
+			team *model.Team
+		}
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		dbUpdateCheck   func(db *sql.DB) bool
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name: "errors when id is empty",
+			input: struct {
+				id   string
+				team *model.Team
+			}{
+				team: team,
+			},
+			errorExpected: true,
+			errorString:   "id cannot be blank",
+		},
+		{
+			name: "errors when team is empty",
+			input: struct {
+				id   string
+				team *model.Team
+			}{
+				id: "c_id1",
+			},
+			errorExpected: true,
+			errorString:   "team cannot be blank",
+		},
+		{
+			name: "errors when candidate belongs to another team",
+			input: struct {
+				id   string
+				team *model.Team
+			}{
+				id:   "c_id1",
+				team: team,
+			},
+			setupSqlStmts: []TestSqlStmts{
+				{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id2', 'Team2')`},
+				{
+					Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+							VALUES ('c_id1', $1, 'team_id2')`,
+					Args: []any{&persona},
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id2'`},
+			},
+			errorExpected: true,
+			errorString:   "no candidate for id c_id1",
+		},
+		{
+			name: "keeps the time an archived candidate was first archived",
+			input: struct {
+				id   string
+				team *model.Team
+			}{
+				id:   "c_id1",
+				team: team,
+			},
+			setupSqlStmts: []TestSqlStmts{
+				{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1')`},
+				{
+					Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id", "archived_at")
+							VALUES ('c_id1', $1, 'team_id1', '2023-01-01 00:00:00+00')`,
+					Args: []any{&persona},
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var archivedAt time.Time
+				row := db.QueryRow(`SELECT archived_at FROM public."candidates" WHERE id = 'c_id1'`)
+				assert.NoError(t, row.Scan(&archivedAt))
+				assert.True(t, archivedAt.Equal(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)))
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "successfully archives a candidate",
+			input: struct {
+				id   string
+				team *model.Team
 			}{
-				persona: &model.Persona{Name: "user_1"},
+				id:   "c_id1",
+				team: team,
 			},
-			output:          "",
-			setupSqlStmts:   nil,
-			cleanupSqlStmts: nil,
-			dbUpdateCheck:   nil,
-			errorExpected:   true,
-			errorString:     "team cannot be blank",
+			setupSqlStmts: []TestSqlStmts{
+				{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1')`},
+				{
+					Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+							VALUES ('c_id1', $1, 'team_id1')`,
+					Args: []any{&persona},
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var archivedAt sql.NullTime
+				row := db.QueryRow(`SELECT archived_at FROM public."candidates" WHERE id = 'c_id1'`)
+				assert.NoError(t, row.Scan(&archivedAt))
+				assert.True(t, archivedAt.Valid)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
 		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			err := s.ArchiveCandidateForTeam(tt.input.id, tt.input.team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_UnarchiveCandidateForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	tests := []struct {
+		name  string
+		input struct {
+			id   string
+			team *model.Team
+		}
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		dbUpdateCheck   func(db *sql.DB) bool
+		errorExpected   bool
+		errorString     string
+	}{
 		{
-			name: "errors when persona is invalid",
+			name: "errors when id is empty",
 			input: struct {
-				id      string
-				persona *model.Persona
-				team    *model.Team
+				id   string
+				team *model.Team
 			}{
 				team: team,
 			},
-			output:          "",
-			setupSqlStmts:   nil,
-			cleanupSqlStmts: nil,
-			dbUpdateCheck:   nil,
-			errorExpected:   true,
-			errorString:     "cannot create Candidate without a valid persona",
+			errorExpected: true,
+			errorString:   "id cannot be blank",
 		},
 		{
-			name: "errors when team does not exist in Database",
+			name: "errors when candidate does not exist",
 			input: struct {
-				id      string
-				persona *model.Persona
-				team    *model.Team
+				id   string
+				team *model.Team
 			}{
-				persona: &model.Persona{Name: "user_1", BuiltBy: "HUMAN"},
-				team:    team,
+				id:   "c_id1",
+				team: team,
 			},
-			output: "",
 			setupSqlStmts: []TestSqlStmts{
-				{
-					Query: `INSERT INTO public."teams" (
-						"id", "name"
-					)
-					VALUES (
-						'team_id2', 'Team2'
-					)`,
-				},
+				{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1')`},
 			},
 			cleanupSqlStmts: []TestSqlStmts{
-				{Query: `DELETE FROM public."teams" WHERE id = 'team_id2'`},
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
 			},
-			dbUpdateCheck: nil,
 			errorExpected: true,
-			errorString:   "THIS IS BAD: dbError while inserting Candidate: can_id1: pq: insert or update on table \"candidates\" violates foreign key constraint \"candidates_team_id_fkey\"",
+			errorString:   "no candidate for id c_id1",
 		},
 		{
-			name: "successfully creates a new candidate",
+			name: "successfully unarchives a candidate",
 			input: struct {
-				id      string
-				persona *model.Persona
-				team    *model.Team
+				id   string
+				team *model.Team
 			}{
-				persona: &model.Persona{Name: "user_1", BuiltBy: "HUMAN"},
-				team:    team,
+				id:   "c_id1",
+				team: team,
 			},
-			output: "can_id1",
 			setupSqlStmts: []TestSqlStmts{
+				{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1')`},
 				{
-					Query: `INSERT INTO public."teams" (
-								"id", "name"
-							)
-							VALUES (
-								'team_id1', 'Team1'
-							)`,
+					Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id", "archived_at")
+							VALUES ('c_id1', $1, 'team_id1', CURRENT_TIMESTAMP)`,
+					Args: []any{&persona},
 				},
 			},
 			cleanupSqlStmts: []TestSqlStmts{
 				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
 			},
 			dbUpdateCheck: func(db *sql.DB) bool {
-				var id string
-				var persona model.Persona
-				var createdAt time.Time
-				row := db.QueryRow(
-					`SELECT id, manually_created_persona, created_at FROM public."candidates" WHERE team_id = 'team_id1'`,
-				)
-				assert.NoError(t, row.Err())
-				err := row.Scan(&id, &persona, &createdAt)
-				assert.NoError(t, err)
-				assert.Equal(t, "can_id1", id)
-				assert.Equal(t, "user_1", persona.Name)
+				var archivedAt sql.NullTime
+				row := db.QueryRow(`SELECT archived_at FROM public."candidates" WHERE id = 'c_id1'`)
+				assert.NoError(t, row.Scan(&archivedAt))
+				assert.False(t, archivedAt.Valid)
 				return true
 			},
 			errorExpected: false,
 			errorString:   "",
 		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			err := s.UnarchiveCandidateForTeam(tt.input.id, tt.input.team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_DeleteCandidateForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "ai persona 1", BuiltBy: "AI", FileUploadId: "fp_id1"}
+	tests := []struct {
+		name  string
+		input struct {
+			id   string
+			team *model.Team
+		}
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		dbUpdateCheck   func(db *sql.DB) bool
+		errorExpected   bool
+		errorString     string
+	}{
 		{
-			name: "successfully updates a candidate",
+			name: "errors when id is empty",
 			input: struct {
-				id      string
-				persona *model.Persona
-				team    *model.Team
+				id   string
+				team *model.Team
 			}{
-				id:      "can_id1",
-				persona: &model.Persona{Name: "user_1", BuiltBy: "HUMAN"},
-				team:    team,
+				team: team,
+			},
+			errorExpected: true,
+			errorString:   "id cannot be blank",
+		},
+		{
+			name: "errors when team is empty",
+			input: struct {
+				id   string
+				team *model.Team
+			}{
+				id: "c_id1",
+			},
+			errorExpected: true,
+			errorString:   "team cannot be blank",
+		},
+		{
+			name: "errors when candidate belongs to another team",
+			input: struct {
+				id   string
+				team *model.Team
+			}{
+				id:   "c_id1",
+				team: team,
 			},
-			output: "can_id1",
 			setupSqlStmts: []TestSqlStmts{
+				{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id2', 'Team2')`},
 				{
-					Query: `INSERT INTO public."teams" (
-								"id", "name"
-							)
-							VALUES (
-								'team_id1', 'Team1'
-							)`,
+					Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+							VALUES ('c_id1', $1, 'team_id2')`,
+					Args: []any{&persona},
 				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id2'`},
+			},
+			errorExpected: true,
+			errorString:   "no candidate for id c_id1",
+		},
+		{
+			name: "deletes the candidate and keeps its file upload",
+			input: struct {
+				id   string
+				team *model.Team
+			}{
+				id:   "c_id1",
+				team: team,
+			},
+			setupSqlStmts: []TestSqlStmts{
+				{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1')`},
 				{
-					Query: `INSERT INTO public."candidates" (
-								"id", "ai_generated_persona", "team_id"
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id"
 							)
 							VALUES (
-								'can_id1', $1, 'team_id1'
+								'fp_id1', 'file1.pdf', 'https://presigned_url1', 'SUCCESS', 'COMPLETED', 'team_id1'
 							)`,
-					Args: []any{&aiGeneratedPersona},
+				},
+				{
+					Query: `INSERT INTO public."candidates" ("id", "ai_generated_persona", "team_id", "file_upload_id")
+							VALUES ('c_id1', $1, 'team_id1', 'fp_id1')`,
+					Args: []any{&persona},
 				},
 			},
 			cleanupSqlStmts: []TestSqlStmts{
 				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
 			},
 			dbUpdateCheck: func(db *sql.DB) bool {
-				var id string
-				var aiPersona, manualPersona model.Persona
-				var createdAt time.Time
-				row := db.QueryRow(
-					`SELECT id, ai_generated_persona, manually_created_persona, created_at FROM public."candidates" WHERE team_id = 'team_id1'`,
-				)
-				assert.NoError(t, row.Err())
-				err := row.Scan(&id, &aiPersona, &manualPersona, &createdAt)
-				assert.NoError(t, err)
-				assert.Equal(t, "can_id1", id)
-				assert.Equal(t, "generated_name", aiPersona.Name)
-				assert.Equal(t, "user_1", manualPersona.Name)
+				var candidateCount, fileUploadCount int
+				row := db.QueryRow(`SELECT count(id) FROM public."candidates" WHERE id = 'c_id1'`)
+				assert.NoError(t, row.Scan(&candidateCount))
+				assert.Equal(t, 0, candidateCount)
+				row = db.QueryRow(`SELECT count(id) FROM public."file_uploads" WHERE id = 'fp_id1'`)
+				assert.NoError(t, row.Scan(&fileUploadCount))
+				assert.Equal(t, 1, fileUploadCount)
 				return true
 			},
 			errorExpected: false,
@@ -700,18 +2212,13 @@ func Test_UpdateCandidateWithManuallyCreatedPersonaForTeam(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			s, _ := NewDbStorage(
 				StorageOptions{
-					Db:          testDb,
-					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "can_id1"},
+					Db: testDb,
 				},
 			)
 
 			runSqlOnDb(t, s.db, tt.setupSqlStmts)
 			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
-			id, err := s.UpdateCandidateWithManuallyCreatedPersonaForTeam(
-				tt.input.id, tt.input.persona, tt.input.team,
-			)
-
-			assert.Equal(t, tt.output, id)
+			err := s.DeleteCandidateForTeam(tt.input.id, tt.input.team)
 			if !tt.errorExpected {
 				assert.NoError(t, err)
 			} else {
@@ -16,6 +16,56 @@ CREATE TABLE "accounts" (
     CONSTRAINT "accounts_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "candidate_notes" (
+    "id" TEXT NOT NULL,
+    "candidate_id" TEXT NOT NULL,
+    "author_user_id" TEXT,
+    "body" TEXT NOT NULL,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "candidate_notes_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "candidate_persona_revisions" (
+    "id" TEXT NOT NULL,
+    "candidate_id" TEXT NOT NULL,
+    "persona" JSONB NOT NULL,
+    "built_by" TEXT NOT NULL DEFAULT '',
+    "builder_version" TEXT NOT NULL DEFAULT '',
+    "edited_by_user_id" TEXT,
+    "reverted_from_revision_id" TEXT,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "candidate_persona_revisions_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "candidate_stage_changes" (
+    "id" TEXT NOT NULL,
+    "candidate_id" TEXT NOT NULL,
+    "from_stage_id" TEXT,
+    "from_stage_name" TEXT,
+    "to_stage_id" TEXT,
+    "to_stage_name" TEXT NOT NULL,
+    "changed_by_user_id" TEXT,
+    "reason" TEXT,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "candidate_stage_changes_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "candidate_tags" (
+    "candidate_id" TEXT NOT NULL,
+    "tag_id" TEXT NOT NULL,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "candidate_tags_pkey" PRIMARY KEY ("candidate_id", "tag_id")
+);
+
 -- CreateTable
 CREATE TABLE "candidates" (
     "id" TEXT NOT NULL,
@@ -25,10 +75,44 @@ CREATE TABLE "candidates" (
     "file_upload_id" TEXT,
     "team_id" TEXT NOT NULL,
     "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "search_vector" tsvector,
+    "archived_at" TIMESTAMPTZ(3),
+    "pipeline_stage_id" TEXT,
 
     CONSTRAINT "candidates_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "fit_assessments" (
+    "id" TEXT NOT NULL,
+    "team_id" TEXT NOT NULL,
+    "candidate_id" TEXT NOT NULL,
+    "job_opening_id" TEXT NOT NULL,
+    "persona_version" TEXT NOT NULL,
+    "job_opening_version" TEXT NOT NULL,
+    "prompt_version" TEXT NOT NULL,
+    "status" TEXT NOT NULL DEFAULT 'NOT STARTED',
+    "verdict" JSONB,
+    "failure_message" TEXT,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "fit_assessments_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "file_upload_texts" (
+    "file_upload_id" TEXT NOT NULL,
+    "text" TEXT NOT NULL,
+    "text_hash" TEXT NOT NULL,
+    "page_count" INTEGER NOT NULL,
+    "extractor_version" TEXT NOT NULL,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "file_upload_texts_pkey" PRIMARY KEY ("file_upload_id")
+);
+
 -- CreateTable
 CREATE TABLE "file_uploads" (
     "id" TEXT NOT NULL,
@@ -39,10 +123,99 @@ CREATE TABLE "file_uploads" (
     "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
     "processing_status" TEXT NOT NULL,
     "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "failure_category" TEXT,
+    "failure_message" TEXT,
+    "failed_at" TIMESTAMPTZ(3),
+    "parent_file_upload_id" TEXT,
+    "content_hash" TEXT,
+    "duplicate_of_candidate_id" TEXT,
+    "merged_into_candidate_id" TEXT,
+    "processing_started_at" TIMESTAMPTZ(3),
+    "processing_heartbeat_at" TIMESTAMPTZ(3),
+    "processing_reap_count" INTEGER NOT NULL DEFAULT 0,
+    "processing_priority" TEXT NOT NULL DEFAULT 'NORMAL',
+    "dispatched_at" TIMESTAMPTZ(3),
+    "processing_retry_deadline_at" TIMESTAMPTZ(3),
 
     CONSTRAINT "file_uploads_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "job_applications" (
+    "id" TEXT NOT NULL,
+    "job_opening_id" TEXT NOT NULL,
+    "candidate_id" TEXT NOT NULL,
+    "pipeline_stage_id" TEXT,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "job_applications_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "job_openings" (
+    "id" TEXT NOT NULL,
+    "team_id" TEXT NOT NULL,
+    "title" TEXT NOT NULL,
+    "description" TEXT NOT NULL DEFAULT '',
+    "required_skills" TEXT[],
+    "nice_to_have_skills" TEXT[],
+    "location" TEXT NOT NULL DEFAULT '',
+    "min_yoe" INTEGER NOT NULL DEFAULT 0,
+    "status" TEXT NOT NULL DEFAULT 'OPEN',
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "job_openings_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "pipeline_stages" (
+    "id" TEXT NOT NULL,
+    "team_id" TEXT NOT NULL,
+    "name" TEXT NOT NULL,
+    "position" INTEGER NOT NULL,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "pipeline_stages_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "scorecard_criteria" (
+    "id" TEXT NOT NULL,
+    "team_id" TEXT NOT NULL,
+    "name" TEXT NOT NULL,
+    "description" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "scorecard_criteria_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "scorecard_ratings" (
+    "scorecard_id" TEXT NOT NULL,
+    "scorecard_criterion_id" TEXT NOT NULL,
+    "rating" INTEGER NOT NULL,
+
+    CONSTRAINT "scorecard_ratings_pkey" PRIMARY KEY ("scorecard_id", "scorecard_criterion_id"),
+    CONSTRAINT "scorecard_ratings_rating_check" CHECK ("rating" BETWEEN 1 AND 5)
+);
+
+-- CreateTable
+CREATE TABLE "scorecards" (
+    "id" TEXT NOT NULL,
+    "candidate_id" TEXT NOT NULL,
+    "job_opening_id" TEXT,
+    "interviewer_user_id" TEXT,
+    "comment" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "scorecards_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "sessions" (
     "id" TEXT NOT NULL,
@@ -53,6 +226,17 @@ CREATE TABLE "sessions" (
     CONSTRAINT "sessions_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "tags" (
+    "id" TEXT NOT NULL,
+    "team_id" TEXT NOT NULL,
+    "name" TEXT NOT NULL,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "tags_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "teams" (
     "id" TEXT NOT NULL,
@@ -78,29 +262,185 @@ CREATE TABLE "users" (
 -- CreateIndex
 CREATE UNIQUE INDEX "accounts_provider_provider_account_id_key" ON "accounts"("provider" ASC, "provider_account_id" ASC);
 
+-- CreateIndex
+CREATE INDEX "candidate_notes_candidate_id_created_at_idx" ON "candidate_notes"("candidate_id" ASC, "created_at" ASC);
+
+-- CreateIndex
+CREATE INDEX "candidate_persona_revisions_candidate_id_created_at_idx" ON "candidate_persona_revisions"("candidate_id" ASC, "created_at" ASC);
+
+-- CreateIndex
+CREATE INDEX "candidate_stage_changes_candidate_id_created_at_idx" ON "candidate_stage_changes"("candidate_id" ASC, "created_at" ASC);
+
+-- CreateIndex
+CREATE INDEX "candidate_tags_tag_id_idx" ON "candidate_tags"("tag_id" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "candidates_file_upload_id_key" ON "candidates"("file_upload_id" ASC);
 
+-- CreateIndex
+CREATE INDEX "candidates_pipeline_stage_id_idx" ON "candidates"("pipeline_stage_id" ASC);
+
+-- CreateIndex
+CREATE INDEX "candidates_search_vector_idx" ON "candidates" USING GIN ("search_vector");
+
+-- CreateIndex
+CREATE INDEX "file_uploads_merged_into_candidate_id_idx" ON "file_uploads"("merged_into_candidate_id" ASC);
+
+-- CreateIndex
+CREATE INDEX "file_uploads_parent_file_upload_id_idx" ON "file_uploads"("parent_file_upload_id" ASC);
+
+-- CreateIndex
+CREATE INDEX "file_uploads_processing_status_processing_heartbeat_at_idx" ON "file_uploads"("processing_status" ASC, "processing_heartbeat_at" ASC);
+
+-- CreateIndex
+CREATE INDEX "file_uploads_processing_status_team_id_idx" ON "file_uploads"("processing_status" ASC, "team_id" ASC);
+
+-- CreateIndex
+CREATE INDEX "file_uploads_team_id_content_hash_idx" ON "file_uploads"("team_id" ASC, "content_hash" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "fit_assessments_candidate_id_job_opening_id_versions_key" ON "fit_assessments"("candidate_id" ASC, "job_opening_id" ASC, "persona_version" ASC, "job_opening_version" ASC, "prompt_version" ASC);
+
+-- CreateIndex
+CREATE INDEX "fit_assessments_status_idx" ON "fit_assessments"("status" ASC);
+
+-- CreateIndex
+CREATE INDEX "job_applications_candidate_id_idx" ON "job_applications"("candidate_id" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "job_applications_job_opening_id_candidate_id_key" ON "job_applications"("job_opening_id" ASC, "candidate_id" ASC);
+
+-- CreateIndex
+CREATE INDEX "job_openings_team_id_idx" ON "job_openings"("team_id" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "pipeline_stages_team_id_name_key" ON "pipeline_stages"("team_id" ASC, "name" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "scorecard_criteria_team_id_name_key" ON "scorecard_criteria"("team_id" ASC, "name" ASC);
+
+-- CreateIndex
+CREATE INDEX "scorecard_ratings_scorecard_criterion_id_idx" ON "scorecard_ratings"("scorecard_criterion_id" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "scorecards_candidate_id_job_opening_id_interviewer_user_id_key" ON "scorecards"("candidate_id" ASC, (COALESCE("job_opening_id", '')) ASC, "interviewer_user_id" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "sessions_session_token_key" ON "sessions"("session_token" ASC);
 
+-- CreateIndex
+CREATE UNIQUE INDEX "tags_team_id_name_key" ON "tags"("team_id" ASC, "name" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "users_email_key" ON "users"("email" ASC);
 
 -- AddForeignKey
 ALTER TABLE "accounts" ADD CONSTRAINT "accounts_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "candidate_notes" ADD CONSTRAINT "candidate_notes_author_user_id_fkey" FOREIGN KEY ("author_user_id") REFERENCES "users"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_notes" ADD CONSTRAINT "candidate_notes_candidate_id_fkey" FOREIGN KEY ("candidate_id") REFERENCES "candidates"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_persona_revisions" ADD CONSTRAINT "candidate_persona_revisions_candidate_id_fkey" FOREIGN KEY ("candidate_id") REFERENCES "candidates"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_persona_revisions" ADD CONSTRAINT "candidate_persona_revisions_edited_by_user_id_fkey" FOREIGN KEY ("edited_by_user_id") REFERENCES "users"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_persona_revisions" ADD CONSTRAINT "candidate_persona_revisions_reverted_from_revision_id_fkey" FOREIGN KEY ("reverted_from_revision_id") REFERENCES "candidate_persona_revisions"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_stage_changes" ADD CONSTRAINT "candidate_stage_changes_candidate_id_fkey" FOREIGN KEY ("candidate_id") REFERENCES "candidates"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_stage_changes" ADD CONSTRAINT "candidate_stage_changes_changed_by_user_id_fkey" FOREIGN KEY ("changed_by_user_id") REFERENCES "users"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_stage_changes" ADD CONSTRAINT "candidate_stage_changes_from_stage_id_fkey" FOREIGN KEY ("from_stage_id") REFERENCES "pipeline_stages"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_stage_changes" ADD CONSTRAINT "candidate_stage_changes_to_stage_id_fkey" FOREIGN KEY ("to_stage_id") REFERENCES "pipeline_stages"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_tags" ADD CONSTRAINT "candidate_tags_candidate_id_fkey" FOREIGN KEY ("candidate_id") REFERENCES "candidates"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_tags" ADD CONSTRAINT "candidate_tags_tag_id_fkey" FOREIGN KEY ("tag_id") REFERENCES "tags"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "candidates" ADD CONSTRAINT "candidates_file_upload_id_fkey" FOREIGN KEY ("file_upload_id") REFERENCES "file_uploads"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "candidates" ADD CONSTRAINT "candidates_pipeline_stage_id_fkey" FOREIGN KEY ("pipeline_stage_id") REFERENCES "pipeline_stages"("id") ON DELETE NO ACTION ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "candidates" ADD CONSTRAINT "candidates_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "teams"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "file_upload_texts" ADD CONSTRAINT "file_upload_texts_file_upload_id_fkey" FOREIGN KEY ("file_upload_id") REFERENCES "file_uploads"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "file_uploads" ADD CONSTRAINT "file_uploads_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "teams"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "file_uploads" ADD CONSTRAINT "file_uploads_duplicate_of_candidate_id_fkey" FOREIGN KEY ("duplicate_of_candidate_id") REFERENCES "candidates"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "file_uploads" ADD CONSTRAINT "file_uploads_merged_into_candidate_id_fkey" FOREIGN KEY ("merged_into_candidate_id") REFERENCES "candidates"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "file_uploads" ADD CONSTRAINT "file_uploads_parent_file_upload_id_fkey" FOREIGN KEY ("parent_file_upload_id") REFERENCES "file_uploads"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "fit_assessments" ADD CONSTRAINT "fit_assessments_candidate_id_fkey" FOREIGN KEY ("candidate_id") REFERENCES "candidates"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "fit_assessments" ADD CONSTRAINT "fit_assessments_job_opening_id_fkey" FOREIGN KEY ("job_opening_id") REFERENCES "job_openings"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "fit_assessments" ADD CONSTRAINT "fit_assessments_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "teams"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "job_applications" ADD CONSTRAINT "job_applications_candidate_id_fkey" FOREIGN KEY ("candidate_id") REFERENCES "candidates"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "job_applications" ADD CONSTRAINT "job_applications_job_opening_id_fkey" FOREIGN KEY ("job_opening_id") REFERENCES "job_openings"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "job_applications" ADD CONSTRAINT "job_applications_pipeline_stage_id_fkey" FOREIGN KEY ("pipeline_stage_id") REFERENCES "pipeline_stages"("id") ON DELETE NO ACTION ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "job_openings" ADD CONSTRAINT "job_openings_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "teams"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "pipeline_stages" ADD CONSTRAINT "pipeline_stages_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "teams"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "scorecard_criteria" ADD CONSTRAINT "scorecard_criteria_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "teams"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "scorecard_ratings" ADD CONSTRAINT "scorecard_ratings_scorecard_criterion_id_fkey" FOREIGN KEY ("scorecard_criterion_id") REFERENCES "scorecard_criteria"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "scorecard_ratings" ADD CONSTRAINT "scorecard_ratings_scorecard_id_fkey" FOREIGN KEY ("scorecard_id") REFERENCES "scorecards"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "scorecards" ADD CONSTRAINT "scorecards_candidate_id_fkey" FOREIGN KEY ("candidate_id") REFERENCES "candidates"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "scorecards" ADD CONSTRAINT "scorecards_interviewer_user_id_fkey" FOREIGN KEY ("interviewer_user_id") REFERENCES "users"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "scorecards" ADD CONSTRAINT "scorecards_job_opening_id_fkey" FOREIGN KEY ("job_opening_id") REFERENCES "job_openings"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "sessions" ADD CONSTRAINT "sessions_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "tags" ADD CONSTRAINT "tags_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "teams"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "users" ADD CONSTRAINT "users_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "teams"("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -14,3 +14,140 @@ CREATE TRIGGER update_candidate_updated_at BEFORE UPDATE ON candidates FOR EACH
 
 -- FileUpload updated_at trigger
 CREATE TRIGGER update_file_upload_updated_at BEFORE UPDATE ON file_uploads FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
+
+-- FileUploadText updated_at trigger
+CREATE TRIGGER update_file_upload_text_updated_at BEFORE UPDATE ON file_upload_texts FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
+
+-- PipelineStage updated_at trigger
+CREATE TRIGGER update_pipeline_stage_updated_at BEFORE UPDATE ON pipeline_stages FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
+
+-- JobOpening updated_at trigger
+CREATE TRIGGER update_job_opening_updated_at BEFORE UPDATE ON job_openings FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
+
+-- JobApplication updated_at trigger
+CREATE TRIGGER update_job_application_updated_at BEFORE UPDATE ON job_applications FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
+
+-- FitAssessment updated_at trigger
+CREATE TRIGGER update_fit_assessment_updated_at BEFORE UPDATE ON fit_assessments FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
+
+-- CandidateNote updated_at trigger
+CREATE TRIGGER update_candidate_note_updated_at BEFORE UPDATE ON candidate_notes FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
+
+-- Tag updated_at trigger
+CREATE TRIGGER update_tag_updated_at BEFORE UPDATE ON tags FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
+
+-- ScorecardCriterion updated_at trigger
+CREATE TRIGGER update_scorecard_criterion_updated_at BEFORE UPDATE ON scorecard_criteria FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
+
+-- Scorecard updated_at trigger
+CREATE TRIGGER update_scorecard_updated_at BEFORE UPDATE ON scorecards FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
+
+-- CandidatePersonaText function
+-- Joins all string values found at the json path in a persona.
+CREATE OR REPLACE FUNCTION candidate_persona_text(persona JSONB, path JSONPATH)
+RETURNS TEXT AS $$
+    SELECT COALESCE(string_agg(value #>> '{}', ' '), '')
+    FROM jsonb_path_query(COALESCE(persona, '{}'::jsonb), path) AS value
+    WHERE jsonb_typeof(value) = 'string'
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- CandidateSearchDocument function
+-- The searchable text of a candidate. Used to build search snippets.
+CREATE OR REPLACE FUNCTION candidate_search_document(persona JSONB, resume_text TEXT)
+RETURNS TEXT AS $$
+    SELECT concat_ws(' ',
+        candidate_persona_text(persona, '$."Name"'),
+        candidate_persona_text(persona, '$."Tech Skills"[*]'),
+        candidate_persona_text(persona, '$."Soft Skills"[*]'),
+        candidate_persona_text(persona, '$."Experience"[*]."Title"'),
+        candidate_persona_text(persona, '$."Recommended Roles"[*]'),
+        candidate_persona_text(persona, '$."Experience"[*]."Company Name"'),
+        candidate_persona_text(persona, '$."Education"[*]."Qualification"'),
+        candidate_persona_text(persona, '$."Education"[*]."Institute"'),
+        candidate_persona_text(persona, '$."Certifications"[*]'),
+        resume_text
+    )
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- CandidateSearchVector function
+-- Name ranks highest, followed by skills and titles, followed by companies, education and certifications.
+-- The raw resume text ranks lowest.
+CREATE OR REPLACE FUNCTION candidate_search_vector(persona JSONB, resume_text TEXT)
+RETURNS TSVECTOR AS $$
+    SELECT
+        setweight(to_tsvector('english', candidate_persona_text(persona, '$."Name"')), 'A') ||
+        setweight(to_tsvector('english', concat_ws(' ',
+            candidate_persona_text(persona, '$."Tech Skills"[*]'),
+            candidate_persona_text(persona, '$."Soft Skills"[*]'),
+            candidate_persona_text(persona, '$."Experience"[*]."Title"'),
+            candidate_persona_text(persona, '$."Recommended Roles"[*]')
+        )), 'B') ||
+        setweight(to_tsvector('english', concat_ws(' ',
+            candidate_persona_text(persona, '$."Experience"[*]."Company Name"'),
+            candidate_persona_text(persona, '$."Education"[*]."Qualification"'),
+            candidate_persona_text(persona, '$."Education"[*]."Institute"'),
+            candidate_persona_text(persona, '$."Certifications"[*]')
+        )), 'C') ||
+        setweight(to_tsvector('english', COALESCE(resume_text, '')), 'D')
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- CreateUpdateCandidateSearchVectorFunction
+CREATE OR REPLACE FUNCTION update_candidate_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector = candidate_search_vector(
+        COALESCE(NEW.manually_created_persona, NEW.ai_generated_persona),
+        (SELECT text FROM file_upload_texts WHERE file_upload_id = NEW.file_upload_id)
+    );
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+-- Candidate search_vector trigger
+CREATE TRIGGER update_candidate_search_vector BEFORE INSERT OR UPDATE OF ai_generated_persona, manually_created_persona, file_upload_id ON candidates FOR EACH ROW EXECUTE PROCEDURE  update_candidate_search_vector();
+
+-- CreateRefreshCandidateSearchVectorFunction
+-- Keeps the search_vector of a candidate in sync when the text of its file upload changes.
+CREATE OR REPLACE FUNCTION refresh_candidate_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE candidates
+    SET search_vector = candidate_search_vector(COALESCE(manually_created_persona, ai_generated_persona), NEW.text)
+    WHERE file_upload_id = NEW.file_upload_id;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+-- FileUploadText search_vector trigger
+CREATE TRIGGER refresh_candidate_search_vector AFTER INSERT OR UPDATE OF text ON file_upload_texts FOR EACH ROW EXECUTE PROCEDURE  refresh_candidate_search_vector();
+
+-- CreateNotifyFileUploadReadyFunction
+-- Also notifies when a file upload stops processing, since that frees up a slot for its team.
+CREATE OR REPLACE FUNCTION notify_file_upload_ready()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.status = 'SUCCESS' AND NEW.processing_status = 'NOT STARTED' THEN
+        PERFORM pg_notify('file_upload_ready', NEW.id);
+    ELSIF TG_OP = 'UPDATE' AND OLD.processing_status IN ('ONGOING', 'RETRYING') AND NEW.processing_status NOT IN ('ONGOING', 'RETRYING') THEN
+        PERFORM pg_notify('file_upload_ready', NEW.id);
+    END IF;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+-- FileUpload ready trigger
+CREATE TRIGGER notify_file_upload_ready AFTER INSERT OR UPDATE OF status, processing_status ON file_uploads FOR EACH ROW EXECUTE PROCEDURE  notify_file_upload_ready();
+
+-- CreateNotifyFitAssessmentReadyFunction
+CREATE OR REPLACE FUNCTION notify_fit_assessment_ready()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.status = 'NOT STARTED' THEN
+        PERFORM pg_notify('fit_assessment_ready', NEW.id);
+    END IF;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+-- FitAssessment ready trigger
+CREATE TRIGGER notify_fit_assessment_ready AFTER INSERT OR UPDATE OF status ON fit_assessments FOR EACH ROW EXECUTE PROCEDURE  notify_fit_assessment_ready();
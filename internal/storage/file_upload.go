@@ -3,6 +3,7 @@ package storage
 import (
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
@@ -12,14 +13,25 @@ import (
 type FileUploadAccessor interface {
 	GetFileUpload(id string) (*model.FileUpload, error)
 	GetFileUploadUsingTx(id string, tx DatabaseTransaction) (*model.FileUpload, error)
-	GetFileUploadsForTeam(team *model.Team) ([]*model.FileUpload, error)
+	GetFileUploadsForTeam(team *model.Team, page *model.PageRequest) ([]*model.FileUpload, *model.PageInfo, error)
+	GetChildFileUploadsForTeam(parentFileUploadId string, team *model.Team) ([]*model.FileUpload, error)
 	GetUnprocessedFileUploadsCountForTeam(team *model.Team) (int, error)
-	GetAllProcessingNotStartedFileUploadIds() ([]string, error)
+	DispatchFileUploadIds(maxConcurrentFileUploads, maxConcurrentFileUploadsPerTeam int, dispatchLease time.Duration) ([]string, error)
+	GetProcessingQueuePositionForTeam(team *model.Team, dispatchLease time.Duration) (int, error)
 	CreateFileUploadForTeam(name string, team *model.Team) (*model.FileUpload, error)
+	CreateChildFileUploadForTeam(name string, parent *model.FileUpload) (*model.FileUpload, error)
 	UpdateFileUploadWithPresignedUrl(id, presignedUrl string) error
 	UpdateFileUploadWithStatus(id, status string) error
 	UpdateFileUploadWithProcessingStatus(id, processingStatus string) error
 	UpdateFileUploadWithProcessingStatusUsingTx(id, processingStatus string, tx DatabaseTransaction) error
+	UpdateFileUploadWithProcessingFailure(id string, failure *model.FileUploadFailure) error
+	UpdateFileUploadWithProcessingRetry(id string, failure *model.FileUploadFailure, retryDeadline time.Time) error
+	UpdateFileUploadProcessingHeartbeat(id string) error
+	ReapStaleProcessingFileUploads(lease time.Duration, maxReapCount int) (int, error)
+	UpdateFileUploadWithContentHashUsingTx(id, contentHash string, tx DatabaseTransaction) error
+	GetDuplicateCandidateIdForFileUploadUsingTx(fileUpload *model.FileUpload, contentHash string, tx DatabaseTransaction) (string, error)
+	UpdateFileUploadAsDuplicateUsingTx(id, duplicateOfCandidateId string, tx DatabaseTransaction) error
+	ResetFileUploadProcessingForTeam(id string, team *model.Team) error
 	DeleteFileUploadForTeam(id string, team *model.Team) error
 }
 
@@ -38,9 +50,14 @@ func getFileUploadUsingCustomDbHandler(customDb customDbHandler, id string, excl
 
 	var name, status, presignedUrl, teamId, teamName, processingStatus string
 	var teamFileCountLimit, teamCurrentFileCount int64
+	var failureCategory, failureMessage, parentFileUploadId, contentHash, duplicateOfCandidateId, mergedIntoCandidateId sql.NullString
+	var failedAt sql.NullTime
 	queryWithoutLock := `
 		SELECT
-		f.name, f.status, f.presigned_url, f.processing_status, t.id, t.name, t.file_count_limit, t.current_file_count
+		f.name, f.status, f.presigned_url, f.processing_status,
+		f.failure_category, f.failure_message, f.failed_at, f.parent_file_upload_id,
+		f.content_hash, f.duplicate_of_candidate_id, f.merged_into_candidate_id,
+		t.id, t.name, t.file_count_limit, t.current_file_count
 		FROM public."file_uploads" AS f
 		JOIN (
 			SELECT
@@ -52,6 +69,7 @@ func getFileUploadUsingCustomDbHandler(customDb customDbHandler, id string, excl
 			LEFT JOIN
 			public."file_uploads"
 			ON teams.id = file_uploads.team_id
+			AND file_uploads.processing_status <> 'DUPLICATE'
 			GROUP BY teams.id
 		) t
 		ON f.team_id = t.id
@@ -74,9 +92,10 @@ func getFileUploadUsingCustomDbHandler(customDb customDbHandler, id string, excl
 		query, id,
 	)
 	err := row.Scan(
-		&name, &status, &presignedUrl,
-		&processingStatus, &teamId, &teamName,
-		&teamFileCountLimit, &teamCurrentFileCount,
+		&name, &status, &presignedUrl, &processingStatus,
+		&failureCategory, &failureMessage, &failedAt, &parentFileUploadId,
+		&contentHash, &duplicateOfCandidateId, &mergedIntoCandidateId,
+		&teamId, &teamName, &teamFileCountLimit, &teamCurrentFileCount,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -97,29 +116,86 @@ func getFileUploadUsingCustomDbHandler(customDb customDbHandler, id string, excl
 		return nil, err
 	}
 
+	failure, err := fileUploadFailureFromColumns(failureCategory, failureMessage, failedAt)
+	if err != nil {
+		return nil, err
+	}
+
 	return model.NewFileUpload(model.FileUploadOptions{
-		Id:               id,
-		Name:             name,
-		PresignedUrl:     presignedUrl,
-		ProcessingStatus: processingStatus,
-		Status:           status,
-		Team:             team,
+		Id:                     id,
+		Name:                   name,
+		PresignedUrl:           presignedUrl,
+		ProcessingStatus:       processingStatus,
+		Status:                 status,
+		Team:                   team,
+		Failure:                failure,
+		ParentFileUploadId:     parentFileUploadId.String,
+		ContentHash:            contentHash.String,
+		DuplicateOfCandidateId: duplicateOfCandidateId.String,
+		MergedIntoCandidateId:  mergedIntoCandidateId.String,
 	})
 }
 
-func (s *Storage) GetFileUploadsForTeam(team *model.Team) ([]*model.FileUpload, error) {
+func fileUploadFailureFromColumns(category, message sql.NullString, failedAt sql.NullTime) (*model.FileUploadFailure, error) {
+	if !category.Valid {
+		return nil, nil
+	}
+
+	return model.NewFileUploadFailure(model.FileUploadFailureOptions{
+		Category: category.String,
+		Message:  message.String,
+		FailedAt: failedAt.Time,
+	})
+}
+
+var fileUploadKeysetColumns = []keysetColumn{
+	{name: "created_at", expression: "f.created_at", sqlType: "timestamptz"},
+	{name: "id", expression: "f.id", sqlType: "text"},
+}
+
+func (s *Storage) GetFileUploadsForTeam(team *model.Team, page *model.PageRequest) ([]*model.FileUpload, *model.PageInfo, error) {
 	if team == nil || utilities.IsBlank(team.Id()) {
-		return nil, errors.New("team cannot be blank")
+		return nil, nil, errors.New("team cannot be blank")
 	}
 
-	rows, err := s.db.Query(
-		`SELECT f.id, f.name, f.status, f.presigned_url, f.processing_status
+	var totalCount int
+	row := s.db.QueryRow(
+		`SELECT count(f.id)
 		FROM public."file_uploads" AS f
-		WHERE f.team_id = $1 ORDER BY f.created_at ASC, f.id ASC`,
+		WHERE f.team_id = $1`,
 		team.Id(),
 	)
+	err := row.Scan(&totalCount)
 	if err != nil {
-		return nil, utilities.WrapBadError(err, "failed to select file_uploads")
+		return nil, nil, utilities.WrapBadError(err, "failed to count file_uploads")
+	}
+
+	pagination, err := newKeysetPagination("file_uploads", fileUploadKeysetColumns, page)
+	if err != nil {
+		return nil, nil, err
+	}
+	conditions := newSqlConditions(team.Id())
+	limitSql := pagination.apply(conditions)
+
+	rows, err := s.db.Query(
+		fmt.Sprintf(
+			`SELECT f.id, f.name, f.status, f.presigned_url, f.processing_status,
+		f.failure_category, f.failure_message, f.failed_at, f.parent_file_upload_id,
+		f.content_hash, f.duplicate_of_candidate_id, f.merged_into_candidate_id, %s
+		FROM public."file_uploads" AS f
+		WHERE f.team_id = $1
+		%s
+		%s
+		%s`,
+			keysetSelectSql(fileUploadKeysetColumns),
+			conditions.sql(),
+			keysetOrderBySql(fileUploadKeysetColumns),
+			limitSql,
+		),
+		conditions.args...,
+	)
+	if err != nil {
+		return nil, nil, utilities.WrapBadError(err, "failed to select file_uploads")
 	}
 	defer rows.Close()
 
@@ -127,19 +203,125 @@ func (s *Storage) GetFileUploadsForTeam(team *model.Team) ([]*model.FileUpload,
 
 	for rows.Next() {
 		var id, name, status, presignedUrl, processingStatus string
-		err := rows.Scan(&id, &name, &status, &presignedUrl, &processingStatus)
+		var failureCategory, failureMessage, parentFileUploadId, contentHash, duplicateOfCandidateId, mergedIntoCandidateId sql.NullString
+		var failedAt sql.NullTime
+		keysetValues, keysetScanners := pagination.scanners()
+		err := rows.Scan(
+			append(
+				[]any{
+					&id, &name, &status, &presignedUrl, &processingStatus, &failureCategory, &failureMessage, &failedAt,
+					&parentFileUploadId, &contentHash, &duplicateOfCandidateId, &mergedIntoCandidateId,
+				},
+				keysetScanners...,
+			)...,
+		)
+
+		if err != nil {
+			return nil, nil, utilities.WrapBadError(err, "failed while scanning rows")
+		}
+
+		if !pagination.next(keysetValues) {
+			break
+		}
+
+		failure, err := fileUploadFailureFromColumns(failureCategory, failureMessage, failedAt)
+		if err != nil {
+			// TODO: Log this error?
+			continue
+		}
+
+		fileUpload, err := model.NewFileUpload(model.FileUploadOptions{
+			Id:                     id,
+			Name:                   name,
+			PresignedUrl:           presignedUrl,
+			ProcessingStatus:       processingStatus,
+			Status:                 status,
+			Team:                   team,
+			Failure:                failure,
+			ParentFileUploadId:     parentFileUploadId.String,
+			ContentHash:            contentHash.String,
+			DuplicateOfCandidateId: duplicateOfCandidateId.String,
+			MergedIntoCandidateId:  mergedIntoCandidateId.String,
+		})
+
+		if err != nil {
+			// TODO: Log this error?
+			continue
+		}
+
+		fileUploads = append(fileUploads, fileUpload)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, nil, utilities.WrapBadError(err, "failed to correctly go through file_upload rows")
+	}
+
+	nextPageToken, err := pagination.nextPageToken()
+	if err != nil {
+		return nil, nil, utilities.WrapBadError(err, "failed to create next page token for file_uploads")
+	}
+
+	return fileUploads, model.NewPageInfo(nextPageToken, totalCount), nil
+}
+
+// GetChildFileUploadsForTeam returns the file uploads unpacked from an archive, in the order they were created.
+// It is not paginated, since the file count limit of a team bounds the number of children.
+func (s *Storage) GetChildFileUploadsForTeam(parentFileUploadId string, team *model.Team) ([]*model.FileUpload, error) {
+	if utilities.IsBlank(parentFileUploadId) {
+		return nil, errors.New("parentFileUploadId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
 
+	rows, err := s.db.Query(
+		`SELECT f.id, f.name, f.status, f.presigned_url, f.processing_status,
+		f.failure_category, f.failure_message, f.failed_at,
+		f.content_hash, f.duplicate_of_candidate_id, f.merged_into_candidate_id
+		FROM public."file_uploads" AS f
+		WHERE f.parent_file_upload_id = $1 AND f.team_id = $2
+		ORDER BY f.created_at ASC, f.id ASC`,
+		parentFileUploadId, team.Id(),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to select child file_uploads")
+	}
+	defer rows.Close()
+
+	fileUploads := []*model.FileUpload{}
+
+	for rows.Next() {
+		var id, name, status, presignedUrl, processingStatus string
+		var failureCategory, failureMessage, contentHash, duplicateOfCandidateId, mergedIntoCandidateId sql.NullString
+		var failedAt sql.NullTime
+		err := rows.Scan(
+			&id, &name, &status, &presignedUrl, &processingStatus, &failureCategory, &failureMessage, &failedAt,
+			&contentHash, &duplicateOfCandidateId, &mergedIntoCandidateId,
+		)
 		if err != nil {
 			return nil, utilities.WrapBadError(err, "failed while scanning rows")
 		}
 
+		failure, err := fileUploadFailureFromColumns(failureCategory, failureMessage, failedAt)
+		if err != nil {
+			// TODO: Log this error?
+			continue
+		}
+
 		fileUpload, err := model.NewFileUpload(model.FileUploadOptions{
-			Id:               id,
-			Name:             name,
-			PresignedUrl:     presignedUrl,
-			ProcessingStatus: processingStatus,
-			Status:           status,
-			Team:             team,
+			Id:                     id,
+			Name:                   name,
+			PresignedUrl:           presignedUrl,
+			ProcessingStatus:       processingStatus,
+			Status:                 status,
+			Team:                   team,
+			Failure:                failure,
+			ParentFileUploadId:     parentFileUploadId,
+			ContentHash:            contentHash.String,
+			DuplicateOfCandidateId: duplicateOfCandidateId.String,
+			MergedIntoCandidateId:  mergedIntoCandidateId.String,
 		})
 
 		if err != nil {
@@ -152,7 +334,7 @@ func (s *Storage) GetFileUploadsForTeam(team *model.Team) ([]*model.FileUpload,
 
 	err = rows.Err()
 	if err != nil {
-		return nil, utilities.WrapBadError(err, "failed to correctly go through file_upload rows")
+		return nil, utilities.WrapBadError(err, "failed to correctly go through child file_upload rows")
 	}
 	return fileUploads, nil
 }
@@ -167,7 +349,7 @@ func (s *Storage) GetUnprocessedFileUploadsCountForTeam(team *model.Team) (int,
 		`SELECT count(id)
 		FROM public."file_uploads"
 		WHERE team_id = $1
-		AND processing_status <> 'COMPLETED'`,
+		AND processing_status NOT IN ('COMPLETED', 'DUPLICATE')`,
 		team.Id(),
 	)
 	err := row.Scan(&count)
@@ -181,16 +363,68 @@ func (s *Storage) GetUnprocessedFileUploadsCountForTeam(team *model.Team) (int,
 	return count, nil
 }
 
-func (s *Storage) GetAllProcessingNotStartedFileUploadIds() ([]string, error) {
+// fileUploadProcessingLaneSql orders file uploads by their processing_priority, with HIGH first.
+const fileUploadProcessingLaneSql = `CASE processing_priority WHEN 'HIGH' THEN 0 WHEN 'NORMAL' THEN 1 ELSE 2 END`
+
+// waitingFileUploadsSql selects the file uploads waiting to be dispatched, numbered by their turn within their team.
+// Both DispatchFileUploadIds and GetProcessingQueuePositionForTeam use it, with the dispatch lease in seconds as $2.
+const waitingFileUploadsSql = `SELECT id, team_id, created_at, ` + fileUploadProcessingLaneSql + ` AS lane,
+	row_number() OVER (PARTITION BY team_id ORDER BY ` + fileUploadProcessingLaneSql + `, created_at, id) AS team_turn
+	FROM public."file_uploads"
+	WHERE status = 'SUCCESS'
+	AND processing_status = 'NOT STARTED'
+	AND (dispatched_at IS NULL OR dispatched_at <= now() - make_interval(secs => $2))`
+
+// finishedProcessingPrioritySql moves a HIGH priority file upload back to NORMAL. It only stays HIGH until the processing someone is waiting on has finished.
+const finishedProcessingPrioritySql = `CASE WHEN processing_priority = 'HIGH' THEN 'NORMAL' ELSE processing_priority END`
+
+// DispatchFileUploadIds picks the file uploads that should be processed next, marks them as dispatched and returns them in the order they should be processed.
+// Teams take turns, so that a team that uploaded a lot of files does not hold up everyone else. A team's HIGH priority uploads go before its NORMAL ones,
+// which go before its LOW ones. No team has more than maxConcurrentFileUploadsPerTeam dispatched, being processed or waiting to be retried at once,
+// and no more than maxConcurrentFileUploads across all teams.
+// A file upload that is still not started dispatchLease after it was dispatched is assumed lost, and is dispatched again.
+func (s *Storage) DispatchFileUploadIds(maxConcurrentFileUploads, maxConcurrentFileUploadsPerTeam int, dispatchLease time.Duration) ([]string, error) {
+	if maxConcurrentFileUploads <= 0 {
+		return nil, errors.New("maxConcurrentFileUploads should be positive")
+	}
+
+	if maxConcurrentFileUploadsPerTeam <= 0 {
+		return nil, errors.New("maxConcurrentFileUploadsPerTeam should be positive")
+	}
+
+	if dispatchLease <= 0 {
+		return nil, errors.New("dispatchLease should be positive")
+	}
+
 	rows, err := s.db.Query(
-		`SELECT id
-		FROM public."file_uploads"
-		WHERE status = 'SUCCESS'
-		AND processing_status = 'NOT STARTED'
-		ORDER BY created_at ASC, id ASC`,
+		`WITH in_flight AS (
+			SELECT team_id, count(*) AS count
+			FROM public."file_uploads"
+			WHERE processing_status IN ('ONGOING', 'RETRYING')
+			OR (processing_status = 'NOT STARTED' AND dispatched_at > now() - make_interval(secs => $2))
+			GROUP BY team_id
+		), waiting AS (
+			`+waitingFileUploadsSql+`
+		), picked AS (
+			SELECT w.id, row_number() OVER (ORDER BY w.team_turn, w.lane, w.created_at, w.id) AS position
+			FROM waiting AS w
+			LEFT JOIN in_flight AS i ON i.team_id = w.team_id
+			WHERE w.team_turn <= $3 - COALESCE(i.count, 0)
+			ORDER BY position
+			LIMIT GREATEST($1::int - (SELECT COALESCE(sum(count), 0)::int FROM in_flight), 0)
+		), dispatched AS (
+			UPDATE public."file_uploads" SET "dispatched_at" = now()
+			WHERE id IN (SELECT id FROM picked)
+			RETURNING id
+		)
+		SELECT picked.id
+		FROM picked
+		JOIN dispatched ON dispatched.id = picked.id
+		ORDER BY picked.position`,
+		maxConcurrentFileUploads, dispatchLease.Seconds(), maxConcurrentFileUploadsPerTeam,
 	)
 	if err != nil {
-		return nil, utilities.WrapBadError(err, "failed to select file_upload ids")
+		return nil, utilities.WrapBadError(err, "failed to dispatch file_upload ids")
 	}
 	defer rows.Close()
 
@@ -213,17 +447,65 @@ func (s *Storage) GetAllProcessingNotStartedFileUploadIds() ([]string, error) {
 	return fileUploadIds, nil
 }
 
+// GetProcessingQueuePositionForTeam returns how many file uploads, across all teams, will be dispatched before the team's next one, plus one.
+// It returns 0 when the team has no file uploads waiting to be dispatched. dispatchLease should be the one given to DispatchFileUploadIds.
+func (s *Storage) GetProcessingQueuePositionForTeam(team *model.Team, dispatchLease time.Duration) (int, error) {
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return 0, errors.New("team cannot be blank")
+	}
+
+	if dispatchLease <= 0 {
+		return 0, errors.New("dispatchLease should be positive")
+	}
+
+	var position int
+	row := s.db.QueryRow(
+		`WITH waiting AS (
+			`+waitingFileUploadsSql+`
+		), queue AS (
+			SELECT team_id, row_number() OVER (ORDER BY team_turn, lane, created_at, id) AS position
+			FROM waiting
+		)
+		SELECT COALESCE(min(position), 0) FROM queue WHERE team_id = $1`,
+		team.Id(), dispatchLease.Seconds(),
+	)
+	err := row.Scan(&position)
+	if err != nil {
+		return 0, utilities.WrapBadError(err, fmt.Sprintf("dbError while getting processing queue position for team: %s", team.Id()))
+	}
+	return position, nil
+}
+
 func (s *Storage) CreateFileUploadForTeam(name string, team *model.Team) (*model.FileUpload, error) {
+	return s.createFileUploadForTeam(name, team, "")
+}
+
+// CreateChildFileUploadForTeam creates a file upload for a file unpacked from the parent archive, in the team of the parent.
+func (s *Storage) CreateChildFileUploadForTeam(name string, parent *model.FileUpload) (*model.FileUpload, error) {
+	if parent == nil {
+		return nil, errors.New("parent cannot be nil")
+	}
+
+	return s.createFileUploadForTeam(name, parent.Team(), parent.Id())
+}
+
+func (s *Storage) createFileUploadForTeam(name string, team *model.Team, parentFileUploadId string) (*model.FileUpload, error) {
 	id := s.IdGenerator.Generate()
 	initialFileUploadStatus := "INITIATED"
 	initialFileUploadProcessingStatus := "NOT STARTED"
+	// Files unpacked from an archive are a bulk upload, so they wait behind files that were uploaded on their own.
+	processingPriority := "NORMAL"
+	if !utilities.IsBlank(parentFileUploadId) {
+		processingPriority = "LOW"
+	}
 
 	newFileUpload, err := model.NewFileUpload(model.FileUploadOptions{
-		Id:               id,
-		Name:             name,
-		Status:           initialFileUploadStatus,
-		ProcessingStatus: initialFileUploadProcessingStatus,
-		Team:             team,
+		Id:                 id,
+		Name:               name,
+		Status:             initialFileUploadStatus,
+		ProcessingStatus:   initialFileUploadProcessingStatus,
+		Team:               team,
+		ParentFileUploadId: parentFileUploadId,
 	})
 	if err != nil {
 		return nil, err
@@ -231,10 +513,10 @@ func (s *Storage) CreateFileUploadForTeam(name string, team *model.Team) (*model
 
 	result, err := s.db.Exec(
 		`INSERT INTO public."file_uploads"
-		("id", "name", "presigned_url", "status", "processing_status", "team_id")
+		("id", "name", "presigned_url", "status", "processing_status", "team_id", "parent_file_upload_id", "processing_priority")
 		VALUES
-		($1, $2, '', $3, $4, $5)`,
-		id, name, initialFileUploadStatus, initialFileUploadProcessingStatus, team.Id(),
+		($1, $2, '', $3, $4, $5, NULLIF($6, ''), $7)`,
+		id, name, initialFileUploadStatus, initialFileUploadProcessingStatus, team.Id(), parentFileUploadId, processingPriority,
 	)
 	if err != nil {
 		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while inserting file_upload: %s", id))
@@ -317,7 +599,17 @@ func updateFileUploadWithProcessingStatusUsingCustomDbHandler(customDb customDbH
 		return errors.New("processing status should be valid")
 	}
 
-	result, err := customDb.Exec(`UPDATE public."file_uploads" SET "processing_status" = $2 WHERE id = $1`, id, processingStatus)
+	// A failure only describes the processing status it was recorded with, so it is cleared whenever the status moves on.
+	// The heartbeat is only kept while processing is ONGOING.
+	result, err := customDb.Exec(
+		`UPDATE public."file_uploads"
+		SET "processing_status" = $2, "failure_category" = NULL, "failure_message" = NULL, "failed_at" = NULL,
+		"processing_started_at" = CASE WHEN $2 = 'ONGOING' THEN now() ELSE processing_started_at END,
+		"processing_heartbeat_at" = CASE WHEN $2 = 'ONGOING' THEN now() ELSE NULL END, "dispatched_at" = NULL, "processing_retry_deadline_at" = NULL,
+		"processing_priority" = CASE WHEN $2 IN ('COMPLETED', 'FAILED', 'DUPLICATE') THEN `+finishedProcessingPrioritySql+` ELSE processing_priority END
+		WHERE id = $1`,
+		id, processingStatus,
+	)
 	if err != nil {
 		return utilities.WrapBadError(err, fmt.Sprintf("dbError while updating fileUpload: %s %s", id, processingStatus))
 	}
@@ -333,6 +625,282 @@ func updateFileUploadWithProcessingStatusUsingCustomDbHandler(customDb customDbH
 	return nil
 }
 
+func (s *Storage) UpdateFileUploadWithProcessingFailure(id string, failure *model.FileUploadFailure) error {
+	return s.updateFileUploadWithProcessingStatusAndFailure(id, "FAILED", failure, sql.NullTime{})
+}
+
+// UpdateFileUploadWithProcessingRetry records why processing failed while the file upload waits to be processed again.
+// The retry should have started by retryDeadline. If it has not, ReapStaleProcessingFileUploads assumes it was lost.
+func (s *Storage) UpdateFileUploadWithProcessingRetry(id string, failure *model.FileUploadFailure, retryDeadline time.Time) error {
+	if retryDeadline.IsZero() {
+		return errors.New("retryDeadline cannot be zero")
+	}
+	return s.updateFileUploadWithProcessingStatusAndFailure(id, "RETRYING", failure, sql.NullTime{Time: retryDeadline, Valid: true})
+}
+
+func (s *Storage) updateFileUploadWithProcessingStatusAndFailure(id, processingStatus string, failure *model.FileUploadFailure, retryDeadline sql.NullTime) error {
+	if utilities.IsBlank(id) {
+		return errors.New("id cannot be blank")
+	}
+
+	if failure == nil {
+		return errors.New("failure cannot be nil")
+	}
+
+	result, err := s.db.Exec(
+		`UPDATE public."file_uploads"
+		SET "processing_status" = $2, "failure_category" = $3, "failure_message" = $4, "failed_at" = $5,
+		"processing_heartbeat_at" = NULL, "processing_retry_deadline_at" = $6,
+		"processing_priority" = CASE WHEN $2 = 'FAILED' THEN `+finishedProcessingPrioritySql+` ELSE processing_priority END
+		WHERE id = $1`,
+		id, processingStatus, failure.Category(), failure.Message(), failure.FailedAt(), retryDeadline,
+	)
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while updating fileUpload with failure: %s %s", id, failure.Category()))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while checking affected row while updating fileUpload with failure: %s %s", id, failure.Category()))
+	}
+
+	if rowsAffected != 1 {
+		return utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when updating file_upload in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+	return nil
+}
+
+// UpdateFileUploadProcessingHeartbeat records that a worker is still processing the file upload.
+// A file upload that is locked by the transaction processing it is skipped, since the lock already shows that it is being worked on.
+func (s *Storage) UpdateFileUploadProcessingHeartbeat(id string) error {
+	if utilities.IsBlank(id) {
+		return errors.New("id cannot be blank")
+	}
+
+	_, err := s.db.Exec(
+		`UPDATE public."file_uploads"
+		SET "processing_heartbeat_at" = now()
+		WHERE id IN (
+			SELECT id FROM public."file_uploads"
+			WHERE id = $1 AND processing_status = 'ONGOING'
+			FOR UPDATE SKIP LOCKED
+		)`,
+		id,
+	)
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while updating heartbeat of fileUpload: %s", id))
+	}
+	return nil
+}
+
+// staleProcessingFileUploadSql matches the file uploads that ReapStaleProcessingFileUploads reaps, with the lease in seconds as $1.
+// A RETRYING upload without a retry deadline is given the lease from when it was last updated.
+const staleProcessingFileUploadSql = `((processing_status = 'ONGOING' AND COALESCE(processing_heartbeat_at, updated_at) < now() - make_interval(secs => $1))
+	OR (processing_status = 'RETRYING' AND COALESCE(processing_retry_deadline_at, updated_at) < now() - make_interval(secs => $1)))`
+
+// ReapStaleProcessingFileUploads finds file uploads left ONGOING whose heartbeat is older than the lease, which happens when the worker processing them crashed.
+// It also finds file uploads left RETRYING more than the lease past their retry deadline, which happens when the retry job was lost.
+// They are moved back to NOT STARTED so they are processed again, unless they have already been reaped maxReapCount times, in which case they are failed.
+// It returns the number of file uploads reaped.
+func (s *Storage) ReapStaleProcessingFileUploads(lease time.Duration, maxReapCount int) (int, error) {
+	if lease <= 0 {
+		return 0, errors.New("lease should be positive")
+	}
+
+	if maxReapCount < 0 {
+		return 0, errors.New("maxReapCount cannot be negative")
+	}
+
+	// Rows locked by an open transaction are skipped, as the worker holding the lock is still alive.
+	failedResult, err := s.db.Exec(
+		`UPDATE public."file_uploads"
+		SET "processing_status" = 'FAILED', "failure_category" = 'PROCESSING STALLED',
+		"failure_message" = 'processing stopped responding too many times', "failed_at" = now(),
+		"processing_heartbeat_at" = NULL, "processing_retry_deadline_at" = NULL, "processing_priority" = `+finishedProcessingPrioritySql+`
+		WHERE id IN (
+			SELECT id FROM public."file_uploads"
+			WHERE `+staleProcessingFileUploadSql+`
+			AND processing_reap_count >= $2
+			FOR UPDATE SKIP LOCKED
+		)`,
+		lease.Seconds(), maxReapCount,
+	)
+	if err != nil {
+		return 0, utilities.WrapBadError(err, "dbError while failing stale fileUploads")
+	}
+
+	failedCount, err := failedResult.RowsAffected()
+	if err != nil {
+		return 0, utilities.WrapBadError(err, "dbError while checking affected rows while failing stale fileUploads")
+	}
+
+	resetResult, err := s.db.Exec(
+		`UPDATE public."file_uploads"
+		SET "processing_status" = 'NOT STARTED', "processing_heartbeat_at" = NULL, "dispatched_at" = NULL,
+		"processing_retry_deadline_at" = NULL, "processing_reap_count" = processing_reap_count + 1
+		WHERE id IN (
+			SELECT id FROM public."file_uploads"
+			WHERE `+staleProcessingFileUploadSql+`
+			AND processing_reap_count < $2
+			FOR UPDATE SKIP LOCKED
+		)`,
+		lease.Seconds(), maxReapCount,
+	)
+	if err != nil {
+		return int(failedCount), utilities.WrapBadError(err, "dbError while resetting stale fileUploads")
+	}
+
+	resetCount, err := resetResult.RowsAffected()
+	if err != nil {
+		return int(failedCount), utilities.WrapBadError(err, "dbError while checking affected rows while resetting stale fileUploads")
+	}
+
+	return int(failedCount + resetCount), nil
+}
+
+func (s *Storage) UpdateFileUploadWithContentHashUsingTx(id, contentHash string, tx DatabaseTransaction) error {
+	if utilities.IsBlank(id) {
+		return errors.New("id cannot be blank")
+	}
+
+	if utilities.IsBlank(contentHash) {
+		return errors.New("contentHash cannot be blank")
+	}
+
+	result, err := tx.Exec(`UPDATE public."file_uploads" SET "content_hash" = $2 WHERE id = $1`, id, contentHash)
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while updating fileUpload with content hash: %s %s", id, contentHash))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while checking affected row while updating fileUpload with content hash: %s %s", id, contentHash))
+	}
+
+	if rowsAffected != 1 {
+		return utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when updating file_upload in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+	return nil
+}
+
+// GetDuplicateCandidateIdForFileUploadUsingTx returns the id of the candidate created from an earlier upload of the same file by the team.
+// It returns an empty id when the file has not been processed into a candidate before.
+// It holds a lock on the team and content hash until tx ends, so concurrent uploads of the same file cannot both create a candidate.
+func (s *Storage) GetDuplicateCandidateIdForFileUploadUsingTx(fileUpload *model.FileUpload, contentHash string, tx DatabaseTransaction) (string, error) {
+	if fileUpload == nil {
+		return "", errors.New("fileUpload cannot be nil")
+	}
+
+	if utilities.IsBlank(contentHash) {
+		return "", errors.New("contentHash cannot be blank")
+	}
+
+	_, err := tx.Exec(
+		`SELECT pg_advisory_xact_lock(hashtext($1::text || ':' || $2::text))`,
+		fileUpload.Team().Id(), contentHash,
+	)
+	if err != nil {
+		return "", utilities.WrapBadError(err, fmt.Sprintf("dbError while locking content hash for fileUpload: %s", fileUpload.Id()))
+	}
+
+	var candidateId string
+	row := tx.QueryRow(
+		`SELECT c.id
+		FROM public."file_uploads" AS f
+		JOIN public."candidates" AS c
+		ON c.file_upload_id = f.id
+		WHERE f.team_id = $1 AND f.content_hash = $2 AND f.id <> $3
+		ORDER BY f.created_at ASC, f.id ASC
+		LIMIT 1`,
+		fileUpload.Team().Id(), contentHash, fileUpload.Id(),
+	)
+	err = row.Scan(&candidateId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		return "", utilities.WrapBadError(err, fmt.Sprintf("dbError while getting duplicate candidate for fileUpload: %s", fileUpload.Id()))
+	}
+	return candidateId, nil
+}
+
+func (s *Storage) UpdateFileUploadAsDuplicateUsingTx(id, duplicateOfCandidateId string, tx DatabaseTransaction) error {
+	if utilities.IsBlank(id) {
+		return errors.New("id cannot be blank")
+	}
+
+	if utilities.IsBlank(duplicateOfCandidateId) {
+		return errors.New("duplicateOfCandidateId cannot be blank")
+	}
+
+	result, err := tx.Exec(
+		`UPDATE public."file_uploads"
+		SET "processing_status" = 'DUPLICATE', "duplicate_of_candidate_id" = $2, "processing_heartbeat_at" = NULL,
+		"processing_priority" = `+finishedProcessingPrioritySql+`
+		WHERE id = $1`,
+		id, duplicateOfCandidateId,
+	)
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while updating fileUpload as duplicate: %s %s", id, duplicateOfCandidateId))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while checking affected row while updating fileUpload as duplicate: %s %s", id, duplicateOfCandidateId))
+	}
+
+	if rowsAffected != 1 {
+		return utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when updating file_upload in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+	return nil
+}
+
+// ResetFileUploadProcessingForTeam moves a file upload that has finished processing back to NOT STARTED, so it gets processed again.
+// Someone is waiting on it, so it is processed ahead of the team's other file uploads.
+// A file upload whose candidate was merged into another candidate, or was deleted, is not reset, as that would create the candidate again.
+// Completed archives have no candidate of their own, only child file uploads.
+func (s *Storage) ResetFileUploadProcessingForTeam(id string, team *model.Team) error {
+	if utilities.IsBlank(id) {
+		return errors.New("id cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return errors.New("team cannot be blank")
+	}
+
+	result, err := s.db.Exec(
+		`UPDATE public."file_uploads"
+		SET "processing_status" = 'NOT STARTED', "failure_category" = NULL, "failure_message" = NULL, "failed_at" = NULL,
+		"duplicate_of_candidate_id" = NULL, "processing_started_at" = NULL, "processing_heartbeat_at" = NULL,
+		"processing_reap_count" = 0, "dispatched_at" = NULL, "processing_priority" = 'HIGH'
+		WHERE id = $1 AND team_id = $2
+		AND status = 'SUCCESS'
+		AND processing_status IN ('COMPLETED', 'FAILED', 'DUPLICATE')
+		AND merged_into_candidate_id IS NULL
+		AND CASE processing_status
+			WHEN 'COMPLETED' THEN
+				EXISTS (SELECT 1 FROM public."candidates" AS c WHERE c.file_upload_id = file_uploads.id)
+				OR EXISTS (SELECT 1 FROM public."file_uploads" AS child WHERE child.parent_file_upload_id = file_uploads.id)
+			WHEN 'DUPLICATE' THEN duplicate_of_candidate_id IS NOT NULL
+			ELSE true
+		END`,
+		id, team.Id(),
+	)
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while resetting processing of fileUpload: %s", id))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while checking affected row while resetting processing of fileUpload: %s", id))
+	}
+
+	if rowsAffected != 1 {
+		return errors.Errorf("fileUpload cannot be reprocessed: %s", id)
+	}
+	return nil
+}
+
 func (s *Storage) DeleteFileUploadForTeam(id string, team *model.Team) error {
 	if utilities.IsBlank(id) {
 		return errors.New("id cannot be blank")
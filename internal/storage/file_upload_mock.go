@@ -1,18 +1,33 @@
 package storage
 
-import "github.com/vipulvpatil/candidate-tracker-go/internal/model"
+import (
+	"time"
+
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+)
 
 type FileUploadAccessorConfigurableMock struct {
 	GetFileUploadInternal                               func(id string) (*model.FileUpload, error)
 	GetFileUploadUsingTxInternal                        func(id string, tx DatabaseTransaction) (*model.FileUpload, error)
-	GetFileUploadsForTeamInteral                        func(team *model.Team) ([]*model.FileUpload, error)
+	GetFileUploadsForTeamInteral                        func(team *model.Team, page *model.PageRequest) ([]*model.FileUpload, *model.PageInfo, error)
+	GetChildFileUploadsForTeamInternal                  func(parentFileUploadId string, team *model.Team) ([]*model.FileUpload, error)
 	GetUnprocessedFileUploadsCountForTeamInternal       func(team *model.Team) (int, error)
-	GetAllProcessingNotStartedFileUploadIdsInternal     func() ([]string, error)
+	DispatchFileUploadIdsInternal                       func(maxConcurrentFileUploads, maxConcurrentFileUploadsPerTeam int, dispatchLease time.Duration) ([]string, error)
+	GetProcessingQueuePositionForTeamInternal           func(team *model.Team, dispatchLease time.Duration) (int, error)
 	CreateFileUploadForTeamInteral                      func(name string, team *model.Team) (*model.FileUpload, error)
+	CreateChildFileUploadForTeamInternal                func(name string, parent *model.FileUpload) (*model.FileUpload, error)
 	UpdateFileUploadWithPresignedUrlInternal            func(id, presignedUrl string) error
 	UpdateFileUploadWithStatusInternal                  func(id, status string) error
 	UpdateFileUploadWithProcessingStatusInternal        func(id, processingStatus string) error
 	UpdateFileUploadWithProcessingStatusUsingTxInternal func(id, processingStatus string, tx DatabaseTransaction) error
+	UpdateFileUploadWithProcessingFailureInternal       func(id string, failure *model.FileUploadFailure) error
+	UpdateFileUploadWithProcessingRetryInternal         func(id string, failure *model.FileUploadFailure, retryDeadline time.Time) error
+	UpdateFileUploadProcessingHeartbeatInternal         func(id string) error
+	ReapStaleProcessingFileUploadsInternal              func(lease time.Duration, maxReapCount int) (int, error)
+	UpdateFileUploadWithContentHashUsingTxInternal      func(id, contentHash string, tx DatabaseTransaction) error
+	GetDuplicateCandidateIdForFileUploadUsingTxInternal func(fileUpload *model.FileUpload, contentHash string, tx DatabaseTransaction) (string, error)
+	UpdateFileUploadAsDuplicateUsingTxInternal          func(id, duplicateOfCandidateId string, tx DatabaseTransaction) error
+	ResetFileUploadProcessingForTeamInternal            func(id string, team *model.Team) error
 	DeleteFileUploadForTeamInteral                      func(id string, team *model.Team) error
 }
 
@@ -24,22 +39,34 @@ func (f *FileUploadAccessorConfigurableMock) GetFileUploadUsingTx(id string, tx
 	return f.GetFileUploadUsingTxInternal(id, tx)
 }
 
-func (f *FileUploadAccessorConfigurableMock) GetFileUploadsForTeam(team *model.Team) ([]*model.FileUpload, error) {
-	return f.GetFileUploadsForTeamInteral(team)
+func (f *FileUploadAccessorConfigurableMock) GetFileUploadsForTeam(team *model.Team, page *model.PageRequest) ([]*model.FileUpload, *model.PageInfo, error) {
+	return f.GetFileUploadsForTeamInteral(team, page)
+}
+
+func (f *FileUploadAccessorConfigurableMock) GetChildFileUploadsForTeam(parentFileUploadId string, team *model.Team) ([]*model.FileUpload, error) {
+	return f.GetChildFileUploadsForTeamInternal(parentFileUploadId, team)
 }
 
 func (f *FileUploadAccessorConfigurableMock) GetUnprocessedFileUploadsCountForTeam(team *model.Team) (int, error) {
 	return f.GetUnprocessedFileUploadsCountForTeamInternal(team)
 }
 
-func (f *FileUploadAccessorConfigurableMock) GetAllProcessingNotStartedFileUploadIds() ([]string, error) {
-	return f.GetAllProcessingNotStartedFileUploadIdsInternal()
+func (f *FileUploadAccessorConfigurableMock) DispatchFileUploadIds(maxConcurrentFileUploads, maxConcurrentFileUploadsPerTeam int, dispatchLease time.Duration) ([]string, error) {
+	return f.DispatchFileUploadIdsInternal(maxConcurrentFileUploads, maxConcurrentFileUploadsPerTeam, dispatchLease)
+}
+
+func (f *FileUploadAccessorConfigurableMock) GetProcessingQueuePositionForTeam(team *model.Team, dispatchLease time.Duration) (int, error) {
+	return f.GetProcessingQueuePositionForTeamInternal(team, dispatchLease)
 }
 
 func (f *FileUploadAccessorConfigurableMock) CreateFileUploadForTeam(name string, team *model.Team) (*model.FileUpload, error) {
 	return f.CreateFileUploadForTeamInteral(name, team)
 }
 
+func (f *FileUploadAccessorConfigurableMock) CreateChildFileUploadForTeam(name string, parent *model.FileUpload) (*model.FileUpload, error) {
+	return f.CreateChildFileUploadForTeamInternal(name, parent)
+}
+
 func (f *FileUploadAccessorConfigurableMock) UpdateFileUploadWithPresignedUrl(id, presignedUrl string) error {
 	return f.UpdateFileUploadWithPresignedUrlInternal(id, presignedUrl)
 }
@@ -56,6 +83,38 @@ func (f *FileUploadAccessorConfigurableMock) UpdateFileUploadWithProcessingStatu
 	return f.UpdateFileUploadWithProcessingStatusUsingTxInternal(id, processingStatus, tx)
 }
 
+func (f *FileUploadAccessorConfigurableMock) UpdateFileUploadWithProcessingFailure(id string, failure *model.FileUploadFailure) error {
+	return f.UpdateFileUploadWithProcessingFailureInternal(id, failure)
+}
+
+func (f *FileUploadAccessorConfigurableMock) UpdateFileUploadWithProcessingRetry(id string, failure *model.FileUploadFailure, retryDeadline time.Time) error {
+	return f.UpdateFileUploadWithProcessingRetryInternal(id, failure, retryDeadline)
+}
+
+func (f *FileUploadAccessorConfigurableMock) UpdateFileUploadProcessingHeartbeat(id string) error {
+	return f.UpdateFileUploadProcessingHeartbeatInternal(id)
+}
+
+func (f *FileUploadAccessorConfigurableMock) ReapStaleProcessingFileUploads(lease time.Duration, maxReapCount int) (int, error) {
+	return f.ReapStaleProcessingFileUploadsInternal(lease, maxReapCount)
+}
+
+func (f *FileUploadAccessorConfigurableMock) UpdateFileUploadWithContentHashUsingTx(id, contentHash string, tx DatabaseTransaction) error {
+	return f.UpdateFileUploadWithContentHashUsingTxInternal(id, contentHash, tx)
+}
+
+func (f *FileUploadAccessorConfigurableMock) GetDuplicateCandidateIdForFileUploadUsingTx(fileUpload *model.FileUpload, contentHash string, tx DatabaseTransaction) (string, error) {
+	return f.GetDuplicateCandidateIdForFileUploadUsingTxInternal(fileUpload, contentHash, tx)
+}
+
+func (f *FileUploadAccessorConfigurableMock) UpdateFileUploadAsDuplicateUsingTx(id, duplicateOfCandidateId string, tx DatabaseTransaction) error {
+	return f.UpdateFileUploadAsDuplicateUsingTxInternal(id, duplicateOfCandidateId, tx)
+}
+
+func (f *FileUploadAccessorConfigurableMock) ResetFileUploadProcessingForTeam(id string, team *model.Team) error {
+	return f.ResetFileUploadProcessingForTeamInternal(id, team)
+}
+
 func (f *FileUploadAccessorConfigurableMock) DeleteFileUploadForTeam(id string, team *model.Team) error {
 	return f.DeleteFileUploadForTeamInteral(id, team)
 }
@@ -3,6 +3,7 @@ package storage
 import (
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
@@ -317,7 +318,7 @@ func Test_GetFileUploadsForTeam(t *testing.T) {
 
 			runSqlOnDb(t, s.db, tt.setupSqlStmts)
 			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
-			fileUpload, err := s.GetFileUploadsForTeam(tt.input)
+			fileUpload, _, err := s.GetFileUploadsForTeam(tt.input, nil)
 			assert.Equal(t, tt.output, fileUpload)
 			if !tt.errorExpected {
 				assert.NoError(t, err)
@@ -329,6 +330,138 @@ func Test_GetFileUploadsForTeam(t *testing.T) {
 	}
 }
 
+func Test_GetChildFileUploadsForTeam(t *testing.T) {
+	currentFileCount := 3
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	childFileUpload1, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:                 "fp_id2",
+		Name:               "file2.pdf",
+		Status:             "SUCCESS",
+		ProcessingStatus:   "COMPLETED",
+		Team:               team,
+		ParentFileUploadId: "fp_id1",
+	})
+	childFileUpload2, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:                 "fp_id3",
+		Name:               "file3.docx",
+		Status:             "SUCCESS",
+		ProcessingStatus:   "NOT STARTED",
+		Team:               team,
+		ParentFileUploadId: "fp_id1",
+	})
+	tests := []struct {
+		name  string
+		input struct {
+			parentFileUploadId string
+			team               *model.Team
+		}
+		output          []*model.FileUpload
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name: "errors when parentFileUploadId is empty",
+			input: struct {
+				parentFileUploadId string
+				team               *model.Team
+			}{
+				team: team,
+			},
+			output:          nil,
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			errorExpected:   true,
+			errorString:     "parentFileUploadId cannot be blank",
+		},
+		{
+			name: "errors when team is nil",
+			input: struct {
+				parentFileUploadId string
+				team               *model.Team
+			}{
+				parentFileUploadId: "fp_id1",
+			},
+			output:          nil,
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			errorExpected:   true,
+			errorString:     "team cannot be blank",
+		},
+		{
+			name: "successfully gets the child file uploads of the team",
+			input: struct {
+				parentFileUploadId string
+				team               *model.Team
+			}{
+				parentFileUploadId: "fp_id1",
+				team:               team,
+			},
+			output: []*model.FileUpload{childFileUpload1, childFileUpload2},
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+								"id", "name"
+							)
+							VALUES
+							('team_id1', 'Team1'),
+							('team_id2', 'Team2')`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id"
+							)
+							VALUES (
+								'fp_id1', 'file1.zip', 'https://presigned_url1', 'SUCCESS', 'COMPLETED', 'team_id1'
+							)`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id", "parent_file_upload_id", "created_at"
+							)
+							VALUES
+							('fp_id2', 'file2.pdf', '', 'SUCCESS', 'COMPLETED', 'team_id1', 'fp_id1', '2023-04-01 10:00:00+00'),
+							('fp_id3', 'file3.docx', '', 'SUCCESS', 'NOT STARTED', 'team_id1', 'fp_id1', '2023-04-01 10:00:01+00'),
+							('fp_id4', 'file4.pdf', '', 'SUCCESS', 'NOT STARTED', 'team_id2', 'fp_id1', '2023-04-01 10:00:02+00'),
+							('fp_id5', 'file5.pdf', '', 'SUCCESS', 'NOT STARTED', 'team_id1', NULL, '2023-04-01 10:00:03+00')`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			fileUploads, err := s.GetChildFileUploadsForTeam(tt.input.parentFileUploadId, tt.input.team)
+			assert.Equal(t, tt.output, fileUploads)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
 func Test_GetUnprocessedFileUploadsCountForTeam(t *testing.T) {
 	currentFileCount := 1
 	team, _ := model.NewTeam(model.TeamOptions{
@@ -449,17 +582,110 @@ func Test_GetUnprocessedFileUploadsCountForTeam(t *testing.T) {
 	}
 }
 
-func Test_GetAllProcessingNotStartedFileUploadIds(t *testing.T) {
+func Test_DispatchFileUploadIds(t *testing.T) {
+	fairSchedulingSetupSqlStmts := []TestSqlStmts{
+		{
+			Query: `INSERT INTO public."teams" (
+						"id", "name"
+					)
+					VALUES
+					('team_id1', 'Team1'),
+					('team_id2', 'Team2')`,
+		},
+		{
+			Query: `INSERT INTO public."file_uploads" (
+						"id", "name", "presigned_url", "status", "processing_status", "team_id", "processing_priority", "created_at"
+					)
+					VALUES
+					('fp_id1', 'file1.pdf', 'https://presigned_url1', 'SUCCESS', 'NOT STARTED', 'team_id1', 'NORMAL', '2023-03-01 10:00:01'),
+					('fp_id2', 'file2.pdf', 'https://presigned_url2', 'SUCCESS', 'NOT STARTED', 'team_id1', 'NORMAL', '2023-03-01 10:00:02'),
+					('fp_id3', 'file3.pdf', 'https://presigned_url3', 'SUCCESS', 'NOT STARTED', 'team_id1', 'NORMAL', '2023-03-01 10:00:03'),
+					('fp_id4', 'file4.pdf', 'https://presigned_url4', 'SUCCESS', 'NOT STARTED', 'team_id1', 'NORMAL', '2023-03-01 10:00:04'),
+					('fp_id5', 'file5.pdf', 'https://presigned_url5', 'SUCCESS', 'ONGOING', 'team_id1', 'NORMAL', '2023-03-01 10:00:00'),
+					('fp_id11', 'file11.pdf', 'https://presigned_url11', 'SUCCESS', 'NOT STARTED', 'team_id2', 'HIGH', '2023-03-01 10:00:06'),
+					('fp_id12', 'file12.pdf', 'https://presigned_url12', 'SUCCESS', 'NOT STARTED', 'team_id2', 'LOW', '2023-03-01 10:00:05')`,
+		},
+	}
+	fairSchedulingCleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+
 	tests := []struct {
-		name            string
+		name  string
+		input struct {
+			maxConcurrentFileUploads        int
+			maxConcurrentFileUploadsPerTeam int
+			dispatchLease                   time.Duration
+		}
 		output          []string
 		setupSqlStmts   []TestSqlStmts
 		cleanupSqlStmts []TestSqlStmts
+		dbUpdateCheck   func(s *Storage) bool
 		errorExpected   bool
 		errorString     string
 	}{
 		{
-			name:   "successfully gets file upload ids",
+			name: "errors when maxConcurrentFileUploads is not positive",
+			input: struct {
+				maxConcurrentFileUploads        int
+				maxConcurrentFileUploadsPerTeam int
+				dispatchLease                   time.Duration
+			}{
+				maxConcurrentFileUploadsPerTeam: 3,
+				dispatchLease:                   5 * time.Minute,
+			},
+			output:          nil,
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "maxConcurrentFileUploads should be positive",
+		},
+		{
+			name: "errors when maxConcurrentFileUploadsPerTeam is not positive",
+			input: struct {
+				maxConcurrentFileUploads        int
+				maxConcurrentFileUploadsPerTeam int
+				dispatchLease                   time.Duration
+			}{
+				maxConcurrentFileUploads: 10,
+				dispatchLease:            5 * time.Minute,
+			},
+			output:          nil,
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "maxConcurrentFileUploadsPerTeam should be positive",
+		},
+		{
+			name: "errors when dispatchLease is not positive",
+			input: struct {
+				maxConcurrentFileUploads        int
+				maxConcurrentFileUploadsPerTeam int
+				dispatchLease                   time.Duration
+			}{
+				maxConcurrentFileUploads:        10,
+				maxConcurrentFileUploadsPerTeam: 3,
+			},
+			output:          nil,
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "dispatchLease should be positive",
+		},
+		{
+			name: "successfully dispatches file upload ids",
+			input: struct {
+				maxConcurrentFileUploads        int
+				maxConcurrentFileUploadsPerTeam int
+				dispatchLease                   time.Duration
+			}{
+				maxConcurrentFileUploads:        10,
+				maxConcurrentFileUploadsPerTeam: 3,
+				dispatchLease:                   5 * time.Minute,
+			},
 			output: []string{"fp_id1", "fp_id6"},
 			setupSqlStmts: []TestSqlStmts{
 				{
@@ -503,11 +729,1408 @@ func Test_GetAllProcessingNotStartedFileUploadIds(t *testing.T) {
 							)`,
 				},
 				{
-					Query: `INSERT INTO public."file_uploads" (
-								"id", "name", "presigned_url", "status", "processing_status", "team_id"
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id"
+							)
+							VALUES (
+								'fp_id5', 'file5.pdf', 'https://presigned_url5', 'SUCCESS', 'FAILED', 'team_id1'
+							)`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id"
+							)
+							VALUES (
+								'fp_id6', 'file6.pdf', 'https://presigned_url6', 'SUCCESS', 'NOT STARTED', 'team_id1'
+							)`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: nil,
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "teams take turns, higher priority goes first, and each team stays within its max concurrent file uploads",
+			input: struct {
+				maxConcurrentFileUploads        int
+				maxConcurrentFileUploadsPerTeam int
+				dispatchLease                   time.Duration
+			}{
+				maxConcurrentFileUploads:        10,
+				maxConcurrentFileUploadsPerTeam: 3,
+				dispatchLease:                   5 * time.Minute,
+			},
+			output:          []string{"fp_id11", "fp_id1", "fp_id2", "fp_id12"},
+			setupSqlStmts:   fairSchedulingSetupSqlStmts,
+			cleanupSqlStmts: fairSchedulingCleanupSqlStmts,
+			dbUpdateCheck: func(s *Storage) bool {
+				fileUploadIds, err := s.DispatchFileUploadIds(10, 3, 5*time.Minute)
+				assert.NoError(t, err)
+				assert.Empty(t, fileUploadIds, "dispatched file uploads are not dispatched again")
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "counts file uploads waiting to be retried against their team's max concurrent file uploads",
+			input: struct {
+				maxConcurrentFileUploads        int
+				maxConcurrentFileUploadsPerTeam int
+				dispatchLease                   time.Duration
+			}{
+				maxConcurrentFileUploads:        10,
+				maxConcurrentFileUploadsPerTeam: 3,
+				dispatchLease:                   5 * time.Minute,
+			},
+			output: []string{"fp_id1"},
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+								"id", "name"
+							)
+							VALUES (
+								'team_id1', 'Team1'
+							)`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id", "created_at"
+							)
+							VALUES
+							('fp_id1', 'file1.pdf', 'https://presigned_url1', 'SUCCESS', 'NOT STARTED', 'team_id1', '2023-03-01 10:00:01'),
+							('fp_id2', 'file2.pdf', 'https://presigned_url2', 'SUCCESS', 'NOT STARTED', 'team_id1', '2023-03-01 10:00:02'),
+							('fp_id3', 'file3.pdf', 'https://presigned_url3', 'SUCCESS', 'RETRYING', 'team_id1', '2023-03-01 10:00:00'),
+							('fp_id4', 'file4.pdf', 'https://presigned_url4', 'SUCCESS', 'RETRYING', 'team_id1', '2023-03-01 10:00:00')`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: nil,
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "stays within max concurrent file uploads across all teams",
+			input: struct {
+				maxConcurrentFileUploads        int
+				maxConcurrentFileUploadsPerTeam int
+				dispatchLease                   time.Duration
+			}{
+				maxConcurrentFileUploads:        2,
+				maxConcurrentFileUploadsPerTeam: 3,
+				dispatchLease:                   5 * time.Minute,
+			},
+			output:          []string{"fp_id11"},
+			setupSqlStmts:   fairSchedulingSetupSqlStmts,
+			cleanupSqlStmts: fairSchedulingCleanupSqlStmts,
+			dbUpdateCheck:   nil,
+			errorExpected:   false,
+			errorString:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			fileUploadIds, err := s.DispatchFileUploadIds(tt.input.maxConcurrentFileUploads, tt.input.maxConcurrentFileUploadsPerTeam, tt.input.dispatchLease)
+			assert.Equal(t, tt.output, fileUploadIds)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s))
+			}
+		})
+	}
+}
+
+func Test_GetProcessingQueuePositionForTeam(t *testing.T) {
+	setupSqlStmts := []TestSqlStmts{
+		{
+			Query: `INSERT INTO public."teams" (
+						"id", "name"
+					)
+					VALUES
+					('team_id1', 'Team1'),
+					('team_id2', 'Team2'),
+					('team_id3', 'Team3'),
+					('team_id4', 'Team4'),
+					('team_id5', 'Team5')`,
+		},
+		{
+			Query: `INSERT INTO public."file_uploads" (
+						"id", "name", "presigned_url", "status", "processing_status", "team_id", "processing_priority", "created_at"
+					)
+					VALUES
+					('fp_id1', 'file1.pdf', 'https://presigned_url1', 'SUCCESS', 'NOT STARTED', 'team_id1', 'NORMAL', '2023-03-01 10:00:01'),
+					('fp_id2', 'file2.pdf', 'https://presigned_url2', 'SUCCESS', 'NOT STARTED', 'team_id1', 'NORMAL', '2023-03-01 10:00:02'),
+					('fp_id11', 'file11.pdf', 'https://presigned_url11', 'SUCCESS', 'NOT STARTED', 'team_id2', 'HIGH', '2023-03-01 10:00:06'),
+					('fp_id21', 'file21.pdf', 'https://presigned_url21', 'SUCCESS', 'ONGOING', 'team_id3', 'NORMAL', '2023-03-01 10:00:00')`,
+		},
+		{
+			Query: `INSERT INTO public."file_uploads" (
+						"id", "name", "presigned_url", "status", "processing_status", "team_id", "processing_priority", "created_at", "dispatched_at"
+					)
+					VALUES
+					('fp_id31', 'file31.pdf', 'https://presigned_url31', 'SUCCESS', 'NOT STARTED', 'team_id4', 'NORMAL', '2023-03-01 10:00:00', now()),
+					('fp_id41', 'file41.pdf', 'https://presigned_url41', 'SUCCESS', 'NOT STARTED', 'team_id5', 'NORMAL', '2023-03-01 10:00:03', now() - interval '1 hour')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2', 'team_id3', 'team_id4', 'team_id5')`},
+	}
+	currentFileCount := 0
+	team1, _ := model.NewTeam(model.TeamOptions{Id: "team_id1", Name: "Team1", CurrentFileCount: &currentFileCount, FileCountLimit: 100})
+	team2, _ := model.NewTeam(model.TeamOptions{Id: "team_id2", Name: "Team2", CurrentFileCount: &currentFileCount, FileCountLimit: 100})
+	team3, _ := model.NewTeam(model.TeamOptions{Id: "team_id3", Name: "Team3", CurrentFileCount: &currentFileCount, FileCountLimit: 100})
+	team4, _ := model.NewTeam(model.TeamOptions{Id: "team_id4", Name: "Team4", CurrentFileCount: &currentFileCount, FileCountLimit: 100})
+	team5, _ := model.NewTeam(model.TeamOptions{Id: "team_id5", Name: "Team5", CurrentFileCount: &currentFileCount, FileCountLimit: 100})
+
+	tests := []struct {
+		name  string
+		input struct {
+			team          *model.Team
+			dispatchLease time.Duration
+		}
+		output          int
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name: "errors when team is nil",
+			input: struct {
+				team          *model.Team
+				dispatchLease time.Duration
+			}{
+				dispatchLease: 5 * time.Minute,
+			},
+			output:          0,
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			errorExpected:   true,
+			errorString:     "team cannot be blank",
+		},
+		{
+			name: "gets position of the team's next file upload behind another team's higher priority one",
+			input: struct {
+				team          *model.Team
+				dispatchLease time.Duration
+			}{
+				team:          team1,
+				dispatchLease: 5 * time.Minute,
+			},
+			output:          2,
+			setupSqlStmts:   setupSqlStmts,
+			cleanupSqlStmts: cleanupSqlStmts,
+			errorExpected:   false,
+			errorString:     "",
+		},
+		{
+			name: "gets position of the team's next file upload at the front of the queue",
+			input: struct {
+				team          *model.Team
+				dispatchLease time.Duration
+			}{
+				team:          team2,
+				dispatchLease: 5 * time.Minute,
+			},
+			output:          1,
+			setupSqlStmts:   setupSqlStmts,
+			cleanupSqlStmts: cleanupSqlStmts,
+			errorExpected:   false,
+			errorString:     "",
+		},
+		{
+			name: "errors when dispatchLease is not positive",
+			input: struct {
+				team          *model.Team
+				dispatchLease time.Duration
+			}{
+				team: team1,
+			},
+			output:          0,
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			errorExpected:   true,
+			errorString:     "dispatchLease should be positive",
+		},
+		{
+			name: "gets 0 when the team has no file uploads waiting",
+			input: struct {
+				team          *model.Team
+				dispatchLease time.Duration
+			}{
+				team:          team3,
+				dispatchLease: 5 * time.Minute,
+			},
+			output:          0,
+			setupSqlStmts:   setupSqlStmts,
+			cleanupSqlStmts: cleanupSqlStmts,
+			errorExpected:   false,
+			errorString:     "",
+		},
+		{
+			name: "gets 0 when the team's file uploads were dispatched within the dispatch lease",
+			input: struct {
+				team          *model.Team
+				dispatchLease time.Duration
+			}{
+				team:          team4,
+				dispatchLease: 5 * time.Minute,
+			},
+			output:          0,
+			setupSqlStmts:   setupSqlStmts,
+			cleanupSqlStmts: cleanupSqlStmts,
+			errorExpected:   false,
+			errorString:     "",
+		},
+		{
+			name: "counts file uploads whose dispatch lease expired as waiting, the same as dispatching does",
+			input: struct {
+				team          *model.Team
+				dispatchLease time.Duration
+			}{
+				team:          team5,
+				dispatchLease: 5 * time.Minute,
+			},
+			output:          3,
+			setupSqlStmts:   setupSqlStmts,
+			cleanupSqlStmts: cleanupSqlStmts,
+			errorExpected:   false,
+			errorString:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			position, err := s.GetProcessingQueuePositionForTeam(tt.input.team, tt.input.dispatchLease)
+			assert.Equal(t, tt.output, position)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_CreateFileUploadForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	fileUpload, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id1",
+		Name:             "file1.pdf",
+		PresignedUrl:     "",
+		Status:           "INITIATED",
+		ProcessingStatus: "NOT STARTED",
+		Team:             team,
+	})
+	tests := []struct {
+		name  string
+		input struct {
+			name string
+			team *model.Team
+		}
+		output          *model.FileUpload
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		dbUpdateCheck   func(db *sql.DB) bool
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name: "errors when name is empty",
+			input: struct {
+				name string
+				team *model.Team
+			}{},
+			output:          nil,
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "cannot create FileUpload with an empty name",
+		},
+		{
+			name: "errors when team is nil",
+			input: struct {
+				name string
+				team *model.Team
+			}{
+				name: "file1.pdf",
+			},
+			output:          nil,
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "cannot create FileUpload with a nil Team",
+		},
+		{
+			name: "errors when team does not exist in Database",
+			input: struct {
+				name string
+				team *model.Team
+			}{
+				name: "file1.pdf",
+				team: team,
+			},
+			output:          nil,
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "THIS IS BAD: dbError while inserting file_upload: fp_id1: pq: insert or update on table \"file_uploads\" violates foreign key constraint \"file_uploads_team_id_fkey\"",
+		},
+		{
+			name: "successfully creates a new file upload",
+			input: struct {
+				name string
+				team *model.Team
+			}{
+				name: "file1.pdf",
+				team: team,
+			},
+			output: fileUpload,
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+								"id", "name"
+							)
+							VALUES (
+								'team_id1', 'Team1'
+							)`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var id, name, presignedUrl, status, processingStatus, createdAt string
+				row := db.QueryRow(
+					`SELECT id, name, presigned_url, status, processing_status, created_at FROM public."file_uploads" WHERE team_id = 'team_id1'`,
+				)
+				assert.NoError(t, row.Err())
+				err := row.Scan(&id, &name, &presignedUrl, &status, &processingStatus, &createdAt)
+				assert.NoError(t, err)
+				assert.Equal(t, "fp_id1", id)
+				assert.Equal(t, "file1.pdf", name)
+				assert.Equal(t, "", presignedUrl)
+				assert.Equal(t, model.FileUploadStatus("INITIATED").String(), status)
+				assert.Equal(t, model.FileUploadProcessingStatus("NOT STARTED").String(), processingStatus)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db:          testDb,
+					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "fp_id1"},
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			fileUpload, err := s.CreateFileUploadForTeam(tt.input.name, tt.input.team)
+			assert.Equal(t, tt.output, fileUpload)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_CreateChildFileUploadForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	parentFileUpload, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id1",
+		Name:             "resumes.zip",
+		PresignedUrl:     "https://presigned_url1",
+		Status:           "SUCCESS",
+		ProcessingStatus: "ONGOING",
+		Team:             team,
+	})
+	fileUpload, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:                 "fp_id2",
+		Name:               "file2.pdf",
+		PresignedUrl:       "",
+		Status:             "INITIATED",
+		ProcessingStatus:   "NOT STARTED",
+		Team:               team,
+		ParentFileUploadId: "fp_id1",
+	})
+	tests := []struct {
+		name  string
+		input struct {
+			name   string
+			parent *model.FileUpload
+		}
+		output          *model.FileUpload
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		dbUpdateCheck   func(db *sql.DB) bool
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name: "errors when parent is nil",
+			input: struct {
+				name   string
+				parent *model.FileUpload
+			}{
+				name: "file2.pdf",
+			},
+			output:          nil,
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "parent cannot be nil",
+		},
+		{
+			name: "errors when name is empty",
+			input: struct {
+				name   string
+				parent *model.FileUpload
+			}{
+				parent: parentFileUpload,
+			},
+			output:          nil,
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "cannot create FileUpload with an empty name",
+		},
+		{
+			name: "successfully creates a new child file upload",
+			input: struct {
+				name   string
+				parent *model.FileUpload
+			}{
+				name:   "file2.pdf",
+				parent: parentFileUpload,
+			},
+			output: fileUpload,
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+								"id", "name"
+							)
+							VALUES (
+								'team_id1', 'Team1'
+							)`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id"
+							)
+							VALUES (
+								'fp_id1', 'resumes.zip', 'https://presigned_url1', 'SUCCESS', 'ONGOING', 'team_id1'
+							)`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var name, status, processingStatus, parentFileUploadId, processingPriority string
+				row := db.QueryRow(
+					`SELECT name, status, processing_status, parent_file_upload_id, processing_priority FROM public."file_uploads" WHERE id = 'fp_id2'`,
+				)
+				assert.NoError(t, row.Err())
+				err := row.Scan(&name, &status, &processingStatus, &parentFileUploadId, &processingPriority)
+				assert.NoError(t, err)
+				assert.Equal(t, "file2.pdf", name)
+				assert.Equal(t, "INITIATED", status)
+				assert.Equal(t, "NOT STARTED", processingStatus)
+				assert.Equal(t, "fp_id1", parentFileUploadId)
+				assert.Equal(t, "LOW", processingPriority)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db:          testDb,
+					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "fp_id2"},
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			fileUpload, err := s.CreateChildFileUploadForTeam(tt.input.name, tt.input.parent)
+			assert.Equal(t, tt.output, fileUpload)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_UpdateFileUploadWithPresignedUrl(t *testing.T) {
+	tests := []struct {
+		name  string
+		input struct {
+			id           string
+			presignedUrl string
+		}
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		dbUpdateCheck   func(db *sql.DB) bool
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name: "errors when id is empty",
+			input: struct {
+				id           string
+				presignedUrl string
+			}{},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "id cannot be blank",
+		},
+		{
+			name: "errors when presignedUrl is empty",
+			input: struct {
+				id           string
+				presignedUrl string
+			}{
+				id: "fp_id1",
+			},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "presignedUrl cannot be blank",
+		},
+		{
+			name: "errors when fileUpload does not exist in database",
+			input: struct {
+				id           string
+				presignedUrl string
+			}{
+				id:           "fp_id1",
+				presignedUrl: "http://presigned_url1",
+			},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "THIS IS BAD: Very few or too many rows were affected when inserting file_upload in db. This is highly unexpected. rowsAffected: 0",
+		},
+		{
+			name: "successfully updates file upload",
+			input: struct {
+				id           string
+				presignedUrl string
+			}{
+				id:           "fp_id1",
+				presignedUrl: "http://presigned_url1",
+			},
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+								"id", "name"
+							)
+							VALUES (
+								'team_id1', 'Team1'
+							)`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id"
+							)
+							VALUES (
+								'fp_id1', 'file1.pdf', '', 'INITIATED', 'NOT STARTED', 'team_id1'
+							)`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var id, name, presignedUrl, status, processingStatus, createdAt string
+				row := db.QueryRow(
+					`SELECT id, name, presigned_url, status, processing_status, created_at FROM public."file_uploads" WHERE team_id = 'team_id1'`,
+				)
+				assert.NoError(t, row.Err())
+				err := row.Scan(&id, &name, &presignedUrl, &status, &processingStatus, &createdAt)
+				assert.NoError(t, err)
+				assert.Equal(t, "fp_id1", id)
+				assert.Equal(t, "file1.pdf", name)
+				assert.Equal(t, "http://presigned_url1", presignedUrl)
+				assert.Equal(t, model.FileUploadStatus("INITIATED").String(), status)
+				assert.Equal(t, model.FileUploadProcessingStatus("NOT STARTED").String(), processingStatus)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db:          testDb,
+					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "fp_id1"},
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			err := s.UpdateFileUploadWithPresignedUrl(tt.input.id, tt.input.presignedUrl)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_UpdateFileUploadWithStatus(t *testing.T) {
+	tests := []struct {
+		name  string
+		input struct {
+			id     string
+			status string
+		}
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		dbUpdateCheck   func(db *sql.DB) bool
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name: "errors when id is empty",
+			input: struct {
+				id     string
+				status string
+			}{},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "id cannot be blank",
+		},
+		{
+			name: "errors when status is not valid",
+			input: struct {
+				id     string
+				status string
+			}{
+				id: "fp_id1",
+			},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "status should be valid",
+		},
+		{
+			name: "errors when fileUpload does not exist in database",
+			input: struct {
+				id     string
+				status string
+			}{
+				id:     "fp_id1",
+				status: "SUCCESS",
+			},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "THIS IS BAD: Very few or too many rows were affected when inserting file_upload in db. This is highly unexpected. rowsAffected: 0",
+		},
+		{
+			name: "successfully updates file upload",
+			input: struct {
+				id     string
+				status string
+			}{
+				id:     "fp_id1",
+				status: "FAILURE",
+			},
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+								"id", "name"
+							)
+							VALUES (
+								'team_id1', 'Team1'
+							)`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id"
+							)
+							VALUES (
+								'fp_id1', 'file1.pdf', 'http://presigned_url1', 'INITIATED', 'NOT STARTED', 'team_id1'
+							)`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var id, name, presignedUrl, status, processingStatus, createdAt string
+				row := db.QueryRow(
+					`SELECT id, name, presigned_url, status, processing_status, created_at FROM public."file_uploads" WHERE team_id = 'team_id1'`,
+				)
+				assert.NoError(t, row.Err())
+				err := row.Scan(&id, &name, &presignedUrl, &status, &processingStatus, &createdAt)
+				assert.NoError(t, err)
+				assert.Equal(t, "fp_id1", id)
+				assert.Equal(t, "file1.pdf", name)
+				assert.Equal(t, "http://presigned_url1", presignedUrl)
+				assert.Equal(t, model.FileUploadStatus("FAILURE").String(), status)
+				assert.Equal(t, model.FileUploadProcessingStatus("NOT STARTED").String(), processingStatus)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db:          testDb,
+					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "fp_id1"},
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			err := s.UpdateFileUploadWithStatus(tt.input.id, tt.input.status)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_UpdateFileUploadWithProcessingStatus(t *testing.T) {
+	tests := []struct {
+		name  string
+		input struct {
+			id               string
+			processingStatus string
+		}
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		dbUpdateCheck   func(db *sql.DB) bool
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name: "errors when id is empty",
+			input: struct {
+				id               string
+				processingStatus string
+			}{},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "id cannot be blank",
+		},
+		{
+			name: "errors when status is not valid",
+			input: struct {
+				id               string
+				processingStatus string
+			}{
+				id: "fp_id1",
+			},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "processing status should be valid",
+		},
+		{
+			name: "errors when fileUpload does not exist in database",
+			input: struct {
+				id               string
+				processingStatus string
+			}{
+				id:               "fp_id1",
+				processingStatus: "COMPLETED",
+			},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "THIS IS BAD: Very few or too many rows were affected when inserting file_upload in db. This is highly unexpected. rowsAffected: 0",
+		},
+		{
+			name: "successfully updates file upload",
+			input: struct {
+				id               string
+				processingStatus string
+			}{
+				id:               "fp_id1",
+				processingStatus: "FAILED",
+			},
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+								"id", "name"
+							)
+							VALUES (
+								'team_id1', 'Team1'
+							)`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id", "processing_priority"
+							)
+							VALUES (
+								'fp_id1', 'file1.pdf', 'http://presigned_url1', 'INITIATED', 'NOT STARTED', 'team_id1', 'HIGH'
+							)`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var id, name, presignedUrl, status, processingStatus, processingPriority, createdAt string
+				row := db.QueryRow(
+					`SELECT id, name, presigned_url, status, processing_status, processing_priority, created_at FROM public."file_uploads" WHERE team_id = 'team_id1'`,
+				)
+				assert.NoError(t, row.Err())
+				err := row.Scan(&id, &name, &presignedUrl, &status, &processingStatus, &processingPriority, &createdAt)
+				assert.NoError(t, err)
+				assert.Equal(t, "fp_id1", id)
+				assert.Equal(t, "file1.pdf", name)
+				assert.Equal(t, "http://presigned_url1", presignedUrl)
+				assert.Equal(t, model.FileUploadStatus("INITIATED").String(), status)
+				assert.Equal(t, model.FileUploadProcessingStatus("FAILED").String(), processingStatus)
+				assert.Equal(t, "NORMAL", processingPriority)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "clears the failure of a file upload that is processed again",
+			input: struct {
+				id               string
+				processingStatus string
+			}{
+				id:               "fp_id1",
+				processingStatus: "ONGOING",
+			},
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+								"id", "name"
+							)
+							VALUES (
+								'team_id1', 'Team1'
+							)`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id",
+								"failure_category", "failure_message", "failed_at"
+							)
+							VALUES (
+								'fp_id1', 'file1.pdf', 'http://presigned_url1', 'SUCCESS', 'RETRYING', 'team_id1',
+								'STORAGE ERROR', 'connection reset by peer', '2023-03-01'
+							)`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var processingStatus string
+				var failureCategory, failureMessage sql.NullString
+				var failedAt sql.NullTime
+				row := db.QueryRow(
+					`SELECT processing_status, failure_category, failure_message, failed_at FROM public."file_uploads" WHERE id = 'fp_id1'`,
+				)
+				err := row.Scan(&processingStatus, &failureCategory, &failureMessage, &failedAt)
+				assert.NoError(t, err)
+				assert.Equal(t, "ONGOING", processingStatus)
+				assert.False(t, failureCategory.Valid)
+				assert.False(t, failureMessage.Valid)
+				assert.False(t, failedAt.Valid)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db:          testDb,
+					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "fp_id1"},
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			err := s.UpdateFileUploadWithProcessingStatus(tt.input.id, tt.input.processingStatus)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_UpdateFileUploadWithProcessingStatusUsingTx(t *testing.T) {
+	tests := []struct {
+		name  string
+		input struct {
+			id               string
+			processingStatus string
+		}
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		dbUpdateCheck   func(db *sql.DB) bool
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name: "errors when id is empty",
+			input: struct {
+				id               string
+				processingStatus string
+			}{},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "id cannot be blank",
+		},
+		{
+			name: "errors when status is not valid",
+			input: struct {
+				id               string
+				processingStatus string
+			}{
+				id: "fp_id1",
+			},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "processing status should be valid",
+		},
+		{
+			name: "errors when fileUpload does not exist in database",
+			input: struct {
+				id               string
+				processingStatus string
+			}{
+				id:               "fp_id1",
+				processingStatus: "COMPLETED",
+			},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "THIS IS BAD: Very few or too many rows were affected when inserting file_upload in db. This is highly unexpected. rowsAffected: 0",
+		},
+		{
+			name: "successfully updates file upload",
+			input: struct {
+				id               string
+				processingStatus string
+			}{
+				id:               "fp_id1",
+				processingStatus: "FAILED",
+			},
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+								"id", "name"
+							)
+							VALUES (
+								'team_id1', 'Team1'
+							)`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id"
+							)
+							VALUES (
+								'fp_id1', 'file1.pdf', 'http://presigned_url1', 'INITIATED', 'NOT STARTED', 'team_id1'
+							)`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var id, name, presignedUrl, status, processingStatus, createdAt string
+				row := db.QueryRow(
+					`SELECT id, name, presigned_url, status, processing_status, created_at FROM public."file_uploads" WHERE team_id = 'team_id1'`,
+				)
+				assert.NoError(t, row.Err())
+				err := row.Scan(&id, &name, &presignedUrl, &status, &processingStatus, &createdAt)
+				assert.NoError(t, err)
+				assert.Equal(t, "fp_id1", id)
+				assert.Equal(t, "file1.pdf", name)
+				assert.Equal(t, "http://presigned_url1", presignedUrl)
+				assert.Equal(t, model.FileUploadStatus("INITIATED").String(), status)
+				assert.Equal(t, model.FileUploadProcessingStatus("FAILED").String(), processingStatus)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db:          testDb,
+					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "fp_id1"},
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+
+			tx, err := s.BeginTransaction()
+			assert.NoError(t, err)
+			err = s.UpdateFileUploadWithProcessingStatusUsingTx(tt.input.id, tt.input.processingStatus, tx)
+			tx.Commit()
+
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_UpdateFileUploadWithProcessingFailure(t *testing.T) {
+	failedAt := time.Date(2023, time.March, 1, 10, 0, 0, 0, time.UTC)
+	failure, _ := model.NewFileUploadFailure(model.FileUploadFailureOptions{
+		Category: "NOT A RESUME",
+		Message:  "needs a valid resume to parse",
+		FailedAt: failedAt,
+	})
+	tests := []struct {
+		name  string
+		input struct {
+			id      string
+			failure *model.FileUploadFailure
+		}
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		dbUpdateCheck   func(s *Storage) bool
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name: "errors when id is empty",
+			input: struct {
+				id      string
+				failure *model.FileUploadFailure
+			}{},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "id cannot be blank",
+		},
+		{
+			name: "errors when failure is nil",
+			input: struct {
+				id      string
+				failure *model.FileUploadFailure
+			}{
+				id: "fp_id1",
+			},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "failure cannot be nil",
+		},
+		{
+			name: "errors when fileUpload does not exist in database",
+			input: struct {
+				id      string
+				failure *model.FileUploadFailure
+			}{
+				id:      "fp_id1",
+				failure: failure,
+			},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "THIS IS BAD: Very few or too many rows were affected when updating file_upload in db. This is highly unexpected. rowsAffected: 0",
+		},
+		{
+			name: "successfully updates file upload",
+			input: struct {
+				id      string
+				failure *model.FileUploadFailure
+			}{
+				id:      "fp_id1",
+				failure: failure,
+			},
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+								"id", "name"
+							)
+							VALUES (
+								'team_id1', 'Team1'
+							)`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id"
+							)
+							VALUES (
+								'fp_id1', 'file1.pdf', 'http://presigned_url1', 'SUCCESS', 'ONGOING', 'team_id1'
+							)`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: func(s *Storage) bool {
+				fileUpload, err := s.GetFileUpload("fp_id1")
+				assert.NoError(t, err)
+				assert.Equal(t, "FAILED", fileUpload.ProcessingStatus())
+				assert.NotNil(t, fileUpload.Failure())
+				assert.Equal(t, "NOT A RESUME", fileUpload.Failure().Category())
+				assert.Equal(t, "needs a valid resume to parse", fileUpload.Failure().Message())
+				assert.True(t, failedAt.Equal(fileUpload.Failure().FailedAt()))
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			err := s.UpdateFileUploadWithProcessingFailure(tt.input.id, tt.input.failure)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s))
+			}
+		})
+	}
+}
+
+func Test_UpdateFileUploadWithProcessingRetry(t *testing.T) {
+	failedAt := time.Date(2023, time.March, 1, 10, 0, 0, 0, time.UTC)
+	failure, _ := model.NewFileUploadFailure(model.FileUploadFailureOptions{
+		Category: "LLM ERROR",
+		Message:  "error, status code: 429, message: Rate limit reached",
+		FailedAt: failedAt,
+	})
+	retryDeadline := failedAt.Add(time.Minute)
+	tests := []struct {
+		name  string
+		input struct {
+			id            string
+			failure       *model.FileUploadFailure
+			retryDeadline time.Time
+		}
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		dbUpdateCheck   func(s *Storage) bool
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name: "errors when id is empty",
+			input: struct {
+				id            string
+				failure       *model.FileUploadFailure
+				retryDeadline time.Time
+			}{
+				retryDeadline: retryDeadline,
+			},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "id cannot be blank",
+		},
+		{
+			name: "errors when failure is nil",
+			input: struct {
+				id            string
+				failure       *model.FileUploadFailure
+				retryDeadline time.Time
+			}{
+				id:            "fp_id1",
+				retryDeadline: retryDeadline,
+			},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "failure cannot be nil",
+		},
+		{
+			name: "errors when retryDeadline is zero",
+			input: struct {
+				id            string
+				failure       *model.FileUploadFailure
+				retryDeadline time.Time
+			}{
+				id:      "fp_id1",
+				failure: failure,
+			},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "retryDeadline cannot be zero",
+		},
+		{
+			name: "errors when fileUpload does not exist in database",
+			input: struct {
+				id            string
+				failure       *model.FileUploadFailure
+				retryDeadline time.Time
+			}{
+				id:            "fp_id1",
+				failure:       failure,
+				retryDeadline: retryDeadline,
+			},
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "THIS IS BAD: Very few or too many rows were affected when updating file_upload in db. This is highly unexpected. rowsAffected: 0",
+		},
+		{
+			name: "successfully updates file upload",
+			input: struct {
+				id            string
+				failure       *model.FileUploadFailure
+				retryDeadline time.Time
+			}{
+				id:            "fp_id1",
+				failure:       failure,
+				retryDeadline: retryDeadline,
+			},
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+								"id", "name"
 							)
 							VALUES (
-								'fp_id5', 'file5.pdf', 'https://presigned_url5', 'SUCCESS', 'FAILED', 'team_id1'
+								'team_id1', 'Team1'
 							)`,
 				},
 				{
@@ -515,13 +2138,27 @@ func Test_GetAllProcessingNotStartedFileUploadIds(t *testing.T) {
 								"id", "name", "presigned_url", "status", "processing_status", "team_id"
 							)
 							VALUES (
-								'fp_id6', 'file6.pdf', 'https://presigned_url6', 'SUCCESS', 'NOT STARTED', 'team_id1'
+								'fp_id1', 'file1.pdf', 'http://presigned_url1', 'SUCCESS', 'ONGOING', 'team_id1'
 							)`,
 				},
 			},
 			cleanupSqlStmts: []TestSqlStmts{
 				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
 			},
+			dbUpdateCheck: func(s *Storage) bool {
+				fileUpload, err := s.GetFileUpload("fp_id1")
+				assert.NoError(t, err)
+				assert.Equal(t, "RETRYING", fileUpload.ProcessingStatus())
+				assert.NotNil(t, fileUpload.Failure())
+				assert.Equal(t, "LLM ERROR", fileUpload.Failure().Category())
+				assert.Equal(t, "error, status code: 429, message: Rate limit reached", fileUpload.Failure().Message())
+				assert.True(t, failedAt.Equal(fileUpload.Failure().FailedAt()))
+				var processingRetryDeadlineAt time.Time
+				err = s.db.QueryRow(`SELECT processing_retry_deadline_at FROM public."file_uploads" WHERE id = 'fp_id1'`).Scan(&processingRetryDeadlineAt)
+				assert.NoError(t, err)
+				assert.True(t, retryDeadline.Equal(processingRetryDeadlineAt))
+				return true
+			},
 			errorExpected: false,
 			errorString:   "",
 		},
@@ -537,101 +2174,76 @@ func Test_GetAllProcessingNotStartedFileUploadIds(t *testing.T) {
 
 			runSqlOnDb(t, s.db, tt.setupSqlStmts)
 			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
-			fileUploadIds, err := s.GetAllProcessingNotStartedFileUploadIds()
-			assert.Equal(t, tt.output, fileUploadIds)
+			err := s.UpdateFileUploadWithProcessingRetry(tt.input.id, tt.input.failure, tt.input.retryDeadline)
 			if !tt.errorExpected {
 				assert.NoError(t, err)
 			} else {
 				assert.NotEmpty(t, tt.errorString)
 				assert.EqualError(t, err, tt.errorString)
 			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s))
+			}
 		})
 	}
 }
 
-func Test_CreateFileUploadForTeam(t *testing.T) {
-	currentFileCount := 1
-	team, _ := model.NewTeam(model.TeamOptions{
-		Id:               "team_id1",
-		Name:             "Team1",
-		CurrentFileCount: &currentFileCount,
-		FileCountLimit:   100,
-	})
-	fileUpload, _ := model.NewFileUpload(model.FileUploadOptions{
-		Id:               "fp_id1",
-		Name:             "file1.pdf",
-		PresignedUrl:     "",
-		Status:           "INITIATED",
-		ProcessingStatus: "NOT STARTED",
-		Team:             team,
-	})
+func Test_UpdateFileUploadProcessingHeartbeat(t *testing.T) {
 	tests := []struct {
-		name  string
-		input struct {
-			name string
-			team *model.Team
-		}
-		output          *model.FileUpload
+		name            string
+		input           string
 		setupSqlStmts   []TestSqlStmts
 		cleanupSqlStmts []TestSqlStmts
-		dbUpdateCheck   func(db *sql.DB) bool
+		dbUpdateCheck   func(s *Storage) bool
 		errorExpected   bool
 		errorString     string
 	}{
 		{
-			name: "errors when name is empty",
-			input: struct {
-				name string
-				team *model.Team
-			}{},
-			output:          nil,
+			name:            "errors when id is empty",
+			input:           "",
 			setupSqlStmts:   nil,
 			cleanupSqlStmts: nil,
 			dbUpdateCheck:   nil,
 			errorExpected:   true,
-			errorString:     "cannot create FileUpload with an empty name",
+			errorString:     "id cannot be blank",
 		},
 		{
-			name: "errors when team is nil",
-			input: struct {
-				name string
-				team *model.Team
-			}{
-				name: "file1.pdf",
+			name:  "updates heartbeat of file upload that is being processed",
+			input: "fp_id1",
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+								"id", "name"
+							)
+							VALUES (
+								'team_id1', 'Team1'
+							)`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id", "processing_heartbeat_at"
+							)
+							VALUES (
+								'fp_id1', 'file1.pdf', 'http://presigned_url1', 'SUCCESS', 'ONGOING', 'team_id1', now() - interval '1 hour'
+							)`,
+				},
 			},
-			output:          nil,
-			setupSqlStmts:   nil,
-			cleanupSqlStmts: nil,
-			dbUpdateCheck:   nil,
-			errorExpected:   true,
-			errorString:     "cannot create FileUpload with a nil Team",
-		},
-		{
-			name: "errors when team does not exist in Database",
-			input: struct {
-				name string
-				team *model.Team
-			}{
-				name: "file1.pdf",
-				team: team,
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
 			},
-			output:          nil,
-			setupSqlStmts:   nil,
-			cleanupSqlStmts: nil,
-			dbUpdateCheck:   nil,
-			errorExpected:   true,
-			errorString:     "THIS IS BAD: dbError while inserting file_upload: fp_id1: pq: insert or update on table \"file_uploads\" violates foreign key constraint \"file_uploads_team_id_fkey\"",
+			dbUpdateCheck: func(s *Storage) bool {
+				var heartbeatIsRecent bool
+				row := s.db.QueryRow(`SELECT processing_heartbeat_at > now() - interval '1 minute' FROM public."file_uploads" WHERE id = 'fp_id1'`)
+				assert.NoError(t, row.Scan(&heartbeatIsRecent))
+				assert.True(t, heartbeatIsRecent)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
 		},
 		{
-			name: "successfully creates a new file upload",
-			input: struct {
-				name string
-				team *model.Team
-			}{
-				name: "file1.pdf",
-				team: team,
-			},
-			output: fileUpload,
+			name:  "does not update heartbeat of file upload that is not being processed",
+			input: "fp_id1",
 			setupSqlStmts: []TestSqlStmts{
 				{
 					Query: `INSERT INTO public."teams" (
@@ -641,23 +2253,23 @@ func Test_CreateFileUploadForTeam(t *testing.T) {
 								'team_id1', 'Team1'
 							)`,
 				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id"
+							)
+							VALUES (
+								'fp_id1', 'file1.pdf', 'http://presigned_url1', 'SUCCESS', 'COMPLETED', 'team_id1'
+							)`,
+				},
 			},
 			cleanupSqlStmts: []TestSqlStmts{
 				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
 			},
-			dbUpdateCheck: func(db *sql.DB) bool {
-				var id, name, presignedUrl, status, processingStatus, createdAt string
-				row := db.QueryRow(
-					`SELECT id, name, presigned_url, status, processing_status, created_at FROM public."file_uploads" WHERE team_id = 'team_id1'`,
-				)
-				assert.NoError(t, row.Err())
-				err := row.Scan(&id, &name, &presignedUrl, &status, &processingStatus, &createdAt)
-				assert.NoError(t, err)
-				assert.Equal(t, "fp_id1", id)
-				assert.Equal(t, "file1.pdf", name)
-				assert.Equal(t, "", presignedUrl)
-				assert.Equal(t, model.FileUploadStatus("INITIATED").String(), status)
-				assert.Equal(t, model.FileUploadProcessingStatus("NOT STARTED").String(), processingStatus)
+			dbUpdateCheck: func(s *Storage) bool {
+				var heartbeatAt sql.NullTime
+				row := s.db.QueryRow(`SELECT processing_heartbeat_at FROM public."file_uploads" WHERE id = 'fp_id1'`)
+				assert.NoError(t, row.Scan(&heartbeatAt))
+				assert.False(t, heartbeatAt.Valid)
 				return true
 			},
 			errorExpected: false,
@@ -669,15 +2281,13 @@ func Test_CreateFileUploadForTeam(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			s, _ := NewDbStorage(
 				StorageOptions{
-					Db:          testDb,
-					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "fp_id1"},
+					Db: testDb,
 				},
 			)
 
 			runSqlOnDb(t, s.db, tt.setupSqlStmts)
 			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
-			fileUpload, err := s.CreateFileUploadForTeam(tt.input.name, tt.input.team)
-			assert.Equal(t, tt.output, fileUpload)
+			err := s.UpdateFileUploadProcessingHeartbeat(tt.input)
 			if !tt.errorExpected {
 				assert.NoError(t, err)
 			} else {
@@ -685,74 +2295,65 @@ func Test_CreateFileUploadForTeam(t *testing.T) {
 				assert.EqualError(t, err, tt.errorString)
 			}
 			if tt.dbUpdateCheck != nil {
-				assert.True(t, tt.dbUpdateCheck(s.db))
+				assert.True(t, tt.dbUpdateCheck(s))
 			}
 		})
 	}
 }
 
-func Test_UpdateFileUploadWithPresignedUrl(t *testing.T) {
+func Test_ReapStaleProcessingFileUploads(t *testing.T) {
 	tests := []struct {
 		name  string
 		input struct {
-			id           string
-			presignedUrl string
+			lease        time.Duration
+			maxReapCount int
 		}
 		setupSqlStmts   []TestSqlStmts
 		cleanupSqlStmts []TestSqlStmts
-		dbUpdateCheck   func(db *sql.DB) bool
+		output          int
+		dbUpdateCheck   func(s *Storage) bool
 		errorExpected   bool
 		errorString     string
 	}{
 		{
-			name: "errors when id is empty",
-			input: struct {
-				id           string
-				presignedUrl string
-			}{},
-			setupSqlStmts:   nil,
-			cleanupSqlStmts: nil,
-			dbUpdateCheck:   nil,
-			errorExpected:   true,
-			errorString:     "id cannot be blank",
-		},
-		{
-			name: "errors when presignedUrl is empty",
+			name: "errors when lease is not positive",
 			input: struct {
-				id           string
-				presignedUrl string
+				lease        time.Duration
+				maxReapCount int
 			}{
-				id: "fp_id1",
+				maxReapCount: 2,
 			},
 			setupSqlStmts:   nil,
 			cleanupSqlStmts: nil,
+			output:          0,
 			dbUpdateCheck:   nil,
 			errorExpected:   true,
-			errorString:     "presignedUrl cannot be blank",
+			errorString:     "lease should be positive",
 		},
 		{
-			name: "errors when fileUpload does not exist in database",
+			name: "errors when maxReapCount is negative",
 			input: struct {
-				id           string
-				presignedUrl string
+				lease        time.Duration
+				maxReapCount int
 			}{
-				id:           "fp_id1",
-				presignedUrl: "http://presigned_url1",
+				lease:        5 * time.Minute,
+				maxReapCount: -1,
 			},
 			setupSqlStmts:   nil,
 			cleanupSqlStmts: nil,
+			output:          0,
 			dbUpdateCheck:   nil,
 			errorExpected:   true,
-			errorString:     "THIS IS BAD: Very few or too many rows were affected when inserting file_upload in db. This is highly unexpected. rowsAffected: 0",
+			errorString:     "maxReapCount cannot be negative",
 		},
 		{
-			name: "successfully updates file upload",
+			name: "resets stale file uploads and lost retries, fails those reaped too many times and leaves the rest alone",
 			input: struct {
-				id           string
-				presignedUrl string
+				lease        time.Duration
+				maxReapCount int
 			}{
-				id:           "fp_id1",
-				presignedUrl: "http://presigned_url1",
+				lease:        5 * time.Minute,
+				maxReapCount: 2,
 			},
 			setupSqlStmts: []TestSqlStmts{
 				{
@@ -765,29 +2366,57 @@ func Test_UpdateFileUploadWithPresignedUrl(t *testing.T) {
 				},
 				{
 					Query: `INSERT INTO public."file_uploads" (
-								"id", "name", "presigned_url", "status", "processing_status", "team_id"
+								"id", "name", "presigned_url", "status", "processing_status", "team_id", "processing_heartbeat_at", "processing_reap_count", "updated_at",
+								"processing_retry_deadline_at"
 							)
-							VALUES (
-								'fp_id1', 'file1.pdf', '', 'INITIATED', 'NOT STARTED', 'team_id1'
-							)`,
+							VALUES
+							('fp_id1', 'file1.pdf', 'http://presigned_url1', 'SUCCESS', 'ONGOING', 'team_id1', now() - interval '10 minutes', 0, now(), NULL),
+							('fp_id2', 'file2.pdf', 'http://presigned_url2', 'SUCCESS', 'ONGOING', 'team_id1', now() - interval '10 minutes', 2, now(), NULL),
+							('fp_id3', 'file3.pdf', 'http://presigned_url3', 'SUCCESS', 'ONGOING', 'team_id1', now() - interval '1 minute', 0, now(), NULL),
+							('fp_id4', 'file4.pdf', 'http://presigned_url4', 'SUCCESS', 'RETRYING', 'team_id1', NULL, 0, now() - interval '1 hour', now() - interval '1 minute'),
+							('fp_id5', 'file5.pdf', 'http://presigned_url5', 'SUCCESS', 'ONGOING', 'team_id1', NULL, 0, now() - interval '1 hour', NULL),
+							('fp_id6', 'file6.pdf', 'http://presigned_url6', 'SUCCESS', 'RETRYING', 'team_id1', NULL, 0, now() - interval '1 hour', now() - interval '10 minutes')`,
 				},
 			},
 			cleanupSqlStmts: []TestSqlStmts{
 				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
 			},
-			dbUpdateCheck: func(db *sql.DB) bool {
-				var id, name, presignedUrl, status, processingStatus, createdAt string
-				row := db.QueryRow(
-					`SELECT id, name, presigned_url, status, processing_status, created_at FROM public."file_uploads" WHERE team_id = 'team_id1'`,
-				)
-				assert.NoError(t, row.Err())
-				err := row.Scan(&id, &name, &presignedUrl, &status, &processingStatus, &createdAt)
+			output: 4,
+			dbUpdateCheck: func(s *Storage) bool {
+				fileUpload, err := s.GetFileUpload("fp_id1")
 				assert.NoError(t, err)
-				assert.Equal(t, "fp_id1", id)
-				assert.Equal(t, "file1.pdf", name)
-				assert.Equal(t, "http://presigned_url1", presignedUrl)
-				assert.Equal(t, model.FileUploadStatus("INITIATED").String(), status)
-				assert.Equal(t, model.FileUploadProcessingStatus("NOT STARTED").String(), processingStatus)
+				assert.Equal(t, "NOT STARTED", fileUpload.ProcessingStatus())
+				var reapCount int
+				row := s.db.QueryRow(`SELECT processing_reap_count FROM public."file_uploads" WHERE id = 'fp_id1'`)
+				assert.NoError(t, row.Scan(&reapCount))
+				assert.Equal(t, 1, reapCount)
+
+				fileUpload, err = s.GetFileUpload("fp_id2")
+				assert.NoError(t, err)
+				assert.Equal(t, "FAILED", fileUpload.ProcessingStatus())
+				assert.NotNil(t, fileUpload.Failure())
+				assert.Equal(t, "PROCESSING STALLED", fileUpload.Failure().Category())
+
+				fileUpload, err = s.GetFileUpload("fp_id3")
+				assert.NoError(t, err)
+				assert.Equal(t, "ONGOING", fileUpload.ProcessingStatus())
+
+				fileUpload, err = s.GetFileUpload("fp_id4")
+				assert.NoError(t, err)
+				assert.Equal(t, "RETRYING", fileUpload.ProcessingStatus())
+
+				fileUpload, err = s.GetFileUpload("fp_id5")
+				assert.NoError(t, err)
+				assert.Equal(t, "NOT STARTED", fileUpload.ProcessingStatus())
+
+				fileUpload, err = s.GetFileUpload("fp_id6")
+				assert.NoError(t, err)
+				assert.Equal(t, "NOT STARTED", fileUpload.ProcessingStatus())
+				var processingRetryDeadlineAt sql.NullTime
+				row = s.db.QueryRow(`SELECT processing_reap_count, processing_retry_deadline_at FROM public."file_uploads" WHERE id = 'fp_id6'`)
+				assert.NoError(t, row.Scan(&reapCount, &processingRetryDeadlineAt))
+				assert.Equal(t, 1, reapCount)
+				assert.False(t, processingRetryDeadlineAt.Valid)
 				return true
 			},
 			errorExpected: false,
@@ -799,33 +2428,33 @@ func Test_UpdateFileUploadWithPresignedUrl(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			s, _ := NewDbStorage(
 				StorageOptions{
-					Db:          testDb,
-					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "fp_id1"},
+					Db: testDb,
 				},
 			)
 
 			runSqlOnDb(t, s.db, tt.setupSqlStmts)
 			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
-			err := s.UpdateFileUploadWithPresignedUrl(tt.input.id, tt.input.presignedUrl)
+			reapedCount, err := s.ReapStaleProcessingFileUploads(tt.input.lease, tt.input.maxReapCount)
 			if !tt.errorExpected {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.output, reapedCount)
 			} else {
 				assert.NotEmpty(t, tt.errorString)
 				assert.EqualError(t, err, tt.errorString)
 			}
 			if tt.dbUpdateCheck != nil {
-				assert.True(t, tt.dbUpdateCheck(s.db))
+				assert.True(t, tt.dbUpdateCheck(s))
 			}
 		})
 	}
 }
 
-func Test_UpdateFileUploadWithStatus(t *testing.T) {
+func Test_UpdateFileUploadWithContentHashUsingTx(t *testing.T) {
 	tests := []struct {
 		name  string
 		input struct {
-			id     string
-			status string
+			id          string
+			contentHash string
 		}
 		setupSqlStmts   []TestSqlStmts
 		cleanupSqlStmts []TestSqlStmts
@@ -836,8 +2465,8 @@ func Test_UpdateFileUploadWithStatus(t *testing.T) {
 		{
 			name: "errors when id is empty",
 			input: struct {
-				id     string
-				status string
+				id          string
+				contentHash string
 			}{},
 			setupSqlStmts:   nil,
 			cleanupSqlStmts: nil,
@@ -846,10 +2475,10 @@ func Test_UpdateFileUploadWithStatus(t *testing.T) {
 			errorString:     "id cannot be blank",
 		},
 		{
-			name: "errors when status is not valid",
+			name: "errors when contentHash is empty",
 			input: struct {
-				id     string
-				status string
+				id          string
+				contentHash string
 			}{
 				id: "fp_id1",
 			},
@@ -857,31 +2486,31 @@ func Test_UpdateFileUploadWithStatus(t *testing.T) {
 			cleanupSqlStmts: nil,
 			dbUpdateCheck:   nil,
 			errorExpected:   true,
-			errorString:     "status should be valid",
+			errorString:     "contentHash cannot be blank",
 		},
 		{
 			name: "errors when fileUpload does not exist in database",
 			input: struct {
-				id     string
-				status string
+				id          string
+				contentHash string
 			}{
-				id:     "fp_id1",
-				status: "SUCCESS",
+				id:          "fp_id1",
+				contentHash: "hash1",
 			},
 			setupSqlStmts:   nil,
 			cleanupSqlStmts: nil,
 			dbUpdateCheck:   nil,
 			errorExpected:   true,
-			errorString:     "THIS IS BAD: Very few or too many rows were affected when inserting file_upload in db. This is highly unexpected. rowsAffected: 0",
+			errorString:     "THIS IS BAD: Very few or too many rows were affected when updating file_upload in db. This is highly unexpected. rowsAffected: 0",
 		},
 		{
 			name: "successfully updates file upload",
 			input: struct {
-				id     string
-				status string
+				id          string
+				contentHash string
 			}{
-				id:     "fp_id1",
-				status: "FAILURE",
+				id:          "fp_id1",
+				contentHash: "hash1",
 			},
 			setupSqlStmts: []TestSqlStmts{
 				{
@@ -897,7 +2526,7 @@ func Test_UpdateFileUploadWithStatus(t *testing.T) {
 								"id", "name", "presigned_url", "status", "processing_status", "team_id"
 							)
 							VALUES (
-								'fp_id1', 'file1.pdf', 'http://presigned_url1', 'INITIATED', 'NOT STARTED', 'team_id1'
+								'fp_id1', 'file1.pdf', 'http://presigned_url1', 'SUCCESS', 'ONGOING', 'team_id1'
 							)`,
 				},
 			},
@@ -905,18 +2534,12 @@ func Test_UpdateFileUploadWithStatus(t *testing.T) {
 				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
 			},
 			dbUpdateCheck: func(db *sql.DB) bool {
-				var id, name, presignedUrl, status, processingStatus, createdAt string
-				row := db.QueryRow(
-					`SELECT id, name, presigned_url, status, processing_status, created_at FROM public."file_uploads" WHERE team_id = 'team_id1'`,
-				)
+				var contentHash string
+				row := db.QueryRow(`SELECT content_hash FROM public."file_uploads" WHERE id = 'fp_id1'`)
 				assert.NoError(t, row.Err())
-				err := row.Scan(&id, &name, &presignedUrl, &status, &processingStatus, &createdAt)
+				err := row.Scan(&contentHash)
 				assert.NoError(t, err)
-				assert.Equal(t, "fp_id1", id)
-				assert.Equal(t, "file1.pdf", name)
-				assert.Equal(t, "http://presigned_url1", presignedUrl)
-				assert.Equal(t, model.FileUploadStatus("FAILURE").String(), status)
-				assert.Equal(t, model.FileUploadProcessingStatus("NOT STARTED").String(), processingStatus)
+				assert.Equal(t, "hash1", contentHash)
 				return true
 			},
 			errorExpected: false,
@@ -928,14 +2551,18 @@ func Test_UpdateFileUploadWithStatus(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			s, _ := NewDbStorage(
 				StorageOptions{
-					Db:          testDb,
-					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "fp_id1"},
+					Db: testDb,
 				},
 			)
 
 			runSqlOnDb(t, s.db, tt.setupSqlStmts)
 			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
-			err := s.UpdateFileUploadWithStatus(tt.input.id, tt.input.status)
+
+			tx, err := s.BeginTransaction()
+			assert.NoError(t, err)
+			err = s.UpdateFileUploadWithContentHashUsingTx(tt.input.id, tt.input.contentHash, tx)
+			tx.Commit()
+
 			if !tt.errorExpected {
 				assert.NoError(t, err)
 			} else {
@@ -947,109 +2574,156 @@ func Test_UpdateFileUploadWithStatus(t *testing.T) {
 			}
 		})
 	}
-}
-
-func Test_UpdateFileUploadWithProcessingStatus(t *testing.T) {
+}
+
+func Test_GetDuplicateCandidateIdForFileUploadUsingTx(t *testing.T) {
+	currentFileCount := 3
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	fileUpload, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id3",
+		Name:             "file3.pdf",
+		Status:           "SUCCESS",
+		ProcessingStatus: "ONGOING",
+		Team:             team,
+	})
+	seedSqlStmts := []TestSqlStmts{
+		{
+			Query: `INSERT INTO public."teams" (
+						"id", "name"
+					)
+					VALUES
+					('team_id1', 'Team1'),
+					('team_id2', 'Team2')`,
+		},
+		{
+			Query: `INSERT INTO public."file_uploads" (
+						"id", "name", "presigned_url", "status", "processing_status", "team_id", "content_hash", "created_at"
+					)
+					VALUES
+					('fp_id1', 'file1.pdf', '', 'SUCCESS', 'COMPLETED', 'team_id1', 'hash1', '2023-04-01 10:00:00+00'),
+					('fp_id2', 'file2.pdf', '', 'SUCCESS', 'COMPLETED', 'team_id1', 'hash1', '2023-04-01 10:00:01+00'),
+					('fp_id3', 'file3.pdf', '', 'SUCCESS', 'ONGOING', 'team_id1', 'hash3', '2023-04-01 10:00:02+00'),
+					('fp_id4', 'file4.pdf', '', 'SUCCESS', 'COMPLETED', 'team_id2', 'hash4', '2023-04-01 10:00:03+00'),
+					('fp_id5', 'file5.pdf', '', 'SUCCESS', 'FAILED', 'team_id1', 'hash5', '2023-04-01 10:00:04+00')`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" (
+						"id", "team_id", "file_upload_id"
+					)
+					VALUES
+					('candidate_id1', 'team_id1', 'fp_id1'),
+					('candidate_id2', 'team_id1', 'fp_id2'),
+					('candidate_id3', 'team_id1', 'fp_id3'),
+					('candidate_id4', 'team_id2', 'fp_id4')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
 	tests := []struct {
 		name  string
 		input struct {
-			id               string
-			processingStatus string
+			fileUpload  *model.FileUpload
+			contentHash string
 		}
+		output          string
 		setupSqlStmts   []TestSqlStmts
 		cleanupSqlStmts []TestSqlStmts
-		dbUpdateCheck   func(db *sql.DB) bool
 		errorExpected   bool
 		errorString     string
 	}{
 		{
-			name: "errors when id is empty",
+			name: "errors when fileUpload is nil",
 			input: struct {
-				id               string
-				processingStatus string
-			}{},
+				fileUpload  *model.FileUpload
+				contentHash string
+			}{
+				contentHash: "hash1",
+			},
+			output:          "",
 			setupSqlStmts:   nil,
 			cleanupSqlStmts: nil,
-			dbUpdateCheck:   nil,
 			errorExpected:   true,
-			errorString:     "id cannot be blank",
+			errorString:     "fileUpload cannot be nil",
 		},
 		{
-			name: "errors when status is not valid",
+			name: "errors when contentHash is empty",
 			input: struct {
-				id               string
-				processingStatus string
+				fileUpload  *model.FileUpload
+				contentHash string
 			}{
-				id: "fp_id1",
+				fileUpload: fileUpload,
 			},
+			output:          "",
 			setupSqlStmts:   nil,
 			cleanupSqlStmts: nil,
-			dbUpdateCheck:   nil,
 			errorExpected:   true,
-			errorString:     "processing status should be valid",
+			errorString:     "contentHash cannot be blank",
 		},
 		{
-			name: "errors when fileUpload does not exist in database",
+			name: "gets the candidate of the earliest file upload with the same content",
 			input: struct {
-				id               string
-				processingStatus string
+				fileUpload  *model.FileUpload
+				contentHash string
 			}{
-				id:               "fp_id1",
-				processingStatus: "COMPLETED",
+				fileUpload:  fileUpload,
+				contentHash: "hash1",
 			},
-			setupSqlStmts:   nil,
-			cleanupSqlStmts: nil,
-			dbUpdateCheck:   nil,
-			errorExpected:   true,
-			errorString:     "THIS IS BAD: Very few or too many rows were affected when inserting file_upload in db. This is highly unexpected. rowsAffected: 0",
+			output:          "candidate_id1",
+			setupSqlStmts:   seedSqlStmts,
+			cleanupSqlStmts: cleanupSqlStmts,
+			errorExpected:   false,
+			errorString:     "",
 		},
 		{
-			name: "successfully updates file upload",
+			name: "ignores the candidate of the file upload itself",
 			input: struct {
-				id               string
-				processingStatus string
+				fileUpload  *model.FileUpload
+				contentHash string
 			}{
-				id:               "fp_id1",
-				processingStatus: "FAILED",
-			},
-			setupSqlStmts: []TestSqlStmts{
-				{
-					Query: `INSERT INTO public."teams" (
-								"id", "name"
-							)
-							VALUES (
-								'team_id1', 'Team1'
-							)`,
-				},
-				{
-					Query: `INSERT INTO public."file_uploads" (
-								"id", "name", "presigned_url", "status", "processing_status", "team_id"
-							)
-							VALUES (
-								'fp_id1', 'file1.pdf', 'http://presigned_url1', 'INITIATED', 'NOT STARTED', 'team_id1'
-							)`,
-				},
+				fileUpload:  fileUpload,
+				contentHash: "hash3",
 			},
-			cleanupSqlStmts: []TestSqlStmts{
-				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			output:          "",
+			setupSqlStmts:   seedSqlStmts,
+			cleanupSqlStmts: cleanupSqlStmts,
+			errorExpected:   false,
+			errorString:     "",
+		},
+		{
+			name: "ignores file uploads of other teams",
+			input: struct {
+				fileUpload  *model.FileUpload
+				contentHash string
+			}{
+				fileUpload:  fileUpload,
+				contentHash: "hash4",
 			},
-			dbUpdateCheck: func(db *sql.DB) bool {
-				var id, name, presignedUrl, status, processingStatus, createdAt string
-				row := db.QueryRow(
-					`SELECT id, name, presigned_url, status, processing_status, created_at FROM public."file_uploads" WHERE team_id = 'team_id1'`,
-				)
-				assert.NoError(t, row.Err())
-				err := row.Scan(&id, &name, &presignedUrl, &status, &processingStatus, &createdAt)
-				assert.NoError(t, err)
-				assert.Equal(t, "fp_id1", id)
-				assert.Equal(t, "file1.pdf", name)
-				assert.Equal(t, "http://presigned_url1", presignedUrl)
-				assert.Equal(t, model.FileUploadStatus("INITIATED").String(), status)
-				assert.Equal(t, model.FileUploadProcessingStatus("FAILED").String(), processingStatus)
-				return true
+			output:          "",
+			setupSqlStmts:   seedSqlStmts,
+			cleanupSqlStmts: cleanupSqlStmts,
+			errorExpected:   false,
+			errorString:     "",
+		},
+		{
+			name: "ignores file uploads without a candidate",
+			input: struct {
+				fileUpload  *model.FileUpload
+				contentHash string
+			}{
+				fileUpload:  fileUpload,
+				contentHash: "hash5",
 			},
-			errorExpected: false,
-			errorString:   "",
+			output:          "",
+			setupSqlStmts:   seedSqlStmts,
+			cleanupSqlStmts: cleanupSqlStmts,
+			errorExpected:   false,
+			errorString:     "",
 		},
 	}
 
@@ -1057,33 +2731,41 @@ func Test_UpdateFileUploadWithProcessingStatus(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			s, _ := NewDbStorage(
 				StorageOptions{
-					Db:          testDb,
-					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "fp_id1"},
+					Db: testDb,
 				},
 			)
 
 			runSqlOnDb(t, s.db, tt.setupSqlStmts)
 			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
-			err := s.UpdateFileUploadWithProcessingStatus(tt.input.id, tt.input.processingStatus)
+
+			tx, err := s.BeginTransaction()
+			assert.NoError(t, err)
+			candidateId, err := s.GetDuplicateCandidateIdForFileUploadUsingTx(tt.input.fileUpload, tt.input.contentHash, tx)
+			if !tt.errorExpected {
+				var advisoryLockCount int
+				row := tx.QueryRow(`SELECT COUNT(*) FROM pg_locks WHERE locktype = 'advisory' AND pid = pg_backend_pid()`)
+				assert.NoError(t, row.Scan(&advisoryLockCount))
+				assert.Equal(t, 1, advisoryLockCount)
+			}
+			tx.Commit()
+
+			assert.Equal(t, tt.output, candidateId)
 			if !tt.errorExpected {
 				assert.NoError(t, err)
 			} else {
 				assert.NotEmpty(t, tt.errorString)
 				assert.EqualError(t, err, tt.errorString)
 			}
-			if tt.dbUpdateCheck != nil {
-				assert.True(t, tt.dbUpdateCheck(s.db))
-			}
 		})
 	}
 }
 
-func Test_UpdateFileUploadWithProcessingStatusUsingTx(t *testing.T) {
+func Test_UpdateFileUploadAsDuplicateUsingTx(t *testing.T) {
 	tests := []struct {
 		name  string
 		input struct {
-			id               string
-			processingStatus string
+			id                     string
+			duplicateOfCandidateId string
 		}
 		setupSqlStmts   []TestSqlStmts
 		cleanupSqlStmts []TestSqlStmts
@@ -1094,8 +2776,8 @@ func Test_UpdateFileUploadWithProcessingStatusUsingTx(t *testing.T) {
 		{
 			name: "errors when id is empty",
 			input: struct {
-				id               string
-				processingStatus string
+				id                     string
+				duplicateOfCandidateId string
 			}{},
 			setupSqlStmts:   nil,
 			cleanupSqlStmts: nil,
@@ -1104,42 +2786,27 @@ func Test_UpdateFileUploadWithProcessingStatusUsingTx(t *testing.T) {
 			errorString:     "id cannot be blank",
 		},
 		{
-			name: "errors when status is not valid",
-			input: struct {
-				id               string
-				processingStatus string
-			}{
-				id: "fp_id1",
-			},
-			setupSqlStmts:   nil,
-			cleanupSqlStmts: nil,
-			dbUpdateCheck:   nil,
-			errorExpected:   true,
-			errorString:     "processing status should be valid",
-		},
-		{
-			name: "errors when fileUpload does not exist in database",
+			name: "errors when duplicateOfCandidateId is empty",
 			input: struct {
-				id               string
-				processingStatus string
+				id                     string
+				duplicateOfCandidateId string
 			}{
-				id:               "fp_id1",
-				processingStatus: "COMPLETED",
+				id: "fp_id2",
 			},
 			setupSqlStmts:   nil,
 			cleanupSqlStmts: nil,
 			dbUpdateCheck:   nil,
 			errorExpected:   true,
-			errorString:     "THIS IS BAD: Very few or too many rows were affected when inserting file_upload in db. This is highly unexpected. rowsAffected: 0",
+			errorString:     "duplicateOfCandidateId cannot be blank",
 		},
 		{
-			name: "successfully updates file upload",
+			name: "successfully marks file upload as a duplicate",
 			input: struct {
-				id               string
-				processingStatus string
+				id                     string
+				duplicateOfCandidateId string
 			}{
-				id:               "fp_id1",
-				processingStatus: "FAILED",
+				id:                     "fp_id2",
+				duplicateOfCandidateId: "candidate_id1",
 			},
 			setupSqlStmts: []TestSqlStmts{
 				{
@@ -1154,8 +2821,16 @@ func Test_UpdateFileUploadWithProcessingStatusUsingTx(t *testing.T) {
 					Query: `INSERT INTO public."file_uploads" (
 								"id", "name", "presigned_url", "status", "processing_status", "team_id"
 							)
+							VALUES
+							('fp_id1', 'file1.pdf', '', 'SUCCESS', 'COMPLETED', 'team_id1'),
+							('fp_id2', 'file2.pdf', '', 'SUCCESS', 'ONGOING', 'team_id1')`,
+				},
+				{
+					Query: `INSERT INTO public."candidates" (
+								"id", "team_id", "file_upload_id"
+							)
 							VALUES (
-								'fp_id1', 'file1.pdf', 'http://presigned_url1', 'INITIATED', 'NOT STARTED', 'team_id1'
+								'candidate_id1', 'team_id1', 'fp_id1'
 							)`,
 				},
 			},
@@ -1163,18 +2838,13 @@ func Test_UpdateFileUploadWithProcessingStatusUsingTx(t *testing.T) {
 				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
 			},
 			dbUpdateCheck: func(db *sql.DB) bool {
-				var id, name, presignedUrl, status, processingStatus, createdAt string
-				row := db.QueryRow(
-					`SELECT id, name, presigned_url, status, processing_status, created_at FROM public."file_uploads" WHERE team_id = 'team_id1'`,
-				)
+				var processingStatus, duplicateOfCandidateId string
+				row := db.QueryRow(`SELECT processing_status, duplicate_of_candidate_id FROM public."file_uploads" WHERE id = 'fp_id2'`)
 				assert.NoError(t, row.Err())
-				err := row.Scan(&id, &name, &presignedUrl, &status, &processingStatus, &createdAt)
+				err := row.Scan(&processingStatus, &duplicateOfCandidateId)
 				assert.NoError(t, err)
-				assert.Equal(t, "fp_id1", id)
-				assert.Equal(t, "file1.pdf", name)
-				assert.Equal(t, "http://presigned_url1", presignedUrl)
-				assert.Equal(t, model.FileUploadStatus("INITIATED").String(), status)
-				assert.Equal(t, model.FileUploadProcessingStatus("FAILED").String(), processingStatus)
+				assert.Equal(t, "DUPLICATE", processingStatus)
+				assert.Equal(t, "candidate_id1", duplicateOfCandidateId)
 				return true
 			},
 			errorExpected: false,
@@ -1186,8 +2856,7 @@ func Test_UpdateFileUploadWithProcessingStatusUsingTx(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			s, _ := NewDbStorage(
 				StorageOptions{
-					Db:          testDb,
-					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "fp_id1"},
+					Db: testDb,
 				},
 			)
 
@@ -1196,7 +2865,7 @@ func Test_UpdateFileUploadWithProcessingStatusUsingTx(t *testing.T) {
 
 			tx, err := s.BeginTransaction()
 			assert.NoError(t, err)
-			err = s.UpdateFileUploadWithProcessingStatusUsingTx(tt.input.id, tt.input.processingStatus, tx)
+			err = s.UpdateFileUploadAsDuplicateUsingTx(tt.input.id, tt.input.duplicateOfCandidateId, tx)
 			tx.Commit()
 
 			if !tt.errorExpected {
@@ -1212,6 +2881,235 @@ func Test_UpdateFileUploadWithProcessingStatusUsingTx(t *testing.T) {
 	}
 }
 
+func Test_ResetFileUploadProcessingForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	team2, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id2",
+		Name:             "Team2",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	setupSqlStmts := []TestSqlStmts{
+		{
+			Query: `INSERT INTO public."teams" (
+				"id", "name"
+			)
+			VALUES (
+				'team_id1', 'Team1'
+			), (
+				'team_id2', 'Team2'
+			)`,
+		},
+		{
+			Query: `INSERT INTO public."file_uploads" (
+				"id", "name", "presigned_url", "status", "processing_status", "team_id"
+			)
+			VALUES (
+				'fp_id1', 'file1.pdf', 'https://presigned_url1', 'SUCCESS', 'FAILED', 'team_id1'
+			), (
+				'fp_id2', 'file2.pdf', 'https://presigned_url2', 'SUCCESS', 'COMPLETED', 'team_id1'
+			), (
+				'fp_id3', 'file3.pdf', 'https://presigned_url3', 'SUCCESS', 'ONGOING', 'team_id1'
+			), (
+				'fp_id4', 'file4.pdf', 'https://presigned_url4', 'FAILURE', 'FAILED', 'team_id1'
+			), (
+				'fp_id5', 'file5.pdf', 'https://presigned_url5', 'SUCCESS', 'DUPLICATE', 'team_id1'
+			), (
+				'fp_id6', 'file6.pdf', 'https://presigned_url6', 'SUCCESS', 'COMPLETED', 'team_id1'
+			), (
+				'fp_id7', 'file7.pdf', 'https://presigned_url7', 'SUCCESS', 'COMPLETED', 'team_id1'
+			), (
+				'fp_id8', 'file8.pdf', 'https://presigned_url8', 'SUCCESS', 'DUPLICATE', 'team_id1'
+			), (
+				'fp_id9', 'files.zip', 'https://presigned_url9', 'SUCCESS', 'COMPLETED', 'team_id1'
+			)`,
+		},
+		{
+			Query: `INSERT INTO public."file_uploads" (
+				"id", "name", "presigned_url", "status", "processing_status", "team_id", "parent_file_upload_id"
+			)
+			VALUES (
+				'fp_id10', 'file10.pdf', 'https://presigned_url10', 'SUCCESS', 'COMPLETED', 'team_id1', 'fp_id9'
+			)`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" (
+				"id", "team_id", "file_upload_id"
+			)
+			VALUES
+			('candidate_id2', 'team_id1', 'fp_id2'),
+			('candidate_id10', 'team_id1', 'fp_id10')`,
+		},
+		{
+			Query: `UPDATE public."file_uploads"
+			SET "failure_category" = 'NOT A RESUME', "failure_message" = 'needs a valid resume to parse', "failed_at" = now()
+			WHERE id = 'fp_id1'`,
+		},
+		{
+			Query: `UPDATE public."file_uploads" SET "duplicate_of_candidate_id" = 'candidate_id2' WHERE id = 'fp_id5'`,
+		},
+		{
+			Query: `UPDATE public."file_uploads" SET "merged_into_candidate_id" = 'candidate_id2' WHERE id = 'fp_id6'`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+	processingStatusCheck := func(db *sql.DB, id string) string {
+		var processingStatus string
+		err := db.QueryRow(`SELECT processing_status FROM public."file_uploads" WHERE id = $1`, id).Scan(&processingStatus)
+		assert.NoError(t, err)
+		return processingStatus
+	}
+
+	tests := []struct {
+		name                     string
+		id                       string
+		team                     *model.Team
+		expectedProcessingStatus string
+		errorExpected            bool
+		errorString              string
+	}{
+		{
+			name:          "errors when id is empty",
+			id:            "",
+			team:          team,
+			errorExpected: true,
+			errorString:   "id cannot be blank",
+		},
+		{
+			name:          "errors when team is nil",
+			id:            "fp_id1",
+			team:          nil,
+			errorExpected: true,
+			errorString:   "team cannot be blank",
+		},
+		{
+			name:                     "errors when team does not match file upload",
+			id:                       "fp_id1",
+			team:                     team2,
+			expectedProcessingStatus: "FAILED",
+			errorExpected:            true,
+			errorString:              "fileUpload cannot be reprocessed: fp_id1",
+		},
+		{
+			name:                     "errors when processing is ongoing",
+			id:                       "fp_id3",
+			team:                     team,
+			expectedProcessingStatus: "ONGOING",
+			errorExpected:            true,
+			errorString:              "fileUpload cannot be reprocessed: fp_id3",
+		},
+		{
+			name:                     "errors when file was not uploaded successfully",
+			id:                       "fp_id4",
+			team:                     team,
+			expectedProcessingStatus: "FAILED",
+			errorExpected:            true,
+			errorString:              "fileUpload cannot be reprocessed: fp_id4",
+		},
+		{
+			name:                     "resets a failed file upload",
+			id:                       "fp_id1",
+			team:                     team,
+			expectedProcessingStatus: "NOT STARTED",
+			errorExpected:            false,
+			errorString:              "",
+		},
+		{
+			name:                     "resets a completed file upload",
+			id:                       "fp_id2",
+			team:                     team,
+			expectedProcessingStatus: "NOT STARTED",
+			errorExpected:            false,
+			errorString:              "",
+		},
+		{
+			name:                     "resets a duplicate file upload",
+			id:                       "fp_id5",
+			team:                     team,
+			expectedProcessingStatus: "NOT STARTED",
+			errorExpected:            false,
+			errorString:              "",
+		},
+		{
+			name:                     "errors when the candidate of the file upload was merged into another candidate",
+			id:                       "fp_id6",
+			team:                     team,
+			expectedProcessingStatus: "COMPLETED",
+			errorExpected:            true,
+			errorString:              "fileUpload cannot be reprocessed: fp_id6",
+		},
+		{
+			name:                     "errors when the candidate of a completed file upload was deleted",
+			id:                       "fp_id7",
+			team:                     team,
+			expectedProcessingStatus: "COMPLETED",
+			errorExpected:            true,
+			errorString:              "fileUpload cannot be reprocessed: fp_id7",
+		},
+		{
+			name:                     "errors when the candidate a duplicate file upload was a duplicate of was deleted",
+			id:                       "fp_id8",
+			team:                     team,
+			expectedProcessingStatus: "DUPLICATE",
+			errorExpected:            true,
+			errorString:              "fileUpload cannot be reprocessed: fp_id8",
+		},
+		{
+			name:                     "resets a completed archive file upload",
+			id:                       "fp_id9",
+			team:                     team,
+			expectedProcessingStatus: "NOT STARTED",
+			errorExpected:            false,
+			errorString:              "",
+		},
+		{
+			name:                     "errors when file upload was already reset",
+			id:                       "fp_id2",
+			team:                     team,
+			expectedProcessingStatus: "NOT STARTED",
+			errorExpected:            true,
+			errorString:              "fileUpload cannot be reprocessed: fp_id2",
+		},
+	}
+
+	s, _ := NewDbStorage(
+		StorageOptions{
+			Db: testDb,
+		},
+	)
+	runSqlOnDb(t, s.db, setupSqlStmts)
+	defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.ResetFileUploadProcessingForTeam(tt.id, tt.team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.expectedProcessingStatus != "" {
+				assert.Equal(t, tt.expectedProcessingStatus, processingStatusCheck(s.db, tt.id))
+			}
+		})
+	}
+
+	t.Run("clears the failure of a reset file upload", func(t *testing.T) {
+		fileUpload, err := s.GetFileUpload("fp_id1")
+		assert.NoError(t, err)
+		assert.Nil(t, fileUpload.Failure())
+	})
+}
+
 func Test_DeleteFileUploadForTeam(t *testing.T) {
 	currentFileCount := 1
 	team, _ := model.NewTeam(model.TeamOptions{
@@ -1423,3 +3321,73 @@ func Test_DeleteFileUploadForTeam(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetFileUploadsForTeamWithPage(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	setupSqlStmts := []TestSqlStmts{
+		{
+			Query: `INSERT INTO public."teams" (
+						"id", "name"
+					)
+					VALUES (
+						'team_id1', 'Team1'
+					)`,
+		},
+		{
+			Query: `INSERT INTO public."file_uploads" (
+						"id", "name", "presigned_url", "status", "processing_status", "team_id", "created_at"
+					)
+					VALUES (
+						'fp_id1', 'file1.pdf', 'https://presigned_url1', 'INITIATED', 'NOT STARTED', 'team_id1', '2023-01-01 00:00:00+00'
+					), (
+						'fp_id2', 'file2.pdf', 'https://presigned_url2', 'INITIATED', 'NOT STARTED', 'team_id1', '2023-01-02 00:00:00+00'
+					), (
+						'fp_id3', 'file3.pdf', 'https://presigned_url3', 'INITIATED', 'NOT STARTED', 'team_id1', '2023-01-02 00:00:00+00'
+					)`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+	}
+
+	s, _ := NewDbStorage(
+		StorageOptions{
+			Db: testDb,
+		},
+	)
+	runSqlOnDb(t, s.db, setupSqlStmts)
+	defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+	t.Run("pages through file uploads", func(t *testing.T) {
+		page, _ := model.NewPageRequest(model.PageRequestOptions{Size: 2})
+		fileUploads, pageInfo, err := s.GetFileUploadsForTeam(team, page)
+		assert.NoError(t, err)
+		assert.Len(t, fileUploads, 2)
+		assert.Equal(t, "fp_id1", fileUploads[0].Id())
+		assert.Equal(t, "fp_id2", fileUploads[1].Id())
+		assert.Equal(t, 3, pageInfo.TotalCount())
+		assert.NotEmpty(t, pageInfo.NextPageToken())
+
+		page, _ = model.NewPageRequest(model.PageRequestOptions{Size: 2, Token: pageInfo.NextPageToken()})
+		fileUploads, pageInfo, err = s.GetFileUploadsForTeam(team, page)
+		assert.NoError(t, err)
+		assert.Len(t, fileUploads, 1)
+		assert.Equal(t, "fp_id3", fileUploads[0].Id())
+		assert.Equal(t, 3, pageInfo.TotalCount())
+		assert.Empty(t, pageInfo.NextPageToken())
+	})
+
+	t.Run("errors when the page token is invalid", func(t *testing.T) {
+		page, _ := model.NewPageRequest(model.PageRequestOptions{Size: 2, Token: "not a token"})
+		fileUploads, pageInfo, err := s.GetFileUploadsForTeam(team, page)
+		assert.Nil(t, fileUploads)
+		assert.Nil(t, pageInfo)
+		assert.EqualError(t, err, "invalid page token")
+	})
+}
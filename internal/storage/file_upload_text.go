@@ -0,0 +1,116 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+type FileUploadTextAccessor interface {
+	UpsertFileUploadTextUsingTx(fileUploadText *model.FileUploadText, tx DatabaseTransaction) error
+	GetFileUploadTextForTeam(fileUploadId string, team *model.Team) (*model.FileUploadText, error)
+	GetFileUploadTextUsingTx(fileUploadId string, tx DatabaseTransaction) (*model.FileUploadText, error)
+}
+
+func (s *Storage) UpsertFileUploadTextUsingTx(fileUploadText *model.FileUploadText, tx DatabaseTransaction) error {
+	if fileUploadText == nil {
+		return errors.New("fileUploadText cannot be nil")
+	}
+
+	result, err := tx.Exec(
+		`INSERT INTO public."file_upload_texts"
+		("file_upload_id", "text", "text_hash", "page_count", "extractor_version")
+		VALUES
+		($1, $2, $3, $4, $5)
+		ON CONFLICT ("file_upload_id") DO UPDATE SET
+		"text" = EXCLUDED."text",
+		"text_hash" = EXCLUDED."text_hash",
+		"page_count" = EXCLUDED."page_count",
+		"extractor_version" = EXCLUDED."extractor_version"`,
+		fileUploadText.FileUploadId(),
+		fileUploadText.Text(),
+		fileUploadText.TextHash(),
+		fileUploadText.PageCount(),
+		fileUploadText.ExtractorVersion(),
+	)
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while upserting FileUploadText: %s", fileUploadText.FileUploadId()))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while upserting FileUploadText and changing db: %s", fileUploadText.FileUploadId()))
+	}
+	if rowsAffected != 1 {
+		return utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when upserting FileUploadText in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+
+	return nil
+}
+
+func (s *Storage) GetFileUploadTextForTeam(fileUploadId string, team *model.Team) (*model.FileUploadText, error) {
+	if utilities.IsBlank(fileUploadId) {
+		return nil, errors.New("fileUploadId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	var text, extractorVersion string
+	var pageCount int
+	row := s.db.QueryRow(
+		`SELECT t.text, t.page_count, t.extractor_version
+		FROM public."file_upload_texts" AS t
+		JOIN public."file_uploads" AS f ON f.id = t.file_upload_id
+		WHERE t.file_upload_id = $1 AND f.team_id = $2`,
+		fileUploadId, team.Id(),
+	)
+	err := row.Scan(&text, &pageCount, &extractorVersion)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Errorf("no file upload text for id %s", fileUploadId)
+		}
+		return nil, errors.Errorf("getting file upload text for id %s: %v", fileUploadId, err)
+	}
+
+	return model.NewFileUploadText(model.FileUploadTextOptions{
+		FileUploadId:     fileUploadId,
+		Text:             text,
+		PageCount:        pageCount,
+		ExtractorVersion: extractorVersion,
+	})
+}
+
+// GetFileUploadTextUsingTx returns nil if no text has been stored for the file upload yet.
+func (s *Storage) GetFileUploadTextUsingTx(fileUploadId string, tx DatabaseTransaction) (*model.FileUploadText, error) {
+	if utilities.IsBlank(fileUploadId) {
+		return nil, errors.New("fileUploadId cannot be blank")
+	}
+
+	var text, extractorVersion string
+	var pageCount int
+	row := tx.QueryRow(
+		`SELECT text, page_count, extractor_version
+		FROM public."file_upload_texts"
+		WHERE file_upload_id = $1`,
+		fileUploadId,
+	)
+	err := row.Scan(&text, &pageCount, &extractorVersion)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while getting FileUploadText: %s", fileUploadId))
+	}
+
+	return model.NewFileUploadText(model.FileUploadTextOptions{
+		FileUploadId:     fileUploadId,
+		Text:             text,
+		PageCount:        pageCount,
+		ExtractorVersion: extractorVersion,
+	})
+}
@@ -0,0 +1,21 @@
+package storage
+
+import "github.com/vipulvpatil/candidate-tracker-go/internal/model"
+
+type FileUploadTextAccessorConfigurableMock struct {
+	UpsertFileUploadTextUsingTxInternal func(fileUploadText *model.FileUploadText, tx DatabaseTransaction) error
+	GetFileUploadTextForTeamInternal    func(fileUploadId string, team *model.Team) (*model.FileUploadText, error)
+	GetFileUploadTextUsingTxInternal    func(fileUploadId string, tx DatabaseTransaction) (*model.FileUploadText, error)
+}
+
+func (f *FileUploadTextAccessorConfigurableMock) UpsertFileUploadTextUsingTx(fileUploadText *model.FileUploadText, tx DatabaseTransaction) error {
+	return f.UpsertFileUploadTextUsingTxInternal(fileUploadText, tx)
+}
+
+func (f *FileUploadTextAccessorConfigurableMock) GetFileUploadTextForTeam(fileUploadId string, team *model.Team) (*model.FileUploadText, error) {
+	return f.GetFileUploadTextForTeamInternal(fileUploadId, team)
+}
+
+func (f *FileUploadTextAccessorConfigurableMock) GetFileUploadTextUsingTx(fileUploadId string, tx DatabaseTransaction) (*model.FileUploadText, error) {
+	return f.GetFileUploadTextUsingTxInternal(fileUploadId, tx)
+}
@@ -0,0 +1,463 @@
+package storage
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+)
+
+func Test_UpsertFileUploadTextUsingTx(t *testing.T) {
+	fileUploadText, _ := model.NewFileUploadText(model.FileUploadTextOptions{
+		FileUploadId:     "fp_id1",
+		Text:             "resume text",
+		PageCount:        2,
+		ExtractorVersion: "pdf2go-v0.1.1",
+	})
+	tests := []struct {
+		name            string
+		input           *model.FileUploadText
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		dbUpdateCheck   func(db *sql.DB) bool
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name:            "errors when fileUploadText is nil",
+			input:           nil,
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "fileUploadText cannot be nil",
+		},
+		{
+			name:            "errors when fileUpload does not exist in Database",
+			input:           fileUploadText,
+			setupSqlStmts:   nil,
+			cleanupSqlStmts: nil,
+			dbUpdateCheck:   nil,
+			errorExpected:   true,
+			errorString:     "THIS IS BAD: dbError while upserting FileUploadText: fp_id1: pq: insert or update on table \"file_upload_texts\" violates foreign key constraint \"file_upload_texts_file_upload_id_fkey\"",
+		},
+		{
+			name:  "successfully creates a new file upload text",
+			input: fileUploadText,
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+						"id", "name"
+					)
+					VALUES (
+						'team_id1', 'Team1'
+					)`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+						"id", "name", "presigned_url", "status", "processing_status", "team_id"
+					)
+					VALUES (
+						'fp_id1', 'file1.pdf', 'https://presigned_url1', 'INITIATED', 'NOT STARTED', 'team_id1'
+					)`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var text, textHash, extractorVersion string
+				var pageCount int
+				row := db.QueryRow(
+					`SELECT text, text_hash, page_count, extractor_version FROM public."file_upload_texts" WHERE file_upload_id = 'fp_id1'`,
+				)
+				err := row.Scan(&text, &textHash, &pageCount, &extractorVersion)
+				assert.NoError(t, err)
+				assert.Equal(t, "resume text", text)
+				assert.Equal(t, fileUploadText.TextHash(), textHash)
+				assert.Equal(t, 2, pageCount)
+				assert.Equal(t, "pdf2go-v0.1.1", extractorVersion)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name:  "successfully replaces an existing file upload text",
+			input: fileUploadText,
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+						"id", "name"
+					)
+					VALUES (
+						'team_id1', 'Team1'
+					)`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+						"id", "name", "presigned_url", "status", "processing_status", "team_id"
+					)
+					VALUES (
+						'fp_id1', 'file1.pdf', 'https://presigned_url1', 'INITIATED', 'NOT STARTED', 'team_id1'
+					)`,
+				},
+				{
+					Query: `INSERT INTO public."file_upload_texts" (
+						"file_upload_id", "text", "text_hash", "page_count", "extractor_version"
+					)
+					VALUES (
+						'fp_id1', 'old text', 'old_hash', 1, 'old-version'
+					)`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var text, extractorVersion string
+				var pageCount, count int
+				row := db.QueryRow(
+					`SELECT text, page_count, extractor_version, count(*) OVER () FROM public."file_upload_texts" WHERE file_upload_id = 'fp_id1'`,
+				)
+				err := row.Scan(&text, &pageCount, &extractorVersion, &count)
+				assert.NoError(t, err)
+				assert.Equal(t, "resume text", text)
+				assert.Equal(t, 2, pageCount)
+				assert.Equal(t, "pdf2go-v0.1.1", extractorVersion)
+				assert.Equal(t, 1, count)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			tx, err := s.BeginTransaction()
+			assert.NoError(t, err)
+			err = s.UpsertFileUploadTextUsingTx(tt.input, tx)
+			tx.Commit()
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_GetFileUploadTextForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	otherTeam, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id2",
+		Name:             "Team2",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	fileUploadText, _ := model.NewFileUploadText(model.FileUploadTextOptions{
+		FileUploadId:     "fp_id1",
+		Text:             "resume text",
+		PageCount:        2,
+		ExtractorVersion: "pdf2go-v0.1.1",
+	})
+	setupSqlStmts := []TestSqlStmts{
+		{
+			Query: `INSERT INTO public."teams" (
+				"id", "name"
+			)
+			VALUES (
+				'team_id1', 'Team1'
+			), (
+				'team_id2', 'Team2'
+			)`,
+		},
+		{
+			Query: `INSERT INTO public."file_uploads" (
+				"id", "name", "presigned_url", "status", "processing_status", "team_id"
+			)
+			VALUES (
+				'fp_id1', 'file1.pdf', 'https://presigned_url1', 'SUCCESS', 'COMPLETED', 'team_id1'
+			), (
+				'fp_id2', 'file2.pdf', 'https://presigned_url2', 'SUCCESS', 'NOT STARTED', 'team_id1'
+			)`,
+		},
+		{
+			Query: `INSERT INTO public."file_upload_texts" (
+				"file_upload_id", "text", "text_hash", "page_count", "extractor_version"
+			)
+			VALUES (
+				'fp_id1', 'resume text', $1, 2, 'pdf2go-v0.1.1'
+			)`,
+			Args: []any{fileUploadText.TextHash()},
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+
+	tests := []struct {
+		name          string
+		fileUploadId  string
+		team          *model.Team
+		output        *model.FileUploadText
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name:          "errors when fileUploadId is blank",
+			fileUploadId:  "",
+			team:          team,
+			output:        nil,
+			errorExpected: true,
+			errorString:   "fileUploadId cannot be blank",
+		},
+		{
+			name:          "errors when team is empty",
+			fileUploadId:  "fp_id1",
+			team:          nil,
+			output:        nil,
+			errorExpected: true,
+			errorString:   "team cannot be blank",
+		},
+		{
+			name:          "errors when file upload has no text",
+			fileUploadId:  "fp_id2",
+			team:          team,
+			output:        nil,
+			errorExpected: true,
+			errorString:   "no file upload text for id fp_id2",
+		},
+		{
+			name:          "errors when file upload belongs to another team",
+			fileUploadId:  "fp_id1",
+			team:          otherTeam,
+			output:        nil,
+			errorExpected: true,
+			errorString:   "no file upload text for id fp_id1",
+		},
+		{
+			name:          "successfully gets file upload text",
+			fileUploadId:  "fp_id1",
+			team:          team,
+			output:        fileUploadText,
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	s, _ := NewDbStorage(
+		StorageOptions{
+			Db: testDb,
+		},
+	)
+	runSqlOnDb(t, s.db, setupSqlStmts)
+	defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := s.GetFileUploadTextForTeam(tt.fileUploadId, tt.team)
+			assert.Equal(t, tt.output, result)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_GetFileUploadTextUsingTx(t *testing.T) {
+	fileUploadText, _ := model.NewFileUploadText(model.FileUploadTextOptions{
+		FileUploadId:     "fp_id1",
+		Text:             "resume text",
+		PageCount:        2,
+		ExtractorVersion: "pdf2go-v0.1.1",
+	})
+	setupSqlStmts := []TestSqlStmts{
+		{
+			Query: `INSERT INTO public."teams" (
+				"id", "name"
+			)
+			VALUES (
+				'team_id1', 'Team1'
+			)`,
+		},
+		{
+			Query: `INSERT INTO public."file_uploads" (
+				"id", "name", "presigned_url", "status", "processing_status", "team_id"
+			)
+			VALUES (
+				'fp_id1', 'file1.pdf', 'https://presigned_url1', 'SUCCESS', 'COMPLETED', 'team_id1'
+			), (
+				'fp_id2', 'file2.pdf', 'https://presigned_url2', 'SUCCESS', 'NOT STARTED', 'team_id1'
+			)`,
+		},
+		{
+			Query: `INSERT INTO public."file_upload_texts" (
+				"file_upload_id", "text", "text_hash", "page_count", "extractor_version"
+			)
+			VALUES (
+				'fp_id1', 'resume text', $1, 2, 'pdf2go-v0.1.1'
+			)`,
+			Args: []any{fileUploadText.TextHash()},
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+	}
+
+	tests := []struct {
+		name          string
+		fileUploadId  string
+		output        *model.FileUploadText
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name:          "errors when fileUploadId is blank",
+			fileUploadId:  "",
+			output:        nil,
+			errorExpected: true,
+			errorString:   "fileUploadId cannot be blank",
+		},
+		{
+			name:          "returns nil when file upload has no text",
+			fileUploadId:  "fp_id2",
+			output:        nil,
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name:          "successfully gets file upload text",
+			fileUploadId:  "fp_id1",
+			output:        fileUploadText,
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	s, _ := NewDbStorage(
+		StorageOptions{
+			Db: testDb,
+		},
+	)
+	runSqlOnDb(t, s.db, setupSqlStmts)
+	defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := s.BeginTransaction()
+			assert.NoError(t, err)
+			defer tx.Rollback()
+			result, err := s.GetFileUploadTextUsingTx(tt.fileUploadId, tx)
+			assert.Equal(t, tt.output, result)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_SearchCandidatesForTeamUsingFileUploadText(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "Alice", BuiltBy: "AI", FileUploadId: "fp_id1"}
+	fileUploadText, _ := model.NewFileUploadText(model.FileUploadTextOptions{
+		FileUploadId:     "fp_id1",
+		Text:             "Maintained Kubernetes clusters for a payments platform",
+		PageCount:        1,
+		ExtractorVersion: "pdf2go-v0.1.1",
+	})
+	setupSqlStmts := []TestSqlStmts{
+		{
+			Query: `INSERT INTO public."teams" (
+				"id", "name"
+			)
+			VALUES (
+				'team_id1', 'Team1'
+			)`,
+		},
+		{
+			Query: `INSERT INTO public."file_uploads" (
+				"id", "name", "presigned_url", "status", "processing_status", "team_id"
+			)
+			VALUES (
+				'fp_id1', 'file1.pdf', 'https://presigned_url1', 'SUCCESS', 'ONGOING', 'team_id1'
+			)`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+	}
+
+	s, _ := NewDbStorage(
+		StorageOptions{
+			Db: testDb,
+		},
+	)
+	runSqlOnDb(t, s.db, setupSqlStmts)
+	defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+	tx, err := s.BeginTransaction()
+	assert.NoError(t, err)
+	err = s.UpsertFileUploadTextUsingTx(fileUploadText, tx)
+	assert.NoError(t, err)
+	err = s.UpsertCandidateWithAiGeneratedPersonaForTeamUsingTx(&persona, team, tx)
+	assert.NoError(t, err)
+	assert.NoError(t, tx.Commit())
+
+	results, _, err := s.SearchCandidatesForTeam("kubernetes", team, nil)
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Contains(t, results[0].Snippet(), "<mark>Kubernetes</mark>")
+
+	tx, err = s.BeginTransaction()
+	assert.NoError(t, err)
+	updatedFileUploadText, _ := model.NewFileUploadText(model.FileUploadTextOptions{
+		FileUploadId:     "fp_id1",
+		Text:             "Built Terraform modules",
+		PageCount:        1,
+		ExtractorVersion: "pdf2go-v0.1.1",
+	})
+	err = s.UpsertFileUploadTextUsingTx(updatedFileUploadText, tx)
+	assert.NoError(t, err)
+	assert.NoError(t, tx.Commit())
+
+	results, _, err = s.SearchCandidatesForTeam("kubernetes", team, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, results)
+
+	results, _, err = s.SearchCandidatesForTeam("terraform", team, nil)
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+}
@@ -0,0 +1,353 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+type FitAssessmentAccessor interface {
+	RequestFitAssessmentForTeam(opts model.FitAssessmentOptions, retryFailed bool, team *model.Team) (*model.FitAssessment, error)
+	GetFitAssessmentUsingTx(id string, tx DatabaseTransaction) (*model.FitAssessment, error)
+	GetAllNotStartedFitAssessmentIds() ([]string, error)
+	UpdateFitAssessmentWithStatusUsingTx(id, status string, tx DatabaseTransaction) error
+	UpdateFitAssessmentWithVerdict(id string, verdict *model.FitVerdict) error
+	UpdateFitAssessmentWithFailure(id, failureMessage string) error
+	FailStaleFitAssessments(lease time.Duration) (int, error)
+}
+
+// RequestFitAssessmentForTeam returns the fit assessment for the candidate, job opening, persona version, job opening version and prompt version in opts,
+// creating one that is not started when there is none. A failed assessment is only requested again when retryFailed is set.
+// The id and status in opts are ignored.
+func (s *Storage) RequestFitAssessmentForTeam(opts model.FitAssessmentOptions, retryFailed bool, team *model.Team) (*model.FitAssessment, error) {
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	opts.Id = s.IdGenerator.Generate()
+	opts.Status = ""
+	opts.Team = team
+	fitAssessment, err := model.NewFitAssessment(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.BeginTransaction()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to start db transaction")
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(
+		`INSERT INTO public."fit_assessments"
+		("id", "team_id", "candidate_id", "job_opening_id", "persona_version", "job_opening_version", "prompt_version")
+		VALUES
+		($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT ("candidate_id", "job_opening_id", "persona_version", "job_opening_version", "prompt_version") DO NOTHING`,
+		fitAssessment.Id(),
+		team.Id(),
+		fitAssessment.CandidateId(),
+		fitAssessment.JobOpeningId(),
+		fitAssessment.PersonaVersion(),
+		fitAssessment.JobOpeningVersion(),
+		fitAssessment.PromptVersion(),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while inserting FitAssessment: %s", fitAssessment.Id()))
+	}
+
+	if retryFailed {
+		_, err = tx.Exec(
+			`UPDATE public."fit_assessments"
+			SET "status" = 'NOT STARTED', "verdict" = NULL, "failure_message" = NULL
+			WHERE candidate_id = $1 AND job_opening_id = $2 AND persona_version = $3 AND job_opening_version = $4 AND prompt_version = $5
+			AND team_id = $6 AND status = 'FAILED'`,
+			fitAssessment.CandidateId(),
+			fitAssessment.JobOpeningId(),
+			fitAssessment.PersonaVersion(),
+			fitAssessment.JobOpeningVersion(),
+			fitAssessment.PromptVersion(),
+			team.Id(),
+		)
+		if err != nil {
+			return nil, utilities.WrapBadError(err, "dbError while retrying failed FitAssessment")
+		}
+	}
+
+	row := tx.QueryRow(
+		`SELECT id, candidate_id, job_opening_id, persona_version, job_opening_version, prompt_version, status, verdict, failure_message, created_at, updated_at
+		FROM public."fit_assessments"
+		WHERE candidate_id = $1 AND job_opening_id = $2 AND persona_version = $3 AND job_opening_version = $4 AND prompt_version = $5
+		AND team_id = $6`,
+		fitAssessment.CandidateId(),
+		fitAssessment.JobOpeningId(),
+		fitAssessment.PersonaVersion(),
+		fitAssessment.JobOpeningVersion(),
+		fitAssessment.PromptVersion(),
+		team.Id(),
+	)
+	fitAssessment, err = scanFitAssessment(row, team)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, utilities.NewBadError(fmt.Sprintf("FitAssessment is missing right after being requested: %s", opts.Id))
+		}
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to commit db transaction")
+	}
+	return fitAssessment, nil
+}
+
+// GetFitAssessmentUsingTx locks the fit assessment until the transaction ends, so that only one worker can start it.
+func (s *Storage) GetFitAssessmentUsingTx(id string, tx DatabaseTransaction) (*model.FitAssessment, error) {
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	var candidateId, jobOpeningId, personaVersion, jobOpeningVersion, promptVersion, status, teamId, teamName string
+	var failureMessage sql.NullString
+	var teamFileCountLimit, teamCurrentFileCount int64
+	var createdAt, updatedAt time.Time
+	verdict := &model.FitVerdict{}
+	row := tx.QueryRow(
+		`SELECT
+		f.candidate_id, f.job_opening_id, f.persona_version, f.job_opening_version, f.prompt_version, f.status, f.verdict, f.failure_message,
+		f.created_at, f.updated_at,
+		t.id, t.name, t.file_count_limit, t.current_file_count
+		FROM public."fit_assessments" AS f
+		JOIN (
+			SELECT
+			teams.id,
+			teams.name,
+			teams.file_count_limit,
+			count(file_uploads.id) AS current_file_count
+			FROM public."teams"
+			LEFT JOIN
+			public."file_uploads"
+			ON teams.id = file_uploads.team_id
+			AND file_uploads.processing_status <> 'DUPLICATE'
+			GROUP BY teams.id
+		) t
+		ON f.team_id = t.id
+		WHERE f.id = $1
+		FOR UPDATE OF f`,
+		id,
+	)
+	err := row.Scan(
+		&candidateId, &jobOpeningId, &personaVersion, &jobOpeningVersion, &promptVersion, &status, verdict, &failureMessage,
+		&createdAt, &updatedAt,
+		&teamId, &teamName, &teamFileCountLimit, &teamCurrentFileCount,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Errorf("no fit assessment for id %s", id)
+		}
+		return nil, errors.Errorf("getting fit assessment for id %s: %v", id, err)
+	}
+
+	currentFileCount := int(teamCurrentFileCount)
+	team, err := model.NewTeam(model.TeamOptions{
+		Id:               teamId,
+		Name:             teamName,
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   int(teamFileCountLimit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !verdict.IsValid() {
+		verdict = nil
+	}
+
+	return model.NewFitAssessment(model.FitAssessmentOptions{
+		Id:                id,
+		CandidateId:       candidateId,
+		JobOpeningId:      jobOpeningId,
+		PersonaVersion:    personaVersion,
+		JobOpeningVersion: jobOpeningVersion,
+		PromptVersion:     promptVersion,
+		Status:            status,
+		Verdict:           verdict,
+		FailureMessage:    failureMessage.String,
+		Team:              team,
+		CreatedAt:         createdAt,
+		UpdatedAt:         updatedAt,
+	})
+}
+
+func (s *Storage) GetAllNotStartedFitAssessmentIds() ([]string, error) {
+	rows, err := s.db.Query(
+		`SELECT id
+		FROM public."fit_assessments"
+		WHERE status = 'NOT STARTED'
+		ORDER BY created_at ASC, id ASC`,
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to select fit_assessment ids")
+	}
+	defer rows.Close()
+
+	fitAssessmentIds := []string{}
+
+	for rows.Next() {
+		var id string
+		err := rows.Scan(&id)
+		if err != nil {
+			return nil, utilities.WrapBadError(err, "failed while scanning rows")
+		}
+
+		fitAssessmentIds = append(fitAssessmentIds, id)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to correctly go through fit_assessment id rows")
+	}
+	return fitAssessmentIds, nil
+}
+
+func (s *Storage) UpdateFitAssessmentWithStatusUsingTx(id, status string, tx DatabaseTransaction) error {
+	if utilities.IsBlank(id) {
+		return errors.New("id cannot be blank")
+	}
+
+	if !model.FitAssessmentStatus(status).Valid() {
+		return errors.New("status should be valid")
+	}
+
+	result, err := tx.Exec(`UPDATE public."fit_assessments" SET "status" = $2 WHERE id = $1`, id, status)
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while updating fitAssessment: %s %s", id, status))
+	}
+
+	return checkFitAssessmentUpdated(result, id)
+}
+
+func (s *Storage) UpdateFitAssessmentWithVerdict(id string, verdict *model.FitVerdict) error {
+	if utilities.IsBlank(id) {
+		return errors.New("id cannot be blank")
+	}
+
+	if !verdict.IsValid() {
+		return errors.New("verdict should be valid")
+	}
+
+	result, err := s.db.Exec(
+		`UPDATE public."fit_assessments"
+		SET "status" = 'COMPLETED', "verdict" = $2, "failure_message" = NULL
+		WHERE id = $1`,
+		id, verdict,
+	)
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while updating fitAssessment with verdict: %s", id))
+	}
+
+	return checkFitAssessmentUpdated(result, id)
+}
+
+func (s *Storage) UpdateFitAssessmentWithFailure(id, failureMessage string) error {
+	if utilities.IsBlank(id) {
+		return errors.New("id cannot be blank")
+	}
+
+	if utilities.IsBlank(failureMessage) {
+		return errors.New("failure message cannot be blank")
+	}
+
+	result, err := s.db.Exec(
+		`UPDATE public."fit_assessments"
+		SET "status" = 'FAILED', "verdict" = NULL, "failure_message" = $2
+		WHERE id = $1`,
+		id, failureMessage,
+	)
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while updating fitAssessment with failure: %s", id))
+	}
+
+	return checkFitAssessmentUpdated(result, id)
+}
+
+// FailStaleFitAssessments fails fit assessments left ONGOING for longer than the lease, which happens when the worker assessing them crashed.
+// They can then be requested again with retryFailed. It returns the number of fit assessments failed.
+func (s *Storage) FailStaleFitAssessments(lease time.Duration) (int, error) {
+	if lease <= 0 {
+		return 0, errors.New("lease should be positive")
+	}
+
+	result, err := s.db.Exec(
+		`UPDATE public."fit_assessments"
+		SET "status" = 'FAILED', "verdict" = NULL, "failure_message" = 'fit assessment stopped responding'
+		WHERE id IN (
+			SELECT id FROM public."fit_assessments"
+			WHERE status = 'ONGOING' AND updated_at < now() - make_interval(secs => $1)
+			FOR UPDATE SKIP LOCKED
+		)`,
+		lease.Seconds(),
+	)
+	if err != nil {
+		return 0, utilities.WrapBadError(err, "dbError while failing stale fitAssessments")
+	}
+
+	failedCount, err := result.RowsAffected()
+	if err != nil {
+		return 0, utilities.WrapBadError(err, "dbError while checking affected rows while failing stale fitAssessments")
+	}
+	return int(failedCount), nil
+}
+
+func checkFitAssessmentUpdated(result sql.Result, id string) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while checking affected row while updating fitAssessment: %s", id))
+	}
+
+	if rowsAffected != 1 {
+		return utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when updating fit_assessment in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+	return nil
+}
+
+// scanFitAssessment returns sql.ErrNoRows as is, so callers can tell a missing fit assessment apart from other errors.
+func scanFitAssessment(row rowScanner, team *model.Team) (*model.FitAssessment, error) {
+	var id, candidateId, jobOpeningId, personaVersion, jobOpeningVersion, promptVersion, status string
+	var failureMessage sql.NullString
+	var createdAt, updatedAt time.Time
+	verdict := &model.FitVerdict{}
+	err := row.Scan(
+		&id, &candidateId, &jobOpeningId, &personaVersion, &jobOpeningVersion, &promptVersion, &status, verdict, &failureMessage,
+		&createdAt, &updatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, err
+		}
+		return nil, utilities.WrapBadError(err, "failed while scanning rows")
+	}
+
+	if !verdict.IsValid() {
+		verdict = nil
+	}
+
+	return model.NewFitAssessment(model.FitAssessmentOptions{
+		Id:                id,
+		CandidateId:       candidateId,
+		JobOpeningId:      jobOpeningId,
+		PersonaVersion:    personaVersion,
+		JobOpeningVersion: jobOpeningVersion,
+		PromptVersion:     promptVersion,
+		Status:            status,
+		Verdict:           verdict,
+		FailureMessage:    failureMessage.String,
+		Team:              team,
+		CreatedAt:         createdAt,
+		UpdatedAt:         updatedAt,
+	})
+}
@@ -0,0 +1,45 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+)
+
+type FitAssessmentAccessorConfigurableMock struct {
+	RequestFitAssessmentForTeamInternal          func(opts model.FitAssessmentOptions, retryFailed bool, team *model.Team) (*model.FitAssessment, error)
+	GetFitAssessmentUsingTxInternal              func(id string, tx DatabaseTransaction) (*model.FitAssessment, error)
+	GetAllNotStartedFitAssessmentIdsInternal     func() ([]string, error)
+	UpdateFitAssessmentWithStatusUsingTxInternal func(id, status string, tx DatabaseTransaction) error
+	UpdateFitAssessmentWithVerdictInternal       func(id string, verdict *model.FitVerdict) error
+	UpdateFitAssessmentWithFailureInternal       func(id, failureMessage string) error
+	FailStaleFitAssessmentsInternal              func(lease time.Duration) (int, error)
+}
+
+func (f *FitAssessmentAccessorConfigurableMock) RequestFitAssessmentForTeam(opts model.FitAssessmentOptions, retryFailed bool, team *model.Team) (*model.FitAssessment, error) {
+	return f.RequestFitAssessmentForTeamInternal(opts, retryFailed, team)
+}
+
+func (f *FitAssessmentAccessorConfigurableMock) GetFitAssessmentUsingTx(id string, tx DatabaseTransaction) (*model.FitAssessment, error) {
+	return f.GetFitAssessmentUsingTxInternal(id, tx)
+}
+
+func (f *FitAssessmentAccessorConfigurableMock) GetAllNotStartedFitAssessmentIds() ([]string, error) {
+	return f.GetAllNotStartedFitAssessmentIdsInternal()
+}
+
+func (f *FitAssessmentAccessorConfigurableMock) UpdateFitAssessmentWithStatusUsingTx(id, status string, tx DatabaseTransaction) error {
+	return f.UpdateFitAssessmentWithStatusUsingTxInternal(id, status, tx)
+}
+
+func (f *FitAssessmentAccessorConfigurableMock) UpdateFitAssessmentWithVerdict(id string, verdict *model.FitVerdict) error {
+	return f.UpdateFitAssessmentWithVerdictInternal(id, verdict)
+}
+
+func (f *FitAssessmentAccessorConfigurableMock) UpdateFitAssessmentWithFailure(id, failureMessage string) error {
+	return f.UpdateFitAssessmentWithFailureInternal(id, failureMessage)
+}
+
+func (f *FitAssessmentAccessorConfigurableMock) FailStaleFitAssessments(lease time.Duration) (int, error) {
+	return f.FailStaleFitAssessmentsInternal(lease)
+}
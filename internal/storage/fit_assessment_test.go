@@ -0,0 +1,559 @@
+package storage
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+func Test_RequestFitAssessmentForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	verdict := &model.FitVerdict{Rating: "GOOD FIT", Strengths: []string{"Knows Go"}}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1')`},
+		{Query: `INSERT INTO public."job_openings" ("id", "team_id", "title") VALUES ('jo_id1', 'team_id1', 'Engineer')`},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, 'team_id1'), ('c_id2', $1, 'team_id1'), ('c_id3', $1, 'team_id1')`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."fit_assessments"
+					("id", "team_id", "candidate_id", "job_opening_id", "persona_version", "job_opening_version", "prompt_version", "status", "verdict")
+					VALUES ('fa_id2', 'team_id1', 'c_id2', 'jo_id1', 'pv1', 'jv1', '1.0.0', 'COMPLETED', $1)`,
+			Args: []any{verdict},
+		},
+		{
+			Query: `INSERT INTO public."fit_assessments"
+					("id", "team_id", "candidate_id", "job_opening_id", "persona_version", "job_opening_version", "prompt_version", "status", "failure_message")
+					VALUES ('fa_id3', 'team_id1', 'c_id3', 'jo_id1', 'pv1', 'jv1', '1.0.0', 'FAILED', 'Open Ai error')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+	}
+	tests := []struct {
+		name  string
+		input struct {
+			opts        model.FitAssessmentOptions
+			retryFailed bool
+			team        *model.Team
+		}
+		output struct {
+			id             string
+			status         string
+			verdict        *model.FitVerdict
+			failureMessage string
+		}
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when team is empty",
+			input: struct {
+				opts        model.FitAssessmentOptions
+				retryFailed bool
+				team        *model.Team
+			}{
+				opts: model.FitAssessmentOptions{CandidateId: "c_id1", JobOpeningId: "jo_id1", PersonaVersion: "pv1", JobOpeningVersion: "jv1", PromptVersion: "1.0.0"},
+			},
+			errorExpected: true,
+			errorString:   "team cannot be blank",
+		},
+		{
+			name: "errors when persona version is empty",
+			input: struct {
+				opts        model.FitAssessmentOptions
+				retryFailed bool
+				team        *model.Team
+			}{
+				opts: model.FitAssessmentOptions{CandidateId: "c_id1", JobOpeningId: "jo_id1", PromptVersion: "1.0.0"},
+				team: team,
+			},
+			errorExpected: true,
+			errorString:   "cannot create FitAssessment with an empty persona version",
+		},
+		{
+			name: "creates a fit assessment that is not started",
+			input: struct {
+				opts        model.FitAssessmentOptions
+				retryFailed bool
+				team        *model.Team
+			}{
+				opts: model.FitAssessmentOptions{CandidateId: "c_id1", JobOpeningId: "jo_id1", PersonaVersion: "pv1", JobOpeningVersion: "jv1", PromptVersion: "1.0.0"},
+				team: team,
+			},
+			output: struct {
+				id             string
+				status         string
+				verdict        *model.FitVerdict
+				failureMessage string
+			}{
+				id:     "fa_id1",
+				status: "NOT STARTED",
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "returns the cached fit assessment",
+			input: struct {
+				opts        model.FitAssessmentOptions
+				retryFailed bool
+				team        *model.Team
+			}{
+				opts: model.FitAssessmentOptions{CandidateId: "c_id2", JobOpeningId: "jo_id1", PersonaVersion: "pv1", JobOpeningVersion: "jv1", PromptVersion: "1.0.0"},
+				team: team,
+			},
+			output: struct {
+				id             string
+				status         string
+				verdict        *model.FitVerdict
+				failureMessage string
+			}{
+				id:      "fa_id2",
+				status:  "COMPLETED",
+				verdict: verdict,
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "leaves a failed fit assessment as is",
+			input: struct {
+				opts        model.FitAssessmentOptions
+				retryFailed bool
+				team        *model.Team
+			}{
+				opts: model.FitAssessmentOptions{CandidateId: "c_id3", JobOpeningId: "jo_id1", PersonaVersion: "pv1", JobOpeningVersion: "jv1", PromptVersion: "1.0.0"},
+				team: team,
+			},
+			output: struct {
+				id             string
+				status         string
+				verdict        *model.FitVerdict
+				failureMessage string
+			}{
+				id:             "fa_id3",
+				status:         "FAILED",
+				failureMessage: "Open Ai error",
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "requests a failed fit assessment again when retrying",
+			input: struct {
+				opts        model.FitAssessmentOptions
+				retryFailed bool
+				team        *model.Team
+			}{
+				opts:        model.FitAssessmentOptions{CandidateId: "c_id3", JobOpeningId: "jo_id1", PersonaVersion: "pv1", JobOpeningVersion: "jv1", PromptVersion: "1.0.0"},
+				retryFailed: true,
+				team:        team,
+			},
+			output: struct {
+				id             string
+				status         string
+				verdict        *model.FitVerdict
+				failureMessage string
+			}{
+				id:     "fa_id3",
+				status: "NOT STARTED",
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "creates a new fit assessment once the persona changes",
+			input: struct {
+				opts        model.FitAssessmentOptions
+				retryFailed bool
+				team        *model.Team
+			}{
+				opts: model.FitAssessmentOptions{CandidateId: "c_id2", JobOpeningId: "jo_id1", PersonaVersion: "pv2", JobOpeningVersion: "jv1", PromptVersion: "1.0.0"},
+				team: team,
+			},
+			output: struct {
+				id             string
+				status         string
+				verdict        *model.FitVerdict
+				failureMessage string
+			}{
+				id:     "fa_id1",
+				status: "NOT STARTED",
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "creates a new fit assessment once the job opening changes",
+			input: struct {
+				opts        model.FitAssessmentOptions
+				retryFailed bool
+				team        *model.Team
+			}{
+				opts: model.FitAssessmentOptions{CandidateId: "c_id2", JobOpeningId: "jo_id1", PersonaVersion: "pv1", JobOpeningVersion: "jv2", PromptVersion: "1.0.0"},
+				team: team,
+			},
+			output: struct {
+				id             string
+				status         string
+				verdict        *model.FitVerdict
+				failureMessage string
+			}{
+				id:     "fa_id1",
+				status: "NOT STARTED",
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db:          testDb,
+					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "fa_id1"},
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			fitAssessment, err := s.RequestFitAssessmentForTeam(tt.input.opts, tt.input.retryFailed, tt.input.team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.output.id, fitAssessment.Id())
+				assert.Equal(t, tt.output.status, fitAssessment.Status())
+				assert.Equal(t, tt.output.verdict, fitAssessment.Verdict())
+				assert.Equal(t, tt.output.failureMessage, fitAssessment.FailureMessage())
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+				assert.Nil(t, fitAssessment)
+			}
+		})
+	}
+}
+
+func Test_GetFitAssessmentUsingTx(t *testing.T) {
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1')`},
+		{Query: `INSERT INTO public."job_openings" ("id", "team_id", "title") VALUES ('jo_id1', 'team_id1', 'Engineer')`},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id") VALUES ('c_id1', $1, 'team_id1')`,
+			Args:  []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."fit_assessments"
+					("id", "team_id", "candidate_id", "job_opening_id", "persona_version", "job_opening_version", "prompt_version")
+					VALUES ('fa_id1', 'team_id1', 'c_id1', 'jo_id1', 'pv1', 'jv1', '1.0.0')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+	}
+	tests := []struct {
+		name          string
+		input         string
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name:          "errors when id is empty",
+			input:         "",
+			errorExpected: true,
+			errorString:   "id cannot be blank",
+		},
+		{
+			name:          "errors when fit assessment does not exist",
+			input:         "fa_id2",
+			errorExpected: true,
+			errorString:   "no fit assessment for id fa_id2",
+		},
+		{
+			name:          "gets the fit assessment with its team",
+			input:         "fa_id1",
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			tx, err := s.BeginTransaction()
+			assert.NoError(t, err)
+			defer tx.Rollback()
+			fitAssessment, err := s.GetFitAssessmentUsingTx(tt.input, tx)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, "fa_id1", fitAssessment.Id())
+				assert.Equal(t, "c_id1", fitAssessment.CandidateId())
+				assert.Equal(t, "jo_id1", fitAssessment.JobOpeningId())
+				assert.Equal(t, "NOT STARTED", fitAssessment.Status())
+				assert.Nil(t, fitAssessment.Verdict())
+				assert.Equal(t, "team_id1", fitAssessment.Team().Id())
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+				assert.Nil(t, fitAssessment)
+			}
+		})
+	}
+}
+
+func Test_GetAllNotStartedFitAssessmentIds(t *testing.T) {
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1')`},
+		{Query: `INSERT INTO public."job_openings" ("id", "team_id", "title") VALUES ('jo_id1', 'team_id1', 'Engineer')`},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id") VALUES ('c_id1', $1, 'team_id1')`,
+			Args:  []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."fit_assessments"
+					("id", "team_id", "candidate_id", "job_opening_id", "persona_version", "job_opening_version", "prompt_version", "status", "created_at")
+					VALUES
+					('fa_id1', 'team_id1', 'c_id1', 'jo_id1', 'pv1', 'jv1', '1.0.0', 'NOT STARTED', '2022-01-02'),
+					('fa_id2', 'team_id1', 'c_id1', 'jo_id1', 'pv2', 'jv1', '1.0.0', 'ONGOING', '2022-01-01'),
+					('fa_id3', 'team_id1', 'c_id1', 'jo_id1', 'pv3', 'jv1', '1.0.0', 'FAILED', '2022-01-01'),
+					('fa_id4', 'team_id1', 'c_id1', 'jo_id1', 'pv4', 'jv1', '1.0.0', 'NOT STARTED', '2022-01-01')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+	}
+
+	t.Run("gets the ids of fit assessments that are not started, oldest first", func(t *testing.T) {
+		s, _ := NewDbStorage(
+			StorageOptions{
+				Db: testDb,
+			},
+		)
+
+		runSqlOnDb(t, s.db, setupSqlStmts)
+		defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+		ids, err := s.GetAllNotStartedFitAssessmentIds()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"fa_id4", "fa_id1"}, ids)
+	})
+}
+
+func Test_UpdateFitAssessmentWithVerdict(t *testing.T) {
+	persona := model.Persona{Name: "manual persona 1"}
+	verdict := &model.FitVerdict{Rating: "WEAK FIT", Gaps: []string{"No Go"}}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1')`},
+		{Query: `INSERT INTO public."job_openings" ("id", "team_id", "title") VALUES ('jo_id1', 'team_id1', 'Engineer')`},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id") VALUES ('c_id1', $1, 'team_id1')`,
+			Args:  []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."fit_assessments"
+					("id", "team_id", "candidate_id", "job_opening_id", "persona_version", "job_opening_version", "prompt_version", "status")
+					VALUES ('fa_id1', 'team_id1', 'c_id1', 'jo_id1', 'pv1', 'jv1', '1.0.0', 'ONGOING')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+	}
+	tests := []struct {
+		name  string
+		input struct {
+			id      string
+			verdict *model.FitVerdict
+		}
+		dbUpdateCheck func(db *sql.DB) bool
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when id is empty",
+			input: struct {
+				id      string
+				verdict *model.FitVerdict
+			}{
+				verdict: verdict,
+			},
+			errorExpected: true,
+			errorString:   "id cannot be blank",
+		},
+		{
+			name: "errors when verdict is invalid",
+			input: struct {
+				id      string
+				verdict *model.FitVerdict
+			}{
+				id:      "fa_id1",
+				verdict: &model.FitVerdict{Rating: "MAYBE"},
+			},
+			errorExpected: true,
+			errorString:   "verdict should be valid",
+		},
+		{
+			name: "errors when fit assessment does not exist",
+			input: struct {
+				id      string
+				verdict *model.FitVerdict
+			}{
+				id:      "fa_id2",
+				verdict: verdict,
+			},
+			errorExpected: true,
+			errorString:   "Very few or too many rows were affected when updating fit_assessment in db. This is highly unexpected. rowsAffected: 0",
+		},
+		{
+			name: "completes the fit assessment with the verdict",
+			input: struct {
+				id      string
+				verdict *model.FitVerdict
+			}{
+				id:      "fa_id1",
+				verdict: verdict,
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var status string
+				savedVerdict := &model.FitVerdict{}
+				err := db.QueryRow(`SELECT status, verdict FROM public."fit_assessments" WHERE id = 'fa_id1'`).Scan(&status, savedVerdict)
+				assert.NoError(t, err)
+				assert.Equal(t, "COMPLETED", status)
+				assert.Equal(t, verdict, savedVerdict)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			err := s.UpdateFitAssessmentWithVerdict(tt.input.id, tt.input.verdict)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_FailStaleFitAssessments(t *testing.T) {
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1')`},
+		{Query: `INSERT INTO public."job_openings" ("id", "team_id", "title") VALUES ('jo_id1', 'team_id1', 'Engineer')`},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id") VALUES ('c_id1', $1, 'team_id1')`,
+			Args:  []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."fit_assessments"
+					("id", "team_id", "candidate_id", "job_opening_id", "persona_version", "job_opening_version", "prompt_version", "status", "updated_at")
+					VALUES
+					('fa_id1', 'team_id1', 'c_id1', 'jo_id1', 'pv1', 'jv1', '1.0.0', 'ONGOING', now() - interval '10 minutes'),
+					('fa_id2', 'team_id1', 'c_id1', 'jo_id1', 'pv2', 'jv1', '1.0.0', 'ONGOING', now() - interval '1 minute'),
+					('fa_id3', 'team_id1', 'c_id1', 'jo_id1', 'pv3', 'jv1', '1.0.0', 'NOT STARTED', now() - interval '10 minutes')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+	}
+	tests := []struct {
+		name          string
+		input         time.Duration
+		output        int
+		dbUpdateCheck func(db *sql.DB) bool
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name:          "errors when lease is not positive",
+			input:         0,
+			output:        0,
+			errorExpected: true,
+			errorString:   "lease should be positive",
+		},
+		{
+			name:   "fails fit assessments left ongoing for longer than the lease and leaves the rest alone",
+			input:  5 * time.Minute,
+			output: 1,
+			dbUpdateCheck: func(db *sql.DB) bool {
+				rows, err := db.Query(`SELECT id, status, COALESCE(failure_message, '') FROM public."fit_assessments" ORDER BY id`)
+				assert.NoError(t, err)
+				defer rows.Close()
+				statuses := map[string]string{}
+				failureMessages := map[string]string{}
+				for rows.Next() {
+					var id, status, failureMessage string
+					assert.NoError(t, rows.Scan(&id, &status, &failureMessage))
+					statuses[id] = status
+					failureMessages[id] = failureMessage
+				}
+				assert.Equal(t, map[string]string{"fa_id1": "FAILED", "fa_id2": "ONGOING", "fa_id3": "NOT STARTED"}, statuses)
+				assert.Equal(t, "fit assessment stopped responding", failureMessages["fa_id1"])
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			failedCount, err := s.FailStaleFitAssessments(tt.input)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.output, failedCount)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
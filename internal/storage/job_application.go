@@ -0,0 +1,282 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+type JobApplicationAccessor interface {
+	AddCandidateToJobOpeningForTeam(candidateId, jobOpeningId string, team *model.Team) (*model.JobApplication, error)
+	RemoveCandidateFromJobOpeningForTeam(candidateId, jobOpeningId string, team *model.Team) error
+	GetJobApplicationsForJobOpeningForTeam(jobOpeningId string, team *model.Team) ([]*model.JobApplication, error)
+	GetJobApplicationsForCandidateForTeam(candidateId string, team *model.Team) ([]*model.JobApplication, error)
+	UpdateJobApplicationPipelineStageForTeam(id, pipelineStageId string, team *model.Team) (*model.JobApplication, error)
+}
+
+// AddCandidateToJobOpeningForTeam starts the application in the first pipeline stage of the team.
+// Candidates can only be added to open job openings.
+func (s *Storage) AddCandidateToJobOpeningForTeam(candidateId, jobOpeningId string, team *model.Team) (*model.JobApplication, error) {
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	if utilities.IsBlank(jobOpeningId) {
+		return nil, errors.New("jobOpeningId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	tx, err := s.BeginTransaction()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to start db transaction")
+	}
+	defer tx.Rollback()
+
+	var status string
+	row := tx.QueryRow(
+		`SELECT status FROM public."job_openings" WHERE id = $1 AND team_id = $2 FOR SHARE`,
+		jobOpeningId, team.Id(),
+	)
+	err = row.Scan(&status)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Errorf("no job opening for id %s", jobOpeningId)
+		}
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("failed to select JobOpening: %s", jobOpeningId))
+	}
+	if status != model.JobOpeningStatus("OPEN").String() {
+		return nil, errors.Errorf("cannot add candidates to closed job opening %s", jobOpeningId)
+	}
+
+	var id string
+	row = tx.QueryRow(
+		`SELECT id FROM public."candidates" WHERE id = $1 AND team_id = $2 FOR SHARE`,
+		candidateId, team.Id(),
+	)
+	err = row.Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Errorf("no candidate for id %s", candidateId)
+		}
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("failed to select Candidate: %s", candidateId))
+	}
+
+	opts := model.JobApplicationOptions{
+		Id:           s.IdGenerator.Generate(),
+		JobOpeningId: jobOpeningId,
+		CandidateId:  candidateId,
+	}
+	var pipelineStageId sql.NullString
+	row = tx.QueryRow(
+		`INSERT INTO public."job_applications"
+		("id", "job_opening_id", "candidate_id", "pipeline_stage_id")
+		VALUES
+		($1, $2, $3, (SELECT id FROM public."pipeline_stages" WHERE team_id = $4 ORDER BY position ASC, id ASC LIMIT 1))
+		ON CONFLICT ("job_opening_id", "candidate_id") DO NOTHING
+		RETURNING pipeline_stage_id, created_at, updated_at`,
+		opts.Id, jobOpeningId, candidateId, team.Id(),
+	)
+	err = row.Scan(&pipelineStageId, &opts.CreatedAt, &opts.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Errorf("candidate %s is already added to job opening %s", candidateId, jobOpeningId)
+		}
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while inserting JobApplication: %s", opts.Id))
+	}
+	opts.PipelineStageId = pipelineStageId.String
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "dbError while adding candidate to job opening tx")
+	}
+
+	return model.NewJobApplication(opts)
+}
+
+func (s *Storage) RemoveCandidateFromJobOpeningForTeam(candidateId, jobOpeningId string, team *model.Team) error {
+	if utilities.IsBlank(candidateId) {
+		return errors.New("candidateId cannot be blank")
+	}
+
+	if utilities.IsBlank(jobOpeningId) {
+		return errors.New("jobOpeningId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return errors.New("team cannot be blank")
+	}
+
+	result, err := s.db.Exec(
+		`DELETE FROM public."job_applications" AS a
+		USING public."job_openings" AS j
+		WHERE a.job_opening_id = j.id
+		AND a.candidate_id = $1 AND a.job_opening_id = $2 AND j.team_id = $3`,
+		candidateId, jobOpeningId, team.Id(),
+	)
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while deleting JobApplication for candidate %s and job opening %s", candidateId, jobOpeningId))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while deleting JobApplication and changing db for candidate %s and job opening %s", candidateId, jobOpeningId))
+	}
+	if rowsAffected == 0 {
+		return errors.Errorf("candidate %s is not added to job opening %s", candidateId, jobOpeningId)
+	}
+	if rowsAffected != 1 {
+		return utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when deleting JobApplication in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+	return nil
+}
+
+func (s *Storage) GetJobApplicationsForJobOpeningForTeam(jobOpeningId string, team *model.Team) ([]*model.JobApplication, error) {
+	if utilities.IsBlank(jobOpeningId) {
+		return nil, errors.New("jobOpeningId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	rows, err := s.db.Query(
+		`SELECT a.id, a.job_opening_id, a.candidate_id, a.pipeline_stage_id, a.created_at, a.updated_at
+		FROM public."job_applications" AS a
+		JOIN public."job_openings" AS j ON j.id = a.job_opening_id
+		WHERE a.job_opening_id = $1 AND j.team_id = $2
+		ORDER BY a.created_at ASC, a.id ASC`,
+		jobOpeningId, team.Id(),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to select job applications")
+	}
+	defer rows.Close()
+
+	return jobApplicationsFromRows(rows)
+}
+
+func (s *Storage) GetJobApplicationsForCandidateForTeam(candidateId string, team *model.Team) ([]*model.JobApplication, error) {
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	rows, err := s.db.Query(
+		`SELECT a.id, a.job_opening_id, a.candidate_id, a.pipeline_stage_id, a.created_at, a.updated_at
+		FROM public."job_applications" AS a
+		JOIN public."job_openings" AS j ON j.id = a.job_opening_id
+		WHERE a.candidate_id = $1 AND j.team_id = $2
+		ORDER BY a.created_at ASC, a.id ASC`,
+		candidateId, team.Id(),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to select job applications")
+	}
+	defer rows.Close()
+
+	return jobApplicationsFromRows(rows)
+}
+
+func (s *Storage) UpdateJobApplicationPipelineStageForTeam(id, pipelineStageId string, team *model.Team) (*model.JobApplication, error) {
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	if utilities.IsBlank(pipelineStageId) {
+		return nil, errors.New("pipelineStageId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	tx, err := s.BeginTransaction()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to start db transaction")
+	}
+	defer tx.Rollback()
+
+	var stageId string
+	row := tx.QueryRow(
+		`SELECT id FROM public."pipeline_stages" WHERE id = $1 AND team_id = $2 FOR SHARE`,
+		pipelineStageId, team.Id(),
+	)
+	err = row.Scan(&stageId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Errorf("no pipeline stage for id %s", pipelineStageId)
+		}
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("failed to select PipelineStage: %s", pipelineStageId))
+	}
+
+	row = tx.QueryRow(
+		`UPDATE public."job_applications" AS a SET "pipeline_stage_id" = $2
+		FROM public."job_openings" AS j
+		WHERE a.job_opening_id = j.id
+		AND a.id = $1 AND j.team_id = $3
+		RETURNING a.id, a.job_opening_id, a.candidate_id, a.pipeline_stage_id, a.created_at, a.updated_at`,
+		id, pipelineStageId, team.Id(),
+	)
+	jobApplication, err := scanJobApplication(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Errorf("no job application for id %s", id)
+		}
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "dbError while updating job application pipeline stage tx")
+	}
+	return jobApplication, nil
+}
+
+func jobApplicationsFromRows(rows *sql.Rows) ([]*model.JobApplication, error) {
+	jobApplications := []*model.JobApplication{}
+	for rows.Next() {
+		jobApplication, err := scanJobApplication(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobApplications = append(jobApplications, jobApplication)
+	}
+
+	err := rows.Err()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to correctly go through job applications rows")
+	}
+	return jobApplications, nil
+}
+
+// scanJobApplication returns sql.ErrNoRows as is, so callers can tell a missing job application apart from other errors.
+func scanJobApplication(row rowScanner) (*model.JobApplication, error) {
+	var id, jobOpeningId, candidateId string
+	var pipelineStageId sql.NullString
+	var createdAt, updatedAt time.Time
+	err := row.Scan(&id, &jobOpeningId, &candidateId, &pipelineStageId, &createdAt, &updatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, err
+		}
+		return nil, utilities.WrapBadError(err, "failed while scanning rows")
+	}
+
+	return model.NewJobApplication(model.JobApplicationOptions{
+		Id:              id,
+		JobOpeningId:    jobOpeningId,
+		CandidateId:     candidateId,
+		PipelineStageId: pipelineStageId.String,
+		CreatedAt:       createdAt,
+		UpdatedAt:       updatedAt,
+	})
+}
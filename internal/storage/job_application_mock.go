@@ -0,0 +1,31 @@
+package storage
+
+import "github.com/vipulvpatil/candidate-tracker-go/internal/model"
+
+type JobApplicationAccessorConfigurableMock struct {
+	AddCandidateToJobOpeningForTeamInternal          func(candidateId, jobOpeningId string, team *model.Team) (*model.JobApplication, error)
+	RemoveCandidateFromJobOpeningForTeamInternal     func(candidateId, jobOpeningId string, team *model.Team) error
+	GetJobApplicationsForJobOpeningForTeamInternal   func(jobOpeningId string, team *model.Team) ([]*model.JobApplication, error)
+	GetJobApplicationsForCandidateForTeamInternal    func(candidateId string, team *model.Team) ([]*model.JobApplication, error)
+	UpdateJobApplicationPipelineStageForTeamInternal func(id, pipelineStageId string, team *model.Team) (*model.JobApplication, error)
+}
+
+func (j *JobApplicationAccessorConfigurableMock) AddCandidateToJobOpeningForTeam(candidateId, jobOpeningId string, team *model.Team) (*model.JobApplication, error) {
+	return j.AddCandidateToJobOpeningForTeamInternal(candidateId, jobOpeningId, team)
+}
+
+func (j *JobApplicationAccessorConfigurableMock) RemoveCandidateFromJobOpeningForTeam(candidateId, jobOpeningId string, team *model.Team) error {
+	return j.RemoveCandidateFromJobOpeningForTeamInternal(candidateId, jobOpeningId, team)
+}
+
+func (j *JobApplicationAccessorConfigurableMock) GetJobApplicationsForJobOpeningForTeam(jobOpeningId string, team *model.Team) ([]*model.JobApplication, error) {
+	return j.GetJobApplicationsForJobOpeningForTeamInternal(jobOpeningId, team)
+}
+
+func (j *JobApplicationAccessorConfigurableMock) GetJobApplicationsForCandidateForTeam(candidateId string, team *model.Team) ([]*model.JobApplication, error) {
+	return j.GetJobApplicationsForCandidateForTeamInternal(candidateId, team)
+}
+
+func (j *JobApplicationAccessorConfigurableMock) UpdateJobApplicationPipelineStageForTeam(id, pipelineStageId string, team *model.Team) (*model.JobApplication, error) {
+	return j.UpdateJobApplicationPipelineStageForTeamInternal(id, pipelineStageId, team)
+}
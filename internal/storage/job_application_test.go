@@ -0,0 +1,506 @@
+package storage
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+func jobApplicationIds(jobApplications []*model.JobApplication) []string {
+	ids := []string{}
+	for _, jobApplication := range jobApplications {
+		ids = append(ids, jobApplication.Id())
+	}
+	return ids
+}
+
+func Test_AddCandidateToJobOpeningForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."pipeline_stages" ("id", "team_id", "name", "position")
+					VALUES ('ps_id2', 'team_id1', 'Screening', 1), ('ps_id1', 'team_id1', 'Sourced', 0)`,
+		},
+		{
+			Query: `INSERT INTO public."job_openings" ("id", "team_id", "title", "status")
+					VALUES ('jo_id1', 'team_id1', 'Engineer', 'OPEN'), ('jo_id2', 'team_id1', 'Designer', 'CLOSED'), ('jo_id3', 'team_id2', 'Engineer', 'OPEN')`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, 'team_id1'), ('c_id2', $1, 'team_id1'), ('c_id3', $1, 'team_id2')`,
+			Args: []any{&persona},
+		},
+		{Query: `INSERT INTO public."job_applications" ("id", "job_opening_id", "candidate_id") VALUES ('ja_id0', 'jo_id1', 'c_id2')`},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+	tests := []struct {
+		name  string
+		input struct {
+			candidateId  string
+			jobOpeningId string
+		}
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when candidateId is empty",
+			input: struct {
+				candidateId  string
+				jobOpeningId string
+			}{
+				jobOpeningId: "jo_id1",
+			},
+			errorExpected: true,
+			errorString:   "candidateId cannot be blank",
+		},
+		{
+			name: "errors when jobOpeningId is empty",
+			input: struct {
+				candidateId  string
+				jobOpeningId string
+			}{
+				candidateId: "c_id1",
+			},
+			errorExpected: true,
+			errorString:   "jobOpeningId cannot be blank",
+		},
+		{
+			name: "errors when job opening belongs to another team",
+			input: struct {
+				candidateId  string
+				jobOpeningId string
+			}{
+				candidateId:  "c_id1",
+				jobOpeningId: "jo_id3",
+			},
+			errorExpected: true,
+			errorString:   "no job opening for id jo_id3",
+		},
+		{
+			name: "errors when job opening is closed",
+			input: struct {
+				candidateId  string
+				jobOpeningId string
+			}{
+				candidateId:  "c_id1",
+				jobOpeningId: "jo_id2",
+			},
+			errorExpected: true,
+			errorString:   "cannot add candidates to closed job opening jo_id2",
+		},
+		{
+			name: "errors when candidate belongs to another team",
+			input: struct {
+				candidateId  string
+				jobOpeningId string
+			}{
+				candidateId:  "c_id3",
+				jobOpeningId: "jo_id1",
+			},
+			errorExpected: true,
+			errorString:   "no candidate for id c_id3",
+		},
+		{
+			name: "errors when candidate is already added",
+			input: struct {
+				candidateId  string
+				jobOpeningId string
+			}{
+				candidateId:  "c_id2",
+				jobOpeningId: "jo_id1",
+			},
+			errorExpected: true,
+			errorString:   "candidate c_id2 is already added to job opening jo_id1",
+		},
+		{
+			name: "adds the candidate in the first pipeline stage",
+			input: struct {
+				candidateId  string
+				jobOpeningId string
+			}{
+				candidateId:  "c_id1",
+				jobOpeningId: "jo_id1",
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db:          testDb,
+					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "ja_id1"},
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			jobApplication, err := s.AddCandidateToJobOpeningForTeam(tt.input.candidateId, tt.input.jobOpeningId, team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, "ja_id1", jobApplication.Id())
+				assert.Equal(t, "jo_id1", jobApplication.JobOpeningId())
+				assert.Equal(t, "c_id1", jobApplication.CandidateId())
+				assert.Equal(t, "ps_id1", jobApplication.PipelineStageId())
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+				assert.Nil(t, jobApplication)
+			}
+		})
+	}
+}
+
+func Test_RemoveCandidateFromJobOpeningForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."job_openings" ("id", "team_id", "title")
+					VALUES ('jo_id1', 'team_id1', 'Engineer'), ('jo_id2', 'team_id2', 'Engineer')`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, 'team_id1'), ('c_id2', $1, 'team_id2')`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."job_applications" ("id", "job_opening_id", "candidate_id")
+					VALUES ('ja_id1', 'jo_id1', 'c_id1'), ('ja_id2', 'jo_id2', 'c_id2')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+	tests := []struct {
+		name  string
+		input struct {
+			candidateId  string
+			jobOpeningId string
+		}
+		dbUpdateCheck func(db *sql.DB) bool
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when candidateId is empty",
+			input: struct {
+				candidateId  string
+				jobOpeningId string
+			}{
+				jobOpeningId: "jo_id1",
+			},
+			errorExpected: true,
+			errorString:   "candidateId cannot be blank",
+		},
+		{
+			name: "errors when job opening belongs to another team",
+			input: struct {
+				candidateId  string
+				jobOpeningId string
+			}{
+				candidateId:  "c_id2",
+				jobOpeningId: "jo_id2",
+			},
+			errorExpected: true,
+			errorString:   "candidate c_id2 is not added to job opening jo_id2",
+		},
+		{
+			name: "removes the candidate from the job opening",
+			input: struct {
+				candidateId  string
+				jobOpeningId string
+			}{
+				candidateId:  "c_id1",
+				jobOpeningId: "jo_id1",
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var count int
+				err := db.QueryRow(`SELECT count(*) FROM public."job_applications" WHERE id IN ('ja_id1', 'ja_id2')`).Scan(&count)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, count)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			err := s.RemoveCandidateFromJobOpeningForTeam(tt.input.candidateId, tt.input.jobOpeningId, team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_GetJobApplicationsForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."job_openings" ("id", "team_id", "title")
+					VALUES ('jo_id1', 'team_id1', 'Engineer'), ('jo_id2', 'team_id1', 'Designer'), ('jo_id3', 'team_id2', 'Engineer')`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, 'team_id1'), ('c_id2', $1, 'team_id1'), ('c_id3', $1, 'team_id2')`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."job_applications" ("id", "job_opening_id", "candidate_id", "created_at")
+					VALUES ('ja_id1', 'jo_id1', 'c_id1', '2022-01-01'), ('ja_id2', 'jo_id1', 'c_id2', '2022-01-02'),
+					('ja_id3', 'jo_id2', 'c_id1', '2022-01-03'), ('ja_id4', 'jo_id3', 'c_id3', '2022-01-04')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+	tests := []struct {
+		name          string
+		get           func(s *Storage) ([]*model.JobApplication, error)
+		output        []string
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when jobOpeningId is empty",
+			get: func(s *Storage) ([]*model.JobApplication, error) {
+				return s.GetJobApplicationsForJobOpeningForTeam("", team)
+			},
+			errorExpected: true,
+			errorString:   "jobOpeningId cannot be blank",
+		},
+		{
+			name: "errors when candidateId is empty",
+			get: func(s *Storage) ([]*model.JobApplication, error) {
+				return s.GetJobApplicationsForCandidateForTeam("", team)
+			},
+			errorExpected: true,
+			errorString:   "candidateId cannot be blank",
+		},
+		{
+			name: "returns the applications to the job opening",
+			get: func(s *Storage) ([]*model.JobApplication, error) {
+				return s.GetJobApplicationsForJobOpeningForTeam("jo_id1", team)
+			},
+			output:        []string{"ja_id1", "ja_id2"},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "returns the applications of the candidate",
+			get: func(s *Storage) ([]*model.JobApplication, error) {
+				return s.GetJobApplicationsForCandidateForTeam("c_id1", team)
+			},
+			output:        []string{"ja_id1", "ja_id3"},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "returns nothing for a job opening of another team",
+			get: func(s *Storage) ([]*model.JobApplication, error) {
+				return s.GetJobApplicationsForJobOpeningForTeam("jo_id3", team)
+			},
+			output:        []string{},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			jobApplications, err := tt.get(s)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.output, jobApplicationIds(jobApplications))
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+				assert.Nil(t, jobApplications)
+			}
+		})
+	}
+}
+
+func Test_UpdateJobApplicationPipelineStageForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."pipeline_stages" ("id", "team_id", "name", "position")
+					VALUES ('ps_id1', 'team_id1', 'Sourced', 0), ('ps_id2', 'team_id1', 'Screening', 1), ('ps_id3', 'team_id2', 'Sourced', 0)`,
+		},
+		{
+			Query: `INSERT INTO public."job_openings" ("id", "team_id", "title")
+					VALUES ('jo_id1', 'team_id1', 'Engineer'), ('jo_id2', 'team_id2', 'Engineer')`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id", "pipeline_stage_id")
+					VALUES ('c_id1', $1, 'team_id1', 'ps_id1'), ('c_id2', $1, 'team_id2', NULL)`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."job_applications" ("id", "job_opening_id", "candidate_id", "pipeline_stage_id")
+					VALUES ('ja_id1', 'jo_id1', 'c_id1', 'ps_id1'), ('ja_id2', 'jo_id2', 'c_id2', 'ps_id3')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+	tests := []struct {
+		name  string
+		input struct {
+			id              string
+			pipelineStageId string
+		}
+		dbUpdateCheck func(db *sql.DB) bool
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when id is empty",
+			input: struct {
+				id              string
+				pipelineStageId string
+			}{
+				pipelineStageId: "ps_id2",
+			},
+			errorExpected: true,
+			errorString:   "id cannot be blank",
+		},
+		{
+			name: "errors when stage belongs to another team",
+			input: struct {
+				id              string
+				pipelineStageId string
+			}{
+				id:              "ja_id1",
+				pipelineStageId: "ps_id3",
+			},
+			errorExpected: true,
+			errorString:   "no pipeline stage for id ps_id3",
+		},
+		{
+			name: "errors when job application belongs to another team",
+			input: struct {
+				id              string
+				pipelineStageId string
+			}{
+				id:              "ja_id2",
+				pipelineStageId: "ps_id2",
+			},
+			errorExpected: true,
+			errorString:   "no job application for id ja_id2",
+		},
+		{
+			name: "moves the application without moving the candidate",
+			input: struct {
+				id              string
+				pipelineStageId string
+			}{
+				id:              "ja_id1",
+				pipelineStageId: "ps_id2",
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var applicationStageId, candidateStageId string
+				err := db.QueryRow(
+					`SELECT a.pipeline_stage_id, c.pipeline_stage_id
+					FROM public."job_applications" AS a JOIN public."candidates" AS c ON c.id = a.candidate_id
+					WHERE a.id = 'ja_id1'`,
+				).Scan(&applicationStageId, &candidateStageId)
+				assert.NoError(t, err)
+				assert.Equal(t, "ps_id2", applicationStageId)
+				assert.Equal(t, "ps_id1", candidateStageId)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			jobApplication, err := s.UpdateJobApplicationPipelineStageForTeam(tt.input.id, tt.input.pipelineStageId, team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, "ps_id2", jobApplication.PipelineStageId())
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+				assert.Nil(t, jobApplication)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
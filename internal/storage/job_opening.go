@@ -0,0 +1,240 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+type JobOpeningAccessor interface {
+	CreateJobOpeningForTeam(opts model.JobOpeningOptions, team *model.Team) (*model.JobOpening, error)
+	GetJobOpeningsForTeam(status string, team *model.Team) ([]*model.JobOpening, error)
+	GetJobOpeningForTeam(id string, team *model.Team) (*model.JobOpening, error)
+	UpdateJobOpeningForTeam(opts model.JobOpeningOptions, team *model.Team) (*model.JobOpening, error)
+	DeleteJobOpeningForTeam(id string, team *model.Team) error
+}
+
+// CreateJobOpeningForTeam generates the id of the job opening, so any id in opts is ignored.
+func (s *Storage) CreateJobOpeningForTeam(opts model.JobOpeningOptions, team *model.Team) (*model.JobOpening, error) {
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	opts.Id = s.IdGenerator.Generate()
+	opts.Team = team
+	jobOpening, err := model.NewJobOpening(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	row := s.db.QueryRow(
+		`INSERT INTO public."job_openings"
+		("id", "team_id", "title", "description", "required_skills", "nice_to_have_skills", "location", "min_yoe", "status")
+		VALUES
+		($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING created_at, updated_at`,
+		jobOpening.Id(),
+		team.Id(),
+		jobOpening.Title(),
+		jobOpening.Description(),
+		pq.Array(jobOpening.RequiredSkills()),
+		pq.Array(jobOpening.NiceToHaveSkills()),
+		jobOpening.Location(),
+		jobOpening.MinYoE(),
+		jobOpening.Status(),
+	)
+	err = row.Scan(&opts.CreatedAt, &opts.UpdatedAt)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while inserting JobOpening: %s", jobOpening.Id()))
+	}
+
+	return model.NewJobOpening(opts)
+}
+
+// GetJobOpeningsForTeam returns job openings of the team with the status, or all of them when status is blank.
+func (s *Storage) GetJobOpeningsForTeam(status string, team *model.Team) ([]*model.JobOpening, error) {
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	conditions := newSqlConditions(team.Id())
+	if !utilities.IsBlank(status) {
+		jobOpeningStatus := model.JobOpeningStatus(status)
+		if !jobOpeningStatus.Valid() {
+			return nil, errors.Errorf("invalid job opening status: %s", status)
+		}
+		conditions.add(`status = %s`, jobOpeningStatus.String())
+	}
+
+	rows, err := s.db.Query(
+		fmt.Sprintf(
+			`SELECT id, title, description, required_skills, nice_to_have_skills, location, min_yoe, status, created_at, updated_at
+		FROM public."job_openings"
+		WHERE team_id = $1
+		%s
+		ORDER BY created_at DESC, id DESC`,
+			conditions.sql(),
+		),
+		conditions.args...,
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to select job openings")
+	}
+	defer rows.Close()
+
+	jobOpenings := []*model.JobOpening{}
+	for rows.Next() {
+		jobOpening, err := scanJobOpening(rows, team)
+		if err != nil {
+			return nil, err
+		}
+		jobOpenings = append(jobOpenings, jobOpening)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to correctly go through job openings rows")
+	}
+	return jobOpenings, nil
+}
+
+func (s *Storage) GetJobOpeningForTeam(id string, team *model.Team) (*model.JobOpening, error) {
+	if utilities.IsBlank(id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	row := s.db.QueryRow(
+		`SELECT id, title, description, required_skills, nice_to_have_skills, location, min_yoe, status, created_at, updated_at
+		FROM public."job_openings"
+		WHERE id = $1 AND team_id = $2`,
+		id, team.Id(),
+	)
+	jobOpening, err := scanJobOpening(row, team)
+	if err == sql.ErrNoRows {
+		return nil, errors.Errorf("no job opening for id %s", id)
+	}
+	return jobOpening, err
+}
+
+// UpdateJobOpeningForTeam replaces every field of the job opening with opts.Id.
+func (s *Storage) UpdateJobOpeningForTeam(opts model.JobOpeningOptions, team *model.Team) (*model.JobOpening, error) {
+	if utilities.IsBlank(opts.Id) {
+		return nil, errors.New("id cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	opts.Team = team
+	jobOpening, err := model.NewJobOpening(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	row := s.db.QueryRow(
+		`UPDATE public."job_openings" SET
+		"title" = $3,
+		"description" = $4,
+		"required_skills" = $5,
+		"nice_to_have_skills" = $6,
+		"location" = $7,
+		"min_yoe" = $8,
+		"status" = $9
+		WHERE id = $1 AND team_id = $2
+		RETURNING created_at, updated_at`,
+		jobOpening.Id(),
+		team.Id(),
+		jobOpening.Title(),
+		jobOpening.Description(),
+		pq.Array(jobOpening.RequiredSkills()),
+		pq.Array(jobOpening.NiceToHaveSkills()),
+		jobOpening.Location(),
+		jobOpening.MinYoE(),
+		jobOpening.Status(),
+	)
+	err = row.Scan(&opts.CreatedAt, &opts.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Errorf("no job opening for id %s", opts.Id)
+		}
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while updating JobOpening: %s", opts.Id))
+	}
+
+	return model.NewJobOpening(opts)
+}
+
+// DeleteJobOpeningForTeam also deletes all the applications to the job opening.
+func (s *Storage) DeleteJobOpeningForTeam(id string, team *model.Team) error {
+	if utilities.IsBlank(id) {
+		return errors.New("id cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return errors.New("team cannot be blank")
+	}
+
+	result, err := s.db.Exec(
+		`DELETE FROM public."job_openings" WHERE id = $1 AND team_id = $2`,
+		id, team.Id(),
+	)
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while deleting JobOpening: %s", id))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while deleting JobOpening and changing db: %s", id))
+	}
+	if rowsAffected == 0 {
+		return errors.Errorf("no job opening for id %s", id)
+	}
+	if rowsAffected != 1 {
+		return utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when deleting JobOpening in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanJobOpening returns sql.ErrNoRows as is, so callers can tell a missing job opening apart from other errors.
+func scanJobOpening(row rowScanner, team *model.Team) (*model.JobOpening, error) {
+	var id, title, description, location, status string
+	var requiredSkills, niceToHaveSkills []string
+	var minYoE int
+	var createdAt, updatedAt time.Time
+	err := row.Scan(
+		&id, &title, &description, pq.Array(&requiredSkills), pq.Array(&niceToHaveSkills),
+		&location, &minYoE, &status, &createdAt, &updatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, err
+		}
+		return nil, utilities.WrapBadError(err, "failed while scanning rows")
+	}
+
+	return model.NewJobOpening(model.JobOpeningOptions{
+		Id:               id,
+		Title:            title,
+		Description:      description,
+		RequiredSkills:   requiredSkills,
+		NiceToHaveSkills: niceToHaveSkills,
+		Location:         location,
+		MinYoE:           minYoE,
+		Status:           status,
+		Team:             team,
+		CreatedAt:        createdAt,
+		UpdatedAt:        updatedAt,
+	})
+}
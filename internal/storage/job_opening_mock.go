@@ -0,0 +1,31 @@
+package storage
+
+import "github.com/vipulvpatil/candidate-tracker-go/internal/model"
+
+type JobOpeningAccessorConfigurableMock struct {
+	CreateJobOpeningForTeamInternal func(opts model.JobOpeningOptions, team *model.Team) (*model.JobOpening, error)
+	GetJobOpeningsForTeamInternal   func(status string, team *model.Team) ([]*model.JobOpening, error)
+	GetJobOpeningForTeamInternal    func(id string, team *model.Team) (*model.JobOpening, error)
+	UpdateJobOpeningForTeamInternal func(opts model.JobOpeningOptions, team *model.Team) (*model.JobOpening, error)
+	DeleteJobOpeningForTeamInternal func(id string, team *model.Team) error
+}
+
+func (j *JobOpeningAccessorConfigurableMock) CreateJobOpeningForTeam(opts model.JobOpeningOptions, team *model.Team) (*model.JobOpening, error) {
+	return j.CreateJobOpeningForTeamInternal(opts, team)
+}
+
+func (j *JobOpeningAccessorConfigurableMock) GetJobOpeningsForTeam(status string, team *model.Team) ([]*model.JobOpening, error) {
+	return j.GetJobOpeningsForTeamInternal(status, team)
+}
+
+func (j *JobOpeningAccessorConfigurableMock) GetJobOpeningForTeam(id string, team *model.Team) (*model.JobOpening, error) {
+	return j.GetJobOpeningForTeamInternal(id, team)
+}
+
+func (j *JobOpeningAccessorConfigurableMock) UpdateJobOpeningForTeam(opts model.JobOpeningOptions, team *model.Team) (*model.JobOpening, error) {
+	return j.UpdateJobOpeningForTeamInternal(opts, team)
+}
+
+func (j *JobOpeningAccessorConfigurableMock) DeleteJobOpeningForTeam(id string, team *model.Team) error {
+	return j.DeleteJobOpeningForTeamInternal(id, team)
+}
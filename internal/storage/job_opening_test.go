@@ -0,0 +1,478 @@
+package storage
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+func jobOpeningIds(jobOpenings []*model.JobOpening) []string {
+	ids := []string{}
+	for _, jobOpening := range jobOpenings {
+		ids = append(ids, jobOpening.Id())
+	}
+	return ids
+}
+
+func Test_CreateJobOpeningForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1')`},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+	}
+	tests := []struct {
+		name  string
+		input struct {
+			opts model.JobOpeningOptions
+			team *model.Team
+		}
+		dbUpdateCheck func(db *sql.DB) bool
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when team is empty",
+			input: struct {
+				opts model.JobOpeningOptions
+				team *model.Team
+			}{
+				opts: model.JobOpeningOptions{Title: "Engineer"},
+			},
+			errorExpected: true,
+			errorString:   "team cannot be blank",
+		},
+		{
+			name: "errors when title is empty",
+			input: struct {
+				opts model.JobOpeningOptions
+				team *model.Team
+			}{
+				opts: model.JobOpeningOptions{Title: " "},
+				team: team,
+			},
+			errorExpected: true,
+			errorString:   "cannot create JobOpening with an empty title",
+		},
+		{
+			name: "successfully creates an open job opening",
+			input: struct {
+				opts model.JobOpeningOptions
+				team *model.Team
+			}{
+				opts: model.JobOpeningOptions{
+					Title:            "Engineer",
+					Description:      "Builds things",
+					RequiredSkills:   []string{"Go", "SQL"},
+					NiceToHaveSkills: []string{"gRPC"},
+					Location:         "Remote",
+					MinYoE:           3,
+				},
+				team: team,
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var title, status string
+				var minYoE int
+				err := db.QueryRow(`SELECT title, status, min_yoe FROM public."job_openings" WHERE id = 'jo_id1' AND team_id = 'team_id1'`).Scan(&title, &status, &minYoE)
+				assert.NoError(t, err)
+				assert.Equal(t, "Engineer", title)
+				assert.Equal(t, "OPEN", status)
+				assert.Equal(t, 3, minYoE)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db:          testDb,
+					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "jo_id1"},
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			jobOpening, err := s.CreateJobOpeningForTeam(tt.input.opts, tt.input.team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, "jo_id1", jobOpening.Id())
+				assert.Equal(t, []string{"Go", "SQL"}, jobOpening.RequiredSkills())
+				assert.False(t, jobOpening.CreatedAt().IsZero())
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_GetJobOpeningsForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."job_openings" ("id", "team_id", "title", "status", "created_at")
+					VALUES ('jo_id1', 'team_id1', 'Engineer', 'OPEN', '2022-01-01'),
+					('jo_id2', 'team_id1', 'Designer', 'CLOSED', '2022-01-02'),
+					('jo_id3', 'team_id1', 'Manager', 'OPEN', '2022-01-03'),
+					('jo_id4', 'team_id2', 'Engineer', 'OPEN', '2022-01-04')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+	tests := []struct {
+		name  string
+		input struct {
+			status string
+			team   *model.Team
+		}
+		output        []string
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when team is empty",
+			input: struct {
+				status string
+				team   *model.Team
+			}{},
+			output:        nil,
+			errorExpected: true,
+			errorString:   "team cannot be blank",
+		},
+		{
+			name: "errors when status is invalid",
+			input: struct {
+				status string
+				team   *model.Team
+			}{
+				status: "PAUSED",
+				team:   team,
+			},
+			output:        nil,
+			errorExpected: true,
+			errorString:   "invalid job opening status: PAUSED",
+		},
+		{
+			name: "returns all job openings of the team, newest first",
+			input: struct {
+				status string
+				team   *model.Team
+			}{
+				team: team,
+			},
+			output:        []string{"jo_id3", "jo_id2", "jo_id1"},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "returns job openings with the status",
+			input: struct {
+				status string
+				team   *model.Team
+			}{
+				status: "OPEN",
+				team:   team,
+			},
+			output:        []string{"jo_id3", "jo_id1"},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			jobOpenings, err := s.GetJobOpeningsForTeam(tt.input.status, tt.input.team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.output, jobOpeningIds(jobOpenings))
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+				assert.Nil(t, jobOpenings)
+			}
+		})
+	}
+}
+
+func Test_GetJobOpeningForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."job_openings" ("id", "team_id", "title", "required_skills", "nice_to_have_skills", "location", "min_yoe", "status")
+					VALUES ('jo_id1', 'team_id1', 'Engineer', '{Go,SQL}', '{gRPC}', 'Remote', 3, 'CLOSED'),
+					('jo_id2', 'team_id2', 'Engineer', NULL, NULL, '', 0, 'OPEN')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+	tests := []struct {
+		name          string
+		input         string
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name:          "errors when id is empty",
+			input:         "",
+			errorExpected: true,
+			errorString:   "id cannot be blank",
+		},
+		{
+			name:          "errors when job opening belongs to another team",
+			input:         "jo_id2",
+			errorExpected: true,
+			errorString:   "no job opening for id jo_id2",
+		},
+		{
+			name:          "returns the job opening",
+			input:         "jo_id1",
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			jobOpening, err := s.GetJobOpeningForTeam(tt.input, team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, "Engineer", jobOpening.Title())
+				assert.Equal(t, []string{"Go", "SQL"}, jobOpening.RequiredSkills())
+				assert.Equal(t, []string{"gRPC"}, jobOpening.NiceToHaveSkills())
+				assert.Equal(t, "Remote", jobOpening.Location())
+				assert.Equal(t, 3, jobOpening.MinYoE())
+				assert.Equal(t, "CLOSED", jobOpening.Status())
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+				assert.Nil(t, jobOpening)
+			}
+		})
+	}
+}
+
+func Test_UpdateJobOpeningForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."job_openings" ("id", "team_id", "title")
+					VALUES ('jo_id1', 'team_id1', 'Engineer'), ('jo_id2', 'team_id2', 'Engineer')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+	tests := []struct {
+		name          string
+		input         model.JobOpeningOptions
+		dbUpdateCheck func(db *sql.DB) bool
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name:          "errors when id is empty",
+			input:         model.JobOpeningOptions{Title: "Engineer"},
+			errorExpected: true,
+			errorString:   "id cannot be blank",
+		},
+		{
+			name:          "errors when status is invalid",
+			input:         model.JobOpeningOptions{Id: "jo_id1", Title: "Engineer", Status: "PAUSED"},
+			errorExpected: true,
+			errorString:   "cannot create JobOpening with an invalid status: PAUSED",
+		},
+		{
+			name:          "errors when job opening belongs to another team",
+			input:         model.JobOpeningOptions{Id: "jo_id2", Title: "Engineer"},
+			errorExpected: true,
+			errorString:   "no job opening for id jo_id2",
+		},
+		{
+			name:  "successfully updates the job opening",
+			input: model.JobOpeningOptions{Id: "jo_id1", Title: "Senior Engineer", RequiredSkills: []string{"Go"}, MinYoE: 5, Status: "CLOSED"},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var title, status string
+				var minYoE int
+				err := db.QueryRow(`SELECT title, status, min_yoe FROM public."job_openings" WHERE id = 'jo_id1'`).Scan(&title, &status, &minYoE)
+				assert.NoError(t, err)
+				assert.Equal(t, "Senior Engineer", title)
+				assert.Equal(t, "CLOSED", status)
+				assert.Equal(t, 5, minYoE)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			jobOpening, err := s.UpdateJobOpeningForTeam(tt.input, team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, "Senior Engineer", jobOpening.Title())
+				assert.False(t, jobOpening.IsOpen())
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+				assert.Nil(t, jobOpening)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_DeleteJobOpeningForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."job_openings" ("id", "team_id", "title")
+					VALUES ('jo_id1', 'team_id1', 'Engineer'), ('jo_id2', 'team_id2', 'Engineer')`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id") VALUES ('c_id1', $1, 'team_id1')`,
+			Args:  []any{&persona},
+		},
+		{Query: `INSERT INTO public."job_applications" ("id", "job_opening_id", "candidate_id") VALUES ('ja_id1', 'jo_id1', 'c_id1')`},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+	tests := []struct {
+		name          string
+		input         string
+		dbUpdateCheck func(db *sql.DB) bool
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name:          "errors when id is empty",
+			input:         "",
+			errorExpected: true,
+			errorString:   "id cannot be blank",
+		},
+		{
+			name:          "errors when job opening belongs to another team",
+			input:         "jo_id2",
+			errorExpected: true,
+			errorString:   "no job opening for id jo_id2",
+		},
+		{
+			name:  "deletes the job opening and its applications but not the candidates",
+			input: "jo_id1",
+			dbUpdateCheck: func(db *sql.DB) bool {
+				var jobOpeningCount, jobApplicationCount, candidateCount int
+				err := db.QueryRow(
+					`SELECT
+					(SELECT count(*) FROM public."job_openings" WHERE id = 'jo_id1'),
+					(SELECT count(*) FROM public."job_applications" WHERE id = 'ja_id1'),
+					(SELECT count(*) FROM public."candidates" WHERE id = 'c_id1')`,
+				).Scan(&jobOpeningCount, &jobApplicationCount, &candidateCount)
+				assert.NoError(t, err)
+				assert.Equal(t, 0, jobOpeningCount)
+				assert.Equal(t, 0, jobApplicationCount)
+				assert.Equal(t, 1, candidateCount)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			err := s.DeleteJobOpeningForTeam(tt.input, team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+// LeaderLock is held by at most one instance at a time.
+type LeaderLock interface {
+	TryAcquire(ctx context.Context) (bool, error)
+	// StillHeld errors once the lock may have been lost.
+	StillHeld(ctx context.Context) error
+	Release() error
+}
+
+// advisoryLeaderLock holds a postgres session level advisory lock on a connection of its own.
+// If the instance holding it dies, postgres ends the session and releases the lock, so another instance can take over.
+type advisoryLeaderLock struct {
+	db   *sql.DB
+	key  int64
+	conn *sql.Conn
+}
+
+func NewAdvisoryLeaderLock(db *sql.DB, key int64) (LeaderLock, error) {
+	if db == nil {
+		return nil, errors.New("cannot create AdvisoryLeaderLock without a db")
+	}
+	return &advisoryLeaderLock{db: db, key: key}, nil
+}
+
+func (l *advisoryLeaderLock) TryAcquire(ctx context.Context) (bool, error) {
+	if l.conn != nil {
+		return true, nil
+	}
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, utilities.WrapBadError(err, "failed to get a db connection for the leader lock")
+	}
+
+	var acquired bool
+	err = conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, l.key).Scan(&acquired)
+	if err != nil {
+		discardConn(conn)
+		return false, utilities.WrapBadError(err, "dbError while acquiring leader lock")
+	}
+
+	if !acquired {
+		// The lock was not taken on this session, so the connection can safely go back to the pool.
+		conn.Close()
+		return false, nil
+	}
+
+	l.conn = conn
+	return true, nil
+}
+
+func (l *advisoryLeaderLock) StillHeld(ctx context.Context) error {
+	if l.conn == nil {
+		return errors.New("leader lock is not held")
+	}
+
+	// The lock lives as long as the session, so it is held as long as the connection is alive.
+	err := l.conn.PingContext(ctx)
+	if err != nil {
+		discardConn(l.conn)
+		l.conn = nil
+		return utilities.WrapBadError(err, "lost the connection holding the leader lock")
+	}
+	return nil
+}
+
+func (l *advisoryLeaderLock) Release() error {
+	if l.conn == nil {
+		return nil
+	}
+
+	conn := l.conn
+	l.conn = nil
+	_, err := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, l.key)
+	if err != nil {
+		// The lock must never go back to the pool with the connection, so the connection is discarded instead.
+		discardConn(conn)
+		return utilities.WrapBadError(err, "dbError while releasing leader lock")
+	}
+	return conn.Close()
+}
+
+// discardConn closes the underlying connection instead of returning it to the pool.
+func discardConn(conn *sql.Conn) {
+	conn.Raw(func(driverConn any) error {
+		return driver.ErrBadConn
+	})
+	conn.Close()
+}
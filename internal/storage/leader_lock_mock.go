@@ -0,0 +1,21 @@
+package storage
+
+import "context"
+
+type LeaderLockConfigurableMock struct {
+	TryAcquireInternal func(ctx context.Context) (bool, error)
+	StillHeldInternal  func(ctx context.Context) error
+	ReleaseInternal    func() error
+}
+
+func (l *LeaderLockConfigurableMock) TryAcquire(ctx context.Context) (bool, error) {
+	return l.TryAcquireInternal(ctx)
+}
+
+func (l *LeaderLockConfigurableMock) StillHeld(ctx context.Context) error {
+	return l.StillHeldInternal(ctx)
+}
+
+func (l *LeaderLockConfigurableMock) Release() error {
+	return l.ReleaseInternal()
+}
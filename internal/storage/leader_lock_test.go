@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AdvisoryLeaderLock(t *testing.T) {
+	t.Run("only one lock for a key is held at a time, and another can take over once it is released", func(t *testing.T) {
+		ctx := context.Background()
+		lock1, err := NewAdvisoryLeaderLock(testDb, 1001)
+		assert.NoError(t, err)
+		lock2, err := NewAdvisoryLeaderLock(testDb, 1001)
+		assert.NoError(t, err)
+		defer lock1.Release()
+		defer lock2.Release()
+
+		acquired, err := lock1.TryAcquire(ctx)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+		assert.NoError(t, lock1.StillHeld(ctx))
+
+		acquired, err = lock2.TryAcquire(ctx)
+		assert.NoError(t, err)
+		assert.False(t, acquired)
+		assert.EqualError(t, lock2.StillHeld(ctx), "leader lock is not held")
+
+		assert.NoError(t, lock1.Release())
+		assert.EqualError(t, lock1.StillHeld(ctx), "leader lock is not held")
+
+		acquired, err = lock2.TryAcquire(ctx)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+	})
+}
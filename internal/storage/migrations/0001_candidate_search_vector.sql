@@ -0,0 +1,72 @@
+-- Adds full text search over candidate personas.
+-- The same functions and trigger are kept in `database_trigger_test.sql` for tests.
+
+-- AlterTable
+ALTER TABLE "candidates" ADD COLUMN IF NOT EXISTS "search_vector" tsvector;
+
+-- CandidatePersonaText function
+-- Joins all string values found at the json path in a persona.
+CREATE OR REPLACE FUNCTION candidate_persona_text(persona JSONB, path JSONPATH)
+RETURNS TEXT AS $$
+    SELECT COALESCE(string_agg(value #>> '{}', ' '), '')
+    FROM jsonb_path_query(COALESCE(persona, '{}'::jsonb), path) AS value
+    WHERE jsonb_typeof(value) = 'string'
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- CandidateSearchDocument function
+-- The searchable text of a persona. Used to build search snippets.
+CREATE OR REPLACE FUNCTION candidate_search_document(persona JSONB)
+RETURNS TEXT AS $$
+    SELECT concat_ws(' ',
+        candidate_persona_text(persona, '$."Name"'),
+        candidate_persona_text(persona, '$."Tech Skills"[*]'),
+        candidate_persona_text(persona, '$."Soft Skills"[*]'),
+        candidate_persona_text(persona, '$."Experience"[*]."Title"'),
+        candidate_persona_text(persona, '$."Recommended Roles"[*]'),
+        candidate_persona_text(persona, '$."Experience"[*]."Company Name"'),
+        candidate_persona_text(persona, '$."Education"[*]."Qualification"'),
+        candidate_persona_text(persona, '$."Education"[*]."Institute"'),
+        candidate_persona_text(persona, '$."Certifications"[*]')
+    )
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- CandidateSearchVector function
+-- Name ranks highest, followed by skills and titles, followed by companies, education and certifications.
+CREATE OR REPLACE FUNCTION candidate_search_vector(persona JSONB)
+RETURNS TSVECTOR AS $$
+    SELECT
+        setweight(to_tsvector('english', candidate_persona_text(persona, '$."Name"')), 'A') ||
+        setweight(to_tsvector('english', concat_ws(' ',
+            candidate_persona_text(persona, '$."Tech Skills"[*]'),
+            candidate_persona_text(persona, '$."Soft Skills"[*]'),
+            candidate_persona_text(persona, '$."Experience"[*]."Title"'),
+            candidate_persona_text(persona, '$."Recommended Roles"[*]')
+        )), 'B') ||
+        setweight(to_tsvector('english', concat_ws(' ',
+            candidate_persona_text(persona, '$."Experience"[*]."Company Name"'),
+            candidate_persona_text(persona, '$."Education"[*]."Qualification"'),
+            candidate_persona_text(persona, '$."Education"[*]."Institute"'),
+            candidate_persona_text(persona, '$."Certifications"[*]')
+        )), 'C')
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- CreateUpdateCandidateSearchVectorFunction
+CREATE OR REPLACE FUNCTION update_candidate_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector = candidate_search_vector(COALESCE(NEW.manually_created_persona, NEW.ai_generated_persona));
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+-- Candidate search_vector trigger
+DROP TRIGGER IF EXISTS update_candidate_search_vector ON candidates;
+CREATE TRIGGER update_candidate_search_vector BEFORE INSERT OR UPDATE OF ai_generated_persona, manually_created_persona ON candidates FOR EACH ROW EXECUTE PROCEDURE  update_candidate_search_vector();
+
+-- Backfill existing candidates without touching updated_at
+ALTER TABLE "candidates" DISABLE TRIGGER update_candidate_updated_at;
+UPDATE "candidates" SET "search_vector" = candidate_search_vector(COALESCE("manually_created_persona", "ai_generated_persona"));
+ALTER TABLE "candidates" ENABLE TRIGGER update_candidate_updated_at;
+
+-- CreateIndex
+CREATE INDEX IF NOT EXISTS "candidates_search_vector_idx" ON "candidates" USING GIN ("search_vector");
@@ -0,0 +1,97 @@
+-- Stores the text extracted from file uploads and adds it to candidate search.
+-- The same functions and triggers are kept in `database_trigger_test.sql` for tests.
+
+-- CreateTable
+CREATE TABLE "file_upload_texts" (
+    "file_upload_id" TEXT NOT NULL,
+    "text" TEXT NOT NULL,
+    "text_hash" TEXT NOT NULL,
+    "page_count" INTEGER NOT NULL,
+    "extractor_version" TEXT NOT NULL,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "file_upload_texts_pkey" PRIMARY KEY ("file_upload_id")
+);
+
+-- AddForeignKey
+ALTER TABLE "file_upload_texts" ADD CONSTRAINT "file_upload_texts_file_upload_id_fkey" FOREIGN KEY ("file_upload_id") REFERENCES "file_uploads"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- FileUploadText updated_at trigger
+CREATE TRIGGER update_file_upload_text_updated_at BEFORE UPDATE ON file_upload_texts FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
+
+-- Search functions now take the resume text as well
+DROP FUNCTION IF EXISTS candidate_search_document(JSONB);
+DROP FUNCTION IF EXISTS candidate_search_vector(JSONB);
+
+-- CandidateSearchDocument function
+-- The searchable text of a candidate. Used to build search snippets.
+CREATE OR REPLACE FUNCTION candidate_search_document(persona JSONB, resume_text TEXT)
+RETURNS TEXT AS $$
+    SELECT concat_ws(' ',
+        candidate_persona_text(persona, '$."Name"'),
+        candidate_persona_text(persona, '$."Tech Skills"[*]'),
+        candidate_persona_text(persona, '$."Soft Skills"[*]'),
+        candidate_persona_text(persona, '$."Experience"[*]."Title"'),
+        candidate_persona_text(persona, '$."Recommended Roles"[*]'),
+        candidate_persona_text(persona, '$."Experience"[*]."Company Name"'),
+        candidate_persona_text(persona, '$."Education"[*]."Qualification"'),
+        candidate_persona_text(persona, '$."Education"[*]."Institute"'),
+        candidate_persona_text(persona, '$."Certifications"[*]'),
+        resume_text
+    )
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- CandidateSearchVector function
+-- Name ranks highest, followed by skills and titles, followed by companies, education and certifications.
+-- The raw resume text ranks lowest.
+CREATE OR REPLACE FUNCTION candidate_search_vector(persona JSONB, resume_text TEXT)
+RETURNS TSVECTOR AS $$
+    SELECT
+        setweight(to_tsvector('english', candidate_persona_text(persona, '$."Name"')), 'A') ||
+        setweight(to_tsvector('english', concat_ws(' ',
+            candidate_persona_text(persona, '$."Tech Skills"[*]'),
+            candidate_persona_text(persona, '$."Soft Skills"[*]'),
+            candidate_persona_text(persona, '$."Experience"[*]."Title"'),
+            candidate_persona_text(persona, '$."Recommended Roles"[*]')
+        )), 'B') ||
+        setweight(to_tsvector('english', concat_ws(' ',
+            candidate_persona_text(persona, '$."Experience"[*]."Company Name"'),
+            candidate_persona_text(persona, '$."Education"[*]."Qualification"'),
+            candidate_persona_text(persona, '$."Education"[*]."Institute"'),
+            candidate_persona_text(persona, '$."Certifications"[*]')
+        )), 'C') ||
+        setweight(to_tsvector('english', COALESCE(resume_text, '')), 'D')
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- CreateUpdateCandidateSearchVectorFunction
+CREATE OR REPLACE FUNCTION update_candidate_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector = candidate_search_vector(
+        COALESCE(NEW.manually_created_persona, NEW.ai_generated_persona),
+        (SELECT text FROM file_upload_texts WHERE file_upload_id = NEW.file_upload_id)
+    );
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+-- Candidate search_vector trigger
+DROP TRIGGER IF EXISTS update_candidate_search_vector ON candidates;
+CREATE TRIGGER update_candidate_search_vector BEFORE INSERT OR UPDATE OF ai_generated_persona, manually_created_persona, file_upload_id ON candidates FOR EACH ROW EXECUTE PROCEDURE  update_candidate_search_vector();
+
+-- CreateRefreshCandidateSearchVectorFunction
+-- Keeps the search_vector of a candidate in sync when the text of its file upload changes.
+CREATE OR REPLACE FUNCTION refresh_candidate_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE candidates
+    SET search_vector = candidate_search_vector(COALESCE(manually_created_persona, ai_generated_persona), NEW.text)
+    WHERE file_upload_id = NEW.file_upload_id;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+-- FileUploadText search_vector trigger
+CREATE TRIGGER refresh_candidate_search_vector AFTER INSERT OR UPDATE OF text ON file_upload_texts FOR EACH ROW EXECUTE PROCEDURE  refresh_candidate_search_vector();
+
@@ -0,0 +1,6 @@
+-- Records why processing of a file upload failed.
+
+-- AlterTable
+ALTER TABLE "file_uploads" ADD COLUMN "failure_category" TEXT,
+ADD COLUMN "failure_message" TEXT,
+ADD COLUMN "failed_at" TIMESTAMPTZ(3);
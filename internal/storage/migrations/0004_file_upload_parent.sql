@@ -0,0 +1,10 @@
+-- Links a file upload to the archive it was unpacked from.
+
+-- AlterTable
+ALTER TABLE "file_uploads" ADD COLUMN "parent_file_upload_id" TEXT;
+
+-- CreateIndex
+CREATE INDEX "file_uploads_parent_file_upload_id_idx" ON "file_uploads"("parent_file_upload_id");
+
+-- AddForeignKey
+ALTER TABLE "file_uploads" ADD CONSTRAINT "file_uploads_parent_file_upload_id_fkey" FOREIGN KEY ("parent_file_upload_id") REFERENCES "file_uploads"("id") ON DELETE SET NULL ON UPDATE CASCADE;
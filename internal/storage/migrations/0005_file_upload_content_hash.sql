@@ -0,0 +1,11 @@
+-- Stores the SHA-256 of each uploaded file, so that a file uploaded twice by a team is not processed twice.
+
+-- AlterTable
+ALTER TABLE "file_uploads" ADD COLUMN "content_hash" TEXT,
+ADD COLUMN "duplicate_of_candidate_id" TEXT;
+
+-- CreateIndex
+CREATE INDEX "file_uploads_team_id_content_hash_idx" ON "file_uploads"("team_id", "content_hash");
+
+-- AddForeignKey
+ALTER TABLE "file_uploads" ADD CONSTRAINT "file_uploads_duplicate_of_candidate_id_fkey" FOREIGN KEY ("duplicate_of_candidate_id") REFERENCES "candidates"("id") ON DELETE SET NULL ON UPDATE CASCADE;
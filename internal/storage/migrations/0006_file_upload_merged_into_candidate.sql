@@ -0,0 +1,10 @@
+-- When candidates are merged, their file uploads are pointed at the surviving candidate.
+
+-- AlterTable
+ALTER TABLE "file_uploads" ADD COLUMN "merged_into_candidate_id" TEXT;
+
+-- CreateIndex
+CREATE INDEX "file_uploads_merged_into_candidate_id_idx" ON "file_uploads"("merged_into_candidate_id");
+
+-- AddForeignKey
+ALTER TABLE "file_uploads" ADD CONSTRAINT "file_uploads_merged_into_candidate_id_fkey" FOREIGN KEY ("merged_into_candidate_id") REFERENCES "candidates"("id") ON DELETE SET NULL ON UPDATE CASCADE;
@@ -0,0 +1,4 @@
+-- Archived candidates are kept, but are hidden from candidate lists by default.
+
+-- AlterTable
+ALTER TABLE "candidates" ADD COLUMN "archived_at" TIMESTAMPTZ(3);
@@ -0,0 +1,62 @@
+-- Teams move candidates through their own ordered pipeline stages.
+-- Every stage change is recorded. Stage names are copied into the history so that it survives renamed and deleted stages.
+
+-- CreateTable
+CREATE TABLE "pipeline_stages" (
+    "id" TEXT NOT NULL,
+    "team_id" TEXT NOT NULL,
+    "name" TEXT NOT NULL,
+    "position" INTEGER NOT NULL,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "pipeline_stages_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "candidate_stage_changes" (
+    "id" TEXT NOT NULL,
+    "candidate_id" TEXT NOT NULL,
+    "from_stage_id" TEXT,
+    "from_stage_name" TEXT,
+    "to_stage_id" TEXT,
+    "to_stage_name" TEXT NOT NULL,
+    "changed_by_user_id" TEXT,
+    "reason" TEXT,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "candidate_stage_changes_pkey" PRIMARY KEY ("id")
+);
+
+-- AlterTable
+ALTER TABLE "candidates" ADD COLUMN "pipeline_stage_id" TEXT;
+
+-- CreateIndex
+CREATE UNIQUE INDEX "pipeline_stages_team_id_name_key" ON "pipeline_stages"("team_id", "name");
+
+-- CreateIndex
+CREATE INDEX "candidate_stage_changes_candidate_id_created_at_idx" ON "candidate_stage_changes"("candidate_id", "created_at");
+
+-- CreateIndex
+CREATE INDEX "candidates_pipeline_stage_id_idx" ON "candidates"("pipeline_stage_id");
+
+-- AddForeignKey
+ALTER TABLE "pipeline_stages" ADD CONSTRAINT "pipeline_stages_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "teams"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_stage_changes" ADD CONSTRAINT "candidate_stage_changes_candidate_id_fkey" FOREIGN KEY ("candidate_id") REFERENCES "candidates"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_stage_changes" ADD CONSTRAINT "candidate_stage_changes_from_stage_id_fkey" FOREIGN KEY ("from_stage_id") REFERENCES "pipeline_stages"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_stage_changes" ADD CONSTRAINT "candidate_stage_changes_to_stage_id_fkey" FOREIGN KEY ("to_stage_id") REFERENCES "pipeline_stages"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_stage_changes" ADD CONSTRAINT "candidate_stage_changes_changed_by_user_id_fkey" FOREIGN KEY ("changed_by_user_id") REFERENCES "users"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidates" ADD CONSTRAINT "candidates_pipeline_stage_id_fkey" FOREIGN KEY ("pipeline_stage_id") REFERENCES "pipeline_stages"("id") ON DELETE NO ACTION ON UPDATE CASCADE;
+
+-- PipelineStage updated_at trigger
+CREATE TRIGGER update_pipeline_stage_updated_at BEFORE UPDATE ON pipeline_stages FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
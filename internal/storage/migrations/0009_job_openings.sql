@@ -0,0 +1,58 @@
+-- Job openings are the roles a team is hiring for. Candidates apply to openings, and each application has its own pipeline stage.
+-- The same triggers are kept in `database_trigger_test.sql` for tests.
+
+-- CreateTable
+CREATE TABLE "job_openings" (
+    "id" TEXT NOT NULL,
+    "team_id" TEXT NOT NULL,
+    "title" TEXT NOT NULL,
+    "description" TEXT NOT NULL DEFAULT '',
+    "required_skills" TEXT[],
+    "nice_to_have_skills" TEXT[],
+    "location" TEXT NOT NULL DEFAULT '',
+    "min_yoe" INTEGER NOT NULL DEFAULT 0,
+    "status" TEXT NOT NULL DEFAULT 'OPEN',
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "job_openings_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "job_applications" (
+    "id" TEXT NOT NULL,
+    "job_opening_id" TEXT NOT NULL,
+    "candidate_id" TEXT NOT NULL,
+    "pipeline_stage_id" TEXT,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "job_applications_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "job_openings_team_id_idx" ON "job_openings"("team_id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "job_applications_job_opening_id_candidate_id_key" ON "job_applications"("job_opening_id", "candidate_id");
+
+-- CreateIndex
+CREATE INDEX "job_applications_candidate_id_idx" ON "job_applications"("candidate_id");
+
+-- AddForeignKey
+ALTER TABLE "job_openings" ADD CONSTRAINT "job_openings_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "teams"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "job_applications" ADD CONSTRAINT "job_applications_job_opening_id_fkey" FOREIGN KEY ("job_opening_id") REFERENCES "job_openings"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "job_applications" ADD CONSTRAINT "job_applications_candidate_id_fkey" FOREIGN KEY ("candidate_id") REFERENCES "candidates"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "job_applications" ADD CONSTRAINT "job_applications_pipeline_stage_id_fkey" FOREIGN KEY ("pipeline_stage_id") REFERENCES "pipeline_stages"("id") ON DELETE NO ACTION ON UPDATE CASCADE;
+
+-- JobOpening updated_at trigger
+CREATE TRIGGER update_job_opening_updated_at BEFORE UPDATE ON job_openings FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
+
+-- JobApplication updated_at trigger
+CREATE TRIGGER update_job_application_updated_at BEFORE UPDATE ON job_applications FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
@@ -0,0 +1,39 @@
+-- Fit assessments are the LLM's verdicts on how well a candidate fits a job opening. There is one per persona version, job opening version
+-- and prompt version, so a verdict is only requested again once the persona, the job opening or the prompt changes.
+-- The same trigger is kept in `database_trigger_test.sql` for tests.
+
+-- CreateTable
+CREATE TABLE "fit_assessments" (
+    "id" TEXT NOT NULL,
+    "team_id" TEXT NOT NULL,
+    "candidate_id" TEXT NOT NULL,
+    "job_opening_id" TEXT NOT NULL,
+    "persona_version" TEXT NOT NULL,
+    "job_opening_version" TEXT NOT NULL,
+    "prompt_version" TEXT NOT NULL,
+    "status" TEXT NOT NULL DEFAULT 'NOT STARTED',
+    "verdict" JSONB,
+    "failure_message" TEXT,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "fit_assessments_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "fit_assessments_candidate_id_job_opening_id_versions_key" ON "fit_assessments"("candidate_id", "job_opening_id", "persona_version", "job_opening_version", "prompt_version");
+
+-- CreateIndex
+CREATE INDEX "fit_assessments_status_idx" ON "fit_assessments"("status");
+
+-- AddForeignKey
+ALTER TABLE "fit_assessments" ADD CONSTRAINT "fit_assessments_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "teams"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "fit_assessments" ADD CONSTRAINT "fit_assessments_candidate_id_fkey" FOREIGN KEY ("candidate_id") REFERENCES "candidates"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "fit_assessments" ADD CONSTRAINT "fit_assessments_job_opening_id_fkey" FOREIGN KEY ("job_opening_id") REFERENCES "job_openings"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- FitAssessment updated_at trigger
+CREATE TRIGGER update_fit_assessment_updated_at BEFORE UPDATE ON fit_assessments FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
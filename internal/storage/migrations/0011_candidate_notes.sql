@@ -0,0 +1,26 @@
+-- Team members leave notes on candidates. Notes outlive their author, who is then no longer attributed.
+-- The same triggers are kept in `database_trigger_test.sql` for tests.
+
+-- CreateTable
+CREATE TABLE "candidate_notes" (
+    "id" TEXT NOT NULL,
+    "candidate_id" TEXT NOT NULL,
+    "author_user_id" TEXT,
+    "body" TEXT NOT NULL,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "candidate_notes_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "candidate_notes_candidate_id_created_at_idx" ON "candidate_notes"("candidate_id", "created_at");
+
+-- AddForeignKey
+ALTER TABLE "candidate_notes" ADD CONSTRAINT "candidate_notes_candidate_id_fkey" FOREIGN KEY ("candidate_id") REFERENCES "candidates"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_notes" ADD CONSTRAINT "candidate_notes_author_user_id_fkey" FOREIGN KEY ("author_user_id") REFERENCES "users"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- CandidateNote updated_at trigger
+CREATE TRIGGER update_candidate_note_updated_at BEFORE UPDATE ON candidate_notes FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
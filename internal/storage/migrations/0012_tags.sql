@@ -0,0 +1,40 @@
+-- Teams label their candidates with free-form tags.
+-- The same triggers are kept in `database_trigger_test.sql` for tests.
+
+-- CreateTable
+CREATE TABLE "tags" (
+    "id" TEXT NOT NULL,
+    "team_id" TEXT NOT NULL,
+    "name" TEXT NOT NULL,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "tags_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "candidate_tags" (
+    "candidate_id" TEXT NOT NULL,
+    "tag_id" TEXT NOT NULL,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "candidate_tags_pkey" PRIMARY KEY ("candidate_id", "tag_id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "tags_team_id_name_key" ON "tags"("team_id", "name");
+
+-- CreateIndex
+CREATE INDEX "candidate_tags_tag_id_idx" ON "candidate_tags"("tag_id");
+
+-- AddForeignKey
+ALTER TABLE "tags" ADD CONSTRAINT "tags_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "teams"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_tags" ADD CONSTRAINT "candidate_tags_candidate_id_fkey" FOREIGN KEY ("candidate_id") REFERENCES "candidates"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_tags" ADD CONSTRAINT "candidate_tags_tag_id_fkey" FOREIGN KEY ("tag_id") REFERENCES "tags"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- Tag updated_at trigger
+CREATE TRIGGER update_tag_updated_at BEFORE UPDATE ON tags FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
@@ -0,0 +1,72 @@
+-- Teams define the criteria they rate candidates on, and interviewers submit a scorecard per candidate,
+-- optionally for a job opening. An interviewer has at most one scorecard per candidate and job opening.
+-- Scorecards outlive their interviewer, who is then no longer attributed.
+-- The same triggers are kept in `database_trigger_test.sql` for tests.
+
+-- CreateTable
+CREATE TABLE "scorecard_criteria" (
+    "id" TEXT NOT NULL,
+    "team_id" TEXT NOT NULL,
+    "name" TEXT NOT NULL,
+    "description" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "scorecard_criteria_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "scorecards" (
+    "id" TEXT NOT NULL,
+    "candidate_id" TEXT NOT NULL,
+    "job_opening_id" TEXT,
+    "interviewer_user_id" TEXT,
+    "comment" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "scorecards_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "scorecard_ratings" (
+    "scorecard_id" TEXT NOT NULL,
+    "scorecard_criterion_id" TEXT NOT NULL,
+    "rating" INTEGER NOT NULL,
+
+    CONSTRAINT "scorecard_ratings_pkey" PRIMARY KEY ("scorecard_id", "scorecard_criterion_id"),
+    CONSTRAINT "scorecard_ratings_rating_check" CHECK ("rating" BETWEEN 1 AND 5)
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "scorecard_criteria_team_id_name_key" ON "scorecard_criteria"("team_id", "name");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "scorecards_candidate_id_job_opening_id_interviewer_user_id_key" ON "scorecards"("candidate_id", (COALESCE("job_opening_id", '')), "interviewer_user_id");
+
+-- CreateIndex
+CREATE INDEX "scorecard_ratings_scorecard_criterion_id_idx" ON "scorecard_ratings"("scorecard_criterion_id");
+
+-- AddForeignKey
+ALTER TABLE "scorecard_criteria" ADD CONSTRAINT "scorecard_criteria_team_id_fkey" FOREIGN KEY ("team_id") REFERENCES "teams"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "scorecards" ADD CONSTRAINT "scorecards_candidate_id_fkey" FOREIGN KEY ("candidate_id") REFERENCES "candidates"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "scorecards" ADD CONSTRAINT "scorecards_job_opening_id_fkey" FOREIGN KEY ("job_opening_id") REFERENCES "job_openings"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "scorecards" ADD CONSTRAINT "scorecards_interviewer_user_id_fkey" FOREIGN KEY ("interviewer_user_id") REFERENCES "users"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "scorecard_ratings" ADD CONSTRAINT "scorecard_ratings_scorecard_id_fkey" FOREIGN KEY ("scorecard_id") REFERENCES "scorecards"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "scorecard_ratings" ADD CONSTRAINT "scorecard_ratings_scorecard_criterion_id_fkey" FOREIGN KEY ("scorecard_criterion_id") REFERENCES "scorecard_criteria"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- ScorecardCriterion updated_at trigger
+CREATE TRIGGER update_scorecard_criterion_updated_at BEFORE UPDATE ON scorecard_criteria FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
+
+-- Scorecard updated_at trigger
+CREATE TRIGGER update_scorecard_updated_at BEFORE UPDATE ON scorecards FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
@@ -0,0 +1,29 @@
+-- Every persona written to a candidate is kept as a revision, so the edit history of a candidate can be shown and reverted.
+-- Revisions are only ever inserted. History starts with this migration, personas written before it are not backfilled.
+-- Revisions outlive their editor, who is then no longer attributed.
+
+-- CreateTable
+CREATE TABLE "candidate_persona_revisions" (
+    "id" TEXT NOT NULL,
+    "candidate_id" TEXT NOT NULL,
+    "persona" JSONB NOT NULL,
+    "built_by" TEXT NOT NULL DEFAULT '',
+    "builder_version" TEXT NOT NULL DEFAULT '',
+    "edited_by_user_id" TEXT,
+    "reverted_from_revision_id" TEXT,
+    "created_at" TIMESTAMPTZ(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "candidate_persona_revisions_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "candidate_persona_revisions_candidate_id_created_at_idx" ON "candidate_persona_revisions"("candidate_id", "created_at");
+
+-- AddForeignKey
+ALTER TABLE "candidate_persona_revisions" ADD CONSTRAINT "candidate_persona_revisions_candidate_id_fkey" FOREIGN KEY ("candidate_id") REFERENCES "candidates"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_persona_revisions" ADD CONSTRAINT "candidate_persona_revisions_edited_by_user_id_fkey" FOREIGN KEY ("edited_by_user_id") REFERENCES "users"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "candidate_persona_revisions" ADD CONSTRAINT "candidate_persona_revisions_reverted_from_revision_id_fkey" FOREIGN KEY ("reverted_from_revision_id") REFERENCES "candidate_persona_revisions"("id") ON DELETE SET NULL ON UPDATE CASCADE;
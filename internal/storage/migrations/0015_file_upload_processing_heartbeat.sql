@@ -0,0 +1,9 @@
+-- Workers send a heartbeat while processing a file upload, so that uploads left ONGOING by a crashed worker can be reaped.
+
+-- AlterTable
+ALTER TABLE "file_uploads" ADD COLUMN "processing_started_at" TIMESTAMPTZ(3),
+ADD COLUMN "processing_heartbeat_at" TIMESTAMPTZ(3),
+ADD COLUMN "processing_reap_count" INTEGER NOT NULL DEFAULT 0;
+
+-- CreateIndex
+CREATE INDEX "file_uploads_processing_status_processing_heartbeat_at_idx" ON "file_uploads"("processing_status", "processing_heartbeat_at");
@@ -0,0 +1,33 @@
+-- Notifies the dispatcher as soon as a file upload or fit assessment is ready to be processed, instead of it polling for them.
+-- The notification is only delivered once the transaction that made the row ready commits. The payload is the id of the row.
+-- The same functions and triggers are kept in `database_trigger_test.sql` for tests.
+
+-- CreateNotifyFileUploadReadyFunction
+CREATE OR REPLACE FUNCTION notify_file_upload_ready()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.status = 'SUCCESS' AND NEW.processing_status = 'NOT STARTED' THEN
+        PERFORM pg_notify('file_upload_ready', NEW.id);
+    END IF;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+-- FileUpload ready trigger
+DROP TRIGGER IF EXISTS notify_file_upload_ready ON file_uploads;
+CREATE TRIGGER notify_file_upload_ready AFTER INSERT OR UPDATE OF status, processing_status ON file_uploads FOR EACH ROW EXECUTE PROCEDURE  notify_file_upload_ready();
+
+-- CreateNotifyFitAssessmentReadyFunction
+CREATE OR REPLACE FUNCTION notify_fit_assessment_ready()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.status = 'NOT STARTED' THEN
+        PERFORM pg_notify('fit_assessment_ready', NEW.id);
+    END IF;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+-- FitAssessment ready trigger
+DROP TRIGGER IF EXISTS notify_fit_assessment_ready ON fit_assessments;
+CREATE TRIGGER notify_fit_assessment_ready AFTER INSERT OR UPDATE OF status ON fit_assessments FOR EACH ROW EXECUTE PROCEDURE  notify_fit_assessment_ready();
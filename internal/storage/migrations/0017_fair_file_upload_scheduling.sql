@@ -0,0 +1,27 @@
+-- File uploads are dispatched to workers fairly across teams, instead of first come first served.
+-- Each team has a limited number of file uploads dispatched or being processed at once, and teams take turns within that.
+-- Within a team, HIGH priority uploads go before NORMAL ones, which go before LOW ones.
+-- Uploads waiting to be retried hold a slot, so they record when the retry should have started by, and are reaped if it never does.
+-- The same function and trigger are kept in `database_trigger_test.sql` for tests.
+
+-- AlterTable
+ALTER TABLE "file_uploads" ADD COLUMN "processing_priority" TEXT NOT NULL DEFAULT 'NORMAL',
+ADD COLUMN "dispatched_at" TIMESTAMPTZ(3),
+ADD COLUMN "processing_retry_deadline_at" TIMESTAMPTZ(3);
+
+-- CreateIndex
+CREATE INDEX "file_uploads_processing_status_team_id_idx" ON "file_uploads"("processing_status", "team_id");
+
+-- CreateNotifyFileUploadReadyFunction
+-- Also notifies when a file upload stops processing or waiting to be retried, since that frees up a slot for its team.
+CREATE OR REPLACE FUNCTION notify_file_upload_ready()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.status = 'SUCCESS' AND NEW.processing_status = 'NOT STARTED' THEN
+        PERFORM pg_notify('file_upload_ready', NEW.id);
+    ELSIF TG_OP = 'UPDATE' AND OLD.processing_status IN ('ONGOING', 'RETRYING') AND NEW.processing_status NOT IN ('ONGOING', 'RETRYING') THEN
+        PERFORM pg_notify('file_upload_ready', NEW.id);
+    END IF;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
@@ -0,0 +1,79 @@
+package storage
+
+// This is a wrapper around lib/pq's listener. It only exists to enable mocking of postgres notifications.
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+// Channels notified by the triggers in `migrations/0016_processing_notifications.sql`. The payload is the id of the row that is ready.
+const FILE_UPLOAD_READY_CHANNEL = "file_upload_ready"
+const FIT_ASSESSMENT_READY_CHANNEL = "fit_assessment_ready"
+
+type Notification struct {
+	Channel string
+	Id      string
+}
+
+// NotificationListener sends a nil Notification after it reconnects to the database, since notifications sent while it was disconnected are lost.
+type NotificationListener interface {
+	Notifications() <-chan *Notification
+	Close() error
+}
+
+type notificationListener struct {
+	listener      *pq.Listener
+	notifications chan *Notification
+	done          chan struct{}
+}
+
+func NewNotificationListener(dbUrl string, logger utilities.Logger, channels ...string) (NotificationListener, error) {
+	listener := pq.NewListener(dbUrl, 10*time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			logger.LogError(err)
+		}
+	})
+
+	for _, channel := range channels {
+		err := listener.Listen(channel)
+		if err != nil {
+			listener.Close()
+			return nil, err
+		}
+	}
+
+	n := &notificationListener{
+		listener:      listener,
+		notifications: make(chan *Notification),
+		done:          make(chan struct{}),
+	}
+	go n.forward()
+	return n, nil
+}
+
+func (n *notificationListener) forward() {
+	defer close(n.notifications)
+	for pqNotification := range n.listener.Notify {
+		var notification *Notification
+		if pqNotification != nil {
+			notification = &Notification{Channel: pqNotification.Channel, Id: pqNotification.Extra}
+		}
+		select {
+		case n.notifications <- notification:
+		case <-n.done:
+			return
+		}
+	}
+}
+
+func (n *notificationListener) Notifications() <-chan *Notification {
+	return n.notifications
+}
+
+func (n *notificationListener) Close() error {
+	close(n.done)
+	return n.listener.Close()
+}
@@ -0,0 +1,13 @@
+package storage
+
+type NotificationListenerMock struct {
+	NotificationsChan chan *Notification
+}
+
+func (n *NotificationListenerMock) Notifications() <-chan *Notification {
+	return n.NotificationsChan
+}
+
+func (n *NotificationListenerMock) Close() error {
+	return nil
+}
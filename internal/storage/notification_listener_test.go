@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/config"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+func Test_NotificationListener(t *testing.T) {
+	tests := []struct {
+		name                 string
+		setupSqlStmts        []TestSqlStmts
+		cleanupSqlStmts      []TestSqlStmts
+		expectedNotification *Notification
+	}{
+		{
+			name: "notifies when a file upload is ready to be processed",
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+								"id", "name"
+							)
+							VALUES (
+								'team_id1', 'Team1'
+							)`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id"
+							)
+							VALUES (
+								'fp_id1', 'file1.pdf', 'http://presigned_url1', 'INITIATED', 'NOT STARTED', 'team_id1'
+							)`,
+				},
+				{
+					Query: `UPDATE public."file_uploads" SET status = 'SUCCESS' WHERE id = 'fp_id1'`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			expectedNotification: &Notification{Channel: FILE_UPLOAD_READY_CHANNEL, Id: "fp_id1"},
+		},
+		{
+			name: "does not notify when a file upload is not ready to be processed",
+			setupSqlStmts: []TestSqlStmts{
+				{
+					Query: `INSERT INTO public."teams" (
+								"id", "name"
+							)
+							VALUES (
+								'team_id1', 'Team1'
+							)`,
+				},
+				{
+					Query: `INSERT INTO public."file_uploads" (
+								"id", "name", "presigned_url", "status", "processing_status", "team_id"
+							)
+							VALUES (
+								'fp_id1', 'file1.pdf', 'http://presigned_url1', 'INITIATED', 'NOT STARTED', 'team_id1'
+							)`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			expectedNotification: nil,
+		},
+	}
+
+	cfg, _ := config.NewConfigFromEnvVars()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listener, err := NewNotificationListener(cfg.TestDbUrl, &utilities.NullLogger{}, FILE_UPLOAD_READY_CHANNEL)
+			assert.NoError(t, err)
+			defer listener.Close()
+
+			runSqlOnDb(t, testDb, tt.setupSqlStmts)
+			defer runSqlOnDb(t, testDb, tt.cleanupSqlStmts)
+
+			var notification *Notification
+			select {
+			case notification = <-listener.Notifications():
+			case <-time.After(500 * time.Millisecond):
+			}
+			assert.Equal(t, tt.expectedNotification, notification)
+		})
+	}
+}
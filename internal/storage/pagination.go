@@ -0,0 +1,184 @@
+package storage
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+// Pagination is keyset based. Every paginated query orders by a list of keyset columns,
+// the last of which is always unique (id). A page token stores the values of these columns
+// for the last row of a page, so the next page starts right after it.
+// None of the keyset expressions may evaluate to NULL.
+type keysetColumn struct {
+	name       string
+	expression string
+	sqlType    string
+	descending bool
+}
+
+type pageCursor struct {
+	Key    string   `json:"k"`
+	Values []string `json:"v"`
+}
+
+// keysetKey identifies the ordering a page token was created for.
+// Tokens are only accepted for the same ordering.
+func keysetKey(name string, columns []keysetColumn) string {
+	parts := []string{name}
+	for _, column := range columns {
+		direction := "ASC"
+		if column.descending {
+			direction = "DESC"
+		}
+		parts = append(parts, fmt.Sprintf("%s %s", column.name, direction))
+	}
+	return strings.Join(parts, ",")
+}
+
+func encodePageToken(key string, values []string) (string, error) {
+	cursorJson, err := json.Marshal(pageCursor{Key: key, Values: values})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(cursorJson), nil
+}
+
+func decodePageToken(token, key string, columnCount int) ([]string, error) {
+	cursorJson, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.New("invalid page token")
+	}
+
+	var cursor pageCursor
+	err = json.Unmarshal(cursorJson, &cursor)
+	if err != nil {
+		return nil, errors.New("invalid page token")
+	}
+
+	if cursor.Key != key || len(cursor.Values) != columnCount {
+		return nil, errors.New("page token does not match the requested order")
+	}
+
+	return cursor.Values, nil
+}
+
+func keysetOrderBySql(columns []keysetColumn) string {
+	orderBy := []string{}
+	for _, column := range columns {
+		if column.descending {
+			orderBy = append(orderBy, fmt.Sprintf("%s DESC", column.expression))
+		} else {
+			orderBy = append(orderBy, fmt.Sprintf("%s ASC", column.expression))
+		}
+	}
+	return fmt.Sprintf("ORDER BY %s", strings.Join(orderBy, ", "))
+}
+
+// keysetSelectSql returns the keyset columns as text, to be appended to a SELECT list.
+func keysetSelectSql(columns []keysetColumn) string {
+	selects := []string{}
+	for _, column := range columns {
+		selects = append(selects, fmt.Sprintf("(%s)::text", column.expression))
+	}
+	return strings.Join(selects, ", ")
+}
+
+// addKeysetCondition only lets through rows that come after the given values.
+// For columns (a, b, c) this is (a > $1) OR (a = $1 AND b > $2) OR (a = $1 AND b = $2 AND c > $3)
+// with the comparison flipped for descending columns.
+func addKeysetCondition(conditions *sqlConditions, columns []keysetColumn, values []string) {
+	alternatives := []string{}
+	args := []any{}
+	for i, column := range columns {
+		parts := []string{}
+		for j := 0; j < i; j++ {
+			parts = append(parts, fmt.Sprintf("%s = %%s::%s", columns[j].expression, columns[j].sqlType))
+			args = append(args, values[j])
+		}
+		comparison := ">"
+		if column.descending {
+			comparison = "<"
+		}
+		parts = append(parts, fmt.Sprintf("%s %s %%s::%s", column.expression, comparison, column.sqlType))
+		args = append(args, values[i])
+		alternatives = append(alternatives, fmt.Sprintf("(%s)", strings.Join(parts, " AND ")))
+	}
+	conditions.add(fmt.Sprintf("(%s)", strings.Join(alternatives, " OR ")), args...)
+}
+
+type keysetPagination struct {
+	key        string
+	columns    []keysetColumn
+	size       int
+	after      []string
+	seenRows   int
+	lastValues []string
+	hasMore    bool
+}
+
+func newKeysetPagination(name string, columns []keysetColumn, page *model.PageRequest) (*keysetPagination, error) {
+	pagination := &keysetPagination{
+		key:     keysetKey(name, columns),
+		columns: columns,
+	}
+
+	if page == nil {
+		return pagination, nil
+	}
+
+	pagination.size = page.Size()
+	if !utilities.IsBlank(page.Token()) {
+		after, err := decodePageToken(page.Token(), pagination.key, len(columns))
+		if err != nil {
+			return nil, err
+		}
+		pagination.after = after
+	}
+	return pagination, nil
+}
+
+// apply restricts the query to rows after the page token and returns the LIMIT clause.
+// One extra row is fetched to find out whether there is a next page.
+func (p *keysetPagination) apply(conditions *sqlConditions) string {
+	if p.after != nil {
+		addKeysetCondition(conditions, p.columns, p.after)
+	}
+	if p.size == 0 {
+		return ""
+	}
+	return fmt.Sprintf("LIMIT %s", conditions.arg(p.size+1))
+}
+
+func (p *keysetPagination) scanners() ([]string, []any) {
+	values := make([]string, len(p.columns))
+	scanners := make([]any, len(p.columns))
+	for i := range values {
+		scanners[i] = &values[i]
+	}
+	return values, scanners
+}
+
+// next records the keyset values of a scanned row.
+// It returns false for the extra row that only signals the existence of a next page.
+func (p *keysetPagination) next(values []string) bool {
+	p.seenRows++
+	if p.size > 0 && p.seenRows > p.size {
+		p.hasMore = true
+		return false
+	}
+	p.lastValues = values
+	return true
+}
+
+func (p *keysetPagination) nextPageToken() (string, error) {
+	if !p.hasMore {
+		return "", nil
+	}
+	return encodePageToken(p.key, p.lastValues)
+}
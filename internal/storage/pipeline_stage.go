@@ -0,0 +1,566 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+const uniqueViolationErrorCode = "23505"
+
+type PipelineStageAccessor interface {
+	GetPipelineStagesForTeam(team *model.Team) ([]*model.PipelineStage, error)
+	CreateDefaultPipelineStagesForTeam(team *model.Team) ([]*model.PipelineStage, error)
+	CreatePipelineStageForTeam(name string, team *model.Team) (*model.PipelineStage, error)
+	RenamePipelineStageForTeam(id, name string, team *model.Team) error
+	ReorderPipelineStagesForTeam(ids []string, team *model.Team) error
+	DeletePipelineStageForTeam(id string, team *model.Team) error
+	MoveCandidatesToPipelineStageForTeam(candidateIds []string, pipelineStageId, reason, changedByUserId string, team *model.Team) ([]*model.CandidateStageChange, error)
+	GetCandidateStageChangesForTeam(candidateId string, team *model.Team) ([]*model.CandidateStageChange, error)
+}
+
+func (s *Storage) GetPipelineStagesForTeam(team *model.Team) ([]*model.PipelineStage, error) {
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	rows, err := s.db.Query(
+		`SELECT id, name, position
+		FROM public."pipeline_stages"
+		WHERE team_id = $1
+		ORDER BY position ASC, id ASC`,
+		team.Id(),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to select pipeline stages")
+	}
+	defer rows.Close()
+
+	stages := []*model.PipelineStage{}
+	for rows.Next() {
+		var id, name string
+		var position int
+		err := rows.Scan(&id, &name, &position)
+		if err != nil {
+			return nil, utilities.WrapBadError(err, "failed while scanning rows")
+		}
+
+		stage, err := model.NewPipelineStage(model.PipelineStageOptions{
+			Id:       id,
+			Name:     name,
+			Position: position,
+			Team:     team,
+		})
+		if err != nil {
+			// TODO: Log this error?
+			continue
+		}
+
+		stages = append(stages, stage)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to correctly go through pipeline stages rows")
+	}
+	return stages, nil
+}
+
+// CreateDefaultPipelineStagesForTeam only creates the default stages for a team that has no stages.
+// The team row is locked, so concurrent calls cannot create them twice.
+func (s *Storage) CreateDefaultPipelineStagesForTeam(team *model.Team) ([]*model.PipelineStage, error) {
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	tx, err := s.BeginTransaction()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to start db transaction")
+	}
+	defer tx.Rollback()
+
+	var stageCount int
+	row := tx.QueryRow(
+		`SELECT count(p.id)
+		FROM (SELECT id FROM public."teams" WHERE id = $1 FOR UPDATE) AS t
+		LEFT JOIN public."pipeline_stages" AS p ON p.team_id = t.id`,
+		team.Id(),
+	)
+	err = row.Scan(&stageCount)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to count pipeline stages")
+	}
+
+	if stageCount == 0 {
+		for position, name := range model.DefaultPipelineStageNames {
+			id := s.IdGenerator.Generate()
+			result, err := tx.Exec(
+				`INSERT INTO public."pipeline_stages"
+				("id", "team_id", "name", "position")
+				VALUES
+				($1, $2, $3, $4)`,
+				id, team.Id(), name, position,
+			)
+			if err != nil {
+				return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while inserting PipelineStage: %s", id))
+			}
+			rowsAffected, err := result.RowsAffected()
+			if err != nil {
+				return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while inserting PipelineStage and changing db: %s", id))
+			}
+			if rowsAffected != 1 {
+				return nil, utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when inserting PipelineStage in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+			}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "dbError while creating default pipeline stages tx")
+	}
+
+	return s.GetPipelineStagesForTeam(team)
+}
+
+// CreatePipelineStageForTeam adds the stage after all the existing stages of the team.
+func (s *Storage) CreatePipelineStageForTeam(name string, team *model.Team) (*model.PipelineStage, error) {
+	if utilities.IsBlank(name) {
+		return nil, errors.New("name cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	id := s.IdGenerator.Generate()
+	stage, err := model.NewPipelineStage(model.PipelineStageOptions{
+		Id:   id,
+		Name: name,
+		Team: team,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var position int
+	row := s.db.QueryRow(
+		`INSERT INTO public."pipeline_stages"
+		("id", "team_id", "name", "position")
+		SELECT $1, $2, $3, COALESCE(MAX(position) + 1, 0)
+		FROM public."pipeline_stages"
+		WHERE team_id = $2
+		ON CONFLICT ("team_id", "name") DO NOTHING
+		RETURNING position`,
+		id, team.Id(), stage.Name(),
+	)
+	err = row.Scan(&position)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Errorf("pipeline stage %s already exists", stage.Name())
+		}
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while inserting PipelineStage: %s", id))
+	}
+
+	return model.NewPipelineStage(model.PipelineStageOptions{
+		Id:       id,
+		Name:     stage.Name(),
+		Position: position,
+		Team:     team,
+	})
+}
+
+func (s *Storage) RenamePipelineStageForTeam(id, name string, team *model.Team) error {
+	if utilities.IsBlank(id) {
+		return errors.New("id cannot be blank")
+	}
+
+	if utilities.IsBlank(name) {
+		return errors.New("name cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return errors.New("team cannot be blank")
+	}
+
+	stage, err := model.NewPipelineStage(model.PipelineStageOptions{
+		Id:   id,
+		Name: name,
+		Team: team,
+	})
+	if err != nil {
+		return err
+	}
+
+	result, err := s.db.Exec(
+		`UPDATE public."pipeline_stages" SET "name" = $3 WHERE id = $1 AND team_id = $2`,
+		id, team.Id(), stage.Name(),
+	)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == uniqueViolationErrorCode {
+			return errors.Errorf("pipeline stage %s already exists", stage.Name())
+		}
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while renaming PipelineStage: %s", id))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while checking affected row while renaming PipelineStage: %s", id))
+	}
+	if rowsAffected == 0 {
+		return errors.Errorf("no pipeline stage for id %s", id)
+	}
+	if rowsAffected != 1 {
+		return utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when renaming PipelineStage in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+	return nil
+}
+
+// ReorderPipelineStagesForTeam sets the order of all the stages of the team. Every stage has to be listed exactly once.
+func (s *Storage) ReorderPipelineStagesForTeam(ids []string, team *model.Team) error {
+	if len(ids) == 0 {
+		return errors.New("ids cannot be empty")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return errors.New("team cannot be blank")
+	}
+
+	tx, err := s.BeginTransaction()
+	if err != nil {
+		return utilities.WrapBadError(err, "failed to start db transaction")
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query(
+		`SELECT id FROM public."pipeline_stages" WHERE team_id = $1 FOR UPDATE`,
+		team.Id(),
+	)
+	if err != nil {
+		return utilities.WrapBadError(err, "failed to select pipeline stages to reorder")
+	}
+	existingIds := map[string]bool{}
+	for rows.Next() {
+		var id string
+		err := rows.Scan(&id)
+		if err != nil {
+			rows.Close()
+			return utilities.WrapBadError(err, "failed while scanning rows")
+		}
+		existingIds[id] = true
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return utilities.WrapBadError(err, "failed to correctly go through pipeline stages rows")
+	}
+
+	listedIds := map[string]bool{}
+	for _, id := range ids {
+		if !existingIds[id] {
+			return errors.Errorf("no pipeline stage for id %s", id)
+		}
+		if listedIds[id] {
+			return errors.Errorf("pipeline stage %s is listed more than once", id)
+		}
+		listedIds[id] = true
+	}
+	if len(listedIds) != len(existingIds) {
+		return errors.New("every pipeline stage of the team needs to be listed")
+	}
+
+	result, err := tx.Exec(
+		`UPDATE public."pipeline_stages" AS p SET "position" = o.position - 1
+		FROM unnest($2::text[]) WITH ORDINALITY AS o(id, position)
+		WHERE p.id = o.id AND p.team_id = $1`,
+		team.Id(), pq.Array(ids),
+	)
+	if err != nil {
+		return utilities.WrapBadError(err, "dbError while reordering PipelineStages")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return utilities.WrapBadError(err, "dbError while checking affected rows while reordering PipelineStages")
+	}
+	if rowsAffected != int64(len(ids)) {
+		return utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when reordering PipelineStages in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return utilities.WrapBadError(err, "dbError while reordering pipeline stages tx")
+	}
+	return nil
+}
+
+// DeletePipelineStageForTeam only deletes stages without candidates. The stage history keeps the name of the deleted stage.
+func (s *Storage) DeletePipelineStageForTeam(id string, team *model.Team) error {
+	if utilities.IsBlank(id) {
+		return errors.New("id cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return errors.New("team cannot be blank")
+	}
+
+	tx, err := s.BeginTransaction()
+	if err != nil {
+		return utilities.WrapBadError(err, "failed to start db transaction")
+	}
+	defer tx.Rollback()
+
+	var candidateCount, jobApplicationCount int
+	row := tx.QueryRow(
+		`SELECT
+		(SELECT count(*) FROM public."candidates" WHERE pipeline_stage_id = p.id),
+		(SELECT count(*) FROM public."job_applications" WHERE pipeline_stage_id = p.id)
+		FROM (SELECT id FROM public."pipeline_stages" WHERE id = $1 AND team_id = $2 FOR UPDATE) AS p`,
+		id, team.Id(),
+	)
+	err = row.Scan(&candidateCount, &jobApplicationCount)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errors.Errorf("no pipeline stage for id %s", id)
+		}
+		return utilities.WrapBadError(err, fmt.Sprintf("failed to count candidates in PipelineStage: %s", id))
+	}
+	if candidateCount > 0 {
+		return errors.Errorf("cannot delete pipeline stage %s while it has %d candidates", id, candidateCount)
+	}
+	if jobApplicationCount > 0 {
+		return errors.Errorf("cannot delete pipeline stage %s while it has %d job applications", id, jobApplicationCount)
+	}
+
+	result, err := tx.Exec(
+		`DELETE FROM public."pipeline_stages" WHERE id = $1 AND team_id = $2`,
+		id, team.Id(),
+	)
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while deleting PipelineStage: %s", id))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while deleting PipelineStage and changing db: %s", id))
+	}
+	if rowsAffected != 1 {
+		return utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when deleting PipelineStage in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return utilities.WrapBadError(err, "dbError while deleting pipeline stage tx")
+	}
+	return nil
+}
+
+// MoveCandidatesToPipelineStageForTeam moves all the candidates or none of them. Candidates already in the stage are left as they are,
+// so only the candidates that actually moved get a stage change.
+func (s *Storage) MoveCandidatesToPipelineStageForTeam(candidateIds []string, pipelineStageId, reason, changedByUserId string, team *model.Team) ([]*model.CandidateStageChange, error) {
+	if len(candidateIds) == 0 {
+		return nil, errors.New("candidateIds cannot be empty")
+	}
+
+	for _, id := range candidateIds {
+		if utilities.IsBlank(id) {
+			return nil, errors.New("candidateIds cannot contain a blank id")
+		}
+	}
+
+	if utilities.IsBlank(pipelineStageId) {
+		return nil, errors.New("pipelineStageId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	tx, err := s.BeginTransaction()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to start db transaction")
+	}
+	defer tx.Rollback()
+
+	// The stage is locked so that it cannot be deleted while candidates are moved into it.
+	var stageName string
+	row := tx.QueryRow(
+		`SELECT name FROM public."pipeline_stages" WHERE id = $1 AND team_id = $2 FOR SHARE`,
+		pipelineStageId, team.Id(),
+	)
+	err = row.Scan(&stageName)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Errorf("no pipeline stage for id %s", pipelineStageId)
+		}
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("failed to select PipelineStage: %s", pipelineStageId))
+	}
+
+	rows, err := tx.Query(
+		`SELECT c.id, c.pipeline_stage_id, p.name
+		FROM public."candidates" AS c
+		LEFT JOIN public."pipeline_stages" AS p ON p.id = c.pipeline_stage_id
+		WHERE c.team_id = $1 AND c.id = ANY($2)
+		FOR UPDATE OF c`,
+		team.Id(), pq.Array(candidateIds),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to select candidates to move")
+	}
+	type currentStage struct {
+		id   string
+		name string
+	}
+	currentStages := map[string]currentStage{}
+	for rows.Next() {
+		var id string
+		var currentStageId, currentStageName sql.NullString
+		err := rows.Scan(&id, &currentStageId, &currentStageName)
+		if err != nil {
+			rows.Close()
+			return nil, utilities.WrapBadError(err, "failed while scanning rows")
+		}
+		currentStages[id] = currentStage{id: currentStageId.String, name: currentStageName.String}
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to correctly go through candidates rows")
+	}
+
+	changes := []*model.CandidateStageChange{}
+	movedCandidateIds := map[string]bool{}
+	for _, candidateId := range candidateIds {
+		current, ok := currentStages[candidateId]
+		if !ok {
+			return nil, errors.Errorf("no candidate for id %s", candidateId)
+		}
+		if current.id == pipelineStageId || movedCandidateIds[candidateId] {
+			continue
+		}
+		movedCandidateIds[candidateId] = true
+
+		id := s.IdGenerator.Generate()
+		var changedAt time.Time
+		var changedByUserEmail sql.NullString
+		row := tx.QueryRow(
+			`INSERT INTO public."candidate_stage_changes"
+			("id", "candidate_id", "from_stage_id", "from_stage_name", "to_stage_id", "to_stage_name", "changed_by_user_id", "reason")
+			VALUES
+			($1, $2, NULLIF($3, ''), NULLIF($4, ''), $5, $6, NULLIF($7, ''), NULLIF($8, ''))
+			RETURNING created_at, (SELECT email FROM public."users" WHERE id = changed_by_user_id)`,
+			id, candidateId, current.id, current.name, pipelineStageId, stageName, changedByUserId, reason,
+		)
+		err = row.Scan(&changedAt, &changedByUserEmail)
+		if err != nil {
+			return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while inserting CandidateStageChange: %s", id))
+		}
+
+		change, err := model.NewCandidateStageChange(model.CandidateStageChangeOptions{
+			Id:                 id,
+			CandidateId:        candidateId,
+			FromStageId:        current.id,
+			FromStageName:      current.name,
+			ToStageId:          pipelineStageId,
+			ToStageName:        stageName,
+			ChangedByUserId:    changedByUserId,
+			ChangedByUserEmail: changedByUserEmail.String,
+			Reason:             reason,
+			ChangedAt:          changedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	if len(changes) > 0 {
+		ids := []string{}
+		for _, change := range changes {
+			ids = append(ids, change.CandidateId())
+		}
+		result, err := tx.Exec(
+			`UPDATE public."candidates" SET "pipeline_stage_id" = $1 WHERE team_id = $2 AND id = ANY($3)`,
+			pipelineStageId, team.Id(), pq.Array(ids),
+		)
+		if err != nil {
+			return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while moving Candidates to PipelineStage: %s", pipelineStageId))
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while checking affected rows while moving Candidates to PipelineStage: %s", pipelineStageId))
+		}
+		if rowsAffected != int64(len(ids)) {
+			return nil, utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when moving Candidates in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "dbError while moving candidates tx")
+	}
+	return changes, nil
+}
+
+// GetCandidateStageChangesForTeam returns the stage history of a candidate, oldest first.
+func (s *Storage) GetCandidateStageChangesForTeam(candidateId string, team *model.Team) ([]*model.CandidateStageChange, error) {
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	rows, err := s.db.Query(
+		`SELECT sc.id, sc.from_stage_id, sc.from_stage_name, sc.to_stage_id, sc.to_stage_name,
+		sc.changed_by_user_id, u.email, sc.reason, sc.created_at
+		FROM public."candidate_stage_changes" AS sc
+		JOIN public."candidates" AS c ON c.id = sc.candidate_id
+		LEFT JOIN public."users" AS u ON u.id = sc.changed_by_user_id
+		WHERE sc.candidate_id = $1 AND c.team_id = $2
+		ORDER BY sc.created_at ASC, sc.id ASC`,
+		candidateId, team.Id(),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to select candidate stage changes")
+	}
+	defer rows.Close()
+
+	changes := []*model.CandidateStageChange{}
+	for rows.Next() {
+		var id, toStageName string
+		var fromStageId, fromStageName, toStageId, changedByUserId, changedByUserEmail, reason sql.NullString
+		var changedAt time.Time
+		err := rows.Scan(&id, &fromStageId, &fromStageName, &toStageId, &toStageName, &changedByUserId, &changedByUserEmail, &reason, &changedAt)
+		if err != nil {
+			return nil, utilities.WrapBadError(err, "failed while scanning rows")
+		}
+
+		change, err := model.NewCandidateStageChange(model.CandidateStageChangeOptions{
+			Id:                 id,
+			CandidateId:        candidateId,
+			FromStageId:        fromStageId.String,
+			FromStageName:      fromStageName.String,
+			ToStageId:          toStageId.String,
+			ToStageName:        toStageName,
+			ChangedByUserId:    changedByUserId.String,
+			ChangedByUserEmail: changedByUserEmail.String,
+			Reason:             reason.String,
+			ChangedAt:          changedAt,
+		})
+		if err != nil {
+			// TODO: Log this error?
+			continue
+		}
+
+		changes = append(changes, change)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to correctly go through candidate stage changes rows")
+	}
+	return changes, nil
+}
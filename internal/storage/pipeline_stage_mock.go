@@ -0,0 +1,46 @@
+package storage
+
+import "github.com/vipulvpatil/candidate-tracker-go/internal/model"
+
+type PipelineStageAccessorConfigurableMock struct {
+	GetPipelineStagesForTeamInternal             func(team *model.Team) ([]*model.PipelineStage, error)
+	CreateDefaultPipelineStagesForTeamInternal   func(team *model.Team) ([]*model.PipelineStage, error)
+	CreatePipelineStageForTeamInternal           func(name string, team *model.Team) (*model.PipelineStage, error)
+	RenamePipelineStageForTeamInternal           func(id, name string, team *model.Team) error
+	ReorderPipelineStagesForTeamInternal         func(ids []string, team *model.Team) error
+	DeletePipelineStageForTeamInternal           func(id string, team *model.Team) error
+	MoveCandidatesToPipelineStageForTeamInternal func(candidateIds []string, pipelineStageId, reason, changedByUserId string, team *model.Team) ([]*model.CandidateStageChange, error)
+	GetCandidateStageChangesForTeamInternal      func(candidateId string, team *model.Team) ([]*model.CandidateStageChange, error)
+}
+
+func (p *PipelineStageAccessorConfigurableMock) GetPipelineStagesForTeam(team *model.Team) ([]*model.PipelineStage, error) {
+	return p.GetPipelineStagesForTeamInternal(team)
+}
+
+func (p *PipelineStageAccessorConfigurableMock) CreateDefaultPipelineStagesForTeam(team *model.Team) ([]*model.PipelineStage, error) {
+	return p.CreateDefaultPipelineStagesForTeamInternal(team)
+}
+
+func (p *PipelineStageAccessorConfigurableMock) CreatePipelineStageForTeam(name string, team *model.Team) (*model.PipelineStage, error) {
+	return p.CreatePipelineStageForTeamInternal(name, team)
+}
+
+func (p *PipelineStageAccessorConfigurableMock) RenamePipelineStageForTeam(id, name string, team *model.Team) error {
+	return p.RenamePipelineStageForTeamInternal(id, name, team)
+}
+
+func (p *PipelineStageAccessorConfigurableMock) ReorderPipelineStagesForTeam(ids []string, team *model.Team) error {
+	return p.ReorderPipelineStagesForTeamInternal(ids, team)
+}
+
+func (p *PipelineStageAccessorConfigurableMock) DeletePipelineStageForTeam(id string, team *model.Team) error {
+	return p.DeletePipelineStageForTeamInternal(id, team)
+}
+
+func (p *PipelineStageAccessorConfigurableMock) MoveCandidatesToPipelineStageForTeam(candidateIds []string, pipelineStageId, reason, changedByUserId string, team *model.Team) ([]*model.CandidateStageChange, error) {
+	return p.MoveCandidatesToPipelineStageForTeamInternal(candidateIds, pipelineStageId, reason, changedByUserId, team)
+}
+
+func (p *PipelineStageAccessorConfigurableMock) GetCandidateStageChangesForTeam(candidateId string, team *model.Team) ([]*model.CandidateStageChange, error) {
+	return p.GetCandidateStageChangesForTeamInternal(candidateId, team)
+}
@@ -0,0 +1,990 @@
+package storage
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+func pipelineStageNamesInDb(t *testing.T, db *sql.DB, teamId string) []string {
+	rows, err := db.Query(
+		`SELECT name FROM public."pipeline_stages" WHERE team_id = $1 ORDER BY position ASC, id ASC`,
+		teamId,
+	)
+	assert.NoError(t, err)
+	defer rows.Close()
+	names := []string{}
+	for rows.Next() {
+		var name string
+		assert.NoError(t, rows.Scan(&name))
+		names = append(names, name)
+	}
+	return names
+}
+
+func Test_GetPipelineStagesForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	stage1, _ := model.NewPipelineStage(model.PipelineStageOptions{Id: "ps_id1", Name: "Sourced", Position: 0, Team: team})
+	stage2, _ := model.NewPipelineStage(model.PipelineStageOptions{Id: "ps_id2", Name: "Screening", Position: 1, Team: team})
+	tests := []struct {
+		name            string
+		input           *model.Team
+		output          []*model.PipelineStage
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name:          "errors when team is empty",
+			input:         nil,
+			output:        nil,
+			errorExpected: true,
+			errorString:   "team cannot be blank",
+		},
+		{
+			name:   "returns the stages of the team in order",
+			input:  team,
+			output: []*model.PipelineStage{stage1, stage2},
+			setupSqlStmts: []TestSqlStmts{
+				{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+				{
+					Query: `INSERT INTO public."pipeline_stages" ("id", "team_id", "name", "position")
+							VALUES ('ps_id2', 'team_id1', 'Screening', 1), ('ps_id1', 'team_id1', 'Sourced', 0), ('ps_id3', 'team_id2', 'Sourced', 0)`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			stages, err := s.GetPipelineStagesForTeam(tt.input)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			assert.Equal(t, tt.output, stages)
+		})
+	}
+}
+
+func Test_CreateDefaultPipelineStagesForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	tests := []struct {
+		name            string
+		input           *model.Team
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		dbUpdateCheck   func(db *sql.DB) bool
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name:          "errors when team is empty",
+			input:         nil,
+			errorExpected: true,
+			errorString:   "team cannot be blank",
+		},
+		{
+			name:  "does nothing when the team already has stages",
+			input: team,
+			setupSqlStmts: []TestSqlStmts{
+				{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1')`},
+				{
+					Query: `INSERT INTO public."pipeline_stages" ("id", "team_id", "name", "position")
+							VALUES ('ps_id1', 'team_id1', 'Applied', 0)`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				assert.Equal(t, []string{"Applied"}, pipelineStageNamesInDb(t, db, "team_id1"))
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name:  "creates the default stages",
+			input: team,
+			setupSqlStmts: []TestSqlStmts{
+				{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1')`},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				assert.Equal(t, model.DefaultPipelineStageNames, pipelineStageNamesInDb(t, db, "team_id1"))
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db:          testDb,
+					IdGenerator: &utilities.IdGeneratorMockSeries{Series: []string{"ps_id1", "ps_id2", "ps_id3", "ps_id4", "ps_id5", "ps_id6"}},
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			_, err := s.CreateDefaultPipelineStagesForTeam(tt.input)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_CreatePipelineStageForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	stage, _ := model.NewPipelineStage(model.PipelineStageOptions{Id: "ps_id3", Name: "Offer", Position: 2, Team: team})
+	tests := []struct {
+		name  string
+		input struct {
+			name string
+			team *model.Team
+		}
+		output          *model.PipelineStage
+		setupSqlStmts   []TestSqlStmts
+		cleanupSqlStmts []TestSqlStmts
+		errorExpected   bool
+		errorString     string
+	}{
+		{
+			name: "errors when name is empty",
+			input: struct {
+				name string
+				team *model.Team
+			}{
+				team: team,
+			},
+			output:        nil,
+			errorExpected: true,
+			errorString:   "name cannot be blank",
+		},
+		{
+			name: "errors when team is empty",
+			input: struct {
+				name string
+				team *model.Team
+			}{
+				name: "Offer",
+			},
+			output:        nil,
+			errorExpected: true,
+			errorString:   "team cannot be blank",
+		},
+		{
+			name: "errors when the team already has a stage with the name",
+			input: struct {
+				name string
+				team *model.Team
+			}{
+				name: "Screening",
+				team: team,
+			},
+			output: nil,
+			setupSqlStmts: []TestSqlStmts{
+				{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1')`},
+				{
+					Query: `INSERT INTO public."pipeline_stages" ("id", "team_id", "name", "position")
+							VALUES ('ps_id1', 'team_id1', 'Sourced', 0), ('ps_id2', 'team_id1', 'Screening', 1)`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			errorExpected: true,
+			errorString:   "pipeline stage Screening already exists",
+		},
+		{
+			name: "adds the stage after the existing stages",
+			input: struct {
+				name string
+				team *model.Team
+			}{
+				name: " Offer ",
+				team: team,
+			},
+			output: stage,
+			setupSqlStmts: []TestSqlStmts{
+				{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1')`},
+				{
+					Query: `INSERT INTO public."pipeline_stages" ("id", "team_id", "name", "position")
+							VALUES ('ps_id1', 'team_id1', 'Sourced', 0), ('ps_id2', 'team_id1', 'Screening', 1)`,
+				},
+			},
+			cleanupSqlStmts: []TestSqlStmts{
+				{Query: `DELETE FROM public."teams" WHERE id = 'team_id1'`},
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db:          testDb,
+					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "ps_id3"},
+				},
+			)
+
+			runSqlOnDb(t, s.db, tt.setupSqlStmts)
+			defer runSqlOnDb(t, s.db, tt.cleanupSqlStmts)
+			stage, err := s.CreatePipelineStageForTeam(tt.input.name, tt.input.team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			assert.Equal(t, tt.output, stage)
+		})
+	}
+}
+
+func Test_RenamePipelineStageForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."pipeline_stages" ("id", "team_id", "name", "position")
+					VALUES ('ps_id1', 'team_id1', 'Sourced', 0), ('ps_id2', 'team_id1', 'Screening', 1), ('ps_id3', 'team_id2', 'Sourced', 0)`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+	tests := []struct {
+		name  string
+		input struct {
+			id   string
+			name string
+			team *model.Team
+		}
+		dbUpdateCheck func(db *sql.DB) bool
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when id is empty",
+			input: struct {
+				id   string
+				name string
+				team *model.Team
+			}{
+				name: "Applied",
+				team: team,
+			},
+			errorExpected: true,
+			errorString:   "id cannot be blank",
+		},
+		{
+			name: "errors when name is empty",
+			input: struct {
+				id   string
+				name string
+				team *model.Team
+			}{
+				id:   "ps_id1",
+				team: team,
+			},
+			errorExpected: true,
+			errorString:   "name cannot be blank",
+		},
+		{
+			name: "errors when stage belongs to another team",
+			input: struct {
+				id   string
+				name string
+				team *model.Team
+			}{
+				id:   "ps_id3",
+				name: "Applied",
+				team: team,
+			},
+			errorExpected: true,
+			errorString:   "no pipeline stage for id ps_id3",
+		},
+		{
+			name: "errors when the team already has a stage with the name",
+			input: struct {
+				id   string
+				name string
+				team *model.Team
+			}{
+				id:   "ps_id1",
+				name: "Screening",
+				team: team,
+			},
+			errorExpected: true,
+			errorString:   "pipeline stage Screening already exists",
+		},
+		{
+			name: "successfully renames the stage",
+			input: struct {
+				id   string
+				name string
+				team *model.Team
+			}{
+				id:   "ps_id1",
+				name: "Applied",
+				team: team,
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				assert.Equal(t, []string{"Applied", "Screening"}, pipelineStageNamesInDb(t, db, "team_id1"))
+				assert.Equal(t, []string{"Sourced"}, pipelineStageNamesInDb(t, db, "team_id2"))
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			err := s.RenamePipelineStageForTeam(tt.input.id, tt.input.name, tt.input.team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_ReorderPipelineStagesForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."pipeline_stages" ("id", "team_id", "name", "position")
+					VALUES ('ps_id1', 'team_id1', 'Sourced', 0), ('ps_id2', 'team_id1', 'Screening', 1),
+					('ps_id3', 'team_id1', 'Interview', 2), ('ps_id4', 'team_id2', 'Sourced', 0)`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+	tests := []struct {
+		name  string
+		input struct {
+			ids  []string
+			team *model.Team
+		}
+		dbUpdateCheck func(db *sql.DB) bool
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when ids are empty",
+			input: struct {
+				ids  []string
+				team *model.Team
+			}{
+				team: team,
+			},
+			errorExpected: true,
+			errorString:   "ids cannot be empty",
+		},
+		{
+			name: "errors when a stage belongs to another team",
+			input: struct {
+				ids  []string
+				team *model.Team
+			}{
+				ids:  []string{"ps_id3", "ps_id2", "ps_id4"},
+				team: team,
+			},
+			errorExpected: true,
+			errorString:   "no pipeline stage for id ps_id4",
+		},
+		{
+			name: "errors when a stage is listed twice",
+			input: struct {
+				ids  []string
+				team *model.Team
+			}{
+				ids:  []string{"ps_id3", "ps_id2", "ps_id3"},
+				team: team,
+			},
+			errorExpected: true,
+			errorString:   "pipeline stage ps_id3 is listed more than once",
+		},
+		{
+			name: "errors when a stage is missing",
+			input: struct {
+				ids  []string
+				team *model.Team
+			}{
+				ids:  []string{"ps_id3", "ps_id2"},
+				team: team,
+			},
+			errorExpected: true,
+			errorString:   "every pipeline stage of the team needs to be listed",
+		},
+		{
+			name: "successfully reorders the stages",
+			input: struct {
+				ids  []string
+				team *model.Team
+			}{
+				ids:  []string{"ps_id3", "ps_id1", "ps_id2"},
+				team: team,
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				assert.Equal(t, []string{"Interview", "Sourced", "Screening"}, pipelineStageNamesInDb(t, db, "team_id1"))
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			err := s.ReorderPipelineStagesForTeam(tt.input.ids, tt.input.team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_DeletePipelineStageForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."pipeline_stages" ("id", "team_id", "name", "position")
+					VALUES ('ps_id1', 'team_id1', 'Sourced', 0), ('ps_id2', 'team_id1', 'Screening', 1), ('ps_id3', 'team_id2', 'Sourced', 0), ('ps_id4', 'team_id1', 'Offer', 2)`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id", "pipeline_stage_id")
+					VALUES ('c_id1', $1, 'team_id1', 'ps_id1')`,
+			Args: []any{&persona},
+		},
+		{Query: `INSERT INTO public."job_openings" ("id", "team_id", "title") VALUES ('jo_id1', 'team_id1', 'Engineer')`},
+		{
+			Query: `INSERT INTO public."job_applications" ("id", "job_opening_id", "candidate_id", "pipeline_stage_id")
+					VALUES ('ja_id1', 'jo_id1', 'c_id1', 'ps_id4')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+	tests := []struct {
+		name  string
+		input struct {
+			id   string
+			team *model.Team
+		}
+		dbUpdateCheck func(db *sql.DB) bool
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when id is empty",
+			input: struct {
+				id   string
+				team *model.Team
+			}{
+				team: team,
+			},
+			errorExpected: true,
+			errorString:   "id cannot be blank",
+		},
+		{
+			name: "errors when team is empty",
+			input: struct {
+				id   string
+				team *model.Team
+			}{
+				id: "ps_id2",
+			},
+			errorExpected: true,
+			errorString:   "team cannot be blank",
+		},
+		{
+			name: "errors when stage belongs to another team",
+			input: struct {
+				id   string
+				team *model.Team
+			}{
+				id:   "ps_id3",
+				team: team,
+			},
+			errorExpected: true,
+			errorString:   "no pipeline stage for id ps_id3",
+		},
+		{
+			name: "errors when stage has candidates",
+			input: struct {
+				id   string
+				team *model.Team
+			}{
+				id:   "ps_id1",
+				team: team,
+			},
+			errorExpected: true,
+			errorString:   "cannot delete pipeline stage ps_id1 while it has 1 candidates",
+		},
+		{
+			name: "errors when stage has job applications",
+			input: struct {
+				id   string
+				team *model.Team
+			}{
+				id:   "ps_id4",
+				team: team,
+			},
+			errorExpected: true,
+			errorString:   "cannot delete pipeline stage ps_id4 while it has 1 job applications",
+		},
+		{
+			name: "successfully deletes the stage",
+			input: struct {
+				id   string
+				team *model.Team
+			}{
+				id:   "ps_id2",
+				team: team,
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				assert.Equal(t, []string{"Sourced", "Offer"}, pipelineStageNamesInDb(t, db, "team_id1"))
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db: testDb,
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			err := s.DeletePipelineStageForTeam(tt.input.id, tt.input.team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_MoveCandidatesToPipelineStageForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{Query: `INSERT INTO public."users" ("id", "email", "team_id") VALUES ('user_id1', 'test@example.com', 'team_id1')`},
+		{
+			Query: `INSERT INTO public."pipeline_stages" ("id", "team_id", "name", "position")
+					VALUES ('ps_id1', 'team_id1', 'Sourced', 0), ('ps_id2', 'team_id1', 'Screening', 1), ('ps_id3', 'team_id2', 'Sourced', 0)`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id", "pipeline_stage_id")
+					VALUES ('c_id1', $1, 'team_id1', NULL), ('c_id2', $1, 'team_id1', 'ps_id1'), ('c_id3', $1, 'team_id1', 'ps_id2'), ('c_id4', $1, 'team_id2', NULL)`,
+			Args: []any{&persona},
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."users" WHERE id = 'user_id1'`},
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+	stagesInDb := func(t *testing.T, db *sql.DB) map[string]string {
+		rows, err := db.Query(`SELECT id, COALESCE(pipeline_stage_id, '') FROM public."candidates" WHERE team_id = 'team_id1'`)
+		assert.NoError(t, err)
+		defer rows.Close()
+		stages := map[string]string{}
+		for rows.Next() {
+			var id, stageId string
+			assert.NoError(t, rows.Scan(&id, &stageId))
+			stages[id] = stageId
+		}
+		return stages
+	}
+	tests := []struct {
+		name  string
+		input struct {
+			candidateIds    []string
+			pipelineStageId string
+			reason          string
+			changedByUserId string
+			team            *model.Team
+		}
+		output        []*model.CandidateStageChange
+		dbUpdateCheck func(db *sql.DB) bool
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when candidateIds are empty",
+			input: struct {
+				candidateIds    []string
+				pipelineStageId string
+				reason          string
+				changedByUserId string
+				team            *model.Team
+			}{
+				pipelineStageId: "ps_id2",
+				team:            team,
+			},
+			errorExpected: true,
+			errorString:   "candidateIds cannot be empty",
+		},
+		{
+			name: "errors when pipelineStageId is empty",
+			input: struct {
+				candidateIds    []string
+				pipelineStageId string
+				reason          string
+				changedByUserId string
+				team            *model.Team
+			}{
+				candidateIds: []string{"c_id1"},
+				team:         team,
+			},
+			errorExpected: true,
+			errorString:   "pipelineStageId cannot be blank",
+		},
+		{
+			name: "errors when stage belongs to another team",
+			input: struct {
+				candidateIds    []string
+				pipelineStageId string
+				reason          string
+				changedByUserId string
+				team            *model.Team
+			}{
+				candidateIds:    []string{"c_id1"},
+				pipelineStageId: "ps_id3",
+				team:            team,
+			},
+			errorExpected: true,
+			errorString:   "no pipeline stage for id ps_id3",
+		},
+		{
+			name: "moves no candidate when one of them belongs to another team",
+			input: struct {
+				candidateIds    []string
+				pipelineStageId string
+				reason          string
+				changedByUserId string
+				team            *model.Team
+			}{
+				candidateIds:    []string{"c_id1", "c_id4"},
+				pipelineStageId: "ps_id2",
+				team:            team,
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				assert.Equal(t, map[string]string{"c_id1": "", "c_id2": "ps_id1", "c_id3": "ps_id2"}, stagesInDb(t, db))
+				return true
+			},
+			errorExpected: true,
+			errorString:   "no candidate for id c_id4",
+		},
+		{
+			name: "moves the candidates and records the changes",
+			input: struct {
+				candidateIds    []string
+				pipelineStageId string
+				reason          string
+				changedByUserId string
+				team            *model.Team
+			}{
+				candidateIds:    []string{"c_id1", "c_id2", "c_id3"},
+				pipelineStageId: "ps_id2",
+				reason:          "passed the first call",
+				changedByUserId: "user_id1",
+				team:            team,
+			},
+			dbUpdateCheck: func(db *sql.DB) bool {
+				assert.Equal(t, map[string]string{"c_id1": "ps_id2", "c_id2": "ps_id2", "c_id3": "ps_id2"}, stagesInDb(t, db))
+				rows, err := db.Query(
+					`SELECT candidate_id, COALESCE(from_stage_name, ''), to_stage_name, changed_by_user_id, reason
+					FROM public."candidate_stage_changes" ORDER BY candidate_id`,
+				)
+				assert.NoError(t, err)
+				defer rows.Close()
+				changes := [][]string{}
+				for rows.Next() {
+					var candidateId, fromStageName, toStageName, changedByUserId, reason string
+					assert.NoError(t, rows.Scan(&candidateId, &fromStageName, &toStageName, &changedByUserId, &reason))
+					changes = append(changes, []string{candidateId, fromStageName, toStageName, changedByUserId, reason})
+				}
+				assert.Equal(t, [][]string{
+					{"c_id1", "", "Screening", "user_id1", "passed the first call"},
+					{"c_id2", "Sourced", "Screening", "user_id1", "passed the first call"},
+				}, changes)
+				return true
+			},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db:          testDb,
+					IdGenerator: &utilities.IdGeneratorMockSeries{Series: []string{"csc_id1", "csc_id2", "csc_id3"}},
+				},
+			)
+
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+			changes, err := s.MoveCandidatesToPipelineStageForTeam(
+				tt.input.candidateIds, tt.input.pipelineStageId, tt.input.reason, tt.input.changedByUserId, tt.input.team,
+			)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Len(t, changes, 2)
+				for _, change := range changes {
+					assert.Equal(t, "test@example.com", change.ChangedByUserEmail())
+					assert.False(t, change.ChangedAt().IsZero())
+				}
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if tt.dbUpdateCheck != nil {
+				assert.True(t, tt.dbUpdateCheck(s.db))
+			}
+		})
+	}
+}
+
+func Test_GetCandidateStageChangesForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{Query: `INSERT INTO public."users" ("id", "email", "team_id") VALUES ('user_id1', 'test@example.com', 'team_id1')`},
+		{
+			Query: `INSERT INTO public."pipeline_stages" ("id", "team_id", "name", "position")
+					VALUES ('ps_id1', 'team_id1', 'Sourced', 0), ('ps_id2', 'team_id1', 'Screening', 1)`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id", "pipeline_stage_id")
+					VALUES ('c_id1', $1, 'team_id1', 'ps_id2'), ('c_id2', $1, 'team_id2', NULL)`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."candidate_stage_changes" (
+						"id", "candidate_id", "from_stage_id", "from_stage_name", "to_stage_id", "to_stage_name", "changed_by_user_id", "reason", "created_at"
+					)
+					VALUES (
+						'csc_id2', 'c_id1', 'ps_id1', 'Sourced', 'ps_id2', 'Screening', 'user_id1', 'passed the first call', '2023-01-02 00:00:00+00'
+					),(
+						'csc_id1', 'c_id1', NULL, NULL, 'ps_id1', 'Sourced', NULL, NULL, '2023-01-01 00:00:00+00'
+					),(
+						'csc_id3', 'c_id2', NULL, NULL, NULL, 'Sourced', NULL, NULL, '2023-01-01 00:00:00+00'
+					)`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."users" WHERE id = 'user_id1'`},
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+	tests := []struct {
+		name  string
+		input struct {
+			candidateId string
+			team        *model.Team
+		}
+		outputIds     []string
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when candidateId is empty",
+			input: struct {
+				candidateId string
+				team        *model.Team
+			}{
+				team: team,
+			},
+			outputIds:     []string{},
+			errorExpected: true,
+			errorString:   "candidateId cannot be blank",
+		},
+		{
+			name: "returns nothing for a candidate of another team",
+			input: struct {
+				candidateId string
+				team        *model.Team
+			}{
+				candidateId: "c_id2",
+				team:        team,
+			},
+			outputIds:     []string{},
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name: "returns the stage history oldest first",
+			input: struct {
+				candidateId string
+				team        *model.Team
+			}{
+				candidateId: "c_id1",
+				team:        team,
+			},
+			outputIds:     []string{"csc_id1", "csc_id2"},
+			errorExpected: false,
+			errorString:   "",
+		},
+	}
+
+	s, _ := NewDbStorage(
+		StorageOptions{
+			Db: testDb,
+		},
+	)
+	runSqlOnDb(t, s.db, setupSqlStmts)
+	defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := s.GetCandidateStageChangesForTeam(tt.input.candidateId, tt.input.team)
+			changeIds := []string{}
+			for _, change := range changes {
+				changeIds = append(changeIds, change.Id())
+			}
+			assert.Equal(t, tt.outputIds, changeIds)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+			} else {
+				assert.NotEmpty(t, tt.errorString)
+				assert.EqualError(t, err, tt.errorString)
+			}
+			if len(changes) == 2 {
+				assert.Equal(t, "", changes[0].ChangedByUserEmail())
+				assert.Equal(t, "Sourced", changes[1].FromStageName())
+				assert.Equal(t, "test@example.com", changes[1].ChangedByUserEmail())
+				assert.Equal(t, "passed the first call", changes[1].Reason())
+			}
+		})
+	}
+}
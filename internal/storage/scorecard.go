@@ -0,0 +1,352 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+type ScorecardAccessor interface {
+	GetScorecardCriteriaForTeam(team *model.Team) ([]*model.ScorecardCriterion, error)
+	CreateScorecardCriterionForTeam(name, description string, team *model.Team) (*model.ScorecardCriterion, error)
+	DeleteScorecardCriterionForTeam(id string, team *model.Team) error
+	SubmitScorecardForTeam(candidateId, jobOpeningId, interviewerUserId, comment string, ratings []*model.ScorecardRating, team *model.Team) (*model.Scorecard, error)
+	GetScorecardsForTeam(candidateId, jobOpeningId string, team *model.Team) ([]*model.Scorecard, error)
+}
+
+// GetScorecardCriteriaForTeam returns the criteria in the order they were created.
+func (s *Storage) GetScorecardCriteriaForTeam(team *model.Team) ([]*model.ScorecardCriterion, error) {
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	rows, err := s.db.Query(
+		`SELECT id, name, description
+		FROM public."scorecard_criteria"
+		WHERE team_id = $1
+		ORDER BY created_at ASC, id ASC`,
+		team.Id(),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to select scorecard criteria")
+	}
+	defer rows.Close()
+
+	criteria := []*model.ScorecardCriterion{}
+	for rows.Next() {
+		var id, name, description string
+		err := rows.Scan(&id, &name, &description)
+		if err != nil {
+			return nil, utilities.WrapBadError(err, "failed while scanning rows")
+		}
+
+		criterion, err := model.NewScorecardCriterion(model.ScorecardCriterionOptions{
+			Id:          id,
+			Name:        name,
+			Description: description,
+			Team:        team,
+		})
+		if err != nil {
+			// TODO: Log this error?
+			continue
+		}
+
+		criteria = append(criteria, criterion)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to correctly go through scorecard criteria rows")
+	}
+	return criteria, nil
+}
+
+func (s *Storage) CreateScorecardCriterionForTeam(name, description string, team *model.Team) (*model.ScorecardCriterion, error) {
+	if utilities.IsBlank(name) {
+		return nil, errors.New("name cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	criterion, err := model.NewScorecardCriterion(model.ScorecardCriterionOptions{
+		Id:          s.IdGenerator.Generate(),
+		Name:        name,
+		Description: description,
+		Team:        team,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.db.Exec(
+		`INSERT INTO public."scorecard_criteria"
+		("id", "team_id", "name", "description")
+		VALUES
+		($1, $2, $3, $4)
+		ON CONFLICT ("team_id", "name") DO NOTHING`,
+		criterion.Id(), team.Id(), criterion.Name(), criterion.Description(),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while inserting ScorecardCriterion: %s", criterion.Id()))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while inserting ScorecardCriterion and changing db: %s", criterion.Id()))
+	}
+	if rowsAffected == 0 {
+		return nil, errors.Errorf("scorecard criterion %s already exists", criterion.Name())
+	}
+	if rowsAffected != 1 {
+		return nil, utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when inserting ScorecardCriterion in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+
+	return criterion, nil
+}
+
+// DeleteScorecardCriterionForTeam also removes the ratings already given for the criterion.
+func (s *Storage) DeleteScorecardCriterionForTeam(id string, team *model.Team) error {
+	if utilities.IsBlank(id) {
+		return errors.New("id cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return errors.New("team cannot be blank")
+	}
+
+	result, err := s.db.Exec(
+		`DELETE FROM public."scorecard_criteria" WHERE id = $1 AND team_id = $2`,
+		id, team.Id(),
+	)
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while deleting ScorecardCriterion: %s", id))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while deleting ScorecardCriterion and changing db: %s", id))
+	}
+	if rowsAffected == 0 {
+		return errors.Errorf("no scorecard criterion for id %s", id)
+	}
+	if rowsAffected != 1 {
+		return utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when deleting ScorecardCriterion in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+	return nil
+}
+
+// SubmitScorecardForTeam replaces the interviewer's earlier scorecard for the candidate and job opening, if any.
+// The job opening is optional.
+func (s *Storage) SubmitScorecardForTeam(candidateId, jobOpeningId, interviewerUserId, comment string, ratings []*model.ScorecardRating, team *model.Team) (*model.Scorecard, error) {
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	if len(ratings) == 0 {
+		return nil, errors.New("ratings cannot be empty")
+	}
+
+	if utilities.IsBlank(interviewerUserId) {
+		return nil, errors.New("interviewerUserId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	opts := model.ScorecardOptions{
+		Id:                s.IdGenerator.Generate(),
+		CandidateId:       candidateId,
+		JobOpeningId:      jobOpeningId,
+		InterviewerUserId: interviewerUserId,
+		Comment:           comment,
+		Ratings:           ratings,
+	}
+	_, err := model.NewScorecard(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.BeginTransaction()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to start db transaction")
+	}
+	defer tx.Rollback()
+
+	candidateIdsInTeam, err := idsForTeamUsingTx("candidates", []string{candidateId}, team, tx)
+	if err != nil {
+		return nil, err
+	}
+	if !candidateIdsInTeam[candidateId] {
+		return nil, errors.Errorf("no candidate for id %s", candidateId)
+	}
+
+	if !utilities.IsBlank(jobOpeningId) {
+		jobOpeningIdsInTeam, err := idsForTeamUsingTx("job_openings", []string{jobOpeningId}, team, tx)
+		if err != nil {
+			return nil, err
+		}
+		if !jobOpeningIdsInTeam[jobOpeningId] {
+			return nil, errors.Errorf("no job opening for id %s", jobOpeningId)
+		}
+	}
+
+	criterionIds := []string{}
+	ratingValues := []int64{}
+	for _, rating := range ratings {
+		criterionIds = append(criterionIds, rating.CriterionId())
+		ratingValues = append(ratingValues, int64(rating.Rating()))
+	}
+	criterionIdsInTeam, err := idsForTeamUsingTx("scorecard_criteria", criterionIds, team, tx)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range criterionIds {
+		if !criterionIdsInTeam[id] {
+			return nil, errors.Errorf("no scorecard criterion for id %s", id)
+		}
+	}
+
+	var interviewerUserEmail sql.NullString
+	row := tx.QueryRow(
+		`INSERT INTO public."scorecards"
+		("id", "candidate_id", "job_opening_id", "interviewer_user_id", "comment")
+		VALUES
+		($1, $2, $3, $4, $5)
+		ON CONFLICT ("candidate_id", (COALESCE("job_opening_id", '')), "interviewer_user_id") DO UPDATE
+		SET "comment" = EXCLUDED."comment"
+		RETURNING id, created_at, updated_at, (SELECT email FROM public."users" WHERE id = interviewer_user_id)`,
+		opts.Id, candidateId, sql.NullString{String: jobOpeningId, Valid: !utilities.IsBlank(jobOpeningId)}, interviewerUserId, comment,
+	)
+	err = row.Scan(&opts.Id, &opts.CreatedAt, &opts.UpdatedAt, &interviewerUserEmail)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while upserting Scorecard: %s", opts.Id))
+	}
+	opts.InterviewerUserEmail = interviewerUserEmail.String
+
+	_, err = tx.Exec(`DELETE FROM public."scorecard_ratings" WHERE scorecard_id = $1`, opts.Id)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while deleting earlier ratings of Scorecard: %s", opts.Id))
+	}
+
+	result, err := tx.Exec(
+		`INSERT INTO public."scorecard_ratings"
+		("scorecard_id", "scorecard_criterion_id", "rating")
+		SELECT $1, criterion_id, rating
+		FROM unnest($2::text[], $3::integer[]) AS r(criterion_id, rating)`,
+		opts.Id, pq.Array(criterionIds), pq.Array(ratingValues),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while inserting ratings of Scorecard: %s", opts.Id))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while inserting ratings of Scorecard and changing db: %s", opts.Id))
+	}
+	if rowsAffected != int64(len(ratings)) {
+		return nil, utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when inserting ScorecardRatings in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "dbError while submitting scorecard tx")
+	}
+
+	return model.NewScorecard(opts)
+}
+
+// GetScorecardsForTeam returns the scorecards of a candidate, oldest first.
+// Without a job opening, scorecards for all job openings are returned.
+func (s *Storage) GetScorecardsForTeam(candidateId, jobOpeningId string, team *model.Team) ([]*model.Scorecard, error) {
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	conditions := newSqlConditions(team.Id(), candidateId)
+	if !utilities.IsBlank(jobOpeningId) {
+		conditions.add("s.job_opening_id = %s", jobOpeningId)
+	}
+
+	rows, err := s.db.Query(
+		fmt.Sprintf(
+			`SELECT s.id, s.job_opening_id, s.interviewer_user_id, u.email, s.comment, s.created_at, s.updated_at,
+			array_agg(r.scorecard_criterion_id ORDER BY r.scorecard_criterion_id), array_agg(r.rating ORDER BY r.scorecard_criterion_id)
+		FROM public."scorecards" AS s
+		JOIN public."candidates" AS c ON c.id = s.candidate_id
+		JOIN public."scorecard_ratings" AS r ON r.scorecard_id = s.id
+		LEFT JOIN public."users" AS u ON u.id = s.interviewer_user_id
+		WHERE c.team_id = $1 AND s.candidate_id = $2
+		%s
+		GROUP BY s.id, u.email
+		ORDER BY s.created_at ASC, s.id ASC`,
+			conditions.sql(),
+		),
+		conditions.args...,
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to select scorecards")
+	}
+	defer rows.Close()
+
+	scorecards := []*model.Scorecard{}
+	for rows.Next() {
+		var id, comment string
+		var scorecardJobOpeningId, interviewerUserId, interviewerUserEmail sql.NullString
+		var createdAt, updatedAt time.Time
+		var criterionIds []string
+		var ratingValues []int64
+		err := rows.Scan(
+			&id, &scorecardJobOpeningId, &interviewerUserId, &interviewerUserEmail, &comment, &createdAt, &updatedAt,
+			pq.Array(&criterionIds), pq.Array(&ratingValues),
+		)
+		if err != nil {
+			return nil, utilities.WrapBadError(err, "failed while scanning rows")
+		}
+
+		ratings := []*model.ScorecardRating{}
+		for i, criterionId := range criterionIds {
+			rating, err := model.NewScorecardRating(model.ScorecardRatingOptions{
+				CriterionId: criterionId,
+				Rating:      int(ratingValues[i]),
+			})
+			if err != nil {
+				// TODO: Log this error?
+				continue
+			}
+			ratings = append(ratings, rating)
+		}
+
+		scorecard, err := model.NewScorecard(model.ScorecardOptions{
+			Id:                   id,
+			CandidateId:          candidateId,
+			JobOpeningId:         scorecardJobOpeningId.String,
+			InterviewerUserId:    interviewerUserId.String,
+			InterviewerUserEmail: interviewerUserEmail.String,
+			Comment:              comment,
+			Ratings:              ratings,
+			CreatedAt:            createdAt,
+			UpdatedAt:            updatedAt,
+		})
+		if err != nil {
+			// TODO: Log this error?
+			continue
+		}
+
+		scorecards = append(scorecards, scorecard)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to correctly go through scorecards rows")
+	}
+	return scorecards, nil
+}
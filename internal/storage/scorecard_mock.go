@@ -0,0 +1,31 @@
+package storage
+
+import "github.com/vipulvpatil/candidate-tracker-go/internal/model"
+
+type ScorecardAccessorConfigurableMock struct {
+	GetScorecardCriteriaForTeamInternal     func(team *model.Team) ([]*model.ScorecardCriterion, error)
+	CreateScorecardCriterionForTeamInternal func(name, description string, team *model.Team) (*model.ScorecardCriterion, error)
+	DeleteScorecardCriterionForTeamInternal func(id string, team *model.Team) error
+	SubmitScorecardForTeamInternal          func(candidateId, jobOpeningId, interviewerUserId, comment string, ratings []*model.ScorecardRating, team *model.Team) (*model.Scorecard, error)
+	GetScorecardsForTeamInternal            func(candidateId, jobOpeningId string, team *model.Team) ([]*model.Scorecard, error)
+}
+
+func (s *ScorecardAccessorConfigurableMock) GetScorecardCriteriaForTeam(team *model.Team) ([]*model.ScorecardCriterion, error) {
+	return s.GetScorecardCriteriaForTeamInternal(team)
+}
+
+func (s *ScorecardAccessorConfigurableMock) CreateScorecardCriterionForTeam(name, description string, team *model.Team) (*model.ScorecardCriterion, error) {
+	return s.CreateScorecardCriterionForTeamInternal(name, description, team)
+}
+
+func (s *ScorecardAccessorConfigurableMock) DeleteScorecardCriterionForTeam(id string, team *model.Team) error {
+	return s.DeleteScorecardCriterionForTeamInternal(id, team)
+}
+
+func (s *ScorecardAccessorConfigurableMock) SubmitScorecardForTeam(candidateId, jobOpeningId, interviewerUserId, comment string, ratings []*model.ScorecardRating, team *model.Team) (*model.Scorecard, error) {
+	return s.SubmitScorecardForTeamInternal(candidateId, jobOpeningId, interviewerUserId, comment, ratings, team)
+}
+
+func (s *ScorecardAccessorConfigurableMock) GetScorecardsForTeam(candidateId, jobOpeningId string, team *model.Team) ([]*model.Scorecard, error) {
+	return s.GetScorecardsForTeamInternal(candidateId, jobOpeningId, team)
+}
@@ -0,0 +1,489 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+func Test_GetScorecardCriteriaForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."users" ("id", "email", "team_id")
+					VALUES ('user_id1', 'test1@example.com', 'team_id1'), ('user_id2', 'test2@example.com', 'team_id1')`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, 'team_id1'), ('c_id2', $1, 'team_id2')`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."job_openings" ("id", "team_id", "title")
+					VALUES ('jo_id1', 'team_id1', 'Engineer'), ('jo_id2', 'team_id2', 'Designer')`,
+		},
+		{
+			Query: `INSERT INTO public."scorecard_criteria" ("id", "team_id", "name", "created_at")
+					VALUES
+					('sc_crit_id1', 'team_id1', 'Communication', '2023-01-01'),
+					('sc_crit_id2', 'team_id1', 'System design', '2023-01-02'),
+					('sc_crit_id3', 'team_id2', 'Coding', '2023-01-03')`,
+		},
+		{
+			Query: `INSERT INTO public."scorecards" ("id", "candidate_id", "job_opening_id", "interviewer_user_id", "comment", "created_at")
+					VALUES
+					('sc_id1', 'c_id1', 'jo_id1', 'user_id1', 'Solid', '2023-02-01'),
+					('sc_id2', 'c_id1', NULL, 'user_id2', 'Unsure', '2023-02-02')`,
+		},
+		{
+			Query: `INSERT INTO public."scorecard_ratings" ("scorecard_id", "scorecard_criterion_id", "rating")
+					VALUES ('sc_id1', 'sc_crit_id1', 4), ('sc_id1', 'sc_crit_id2', 5), ('sc_id2', 'sc_crit_id2', 2)`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+
+	s, _ := NewDbStorage(StorageOptions{Db: testDb})
+	runSqlOnDb(t, s.db, setupSqlStmts)
+	defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+	criteria, err := s.GetScorecardCriteriaForTeam(team)
+	assert.NoError(t, err)
+	assert.Len(t, criteria, 2)
+	assert.Equal(t, "sc_crit_id1", criteria[0].Id())
+	assert.Equal(t, "Communication", criteria[0].Name())
+	assert.Equal(t, "sc_crit_id2", criteria[1].Id())
+}
+
+func Test_CreateScorecardCriterionForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."users" ("id", "email", "team_id")
+					VALUES ('user_id1', 'test1@example.com', 'team_id1'), ('user_id2', 'test2@example.com', 'team_id1')`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, 'team_id1'), ('c_id2', $1, 'team_id2')`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."job_openings" ("id", "team_id", "title")
+					VALUES ('jo_id1', 'team_id1', 'Engineer'), ('jo_id2', 'team_id2', 'Designer')`,
+		},
+		{
+			Query: `INSERT INTO public."scorecard_criteria" ("id", "team_id", "name", "created_at")
+					VALUES
+					('sc_crit_id1', 'team_id1', 'Communication', '2023-01-01'),
+					('sc_crit_id2', 'team_id1', 'System design', '2023-01-02'),
+					('sc_crit_id3', 'team_id2', 'Coding', '2023-01-03')`,
+		},
+		{
+			Query: `INSERT INTO public."scorecards" ("id", "candidate_id", "job_opening_id", "interviewer_user_id", "comment", "created_at")
+					VALUES
+					('sc_id1', 'c_id1', 'jo_id1', 'user_id1', 'Solid', '2023-02-01'),
+					('sc_id2', 'c_id1', NULL, 'user_id2', 'Unsure', '2023-02-02')`,
+		},
+		{
+			Query: `INSERT INTO public."scorecard_ratings" ("scorecard_id", "scorecard_criterion_id", "rating")
+					VALUES ('sc_id1', 'sc_crit_id1', 4), ('sc_id1', 'sc_crit_id2', 5), ('sc_id2', 'sc_crit_id2', 2)`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+
+	tests := []struct {
+		name          string
+		input         string
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name:          "errors when name is blank",
+			input:         "",
+			errorExpected: true,
+			errorString:   "name cannot be blank",
+		},
+		{
+			name:          "errors when the team already has the criterion",
+			input:         "Communication",
+			errorExpected: true,
+			errorString:   "scorecard criterion Communication already exists",
+		},
+		{
+			name:          "creates the criterion",
+			input:         " Coding ",
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db:          testDb,
+					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "sc_crit_id4"},
+				},
+			)
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+			criterion, err := s.CreateScorecardCriterionForTeam(tt.input, "Writes clean code", team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, "sc_crit_id4", criterion.Id())
+				assert.Equal(t, "Coding", criterion.Name())
+				assert.Equal(t, "Writes clean code", criterion.Description())
+			} else {
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_DeleteScorecardCriterionForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."users" ("id", "email", "team_id")
+					VALUES ('user_id1', 'test1@example.com', 'team_id1'), ('user_id2', 'test2@example.com', 'team_id1')`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, 'team_id1'), ('c_id2', $1, 'team_id2')`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."job_openings" ("id", "team_id", "title")
+					VALUES ('jo_id1', 'team_id1', 'Engineer'), ('jo_id2', 'team_id2', 'Designer')`,
+		},
+		{
+			Query: `INSERT INTO public."scorecard_criteria" ("id", "team_id", "name", "created_at")
+					VALUES
+					('sc_crit_id1', 'team_id1', 'Communication', '2023-01-01'),
+					('sc_crit_id2', 'team_id1', 'System design', '2023-01-02'),
+					('sc_crit_id3', 'team_id2', 'Coding', '2023-01-03')`,
+		},
+		{
+			Query: `INSERT INTO public."scorecards" ("id", "candidate_id", "job_opening_id", "interviewer_user_id", "comment", "created_at")
+					VALUES
+					('sc_id1', 'c_id1', 'jo_id1', 'user_id1', 'Solid', '2023-02-01'),
+					('sc_id2', 'c_id1', NULL, 'user_id2', 'Unsure', '2023-02-02')`,
+		},
+		{
+			Query: `INSERT INTO public."scorecard_ratings" ("scorecard_id", "scorecard_criterion_id", "rating")
+					VALUES ('sc_id1', 'sc_crit_id1', 4), ('sc_id1', 'sc_crit_id2', 5), ('sc_id2', 'sc_crit_id2', 2)`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+
+	tests := []struct {
+		name          string
+		input         string
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name:          "errors when criterion belongs to another team",
+			input:         "sc_crit_id3",
+			errorExpected: true,
+			errorString:   "no scorecard criterion for id sc_crit_id3",
+		},
+		{
+			name:          "deletes the criterion and its ratings",
+			input:         "sc_crit_id1",
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(StorageOptions{Db: testDb})
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+			err := s.DeleteScorecardCriterionForTeam(tt.input, team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				var count int
+				row := s.db.QueryRow(`SELECT count(*) FROM public."scorecard_ratings" WHERE scorecard_criterion_id = $1`, tt.input)
+				assert.NoError(t, row.Scan(&count))
+				assert.Equal(t, 0, count)
+			} else {
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_SubmitScorecardForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."users" ("id", "email", "team_id")
+					VALUES ('user_id1', 'test1@example.com', 'team_id1'), ('user_id2', 'test2@example.com', 'team_id1')`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, 'team_id1'), ('c_id2', $1, 'team_id2')`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."job_openings" ("id", "team_id", "title")
+					VALUES ('jo_id1', 'team_id1', 'Engineer'), ('jo_id2', 'team_id2', 'Designer')`,
+		},
+		{
+			Query: `INSERT INTO public."scorecard_criteria" ("id", "team_id", "name", "created_at")
+					VALUES
+					('sc_crit_id1', 'team_id1', 'Communication', '2023-01-01'),
+					('sc_crit_id2', 'team_id1', 'System design', '2023-01-02'),
+					('sc_crit_id3', 'team_id2', 'Coding', '2023-01-03')`,
+		},
+		{
+			Query: `INSERT INTO public."scorecards" ("id", "candidate_id", "job_opening_id", "interviewer_user_id", "comment", "created_at")
+					VALUES
+					('sc_id1', 'c_id1', 'jo_id1', 'user_id1', 'Solid', '2023-02-01'),
+					('sc_id2', 'c_id1', NULL, 'user_id2', 'Unsure', '2023-02-02')`,
+		},
+		{
+			Query: `INSERT INTO public."scorecard_ratings" ("scorecard_id", "scorecard_criterion_id", "rating")
+					VALUES ('sc_id1', 'sc_crit_id1', 4), ('sc_id1', 'sc_crit_id2', 5), ('sc_id2', 'sc_crit_id2', 2)`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+
+	rating := func(criterionId string, value int) *model.ScorecardRating {
+		r, _ := model.NewScorecardRating(model.ScorecardRatingOptions{CriterionId: criterionId, Rating: value})
+		return r
+	}
+	tests := []struct {
+		name  string
+		input struct {
+			candidateId       string
+			jobOpeningId      string
+			interviewerUserId string
+			ratings           []*model.ScorecardRating
+		}
+		outputId      string
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when ratings are empty",
+			input: struct {
+				candidateId       string
+				jobOpeningId      string
+				interviewerUserId string
+				ratings           []*model.ScorecardRating
+			}{candidateId: "c_id1", interviewerUserId: "user_id1"},
+			errorExpected: true,
+			errorString:   "ratings cannot be empty",
+		},
+		{
+			name: "errors when candidate belongs to another team",
+			input: struct {
+				candidateId       string
+				jobOpeningId      string
+				interviewerUserId string
+				ratings           []*model.ScorecardRating
+			}{candidateId: "c_id2", interviewerUserId: "user_id1", ratings: []*model.ScorecardRating{rating("sc_crit_id1", 3)}},
+			errorExpected: true,
+			errorString:   "no candidate for id c_id2",
+		},
+		{
+			name: "errors when job opening belongs to another team",
+			input: struct {
+				candidateId       string
+				jobOpeningId      string
+				interviewerUserId string
+				ratings           []*model.ScorecardRating
+			}{candidateId: "c_id1", jobOpeningId: "jo_id2", interviewerUserId: "user_id1", ratings: []*model.ScorecardRating{rating("sc_crit_id1", 3)}},
+			errorExpected: true,
+			errorString:   "no job opening for id jo_id2",
+		},
+		{
+			name: "errors when criterion belongs to another team",
+			input: struct {
+				candidateId       string
+				jobOpeningId      string
+				interviewerUserId string
+				ratings           []*model.ScorecardRating
+			}{candidateId: "c_id1", interviewerUserId: "user_id1", ratings: []*model.ScorecardRating{rating("sc_crit_id3", 3)}},
+			errorExpected: true,
+			errorString:   "no scorecard criterion for id sc_crit_id3",
+		},
+		{
+			name: "creates a new scorecard",
+			input: struct {
+				candidateId       string
+				jobOpeningId      string
+				interviewerUserId string
+				ratings           []*model.ScorecardRating
+			}{candidateId: "c_id1", interviewerUserId: "user_id1", ratings: []*model.ScorecardRating{rating("sc_crit_id1", 3)}},
+			outputId:      "sc_id3",
+			errorExpected: false,
+		},
+		{
+			name: "replaces the interviewer's earlier scorecard",
+			input: struct {
+				candidateId       string
+				jobOpeningId      string
+				interviewerUserId string
+				ratings           []*model.ScorecardRating
+			}{candidateId: "c_id1", jobOpeningId: "jo_id1", interviewerUserId: "user_id1", ratings: []*model.ScorecardRating{rating("sc_crit_id1", 3)}},
+			outputId:      "sc_id1",
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db:          testDb,
+					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "sc_id3"},
+				},
+			)
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+			scorecard, err := s.SubmitScorecardForTeam(tt.input.candidateId, tt.input.jobOpeningId, tt.input.interviewerUserId, "Good", tt.input.ratings, team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.outputId, scorecard.Id())
+				assert.Equal(t, "test1@example.com", scorecard.InterviewerUserEmail())
+				assert.Equal(t, "Good", scorecard.Comment())
+
+				var ratingCount int
+				row := s.db.QueryRow(`SELECT count(*) FROM public."scorecard_ratings" WHERE scorecard_id = $1`, tt.outputId)
+				assert.NoError(t, row.Scan(&ratingCount))
+				assert.Equal(t, 1, ratingCount)
+			} else {
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_GetScorecardsForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."users" ("id", "email", "team_id")
+					VALUES ('user_id1', 'test1@example.com', 'team_id1'), ('user_id2', 'test2@example.com', 'team_id1')`,
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, 'team_id1'), ('c_id2', $1, 'team_id2')`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."job_openings" ("id", "team_id", "title")
+					VALUES ('jo_id1', 'team_id1', 'Engineer'), ('jo_id2', 'team_id2', 'Designer')`,
+		},
+		{
+			Query: `INSERT INTO public."scorecard_criteria" ("id", "team_id", "name", "created_at")
+					VALUES
+					('sc_crit_id1', 'team_id1', 'Communication', '2023-01-01'),
+					('sc_crit_id2', 'team_id1', 'System design', '2023-01-02'),
+					('sc_crit_id3', 'team_id2', 'Coding', '2023-01-03')`,
+		},
+		{
+			Query: `INSERT INTO public."scorecards" ("id", "candidate_id", "job_opening_id", "interviewer_user_id", "comment", "created_at")
+					VALUES
+					('sc_id1', 'c_id1', 'jo_id1', 'user_id1', 'Solid', '2023-02-01'),
+					('sc_id2', 'c_id1', NULL, 'user_id2', 'Unsure', '2023-02-02')`,
+		},
+		{
+			Query: `INSERT INTO public."scorecard_ratings" ("scorecard_id", "scorecard_criterion_id", "rating")
+					VALUES ('sc_id1', 'sc_crit_id1', 4), ('sc_id1', 'sc_crit_id2', 5), ('sc_id2', 'sc_crit_id2', 2)`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+
+	tests := []struct {
+		name      string
+		input     string
+		outputIds []string
+	}{
+		{
+			name:      "returns scorecards for every job opening",
+			input:     "",
+			outputIds: []string{"sc_id1", "sc_id2"},
+		},
+		{
+			name:      "returns scorecards for a job opening",
+			input:     "jo_id1",
+			outputIds: []string{"sc_id1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(StorageOptions{Db: testDb})
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+			scorecards, err := s.GetScorecardsForTeam("c_id1", tt.input, team)
+			assert.NoError(t, err)
+			ids := []string{}
+			for _, scorecard := range scorecards {
+				ids = append(ids, scorecard.Id())
+			}
+			assert.Equal(t, tt.outputIds, ids)
+			assert.Len(t, scorecards[0].Ratings(), 2)
+			assert.Equal(t, "sc_crit_id1", scorecards[0].Ratings()[0].CriterionId())
+			assert.Equal(t, 4, scorecards[0].Ratings()[0].Rating())
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+)
+
+// sqlConditions collects optional WHERE clauses alongside their args,
+// so that the placeholders always line up with the args passed to the query.
+type sqlConditions struct {
+	clauses []string
+	args    []any
+}
+
+func newSqlConditions(args ...any) *sqlConditions {
+	return &sqlConditions{
+		clauses: []string{},
+		args:    args,
+	}
+}
+
+// add appends a clause where each %s, in order, is replaced by the placeholder of the matching arg.
+func (c *sqlConditions) add(clause string, args ...any) {
+	for _, arg := range args {
+		c.args = append(c.args, arg)
+		placeholder := fmt.Sprintf("$%d", len(c.args))
+		clause = strings.Replace(clause, "%s", placeholder, 1)
+	}
+	c.clauses = append(c.clauses, clause)
+}
+
+// arg adds an arg without a clause and returns its placeholder.
+// This is useful for args used outside the WHERE clause, like LIMIT.
+func (c *sqlConditions) arg(arg any) string {
+	c.args = append(c.args, arg)
+	return fmt.Sprintf("$%d", len(c.args))
+}
+
+func (c *sqlConditions) sql() string {
+	if len(c.clauses) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("AND %s", strings.Join(c.clauses, "\n\t\tAND "))
+}
+
+func (c *sqlConditions) copy() *sqlConditions {
+	return &sqlConditions{
+		clauses: append([]string{}, c.clauses...),
+		args:    append([]any{}, c.args...),
+	}
+}
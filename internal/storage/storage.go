@@ -12,7 +12,16 @@ type StorageAccessor interface {
 	DatabaseTransactionProvider
 	TeamHydrator
 	FileUploadAccessor
+	FileUploadTextAccessor
 	CandidateAccessor
+	PipelineStageAccessor
+	JobOpeningAccessor
+	JobApplicationAccessor
+	FitAssessmentAccessor
+	CandidateNoteAccessor
+	TagAccessor
+	ScorecardAccessor
+	CandidatePersonaRevisionAccessor
 }
 
 type Storage struct {
@@ -5,7 +5,16 @@ type StorageAccessorMock struct {
 	DatabaseTransactionProvider
 	TeamHydrator
 	FileUploadAccessor
+	FileUploadTextAccessor
 	CandidateAccessor
+	PipelineStageAccessor
+	JobOpeningAccessor
+	JobApplicationAccessor
+	FitAssessmentAccessor
+	CandidateNoteAccessor
+	TagAccessor
+	ScorecardAccessor
+	CandidatePersonaRevisionAccessor
 }
 
 type StorageAccessorMockOption func(*StorageAccessorMock)
@@ -37,8 +46,62 @@ func WithFileUploadAccessorMock(mock FileUploadAccessor) StorageAccessorMockOpti
 	}
 }
 
+func WithFileUploadTextAccessorMock(mock FileUploadTextAccessor) StorageAccessorMockOption {
+	return func(s *StorageAccessorMock) {
+		s.FileUploadTextAccessor = mock
+	}
+}
+
 func WithCandidateAccessorMock(mock CandidateAccessor) StorageAccessorMockOption {
 	return func(s *StorageAccessorMock) {
 		s.CandidateAccessor = mock
 	}
 }
+
+func WithPipelineStageAccessorMock(mock PipelineStageAccessor) StorageAccessorMockOption {
+	return func(s *StorageAccessorMock) {
+		s.PipelineStageAccessor = mock
+	}
+}
+
+func WithJobOpeningAccessorMock(mock JobOpeningAccessor) StorageAccessorMockOption {
+	return func(s *StorageAccessorMock) {
+		s.JobOpeningAccessor = mock
+	}
+}
+
+func WithJobApplicationAccessorMock(mock JobApplicationAccessor) StorageAccessorMockOption {
+	return func(s *StorageAccessorMock) {
+		s.JobApplicationAccessor = mock
+	}
+}
+
+func WithFitAssessmentAccessorMock(mock FitAssessmentAccessor) StorageAccessorMockOption {
+	return func(s *StorageAccessorMock) {
+		s.FitAssessmentAccessor = mock
+	}
+}
+
+func WithCandidateNoteAccessorMock(mock CandidateNoteAccessor) StorageAccessorMockOption {
+	return func(s *StorageAccessorMock) {
+		s.CandidateNoteAccessor = mock
+	}
+}
+
+func WithTagAccessorMock(mock TagAccessor) StorageAccessorMockOption {
+	return func(s *StorageAccessorMock) {
+		s.TagAccessor = mock
+	}
+}
+
+func WithScorecardAccessorMock(mock ScorecardAccessor) StorageAccessorMockOption {
+	return func(s *StorageAccessorMock) {
+		s.ScorecardAccessor = mock
+	}
+}
+
+func WithCandidatePersonaRevisionAccessorMock(mock CandidatePersonaRevisionAccessor) StorageAccessorMockOption {
+	return func(s *StorageAccessorMock) {
+		s.CandidatePersonaRevisionAccessor = mock
+	}
+}
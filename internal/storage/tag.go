@@ -0,0 +1,359 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+type TagAccessor interface {
+	GetTagsForTeam(team *model.Team) ([]*model.Tag, error)
+	GetTagsForCandidateForTeam(candidateId string, team *model.Team) ([]*model.Tag, error)
+	CreateTagForTeam(name string, team *model.Team) (*model.Tag, error)
+	RenameTagForTeam(id, name string, team *model.Team) error
+	DeleteTagForTeam(id string, team *model.Team) error
+	AddTagsToCandidatesForTeam(tagIds, candidateIds []string, team *model.Team) error
+	RemoveTagsFromCandidatesForTeam(tagIds, candidateIds []string, team *model.Team) error
+}
+
+func (s *Storage) GetTagsForTeam(team *model.Team) ([]*model.Tag, error) {
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	return s.getTagsForTeam(team, newSqlConditions(team.Id()))
+}
+
+func (s *Storage) GetTagsForCandidateForTeam(candidateId string, team *model.Team) ([]*model.Tag, error) {
+	if utilities.IsBlank(candidateId) {
+		return nil, errors.New("candidateId cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	conditions := newSqlConditions(team.Id())
+	conditions.add(`t.id IN (SELECT tag_id FROM public."candidate_tags" WHERE candidate_id = %s)`, candidateId)
+	return s.getTagsForTeam(team, conditions)
+}
+
+// getTagsForTeam returns the tags ordered by name. The team id has to be the first arg of the conditions.
+func (s *Storage) getTagsForTeam(team *model.Team, conditions *sqlConditions) ([]*model.Tag, error) {
+	rows, err := s.db.Query(
+		fmt.Sprintf(
+			`SELECT t.id, t.name, count(c.id)
+		FROM public."tags" AS t
+		LEFT JOIN public."candidate_tags" AS ct ON ct.tag_id = t.id
+		LEFT JOIN public."candidates" AS c ON c.id = ct.candidate_id AND c.archived_at IS NULL
+		WHERE t.team_id = $1
+		%s
+		GROUP BY t.id
+		ORDER BY lower(t.name) ASC, t.id ASC`,
+			conditions.sql(),
+		),
+		conditions.args...,
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to select tags")
+	}
+	defer rows.Close()
+
+	tags := []*model.Tag{}
+	for rows.Next() {
+		var id, name string
+		var candidateCount int
+		err := rows.Scan(&id, &name, &candidateCount)
+		if err != nil {
+			return nil, utilities.WrapBadError(err, "failed while scanning rows")
+		}
+
+		tag, err := model.NewTag(model.TagOptions{
+			Id:             id,
+			Name:           name,
+			CandidateCount: candidateCount,
+			Team:           team,
+		})
+		if err != nil {
+			// TODO: Log this error?
+			continue
+		}
+
+		tags = append(tags, tag)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, "failed to correctly go through tags rows")
+	}
+	return tags, nil
+}
+
+func (s *Storage) CreateTagForTeam(name string, team *model.Team) (*model.Tag, error) {
+	if utilities.IsBlank(name) {
+		return nil, errors.New("name cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return nil, errors.New("team cannot be blank")
+	}
+
+	tag, err := model.NewTag(model.TagOptions{
+		Id:   s.IdGenerator.Generate(),
+		Name: name,
+		Team: team,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.db.Exec(
+		`INSERT INTO public."tags"
+		("id", "team_id", "name")
+		VALUES
+		($1, $2, $3)
+		ON CONFLICT ("team_id", "name") DO NOTHING`,
+		tag.Id(), team.Id(), tag.Name(),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while inserting Tag: %s", tag.Id()))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("dbError while inserting Tag and changing db: %s", tag.Id()))
+	}
+	if rowsAffected == 0 {
+		return nil, errors.Errorf("tag %s already exists", tag.Name())
+	}
+	if rowsAffected != 1 {
+		return nil, utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when inserting Tag in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+
+	return tag, nil
+}
+
+func (s *Storage) RenameTagForTeam(id, name string, team *model.Team) error {
+	if utilities.IsBlank(id) {
+		return errors.New("id cannot be blank")
+	}
+
+	if utilities.IsBlank(name) {
+		return errors.New("name cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return errors.New("team cannot be blank")
+	}
+
+	tag, err := model.NewTag(model.TagOptions{
+		Id:   id,
+		Name: name,
+		Team: team,
+	})
+	if err != nil {
+		return err
+	}
+
+	result, err := s.db.Exec(
+		`UPDATE public."tags" SET "name" = $3 WHERE id = $1 AND team_id = $2`,
+		id, team.Id(), tag.Name(),
+	)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == uniqueViolationErrorCode {
+			return errors.Errorf("tag %s already exists", tag.Name())
+		}
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while renaming Tag: %s", id))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while checking affected row while renaming Tag: %s", id))
+	}
+	if rowsAffected == 0 {
+		return errors.Errorf("no tag for id %s", id)
+	}
+	if rowsAffected != 1 {
+		return utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when renaming Tag in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+	return nil
+}
+
+// DeleteTagForTeam also removes the tag from all the candidates it is attached to.
+func (s *Storage) DeleteTagForTeam(id string, team *model.Team) error {
+	if utilities.IsBlank(id) {
+		return errors.New("id cannot be blank")
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return errors.New("team cannot be blank")
+	}
+
+	result, err := s.db.Exec(
+		`DELETE FROM public."tags" WHERE id = $1 AND team_id = $2`,
+		id, team.Id(),
+	)
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while deleting Tag: %s", id))
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return utilities.WrapBadError(err, fmt.Sprintf("dbError while deleting Tag and changing db: %s", id))
+	}
+	if rowsAffected == 0 {
+		return errors.Errorf("no tag for id %s", id)
+	}
+	if rowsAffected != 1 {
+		return utilities.NewBadError(fmt.Sprintf("Very few or too many rows were affected when deleting Tag in db. This is highly unexpected. rowsAffected: %d", rowsAffected))
+	}
+	return nil
+}
+
+// AddTagsToCandidatesForTeam tags all the candidates or none of them. Tags a candidate already has are left as they are.
+func (s *Storage) AddTagsToCandidatesForTeam(tagIds, candidateIds []string, team *model.Team) error {
+	err := validateTagsAndCandidates(tagIds, candidateIds, team)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.BeginTransaction()
+	if err != nil {
+		return utilities.WrapBadError(err, "failed to start db transaction")
+	}
+	defer tx.Rollback()
+
+	err = checkTagsAndCandidatesBelongToTeamUsingTx(tagIds, candidateIds, team, tx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		`INSERT INTO public."candidate_tags"
+		("candidate_id", "tag_id")
+		SELECT candidate_id, tag_id
+		FROM unnest($1::text[]) AS candidate_id, unnest($2::text[]) AS tag_id
+		ON CONFLICT ("candidate_id", "tag_id") DO NOTHING`,
+		pq.Array(candidateIds), pq.Array(tagIds),
+	)
+	if err != nil {
+		return utilities.WrapBadError(err, "dbError while adding Tags to Candidates")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return utilities.WrapBadError(err, "dbError while adding tags to candidates tx")
+	}
+	return nil
+}
+
+// RemoveTagsFromCandidatesForTeam untags all the candidates or none of them.
+func (s *Storage) RemoveTagsFromCandidatesForTeam(tagIds, candidateIds []string, team *model.Team) error {
+	err := validateTagsAndCandidates(tagIds, candidateIds, team)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.BeginTransaction()
+	if err != nil {
+		return utilities.WrapBadError(err, "failed to start db transaction")
+	}
+	defer tx.Rollback()
+
+	err = checkTagsAndCandidatesBelongToTeamUsingTx(tagIds, candidateIds, team, tx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		`DELETE FROM public."candidate_tags" WHERE candidate_id = ANY($1) AND tag_id = ANY($2)`,
+		pq.Array(candidateIds), pq.Array(tagIds),
+	)
+	if err != nil {
+		return utilities.WrapBadError(err, "dbError while removing Tags from Candidates")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return utilities.WrapBadError(err, "dbError while removing tags from candidates tx")
+	}
+	return nil
+}
+
+func validateTagsAndCandidates(tagIds, candidateIds []string, team *model.Team) error {
+	if len(tagIds) == 0 {
+		return errors.New("tagIds cannot be empty")
+	}
+
+	for _, id := range tagIds {
+		if utilities.IsBlank(id) {
+			return errors.New("tagIds cannot contain a blank id")
+		}
+	}
+
+	if len(candidateIds) == 0 {
+		return errors.New("candidateIds cannot be empty")
+	}
+
+	for _, id := range candidateIds {
+		if utilities.IsBlank(id) {
+			return errors.New("candidateIds cannot contain a blank id")
+		}
+	}
+
+	if team == nil || utilities.IsBlank(team.Id()) {
+		return errors.New("team cannot be blank")
+	}
+	return nil
+}
+
+// checkTagsAndCandidatesBelongToTeamUsingTx keeps the tags and candidates from being deleted until the transaction ends.
+func checkTagsAndCandidatesBelongToTeamUsingTx(tagIds, candidateIds []string, team *model.Team, tx DatabaseTransaction) error {
+	tagIdsInTeam, err := idsForTeamUsingTx("tags", tagIds, team, tx)
+	if err != nil {
+		return err
+	}
+	for _, id := range tagIds {
+		if !tagIdsInTeam[id] {
+			return errors.Errorf("no tag for id %s", id)
+		}
+	}
+
+	candidateIdsInTeam, err := idsForTeamUsingTx("candidates", candidateIds, team, tx)
+	if err != nil {
+		return err
+	}
+	for _, id := range candidateIds {
+		if !candidateIdsInTeam[id] {
+			return errors.Errorf("no candidate for id %s", id)
+		}
+	}
+	return nil
+}
+
+func idsForTeamUsingTx(table string, ids []string, team *model.Team, tx DatabaseTransaction) (map[string]bool, error) {
+	rows, err := tx.Query(
+		fmt.Sprintf(`SELECT id FROM public."%s" WHERE team_id = $1 AND id = ANY($2) FOR SHARE`, table),
+		team.Id(), pq.Array(ids),
+	)
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("failed to select %s", table))
+	}
+	defer rows.Close()
+
+	idsInTeam := map[string]bool{}
+	for rows.Next() {
+		var id string
+		err := rows.Scan(&id)
+		if err != nil {
+			return nil, utilities.WrapBadError(err, "failed while scanning rows")
+		}
+		idsInTeam[id] = true
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, utilities.WrapBadError(err, fmt.Sprintf("failed to correctly go through %s id rows", table))
+	}
+	return idsInTeam, nil
+}
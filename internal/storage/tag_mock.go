@@ -0,0 +1,41 @@
+package storage
+
+import "github.com/vipulvpatil/candidate-tracker-go/internal/model"
+
+type TagAccessorConfigurableMock struct {
+	GetTagsForTeamInternal                  func(team *model.Team) ([]*model.Tag, error)
+	GetTagsForCandidateForTeamInternal      func(candidateId string, team *model.Team) ([]*model.Tag, error)
+	CreateTagForTeamInternal                func(name string, team *model.Team) (*model.Tag, error)
+	RenameTagForTeamInternal                func(id, name string, team *model.Team) error
+	DeleteTagForTeamInternal                func(id string, team *model.Team) error
+	AddTagsToCandidatesForTeamInternal      func(tagIds, candidateIds []string, team *model.Team) error
+	RemoveTagsFromCandidatesForTeamInternal func(tagIds, candidateIds []string, team *model.Team) error
+}
+
+func (t *TagAccessorConfigurableMock) GetTagsForTeam(team *model.Team) ([]*model.Tag, error) {
+	return t.GetTagsForTeamInternal(team)
+}
+
+func (t *TagAccessorConfigurableMock) GetTagsForCandidateForTeam(candidateId string, team *model.Team) ([]*model.Tag, error) {
+	return t.GetTagsForCandidateForTeamInternal(candidateId, team)
+}
+
+func (t *TagAccessorConfigurableMock) CreateTagForTeam(name string, team *model.Team) (*model.Tag, error) {
+	return t.CreateTagForTeamInternal(name, team)
+}
+
+func (t *TagAccessorConfigurableMock) RenameTagForTeam(id, name string, team *model.Team) error {
+	return t.RenameTagForTeamInternal(id, name, team)
+}
+
+func (t *TagAccessorConfigurableMock) DeleteTagForTeam(id string, team *model.Team) error {
+	return t.DeleteTagForTeamInternal(id, team)
+}
+
+func (t *TagAccessorConfigurableMock) AddTagsToCandidatesForTeam(tagIds, candidateIds []string, team *model.Team) error {
+	return t.AddTagsToCandidatesForTeamInternal(tagIds, candidateIds, team)
+}
+
+func (t *TagAccessorConfigurableMock) RemoveTagsFromCandidatesForTeam(tagIds, candidateIds []string, team *model.Team) error {
+	return t.RemoveTagsFromCandidatesForTeamInternal(tagIds, candidateIds, team)
+}
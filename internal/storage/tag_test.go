@@ -0,0 +1,511 @@
+package storage
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+func candidateTagsInDb(t *testing.T, db *sql.DB) []string {
+	var pairs []string
+	row := db.QueryRow(`SELECT array_agg(candidate_id || ':' || tag_id ORDER BY candidate_id, tag_id) FROM public."candidate_tags"`)
+	err := row.Scan(pq.Array(&pairs))
+	assert.NoError(t, err)
+	return pairs
+}
+
+func Test_GetTagsForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, 'team_id1'), ('c_id2', $1, 'team_id1'), ('c_id3', $1, 'team_id2')`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id", "archived_at")
+					VALUES ('c_id4', $1, 'team_id1', CURRENT_TIMESTAMP)`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."tags" ("id", "team_id", "name")
+					VALUES ('tag_id1', 'team_id1', 'referral'), ('tag_id2', 'team_id1', 'Q3-batch'), ('tag_id3', 'team_id2', 'other team')`,
+		},
+		{
+			Query: `INSERT INTO public."candidate_tags" ("candidate_id", "tag_id")
+					VALUES ('c_id1', 'tag_id1'), ('c_id4', 'tag_id1')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+
+	t.Run("returns the team's tags ordered by name with counts of unarchived candidates", func(t *testing.T) {
+		s, _ := NewDbStorage(StorageOptions{Db: testDb})
+		runSqlOnDb(t, s.db, setupSqlStmts)
+		defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+		tags, err := s.GetTagsForTeam(team)
+		assert.NoError(t, err)
+		assert.Len(t, tags, 2)
+		assert.Equal(t, "tag_id2", tags[0].Id())
+		assert.Equal(t, "Q3-batch", tags[0].Name())
+		assert.Equal(t, 0, tags[0].CandidateCount())
+		assert.Equal(t, "tag_id1", tags[1].Id())
+		assert.Equal(t, "referral", tags[1].Name())
+		assert.Equal(t, 1, tags[1].CandidateCount())
+	})
+
+	t.Run("returns only the candidate's tags", func(t *testing.T) {
+		s, _ := NewDbStorage(StorageOptions{Db: testDb})
+		runSqlOnDb(t, s.db, setupSqlStmts)
+		defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+		tags, err := s.GetTagsForCandidateForTeam("c_id1", team)
+		assert.NoError(t, err)
+		assert.Len(t, tags, 1)
+		assert.Equal(t, "tag_id1", tags[0].Id())
+		assert.Equal(t, 1, tags[0].CandidateCount())
+
+		tags, err = s.GetTagsForCandidateForTeam("c_id2", team)
+		assert.NoError(t, err)
+		assert.Empty(t, tags)
+	})
+}
+
+func Test_CreateTagForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, 'team_id1'), ('c_id2', $1, 'team_id1'), ('c_id3', $1, 'team_id2')`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id", "archived_at")
+					VALUES ('c_id4', $1, 'team_id1', CURRENT_TIMESTAMP)`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."tags" ("id", "team_id", "name")
+					VALUES ('tag_id1', 'team_id1', 'referral'), ('tag_id2', 'team_id1', 'Q3-batch'), ('tag_id3', 'team_id2', 'other team')`,
+		},
+		{
+			Query: `INSERT INTO public."candidate_tags" ("candidate_id", "tag_id")
+					VALUES ('c_id1', 'tag_id1'), ('c_id4', 'tag_id1')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+
+	tests := []struct {
+		name          string
+		input         string
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name:          "errors when name is blank",
+			input:         " ",
+			errorExpected: true,
+			errorString:   "name cannot be blank",
+		},
+		{
+			name:          "errors when the team already has the tag",
+			input:         " referral ",
+			errorExpected: true,
+			errorString:   "tag referral already exists",
+		},
+		{
+			name:          "creates a tag",
+			input:         "other team",
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(
+				StorageOptions{
+					Db:          testDb,
+					IdGenerator: &utilities.IdGeneratorMockConstant{Id: "tag_id4"},
+				},
+			)
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+			tag, err := s.CreateTagForTeam(tt.input, team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, "tag_id4", tag.Id())
+				assert.Equal(t, "other team", tag.Name())
+				assert.Equal(t, 0, tag.CandidateCount())
+			} else {
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_RenameTagForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, 'team_id1'), ('c_id2', $1, 'team_id1'), ('c_id3', $1, 'team_id2')`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id", "archived_at")
+					VALUES ('c_id4', $1, 'team_id1', CURRENT_TIMESTAMP)`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."tags" ("id", "team_id", "name")
+					VALUES ('tag_id1', 'team_id1', 'referral'), ('tag_id2', 'team_id1', 'Q3-batch'), ('tag_id3', 'team_id2', 'other team')`,
+		},
+		{
+			Query: `INSERT INTO public."candidate_tags" ("candidate_id", "tag_id")
+					VALUES ('c_id1', 'tag_id1'), ('c_id4', 'tag_id1')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+
+	tests := []struct {
+		name  string
+		input struct {
+			id   string
+			name string
+		}
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when tag belongs to another team",
+			input: struct {
+				id   string
+				name string
+			}{id: "tag_id3", name: "renamed"},
+			errorExpected: true,
+			errorString:   "no tag for id tag_id3",
+		},
+		{
+			name: "errors when another tag has the name",
+			input: struct {
+				id   string
+				name string
+			}{id: "tag_id1", name: "Q3-batch"},
+			errorExpected: true,
+			errorString:   "tag Q3-batch already exists",
+		},
+		{
+			name: "renames the tag",
+			input: struct {
+				id   string
+				name string
+			}{id: "tag_id1", name: " employee referral "},
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(StorageOptions{Db: testDb})
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+			err := s.RenameTagForTeam(tt.input.id, tt.input.name, team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				var name string
+				row := s.db.QueryRow(`SELECT name FROM public."tags" WHERE id = $1`, tt.input.id)
+				assert.NoError(t, row.Scan(&name))
+				assert.Equal(t, "employee referral", name)
+			} else {
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_DeleteTagForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, 'team_id1'), ('c_id2', $1, 'team_id1'), ('c_id3', $1, 'team_id2')`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id", "archived_at")
+					VALUES ('c_id4', $1, 'team_id1', CURRENT_TIMESTAMP)`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."tags" ("id", "team_id", "name")
+					VALUES ('tag_id1', 'team_id1', 'referral'), ('tag_id2', 'team_id1', 'Q3-batch'), ('tag_id3', 'team_id2', 'other team')`,
+		},
+		{
+			Query: `INSERT INTO public."candidate_tags" ("candidate_id", "tag_id")
+					VALUES ('c_id1', 'tag_id1'), ('c_id4', 'tag_id1')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+
+	tests := []struct {
+		name          string
+		input         string
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name:          "errors when tag belongs to another team",
+			input:         "tag_id3",
+			errorExpected: true,
+			errorString:   "no tag for id tag_id3",
+		},
+		{
+			name:          "deletes the tag and untags its candidates",
+			input:         "tag_id1",
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(StorageOptions{Db: testDb})
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+			err := s.DeleteTagForTeam(tt.input, team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Empty(t, candidateTagsInDb(t, s.db))
+			} else {
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
+
+func Test_AddTagsToCandidatesForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, 'team_id1'), ('c_id2', $1, 'team_id1'), ('c_id3', $1, 'team_id2')`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id", "archived_at")
+					VALUES ('c_id4', $1, 'team_id1', CURRENT_TIMESTAMP)`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."tags" ("id", "team_id", "name")
+					VALUES ('tag_id1', 'team_id1', 'referral'), ('tag_id2', 'team_id1', 'Q3-batch'), ('tag_id3', 'team_id2', 'other team')`,
+		},
+		{
+			Query: `INSERT INTO public."candidate_tags" ("candidate_id", "tag_id")
+					VALUES ('c_id1', 'tag_id1'), ('c_id4', 'tag_id1')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+
+	tests := []struct {
+		name  string
+		input struct {
+			tagIds       []string
+			candidateIds []string
+		}
+		output        []string
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when tagIds is empty",
+			input: struct {
+				tagIds       []string
+				candidateIds []string
+			}{candidateIds: []string{"c_id1"}},
+			errorExpected: true,
+			errorString:   "tagIds cannot be empty",
+		},
+		{
+			name: "errors when a candidate belongs to another team",
+			input: struct {
+				tagIds       []string
+				candidateIds []string
+			}{tagIds: []string{"tag_id2"}, candidateIds: []string{"c_id1", "c_id3"}},
+			errorExpected: true,
+			errorString:   "no candidate for id c_id3",
+		},
+		{
+			name: "errors when a tag belongs to another team",
+			input: struct {
+				tagIds       []string
+				candidateIds []string
+			}{tagIds: []string{"tag_id3"}, candidateIds: []string{"c_id1"}},
+			errorExpected: true,
+			errorString:   "no tag for id tag_id3",
+		},
+		{
+			name: "tags all the candidates",
+			input: struct {
+				tagIds       []string
+				candidateIds []string
+			}{tagIds: []string{"tag_id1", "tag_id2"}, candidateIds: []string{"c_id1", "c_id2"}},
+			output:        []string{"c_id1:tag_id1", "c_id1:tag_id2", "c_id2:tag_id1", "c_id2:tag_id2", "c_id4:tag_id1"},
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(StorageOptions{Db: testDb})
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+			err := s.AddTagsToCandidatesForTeam(tt.input.tagIds, tt.input.candidateIds, team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.output, candidateTagsInDb(t, s.db))
+			} else {
+				assert.EqualError(t, err, tt.errorString)
+				assert.Equal(t, []string{"c_id1:tag_id1", "c_id4:tag_id1"}, candidateTagsInDb(t, s.db))
+			}
+		})
+	}
+}
+
+func Test_RemoveTagsFromCandidatesForTeam(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "Team1",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := model.Persona{Name: "manual persona 1"}
+	setupSqlStmts := []TestSqlStmts{
+		{Query: `INSERT INTO public."teams" ("id", "name") VALUES ('team_id1', 'Team1'), ('team_id2', 'Team2')`},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id")
+					VALUES ('c_id1', $1, 'team_id1'), ('c_id2', $1, 'team_id1'), ('c_id3', $1, 'team_id2')`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."candidates" ("id", "manually_created_persona", "team_id", "archived_at")
+					VALUES ('c_id4', $1, 'team_id1', CURRENT_TIMESTAMP)`,
+			Args: []any{&persona},
+		},
+		{
+			Query: `INSERT INTO public."tags" ("id", "team_id", "name")
+					VALUES ('tag_id1', 'team_id1', 'referral'), ('tag_id2', 'team_id1', 'Q3-batch'), ('tag_id3', 'team_id2', 'other team')`,
+		},
+		{
+			Query: `INSERT INTO public."candidate_tags" ("candidate_id", "tag_id")
+					VALUES ('c_id1', 'tag_id1'), ('c_id4', 'tag_id1')`,
+		},
+	}
+	cleanupSqlStmts := []TestSqlStmts{
+		{Query: `DELETE FROM public."teams" WHERE id IN ('team_id1', 'team_id2')`},
+	}
+
+	tests := []struct {
+		name  string
+		input struct {
+			tagIds       []string
+			candidateIds []string
+		}
+		output        []string
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name: "errors when candidateIds contains a blank id",
+			input: struct {
+				tagIds       []string
+				candidateIds []string
+			}{tagIds: []string{"tag_id1"}, candidateIds: []string{"c_id1", " "}},
+			errorExpected: true,
+			errorString:   "candidateIds cannot contain a blank id",
+		},
+		{
+			name: "untags the candidates",
+			input: struct {
+				tagIds       []string
+				candidateIds []string
+			}{tagIds: []string{"tag_id1", "tag_id2"}, candidateIds: []string{"c_id1", "c_id2"}},
+			output:        []string{"c_id4:tag_id1"},
+			errorExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewDbStorage(StorageOptions{Db: testDb})
+			runSqlOnDb(t, s.db, setupSqlStmts)
+			defer runSqlOnDb(t, s.db, cleanupSqlStmts)
+
+			err := s.RemoveTagsFromCandidatesForTeam(tt.input.tagIds, tt.input.candidateIds, team)
+			if !tt.errorExpected {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.output, candidateTagsInDb(t, s.db))
+			} else {
+				assert.EqualError(t, err, tt.errorString)
+			}
+		})
+	}
+}
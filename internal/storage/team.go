@@ -46,6 +46,7 @@ func (s *Storage) HydrateTeam(user *model.User) (*model.User, error) {
 			LEFT JOIN
 			public."file_uploads"
 			ON teams.id = file_uploads.team_id
+			AND file_uploads.processing_status <> 'DUPLICATE'
 			GROUP BY teams.id
 		) t
 		ON t.id = users.team_id
@@ -0,0 +1,221 @@
+package workers
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/lib/parser"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+)
+
+const maxArchivedFileSize = 20 << 20
+
+// Archives with more entries than this are not unpacked at all, since checking every entry would take too long.
+const maxArchivedFileCount = 1000
+
+// unpackFileUploadArchive creates a file upload for every supported file in the archive, until the team reaches its file count limit.
+// Files unpacked by an earlier run are skipped, so an archive can be processed again once the limit is raised.
+func unpackFileUploadArchive(fileUpload *model.FileUpload) error {
+	if fileUpload == nil {
+		err := errors.New("fileUpload is required")
+		logger.LogError(err)
+		return err
+	}
+
+	localFilePath, err := fileStorer.GetLocalFilePath(fileUpload.StoragePath(), fileUpload.Name())
+	if err != nil {
+		logger.LogError(err)
+		return newProcessingError("STORAGE ERROR", err)
+	}
+
+	archive, err := zip.OpenReader(localFilePath)
+	if err != nil {
+		logger.LogError(err)
+		return newProcessingError("UNREADABLE FILE", err)
+	}
+	defer archive.Close()
+
+	if len(archive.File) > maxArchivedFileCount {
+		return newProcessingError("UNREADABLE FILE", errors.Errorf("archive contains more than %d files", maxArchivedFileCount))
+	}
+
+	children, err := workerStorage.GetChildFileUploadsForTeam(fileUpload.Id(), fileUpload.Team())
+	if err != nil {
+		logger.LogError(err)
+		return newProcessingError("STORAGE ERROR", err)
+	}
+	unpackedFileNames := map[string]bool{}
+	for _, child := range children {
+		unpackedFileNames[child.Name()] = true
+	}
+
+	tmpDirPath, err := os.MkdirTemp("", fileUpload.Id())
+	if err != nil {
+		logger.LogError(err)
+		return newProcessingError("STORAGE ERROR", err)
+	}
+	defer os.RemoveAll(tmpDirPath)
+
+	team := fileUpload.Team()
+	availableFileCount := team.FileCountLimit() - team.CurrentFileCount()
+	supportedFileCount := 0
+	unpackedFileCount := len(children)
+	createdFileCount := 0
+	archivedFileNames := map[string]bool{}
+	for i, file := range archive.File {
+		if !isUnpackableArchivedFile(file) {
+			continue
+		}
+
+		fileName := uniqueArchivedFileName(path.Base(file.Name), archivedFileNames)
+		if unpackedFileNames[fileName] {
+			supportedFileCount++
+			continue
+		}
+
+		// Once the limit is reached, the remaining files are only counted, so they are checked without being extracted.
+		if createdFileCount >= availableFileCount {
+			header, err := readArchivedFileHeader(file)
+			if err != nil {
+				logger.LogError(err)
+				continue
+			}
+			if parser.IsSupportedFileHeader(header, fileName) {
+				supportedFileCount++
+			}
+			continue
+		}
+
+		localArchivedFilePath, err := extractArchivedFile(file, filepath.Join(tmpDirPath, strconv.Itoa(i)))
+		if err != nil {
+			logger.LogError(err)
+			continue
+		}
+
+		_, err = parser.ExtractorForFile(localArchivedFilePath, fileName)
+		if err != nil {
+			os.Remove(localArchivedFilePath)
+			continue
+		}
+		supportedFileCount++
+
+		err = createChildFileUpload(fileUpload, fileName, localArchivedFilePath)
+		os.Remove(localArchivedFilePath)
+		if err != nil {
+			logger.LogError(err)
+			return newProcessingError("STORAGE ERROR", err)
+		}
+		unpackedFileNames[fileName] = true
+		createdFileCount++
+		unpackedFileCount++
+	}
+
+	if supportedFileCount == 0 {
+		return newProcessingError("UNREADABLE FILE", errors.New("archive does not contain any supported files"))
+	}
+
+	if unpackedFileCount < supportedFileCount {
+		return newProcessingError(
+			"FILE LIMIT REACHED",
+			errors.Errorf("File upload limit reached after unpacking %d of %d files", unpackedFileCount, supportedFileCount),
+		)
+	}
+
+	err = workerStorage.UpdateFileUploadWithProcessingStatus(fileUpload.Id(), "COMPLETED")
+	if err != nil {
+		logger.LogError(err)
+		return newProcessingError("STORAGE ERROR", err)
+	}
+	return nil
+}
+
+// Directories, oversized files and the metadata files that macOS adds to archives are never unpacked.
+func isUnpackableArchivedFile(file *zip.File) bool {
+	if file.FileInfo().IsDir() || file.UncompressedSize64 > maxArchivedFileSize {
+		return false
+	}
+	if strings.HasPrefix(file.Name, "__MACOSX/") {
+		return false
+	}
+	return !strings.HasPrefix(path.Base(file.Name), ".")
+}
+
+// Files with the same name in different folders of an archive are all unpacked. Later files are numbered, like "resume (2).pdf".
+// Names are given in archive order, so every run gives a file the same name.
+func uniqueArchivedFileName(fileName string, takenFileNames map[string]bool) string {
+	extension := path.Ext(fileName)
+	baseName := strings.TrimSuffix(fileName, extension)
+	uniqueFileName := fileName
+	for n := 2; takenFileNames[uniqueFileName]; n++ {
+		uniqueFileName = fmt.Sprintf("%s (%d)%s", baseName, n, extension)
+	}
+	takenFileNames[uniqueFileName] = true
+	return uniqueFileName
+}
+
+func extractArchivedFile(file *zip.File, localFilePath string) (string, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	out, err := os.Create(localFilePath)
+	if err != nil {
+		return "", err
+	}
+	defer out.Close()
+
+	// The size in the archive header cannot be trusted, so reading stops just after the limit.
+	written, err := io.Copy(out, io.LimitReader(reader, maxArchivedFileSize+1))
+	if err == nil && written > maxArchivedFileSize {
+		err = errors.Errorf("archived file is too large: %s", file.Name)
+	}
+	if err != nil {
+		os.Remove(localFilePath)
+		return "", err
+	}
+	return localFilePath, nil
+}
+
+func readArchivedFileHeader(file *zip.File) ([]byte, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	header := make([]byte, 512)
+	n, err := io.ReadFull(reader, header)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, err
+	}
+	return header[:n], nil
+}
+
+// createChildFileUpload stores an unpacked file as a new file upload. It is only marked as uploaded once the file is in storage,
+// which makes it available for processing.
+func createChildFileUpload(parent *model.FileUpload, fileName, localFilePath string) error {
+	child, err := workerStorage.CreateChildFileUploadForTeam(fileName, parent)
+	if err != nil {
+		return err
+	}
+
+	err = fileStorer.UploadLocalFile(child.StoragePath(), child.Name(), localFilePath)
+	if err != nil {
+		skippedErr := workerStorage.UpdateFileUploadWithStatus(child.Id(), "FAILURE")
+		if skippedErr != nil {
+			logger.LogError(skippedErr)
+		}
+		return err
+	}
+
+	return workerStorage.UpdateFileUploadWithStatus(child.Id(), "SUCCESS")
+}
@@ -0,0 +1,305 @@
+package workers
+
+import (
+	"archive/zip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/services/filestorage"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+func Test_unpackFileUploadArchive(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	fileUpload, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id1",
+		Name:             "resumes.zip",
+		PresignedUrl:     "https://presigned_url1",
+		Status:           "SUCCESS",
+		ProcessingStatus: "ONGOING",
+		Team:             team,
+	})
+	nearlyFullTeamFileCount := 99
+	nearlyFullTeam, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &nearlyFullTeamFileCount,
+		FileCountLimit:   100,
+	})
+	nearlyFullTeamFileUpload, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id1",
+		Name:             "resumes.zip",
+		PresignedUrl:     "https://presigned_url1",
+		Status:           "SUCCESS",
+		ProcessingStatus: "ONGOING",
+		Team:             nearlyFullTeam,
+	})
+	unpackedFileUpload, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:                 "fp_test-resume.docx",
+		Name:               "test-resume.docx",
+		Status:             "SUCCESS",
+		ProcessingStatus:   "COMPLETED",
+		Team:               team,
+		ParentFileUploadId: "fp_id1",
+	})
+	unpackedTextFileUpload, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:                 "fp_test-resume.txt",
+		Name:               "test-resume.txt",
+		Status:             "SUCCESS",
+		ProcessingStatus:   "COMPLETED",
+		Team:               team,
+		ParentFileUploadId: "fp_id1",
+	})
+	tooManyFilesArchivePath := filepath.Join(t.TempDir(), "too-many-resumes.zip")
+	tooManyFilesArchive, err := os.Create(tooManyFilesArchivePath)
+	assert.NoError(t, err)
+	tooManyFilesArchiveWriter := zip.NewWriter(tooManyFilesArchive)
+	for i := 0; i <= maxArchivedFileCount; i++ {
+		_, err = tooManyFilesArchiveWriter.Create(fmt.Sprintf("resume-%d.txt", i))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, tooManyFilesArchiveWriter.Close())
+	assert.NoError(t, tooManyFilesArchive.Close())
+
+	tests := []struct {
+		name                         string
+		input                        *model.FileUpload
+		localFilePath                string
+		children                     []*model.FileUpload
+		getChildrenError             error
+		createChildError             error
+		uploadFails                  bool
+		expectedCreatedFileNames     []string
+		expectedUploadedFiles        []string
+		expectedStatusUpdates        map[string]string
+		expectedProcessingCompletion bool
+		errorExpected                bool
+		errorString                  string
+		failureCategory              string
+	}{
+		{
+			name:            "errors if fileUpload is nil",
+			input:           nil,
+			errorExpected:   true,
+			errorString:     "fileUpload is required",
+			failureCategory: "UNKNOWN ERROR",
+		},
+		{
+			name:            "errors if unable to get fileUpload local path",
+			input:           fileUpload,
+			localFilePath:   "",
+			errorExpected:   true,
+			errorString:     "unable to get LocalFilePath",
+			failureCategory: "STORAGE ERROR",
+		},
+		{
+			name:            "errors if file is not a zip archive",
+			input:           fileUpload,
+			localFilePath:   "test_fixtures/test-resume.pdf",
+			errorExpected:   true,
+			errorString:     "zip: not a valid zip file",
+			failureCategory: "UNREADABLE FILE",
+		},
+		{
+			name:            "errors if archive has too many files",
+			input:           fileUpload,
+			localFilePath:   tooManyFilesArchivePath,
+			errorExpected:   true,
+			errorString:     "archive contains more than 1000 files",
+			failureCategory: "UNREADABLE FILE",
+		},
+		{
+			name:             "errors if unable to get child file uploads",
+			input:            fileUpload,
+			localFilePath:    "test_fixtures/test-resumes.zip",
+			getChildrenError: errors.New("unable to get child file uploads"),
+			errorExpected:    true,
+			errorString:      "unable to get child file uploads",
+			failureCategory:  "STORAGE ERROR",
+		},
+		{
+			name:            "errors if archive has no supported files",
+			input:           fileUpload,
+			localFilePath:   "test_fixtures/test-no-resumes.zip",
+			errorExpected:   true,
+			errorString:     "archive does not contain any supported files",
+			failureCategory: "UNREADABLE FILE",
+		},
+		{
+			name:             "errors if unable to create child file upload",
+			input:            fileUpload,
+			localFilePath:    "test_fixtures/test-resumes.zip",
+			createChildError: errors.New("unable to create child file upload"),
+			errorExpected:    true,
+			errorString:      "unable to create child file upload",
+			failureCategory:  "STORAGE ERROR",
+		},
+		{
+			name:                     "errors if unable to upload child file and marks the child as failed",
+			input:                    fileUpload,
+			localFilePath:            "test_fixtures/test-resumes.zip",
+			uploadFails:              true,
+			expectedCreatedFileNames: []string{"test-resume.docx"},
+			expectedStatusUpdates:    map[string]string{"fp_test-resume.docx": "FAILURE"},
+			errorExpected:            true,
+			errorString:              "unable to upload file",
+			failureCategory:          "STORAGE ERROR",
+		},
+		{
+			name:                     "errors if team reaches its file count limit",
+			input:                    nearlyFullTeamFileUpload,
+			localFilePath:            "test_fixtures/test-resumes.zip",
+			expectedCreatedFileNames: []string{"test-resume.docx"},
+			expectedUploadedFiles:    []string{"team_id1/fp_test-resume.docx/test-resume.docx"},
+			expectedStatusUpdates:    map[string]string{"fp_test-resume.docx": "SUCCESS"},
+			errorExpected:            true,
+			errorString:              "File upload limit reached after unpacking 1 of 3 files",
+			failureCategory:          "FILE LIMIT REACHED",
+		},
+		{
+			name:                     "skips files that were unpacked before",
+			input:                    fileUpload,
+			localFilePath:            "test_fixtures/test-resumes.zip",
+			children:                 []*model.FileUpload{unpackedFileUpload},
+			expectedCreatedFileNames: []string{"test-resume.pdf", "test-resume.txt"},
+			expectedUploadedFiles: []string{
+				"team_id1/fp_test-resume.pdf/test-resume.pdf",
+				"team_id1/fp_test-resume.txt/test-resume.txt",
+			},
+			expectedStatusUpdates: map[string]string{
+				"fp_test-resume.pdf": "SUCCESS",
+				"fp_test-resume.txt": "SUCCESS",
+			},
+			expectedProcessingCompletion: true,
+			errorExpected:                false,
+			errorString:                  "",
+		},
+		{
+			name:                     "unpacks files with the same name in different folders under unique names",
+			input:                    fileUpload,
+			localFilePath:            "test_fixtures/test-colliding-resumes.zip",
+			expectedCreatedFileNames: []string{"test-resume.txt", "test-resume (2).txt", "test-resume (2) (2).txt"},
+			expectedUploadedFiles: []string{
+				"team_id1/fp_test-resume.txt/test-resume.txt",
+				"team_id1/fp_test-resume (2).txt/test-resume (2).txt",
+				"team_id1/fp_test-resume (2) (2).txt/test-resume (2) (2).txt",
+			},
+			expectedStatusUpdates: map[string]string{
+				"fp_test-resume.txt":         "SUCCESS",
+				"fp_test-resume (2).txt":     "SUCCESS",
+				"fp_test-resume (2) (2).txt": "SUCCESS",
+			},
+			expectedProcessingCompletion: true,
+			errorExpected:                false,
+			errorString:                  "",
+		},
+		{
+			name:                     "skips files with the same name in different folders that were unpacked before",
+			input:                    fileUpload,
+			localFilePath:            "test_fixtures/test-colliding-resumes.zip",
+			children:                 []*model.FileUpload{unpackedTextFileUpload},
+			expectedCreatedFileNames: []string{"test-resume (2).txt", "test-resume (2) (2).txt"},
+			expectedUploadedFiles: []string{
+				"team_id1/fp_test-resume (2).txt/test-resume (2).txt",
+				"team_id1/fp_test-resume (2) (2).txt/test-resume (2) (2).txt",
+			},
+			expectedStatusUpdates: map[string]string{
+				"fp_test-resume (2).txt":     "SUCCESS",
+				"fp_test-resume (2) (2).txt": "SUCCESS",
+			},
+			expectedProcessingCompletion: true,
+			errorExpected:                false,
+			errorString:                  "",
+		},
+		{
+			name:                     "success",
+			input:                    fileUpload,
+			localFilePath:            "test_fixtures/test-resumes.zip",
+			expectedCreatedFileNames: []string{"test-resume.docx", "test-resume.pdf", "test-resume.txt"},
+			expectedUploadedFiles: []string{
+				"team_id1/fp_test-resume.docx/test-resume.docx",
+				"team_id1/fp_test-resume.pdf/test-resume.pdf",
+				"team_id1/fp_test-resume.txt/test-resume.txt",
+			},
+			expectedStatusUpdates: map[string]string{
+				"fp_test-resume.docx": "SUCCESS",
+				"fp_test-resume.pdf":  "SUCCESS",
+				"fp_test-resume.txt":  "SUCCESS",
+			},
+			expectedProcessingCompletion: true,
+			errorExpected:                false,
+			errorString:                  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			createdFileNames := []string{}
+			statusUpdates := map[string]string{}
+			processingCompleted := false
+			fileStorerMock := &filestorage.FileStorerMock{
+				LocalFilePath: tt.localFilePath,
+				UploadFails:   tt.uploadFails,
+			}
+			logger = &utilities.NullLogger{}
+			fileStorer = fileStorerMock
+			workerStorage = storage.NewStorageAccessorMock(
+				storage.WithFileUploadAccessorMock(&storage.FileUploadAccessorConfigurableMock{
+					GetChildFileUploadsForTeamInternal: func(parentFileUploadId string, team *model.Team) ([]*model.FileUpload, error) {
+						return tt.children, tt.getChildrenError
+					},
+					CreateChildFileUploadForTeamInternal: func(name string, parent *model.FileUpload) (*model.FileUpload, error) {
+						if tt.createChildError != nil {
+							return nil, tt.createChildError
+						}
+						createdFileNames = append(createdFileNames, name)
+						return model.NewFileUpload(model.FileUploadOptions{
+							Id:                 "fp_" + name,
+							Name:               name,
+							Status:             "INITIATED",
+							ProcessingStatus:   "NOT STARTED",
+							Team:               parent.Team(),
+							ParentFileUploadId: parent.Id(),
+						})
+					},
+					UpdateFileUploadWithStatusInternal: func(id, status string) error {
+						statusUpdates[id] = status
+						return nil
+					},
+					UpdateFileUploadWithProcessingStatusInternal: func(id, processingStatus string) error {
+						processingCompleted = id == "fp_id1" && processingStatus == "COMPLETED"
+						return nil
+					},
+				}),
+			)
+
+			err := unpackFileUploadArchive(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+				assert.Equal(t, tt.failureCategory, processingFailureCategory(err))
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.ElementsMatch(t, tt.expectedCreatedFileNames, createdFileNames)
+			assert.ElementsMatch(t, tt.expectedUploadedFiles, fileStorerMock.UploadedFiles)
+			if tt.expectedStatusUpdates == nil {
+				assert.Empty(t, statusUpdates)
+			} else {
+				assert.Equal(t, tt.expectedStatusUpdates, statusUpdates)
+			}
+			assert.Equal(t, tt.expectedProcessingCompletion, processingCompleted)
+		})
+	}
+}
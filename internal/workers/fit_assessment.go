@@ -0,0 +1,105 @@
+package workers
+
+import (
+	"fmt"
+
+	"github.com/gocraft/work"
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/lib/fitassessor"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+func (j *jobContext) assessCandidateFit(job *work.Job) error {
+	fitAssessmentId := job.ArgString("fitAssessmentId")
+
+	fitAssessment, err := updateFitAssessmentToOngoing(fitAssessmentId)
+	if err != nil {
+		logger.LogError(err)
+		return err
+	}
+
+	verdict, err := assessCandidateFitUsingAi(fitAssessment)
+	if err != nil {
+		logger.LogError(err)
+		skippedErr := workerStorage.UpdateFitAssessmentWithFailure(fitAssessment.Id(), err.Error())
+		if skippedErr != nil {
+			logger.LogError(skippedErr)
+		}
+		return err
+	}
+
+	err = workerStorage.UpdateFitAssessmentWithVerdict(fitAssessment.Id(), verdict)
+	if err != nil {
+		logger.LogError(err)
+		return err
+	}
+	return nil
+}
+
+func updateFitAssessmentToOngoing(fitAssessmentId string) (*model.FitAssessment, error) {
+	if utilities.IsBlank(fitAssessmentId) {
+		err := errors.New("fitAssessmentId is required")
+		logger.LogError(err)
+		return nil, err
+	}
+
+	tx, err := workerStorage.BeginTransaction()
+	if err != nil {
+		logger.LogError(err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	fitAssessment, err := workerStorage.GetFitAssessmentUsingTx(fitAssessmentId, tx)
+	if err != nil {
+		logger.LogError(err)
+		return nil, err
+	}
+
+	if !fitAssessment.NotStarted() {
+		err = fmt.Errorf("fitAssessment is in incorrect state: %s", fitAssessment.Id())
+		logger.LogError(err)
+		return nil, err
+	}
+
+	err = workerStorage.UpdateFitAssessmentWithStatusUsingTx(fitAssessmentId, "ONGOING", tx)
+	if err != nil {
+		logger.LogError(err)
+		return nil, err
+	}
+
+	return fitAssessment, tx.Commit()
+}
+
+// assessCandidateFitUsingAi only sends the persona and job opening the assessment was requested for, since the verdict is cached for those versions.
+func assessCandidateFitUsingAi(fitAssessment *model.FitAssessment) (*model.FitVerdict, error) {
+	if fitAssessment == nil {
+		return nil, errors.New("fitAssessment is required")
+	}
+
+	if fitAssessment.PromptVersion() != fitassessor.PROMPT_VERSION {
+		return nil, errors.Errorf("prompt version %s is no longer in use", fitAssessment.PromptVersion())
+	}
+
+	candidate, err := workerStorage.GetCandidateForTeam(fitAssessment.CandidateId(), fitAssessment.Team())
+	if err != nil {
+		return nil, err
+	}
+
+	persona := candidate.Persona()
+	if persona.Version() != fitAssessment.PersonaVersion() {
+		return nil, errors.Errorf("persona of candidate %s changed after the fit assessment was requested", candidate.Id())
+	}
+
+	jobOpening, err := workerStorage.GetJobOpeningForTeam(fitAssessment.JobOpeningId(), fitAssessment.Team())
+	if err != nil {
+		return nil, err
+	}
+
+	if jobOpening.Version() != fitAssessment.JobOpeningVersion() {
+		return nil, errors.Errorf("job opening %s changed after the fit assessment was requested", jobOpening.Id())
+	}
+
+	return fitassessor.Assess(jobOpening, persona, openAiClient)
+}
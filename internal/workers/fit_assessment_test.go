@@ -0,0 +1,326 @@
+package workers
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/clients/openai"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/lib/fitassessor"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+func Test_updateFitAssessmentToOngoing(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	fitAssessmentWithStatus := func(status string) *model.FitAssessment {
+		fitAssessment, _ := model.NewFitAssessment(model.FitAssessmentOptions{
+			Id:                "fa_id1",
+			CandidateId:       "c_id1",
+			JobOpeningId:      "jo_id1",
+			PersonaVersion:    "pv1",
+			JobOpeningVersion: "jv1",
+			PromptVersion:     fitassessor.PROMPT_VERSION,
+			Status:            status,
+			Team:              team,
+		})
+		return fitAssessment
+	}
+
+	tests := []struct {
+		name                      string
+		input                     string
+		output                    *model.FitAssessment
+		fitAssessmentAccessorMock storage.FitAssessmentAccessor
+		txMock                    *storage.DatabaseTransactionMock
+		txShouldCommit            bool
+		errorExpected             bool
+		errorString               string
+	}{
+		{
+			name:                      "errors if fitAssessmentId is blank",
+			input:                     "",
+			output:                    nil,
+			fitAssessmentAccessorMock: nil,
+			txMock:                    nil,
+			txShouldCommit:            false,
+			errorExpected:             true,
+			errorString:               "fitAssessmentId is required",
+		},
+		{
+			name:                      "errors if unable to get transaction",
+			input:                     "fa_id1",
+			output:                    nil,
+			fitAssessmentAccessorMock: nil,
+			txMock:                    nil,
+			txShouldCommit:            false,
+			errorExpected:             true,
+			errorString:               "unable to begin a db transaction",
+		},
+		{
+			name:   "errors if fitAssessment is not in db",
+			input:  "fa_id1",
+			output: nil,
+			fitAssessmentAccessorMock: &storage.FitAssessmentAccessorConfigurableMock{
+				GetFitAssessmentUsingTxInternal: func(string, storage.DatabaseTransaction) (*model.FitAssessment, error) {
+					return nil, errors.New("no fit assessment for id fa_id1")
+				},
+			},
+			txMock:         &storage.DatabaseTransactionMock{},
+			txShouldCommit: false,
+			errorExpected:  true,
+			errorString:    "no fit assessment for id fa_id1",
+		},
+		{
+			name:   "errors if fitAssessment is already started",
+			input:  "fa_id1",
+			output: nil,
+			fitAssessmentAccessorMock: &storage.FitAssessmentAccessorConfigurableMock{
+				GetFitAssessmentUsingTxInternal: func(string, storage.DatabaseTransaction) (*model.FitAssessment, error) {
+					return fitAssessmentWithStatus("ONGOING"), nil
+				},
+			},
+			txMock:         &storage.DatabaseTransactionMock{},
+			txShouldCommit: false,
+			errorExpected:  true,
+			errorString:    "fitAssessment is in incorrect state: fa_id1",
+		},
+		{
+			name:   "errors if unable to update fitAssessment",
+			input:  "fa_id1",
+			output: nil,
+			fitAssessmentAccessorMock: &storage.FitAssessmentAccessorConfigurableMock{
+				GetFitAssessmentUsingTxInternal: func(string, storage.DatabaseTransaction) (*model.FitAssessment, error) {
+					return fitAssessmentWithStatus("NOT STARTED"), nil
+				},
+				UpdateFitAssessmentWithStatusUsingTxInternal: func(id, status string, tx storage.DatabaseTransaction) error {
+					return errors.New("unable to update fit assessment")
+				},
+			},
+			txMock:         &storage.DatabaseTransactionMock{},
+			txShouldCommit: false,
+			errorExpected:  true,
+			errorString:    "unable to update fit assessment",
+		},
+		{
+			name:   "successfully returns a fit assessment",
+			input:  "fa_id1",
+			output: fitAssessmentWithStatus("NOT STARTED"),
+			fitAssessmentAccessorMock: &storage.FitAssessmentAccessorConfigurableMock{
+				GetFitAssessmentUsingTxInternal: func(string, storage.DatabaseTransaction) (*model.FitAssessment, error) {
+					return fitAssessmentWithStatus("NOT STARTED"), nil
+				},
+				UpdateFitAssessmentWithStatusUsingTxInternal: func(id, status string, tx storage.DatabaseTransaction) error {
+					if id != "fa_id1" || status != "ONGOING" {
+						return errors.New("unexpected fit assessment update")
+					}
+					return nil
+				},
+			},
+			txMock:         &storage.DatabaseTransactionMock{},
+			txShouldCommit: true,
+			errorExpected:  false,
+			errorString:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		logger = &utilities.NullLogger{}
+		workerStorage = storage.NewStorageAccessorMock(
+			storage.WithDatabaseTransactionProviderMock(&storage.DatabaseTransactionProviderMock{
+				Transaction: tt.txMock,
+			}),
+			storage.WithFitAssessmentAccessorMock(tt.fitAssessmentAccessorMock),
+		)
+
+		t.Run(tt.name, func(t *testing.T) {
+			fitAssessment, err := updateFitAssessmentToOngoing(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.output, fitAssessment)
+			}
+
+			if tt.txMock != nil {
+				if tt.txShouldCommit {
+					assert.True(t, tt.txMock.Committed, "transaction should have committed")
+				} else {
+					assert.True(t, tt.txMock.Rolledback, "transaction should have rolledback")
+					assert.False(t, tt.txMock.Committed, "transaction should not have committed")
+				}
+			}
+		})
+	}
+}
+
+func Test_assessCandidateFitUsingAi(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+	persona := &model.Persona{Name: "Jane Doe", TechSkills: []string{"Go"}}
+	candidate, _ := model.NewCandidate(model.CandidateOptions{
+		Id:                     "c_id1",
+		ManuallyCreatedPersona: persona,
+		Team:                   team,
+	})
+	jobOpening, _ := model.NewJobOpening(model.JobOpeningOptions{
+		Id:             "jo_id1",
+		Title:          "Backend Engineer",
+		RequiredSkills: []string{"Go"},
+		Team:           team,
+	})
+	fitAssessmentWithVersions := func(personaVersion, jobOpeningVersion, promptVersion string) *model.FitAssessment {
+		fitAssessment, _ := model.NewFitAssessment(model.FitAssessmentOptions{
+			Id:                "fa_id1",
+			CandidateId:       "c_id1",
+			JobOpeningId:      "jo_id1",
+			PersonaVersion:    personaVersion,
+			JobOpeningVersion: jobOpeningVersion,
+			PromptVersion:     promptVersion,
+			Status:            "ONGOING",
+			Team:              team,
+		})
+		return fitAssessment
+	}
+	candidateAccessorMock := &storage.CandidateAccessorConfigurableMock{
+		GetCandidateForTeamInternal: func(id string, team *model.Team) (*model.Candidate, error) {
+			return candidate, nil
+		},
+	}
+	jobOpeningAccessorMock := &storage.JobOpeningAccessorConfigurableMock{
+		GetJobOpeningForTeamInternal: func(id string, team *model.Team) (*model.JobOpening, error) {
+			return jobOpening, nil
+		},
+	}
+
+	tests := []struct {
+		name                   string
+		input                  *model.FitAssessment
+		output                 *model.FitVerdict
+		candidateAccessorMock  storage.CandidateAccessor
+		jobOpeningAccessorMock storage.JobOpeningAccessor
+		openAiClientMock       openai.Client
+		errorExpected          bool
+		errorString            string
+	}{
+		{
+			name:                   "errors if fitAssessment is nil",
+			input:                  nil,
+			output:                 nil,
+			candidateAccessorMock:  nil,
+			jobOpeningAccessorMock: nil,
+			openAiClientMock:       nil,
+			errorExpected:          true,
+			errorString:            "fitAssessment is required",
+		},
+		{
+			name:                   "errors if the prompt version is no longer in use",
+			input:                  fitAssessmentWithVersions(persona.Version(), jobOpening.Version(), "0.0.1"),
+			output:                 nil,
+			candidateAccessorMock:  nil,
+			jobOpeningAccessorMock: nil,
+			openAiClientMock:       nil,
+			errorExpected:          true,
+			errorString:            "prompt version 0.0.1 is no longer in use",
+		},
+		{
+			name:   "errors if unable to get candidate",
+			input:  fitAssessmentWithVersions(persona.Version(), jobOpening.Version(), fitassessor.PROMPT_VERSION),
+			output: nil,
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				GetCandidateForTeamInternal: func(id string, team *model.Team) (*model.Candidate, error) {
+					return nil, errors.New("no candidate for id c_id1")
+				},
+			},
+			jobOpeningAccessorMock: nil,
+			openAiClientMock:       nil,
+			errorExpected:          true,
+			errorString:            "no candidate for id c_id1",
+		},
+		{
+			name:                   "errors if the persona changed",
+			input:                  fitAssessmentWithVersions("pv1", jobOpening.Version(), fitassessor.PROMPT_VERSION),
+			output:                 nil,
+			candidateAccessorMock:  candidateAccessorMock,
+			jobOpeningAccessorMock: nil,
+			openAiClientMock:       nil,
+			errorExpected:          true,
+			errorString:            "persona of candidate c_id1 changed after the fit assessment was requested",
+		},
+		{
+			name:                  "errors if unable to get job opening",
+			input:                 fitAssessmentWithVersions(persona.Version(), jobOpening.Version(), fitassessor.PROMPT_VERSION),
+			output:                nil,
+			candidateAccessorMock: candidateAccessorMock,
+			jobOpeningAccessorMock: &storage.JobOpeningAccessorConfigurableMock{
+				GetJobOpeningForTeamInternal: func(id string, team *model.Team) (*model.JobOpening, error) {
+					return nil, errors.New("no job opening for id jo_id1")
+				},
+			},
+			openAiClientMock: nil,
+			errorExpected:    true,
+			errorString:      "no job opening for id jo_id1",
+		},
+		{
+			name:                   "errors if the job opening changed",
+			input:                  fitAssessmentWithVersions(persona.Version(), "jv1", fitassessor.PROMPT_VERSION),
+			output:                 nil,
+			candidateAccessorMock:  candidateAccessorMock,
+			jobOpeningAccessorMock: jobOpeningAccessorMock,
+			openAiClientMock:       nil,
+			errorExpected:          true,
+			errorString:            "job opening jo_id1 changed after the fit assessment was requested",
+		},
+		{
+			name:                   "errors if Open Ai fails",
+			input:                  fitAssessmentWithVersions(persona.Version(), jobOpening.Version(), fitassessor.PROMPT_VERSION),
+			output:                 nil,
+			candidateAccessorMock:  candidateAccessorMock,
+			jobOpeningAccessorMock: jobOpeningAccessorMock,
+			openAiClientMock:       &openai.MockClientFailure{},
+			errorExpected:          true,
+			errorString:            "unable to call Open Ai",
+		},
+		{
+			name:                   "returns the verdict",
+			input:                  fitAssessmentWithVersions(persona.Version(), jobOpening.Version(), fitassessor.PROMPT_VERSION),
+			output:                 &model.FitVerdict{Rating: "STRONG FIT", Strengths: []string{"Knows Go"}},
+			candidateAccessorMock:  candidateAccessorMock,
+			jobOpeningAccessorMock: jobOpeningAccessorMock,
+			openAiClientMock:       &openai.MockClientSuccess{Text: `{"Rating": "STRONG FIT", "Strengths": ["Knows Go"]}`},
+			errorExpected:          false,
+			errorString:            "",
+		},
+	}
+
+	for _, tt := range tests {
+		logger = &utilities.NullLogger{}
+		openAiClient = tt.openAiClientMock
+		workerStorage = storage.NewStorageAccessorMock(
+			storage.WithCandidateAccessorMock(tt.candidateAccessorMock),
+			storage.WithJobOpeningAccessorMock(tt.jobOpeningAccessorMock),
+		)
+
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := assessCandidateFitUsingAi(tt.input)
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.output, verdict)
+		})
+	}
+}
@@ -1,40 +1,94 @@
 package workers
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"os"
+	"time"
 
 	"github.com/gocraft/work"
 	"github.com/pkg/errors"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/lib/parser"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/lib/parser/personabuilder"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
 )
 
 type jobContext struct{}
 
+// errIncorrectProcessingState means the file upload was enqueued more than once, or was already processed. Running the job again would not help.
+var errIncorrectProcessingState = errors.New("fileUpload is in incorrect processing state")
+
 func (j *jobContext) processFileUpload(job *work.Job) error {
 	fileUploadId := job.ArgString("fileUploadId")
 
 	fileUpload, err := updateFileUploadToProcessing(fileUploadId)
 	if err != nil {
 		logger.LogError(err)
+		if errors.Is(err, errIncorrectProcessingState) {
+			return nil
+		}
 		return err
 	}
 
-	err = processFileUploadUsingAi(fileUpload)
+	stopHeartbeat := startFileUploadHeartbeat(fileUpload.Id(), processingLease/3)
+	defer stopHeartbeat()
+
+	if fileUpload.IsArchive() {
+		err = unpackFileUploadArchive(fileUpload)
+	} else {
+		err = processFileUploadUsingAi(fileUpload)
+	}
 	if err != nil {
 		logger.LogError(err)
-		skippedErr := workerStorage.UpdateFileUploadWithProcessingStatus(fileUpload.Id(), "FAILED")
+		retryable := isRetryableProcessingError(err)
+		// job.Fails does not count this failure yet.
+		if retryable && job.Fails+1 < processFileUploadMaxFails {
+			skippedErr := updateFileUploadToRetrying(fileUpload.Id(), job.Fails+1, err)
+			if skippedErr != nil {
+				logger.LogError(skippedErr)
+			}
+			return err
+		}
+
+		skippedErr := updateFileUploadToFailed(fileUpload.Id(), err)
 		if skippedErr != nil {
 			logger.LogError(skippedErr)
 		}
+		if !retryable {
+			// The failure is recorded on the file upload, and running the job again would fail the same way.
+			return nil
+		}
 		return err
 	}
 
 	return nil
 }
 
+// updateFileUploadToRetrying records the failure along with the latest time the retry can start, which gocraft picks with processingRetryBackoff.
+func updateFileUploadToRetrying(fileUploadId string, fails int64, processingErr error) error {
+	now := time.Now()
+	failure, err := newFileUploadFailureFromError(processingErr, now)
+	if err != nil {
+		logger.LogError(err)
+		return workerStorage.UpdateFileUploadWithProcessingStatus(fileUploadId, "RETRYING")
+	}
+	retryDeadline := now.Add(time.Duration(processingRetryBackoffCeiling(fails)) * time.Second)
+	return workerStorage.UpdateFileUploadWithProcessingRetry(fileUploadId, failure, retryDeadline)
+}
+
+func updateFileUploadToFailed(fileUploadId string, processingErr error) error {
+	failure, err := newFileUploadFailureFromError(processingErr, time.Now())
+	if err != nil {
+		logger.LogError(err)
+		return workerStorage.UpdateFileUploadWithProcessingStatus(fileUploadId, "FAILED")
+	}
+	return workerStorage.UpdateFileUploadWithProcessingFailure(fileUploadId, failure)
+}
+
 func updateFileUploadToProcessing(fileUploadId string) (*model.FileUpload, error) {
 	if utilities.IsBlank(fileUploadId) {
 		err := errors.New("fileUploadId is required")
@@ -56,7 +110,7 @@ func updateFileUploadToProcessing(fileUploadId string) (*model.FileUpload, error
 	}
 
 	if fileUpload.ProcessingOngoing() || fileUpload.ProcessingFinised() {
-		err = fmt.Errorf("fileUpload is in incorrect processing state: %s", fileUpload.Id())
+		err = fmt.Errorf("%w: %s", errIncorrectProcessingState, fileUpload.Id())
 		logger.LogError(err)
 		return nil, err
 	}
@@ -80,43 +134,138 @@ func processFileUploadUsingAi(fileUpload *model.FileUpload) error {
 	tx, err := workerStorage.BeginTransaction()
 	if err != nil {
 		logger.LogError(err)
-		return err
+		return newProcessingError("STORAGE ERROR", err)
 	}
 	defer tx.Rollback()
 
-	localFilePath, err := fileStorer.GetLocalFilePath(fileUpload.StoragePath(), fileUpload.Name())
-	if err != nil {
-		logger.LogError(err)
-		return err
+	// A file upload processed before has its content hash and text stored along with it, so the file is not downloaded again.
+	// It is only extracted again when the stored text was extracted by an older version of an extractor.
+	contentHash := fileUpload.ContentHash()
+	var fileUploadText *model.FileUploadText
+	if !utilities.IsBlank(contentHash) {
+		fileUploadText, err = workerStorage.GetFileUploadTextUsingTx(fileUpload.Id(), tx)
+		if err != nil {
+			logger.LogError(err)
+			return newProcessingError("STORAGE ERROR", err)
+		}
+		if fileUploadText != nil && !parser.IsCurrentExtractorVersion(fileUploadText.ExtractorVersion()) {
+			fileUploadText = nil
+		}
+	}
+
+	localFilePath := ""
+	if fileUploadText == nil {
+		localFilePath, err = fileStorer.GetLocalFilePath(fileUpload.StoragePath(), fileUpload.Name())
+		if err != nil {
+			logger.LogError(err)
+			return newProcessingError("STORAGE ERROR", err)
+		}
+
+		contentHash, err = fileContentHash(localFilePath)
+		if err != nil {
+			logger.LogError(err)
+			return newProcessingError("UNREADABLE FILE", err)
+		}
+
+		err = workerStorage.UpdateFileUploadWithContentHashUsingTx(fileUpload.Id(), contentHash, tx)
+		if err != nil {
+			logger.LogError(err)
+			return newProcessingError("STORAGE ERROR", err)
+		}
 	}
 
-	text, err := parser.GetTextFromPdf(localFilePath)
+	// A file the team has already uploaded is linked to the existing candidate instead of being sent to the LLM again.
+	duplicateOfCandidateId, err := workerStorage.GetDuplicateCandidateIdForFileUploadUsingTx(fileUpload, contentHash, tx)
 	if err != nil {
 		logger.LogError(err)
-		return err
+		return newProcessingError("STORAGE ERROR", err)
 	}
 
-	logger.LogMessageln(text)
+	if !utilities.IsBlank(duplicateOfCandidateId) {
+		err = workerStorage.UpdateFileUploadAsDuplicateUsingTx(fileUpload.Id(), duplicateOfCandidateId, tx)
+		if err != nil {
+			logger.LogError(err)
+			return newProcessingError("STORAGE ERROR", err)
+		}
 
-	persona, err := personabuilder.Build(text, openAiClient)
+		err = tx.Commit()
+		if err != nil {
+			return newProcessingError("STORAGE ERROR", err)
+		}
+		return nil
+	}
+
+	if fileUploadText == nil {
+		fileUploadText, err = extractFileUploadText(fileUpload, localFilePath, tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	persona, err := personabuilder.Build(fileUploadText.Text(), openAiClient)
 	if err != nil {
 		logger.LogError(err)
-		return err
+		return newPersonaBuildProcessingError(err)
 	}
 
 	persona.FileUploadId = fileUpload.Id()
 
-	err = workerStorage.CreateCandidateWithAiGeneratedPersonaForTeamUsingTx(persona, fileUpload.Team(), tx)
+	err = workerStorage.UpsertCandidateWithAiGeneratedPersonaForTeamUsingTx(persona, fileUpload.Team(), tx)
 	if err != nil {
 		logger.LogError(err)
-		return err
+		return newProcessingError("STORAGE ERROR", err)
 	}
 
 	err = workerStorage.UpdateFileUploadWithProcessingStatusUsingTx(fileUpload.Id(), "COMPLETED", tx)
 	if err != nil {
 		logger.LogError(err)
-		return err
+		return newProcessingError("STORAGE ERROR", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return newProcessingError("STORAGE ERROR", err)
+	}
+	return nil
+}
+
+func extractFileUploadText(fileUpload *model.FileUpload, localFilePath string, tx storage.DatabaseTransaction) (*model.FileUploadText, error) {
+	extractedText, err := parser.ExtractText(localFilePath, fileUpload.Name())
+	if err != nil {
+		logger.LogError(err)
+		return nil, newProcessingError("UNREADABLE FILE", err)
+	}
+
+	fileUploadText, err := model.NewFileUploadText(model.FileUploadTextOptions{
+		FileUploadId:     fileUpload.Id(),
+		Text:             extractedText.Text,
+		PageCount:        extractedText.PageCount,
+		ExtractorVersion: extractedText.ExtractorVersion,
+	})
+	if err != nil {
+		logger.LogError(err)
+		return nil, err
+	}
+
+	err = workerStorage.UpsertFileUploadTextUsingTx(fileUploadText, tx)
+	if err != nil {
+		logger.LogError(err)
+		return nil, newProcessingError("STORAGE ERROR", err)
+	}
+	return fileUploadText, nil
+}
+
+func fileContentHash(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
 	}
+	defer file.Close()
 
-	return tx.Commit()
+	hash := sha256.New()
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
 }
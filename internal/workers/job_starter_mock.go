@@ -23,6 +23,6 @@ func (j *JobStarterMockCallCheck) EnqueueUnique(jobName string, args map[string]
 
 type JobStarterMockFailure struct{}
 
-func (j *JobStarterMockFailure) Enqueue(jobName string, args map[string]interface{}) (*work.Job, error) {
+func (j *JobStarterMockFailure) EnqueueUnique(jobName string, args map[string]interface{}) (*work.Job, error) {
 	return nil, errors.New("unable to enqueue job")
 }
@@ -2,10 +2,13 @@ package workers
 
 import (
 	"testing"
+	"time"
 
+	"github.com/gocraft/work"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/clients/openai"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/lib/parser"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/services/filestorage"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
@@ -177,6 +180,144 @@ func Test_updateFileUploadToProcessing(t *testing.T) {
 	}
 }
 
+func Test_processFileUpload(t *testing.T) {
+	currentFileCount := 1
+	team, _ := model.NewTeam(model.TeamOptions{
+		Id:               "team_id1",
+		Name:             "test@example.com",
+		CurrentFileCount: &currentFileCount,
+		FileCountLimit:   100,
+	})
+
+	tests := []struct {
+		name                     string
+		jobFails                 int64
+		processingStatus         string
+		getFileUploadError       error
+		fileStorer               *filestorage.FileStorerMock
+		expectedProcessingStatus string
+		expectedFailureCategory  string
+		expectedRetryWithin      time.Duration
+		errorExpected            bool
+		errorString              string
+	}{
+		{
+			name:                     "does not retry a file upload that is already processed",
+			jobFails:                 0,
+			processingStatus:         "COMPLETED",
+			fileStorer:               &filestorage.FileStorerMock{},
+			expectedProcessingStatus: "",
+			expectedFailureCategory:  "",
+			errorExpected:            false,
+			errorString:              "",
+		},
+		{
+			name:                     "retries when unable to get the file upload",
+			jobFails:                 0,
+			getFileUploadError:       errors.New("unable to get file upload"),
+			fileStorer:               &filestorage.FileStorerMock{},
+			expectedProcessingStatus: "",
+			expectedFailureCategory:  "",
+			errorExpected:            true,
+			errorString:              "unable to get file upload",
+		},
+		{
+			name:                     "retries a storage error",
+			jobFails:                 0,
+			fileStorer:               &filestorage.FileStorerMock{},
+			expectedProcessingStatus: "RETRYING",
+			expectedFailureCategory:  "STORAGE ERROR",
+			expectedRetryWithin:      processingRetryBaseBackoffSeconds * time.Second,
+			errorExpected:            true,
+			errorString:              "unable to get LocalFilePath",
+		},
+		{
+			name:                     "fails a storage error once retries are exhausted",
+			jobFails:                 processFileUploadMaxFails - 1,
+			fileStorer:               &filestorage.FileStorerMock{},
+			expectedProcessingStatus: "FAILED",
+			expectedFailureCategory:  "STORAGE ERROR",
+			errorExpected:            true,
+			errorString:              "unable to get LocalFilePath",
+		},
+		{
+			name:                     "fails an unreadable file without retrying",
+			jobFails:                 0,
+			fileStorer:               &filestorage.FileStorerMock{LocalFilePath: "test_fixtures/missing.pdf"},
+			expectedProcessingStatus: "FAILED",
+			expectedFailureCategory:  "UNREADABLE FILE",
+			errorExpected:            false,
+			errorString:              "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var processingStatus, failureCategory string
+			var retryDeadline time.Time
+			recordFailure := func(id string, failure *model.FileUploadFailure) error {
+				processingStatus = "FAILED"
+				failureCategory = failure.Category()
+				return nil
+			}
+			recordRetry := func(id string, failure *model.FileUploadFailure, deadline time.Time) error {
+				processingStatus = "RETRYING"
+				failureCategory = failure.Category()
+				retryDeadline = deadline
+				return nil
+			}
+			logger = &utilities.NullLogger{}
+			fileStorer = tt.fileStorer
+			workerStorage = storage.NewStorageAccessorMock(
+				storage.WithDatabaseTransactionProviderMock(&storage.DatabaseTransactionProviderMock{
+					Transaction: &storage.DatabaseTransactionMock{},
+				}),
+				storage.WithFileUploadAccessorMock(&storage.FileUploadAccessorConfigurableMock{
+					GetFileUploadUsingTxInternal: func(string, storage.DatabaseTransaction) (*model.FileUpload, error) {
+						if tt.getFileUploadError != nil {
+							return nil, tt.getFileUploadError
+						}
+						processingStatus := "NOT STARTED"
+						if tt.processingStatus != "" {
+							processingStatus = tt.processingStatus
+						}
+						return model.NewFileUpload(model.FileUploadOptions{
+							Id:               "fp_id1",
+							Name:             "file1.pdf",
+							PresignedUrl:     "https://presigned_url1",
+							Status:           "SUCCESS",
+							ProcessingStatus: processingStatus,
+							Team:             team,
+						})
+					},
+					UpdateFileUploadWithProcessingStatusUsingTxInternal: func(id, processingStatus string, tx storage.DatabaseTransaction) error {
+						return nil
+					},
+					UpdateFileUploadWithProcessingRetryInternal:   recordRetry,
+					UpdateFileUploadWithProcessingFailureInternal: recordFailure,
+				}),
+			)
+
+			j := &jobContext{}
+			err := j.processFileUpload(&work.Job{
+				Name:  PROCESS_FILE_UPLOAD,
+				Args:  map[string]interface{}{"fileUploadId": "fp_id1"},
+				Fails: tt.jobFails,
+			})
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedProcessingStatus, processingStatus)
+			assert.Equal(t, tt.expectedFailureCategory, failureCategory)
+			if tt.expectedRetryWithin > 0 {
+				assert.WithinDuration(t, time.Now().Add(tt.expectedRetryWithin), retryDeadline, 5*time.Second)
+			}
+		})
+	}
+}
+
 func Test_processFileUploadUsingAi(t *testing.T) {
 	currentFileCount := 1
 	team, _ := model.NewTeam(model.TeamOptions{
@@ -193,6 +334,44 @@ func Test_processFileUploadUsingAi(t *testing.T) {
 		ProcessingStatus: "ONGOING",
 		Team:             team,
 	})
+	docxFileUpload, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id2",
+		Name:             "file2.docx",
+		PresignedUrl:     "https://presigned_url2",
+		Status:           "SUCCESS",
+		ProcessingStatus: "ONGOING",
+		Team:             team,
+	})
+	processedFileUpload, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id3",
+		Name:             "file3.pdf",
+		PresignedUrl:     "https://presigned_url3",
+		Status:           "SUCCESS",
+		ProcessingStatus: "ONGOING",
+		Team:             team,
+		ContentHash:      "stored_content_hash",
+	})
+	processedDocxFileUpload, _ := model.NewFileUpload(model.FileUploadOptions{
+		Id:               "fp_id4",
+		Name:             "file4.docx",
+		PresignedUrl:     "https://presigned_url4",
+		Status:           "SUCCESS",
+		ProcessingStatus: "ONGOING",
+		Team:             team,
+		ContentHash:      "stored_content_hash",
+	})
+	storedFileUploadText, _ := model.NewFileUploadText(model.FileUploadTextOptions{
+		FileUploadId:     "fp_id3",
+		Text:             "stored resume text",
+		PageCount:        1,
+		ExtractorVersion: parser.PDF_EXTRACTOR_VERSION,
+	})
+	staleFileUploadText, _ := model.NewFileUploadText(model.FileUploadTextOptions{
+		FileUploadId:     "fp_id4",
+		Text:             "stale resume text",
+		PageCount:        1,
+		ExtractorVersion: "docx-v0",
+	})
 	personaJson := `{
 		"Name": "Person",
 		"Email": "someemail@example.com",
@@ -233,16 +412,18 @@ func Test_processFileUploadUsingAi(t *testing.T) {
 		]
 	}`
 	tests := []struct {
-		name                   string
-		input                  *model.FileUpload
-		fileUploadAccessorMock storage.FileUploadAccessor
-		candidateAccessorMock  storage.CandidateAccessor
-		fileStorerMock         filestorage.FileStorer
-		openAiClientMock       openai.Client
-		txMock                 *storage.DatabaseTransactionMock
-		txShouldCommit         bool
-		errorExpected          bool
-		errorString            string
+		name                       string
+		input                      *model.FileUpload
+		fileUploadAccessorMock     storage.FileUploadAccessor
+		fileUploadTextAccessorMock storage.FileUploadTextAccessor
+		candidateAccessorMock      storage.CandidateAccessor
+		fileStorerMock             filestorage.FileStorer
+		openAiClientMock           openai.Client
+		txMock                     *storage.DatabaseTransactionMock
+		txShouldCommit             bool
+		errorExpected              bool
+		errorString                string
+		failureCategory            string
 	}{
 		{
 			name:                   "errors if fileUpload is nil",
@@ -252,6 +433,7 @@ func Test_processFileUploadUsingAi(t *testing.T) {
 			txShouldCommit:         false,
 			errorExpected:          true,
 			errorString:            "fileUpload is required",
+			failureCategory:        "UNKNOWN ERROR",
 		},
 		{
 			name:                   "errors if unable to get transaction",
@@ -261,6 +443,7 @@ func Test_processFileUploadUsingAi(t *testing.T) {
 			txShouldCommit:         false,
 			errorExpected:          true,
 			errorString:            "unable to begin a db transaction",
+			failureCategory:        "STORAGE ERROR",
 		},
 		{
 			name:                   "errors if unable to get fileUpload local path",
@@ -271,40 +454,184 @@ func Test_processFileUploadUsingAi(t *testing.T) {
 			txShouldCommit:         false,
 			errorExpected:          true,
 			errorString:            "unable to get LocalFilePath",
+			failureCategory:        "STORAGE ERROR",
 		},
 		{
-			name:                   "errors if unable to get text from pdf file",
+			name:                   "errors if unable to get text from file",
 			input:                  fileUpload,
 			fileUploadAccessorMock: nil,
 			fileStorerMock: &filestorage.FileStorerMock{
 				LocalFilePath: "invalid_path.pdf",
 			},
-			txMock:         &storage.DatabaseTransactionMock{},
-			txShouldCommit: false,
-			errorExpected:  true,
-			errorString:    "exit status 1",
+			txMock:          &storage.DatabaseTransactionMock{},
+			txShouldCommit:  false,
+			errorExpected:   true,
+			errorString:     "open invalid_path.pdf: no such file or directory",
+			failureCategory: "UNREADABLE FILE",
 		},
 		{
-			name:                   "errors if unable to build persona",
-			input:                  fileUpload,
-			fileUploadAccessorMock: nil,
+			name:  "errors if unable to save content hash",
+			input: docxFileUpload,
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				UpdateFileUploadWithContentHashUsingTxInternal: func(id, contentHash string, tx storage.DatabaseTransaction) error {
+					if id != "fp_id2" || contentHash != "1aeb35c7af772c866668cc31aeb67e99ebb3a6a057add3306d6968e41000ac7f" {
+						return errors.New("unexpected content hash")
+					}
+					return errors.New("unable to save content hash")
+				},
+			},
+			fileStorerMock: &filestorage.FileStorerMock{
+				LocalFilePath: "test_fixtures/test-resume.docx",
+			},
+			txMock:          &storage.DatabaseTransactionMock{},
+			txShouldCommit:  false,
+			errorExpected:   true,
+			errorString:     "unable to save content hash",
+			failureCategory: "STORAGE ERROR",
+		},
+		{
+			name:  "errors if unable to check for an earlier upload of the same file",
+			input: docxFileUpload,
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				UpdateFileUploadWithContentHashUsingTxInternal: func(id, contentHash string, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+				GetDuplicateCandidateIdForFileUploadUsingTxInternal: func(fileUpload *model.FileUpload, contentHash string, tx storage.DatabaseTransaction) (string, error) {
+					return "", errors.New("unable to get duplicate candidate")
+				},
+			},
+			fileStorerMock: &filestorage.FileStorerMock{
+				LocalFilePath: "test_fixtures/test-resume.docx",
+			},
+			txMock:          &storage.DatabaseTransactionMock{},
+			txShouldCommit:  false,
+			errorExpected:   true,
+			errorString:     "unable to get duplicate candidate",
+			failureCategory: "STORAGE ERROR",
+		},
+		{
+			name:  "errors if unable to mark file upload as duplicate",
+			input: docxFileUpload,
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				UpdateFileUploadWithContentHashUsingTxInternal: func(id, contentHash string, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+				GetDuplicateCandidateIdForFileUploadUsingTxInternal: func(fileUpload *model.FileUpload, contentHash string, tx storage.DatabaseTransaction) (string, error) {
+					return "candidate_id1", nil
+				},
+				UpdateFileUploadAsDuplicateUsingTxInternal: func(id, duplicateOfCandidateId string, tx storage.DatabaseTransaction) error {
+					return errors.New("unable to update file upload")
+				},
+			},
+			fileStorerMock: &filestorage.FileStorerMock{
+				LocalFilePath: "test_fixtures/test-resume.docx",
+			},
+			txMock:          &storage.DatabaseTransactionMock{},
+			txShouldCommit:  false,
+			errorExpected:   true,
+			errorString:     "unable to update file upload",
+			failureCategory: "STORAGE ERROR",
+		},
+		{
+			name:  "marks file upload as duplicate without building a persona",
+			input: docxFileUpload,
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				UpdateFileUploadWithContentHashUsingTxInternal: func(id, contentHash string, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+				GetDuplicateCandidateIdForFileUploadUsingTxInternal: func(fileUpload *model.FileUpload, contentHash string, tx storage.DatabaseTransaction) (string, error) {
+					return "candidate_id1", nil
+				},
+				UpdateFileUploadAsDuplicateUsingTxInternal: func(id, duplicateOfCandidateId string, tx storage.DatabaseTransaction) error {
+					if id != "fp_id2" || duplicateOfCandidateId != "candidate_id1" {
+						return errors.New("unexpected duplicate")
+					}
+					return nil
+				},
+			},
+			fileStorerMock: &filestorage.FileStorerMock{
+				LocalFilePath: "test_fixtures/test-resume.docx",
+			},
+			openAiClientMock: nil,
+			txMock:           &storage.DatabaseTransactionMock{},
+			txShouldCommit:   true,
+			errorExpected:    false,
+			errorString:      "",
+		},
+		{
+			name:  "errors if unable to save file upload text",
+			input: fileUpload,
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				UpdateFileUploadWithContentHashUsingTxInternal: func(id, contentHash string, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+				GetDuplicateCandidateIdForFileUploadUsingTxInternal: func(fileUpload *model.FileUpload, contentHash string, tx storage.DatabaseTransaction) (string, error) {
+					return "", nil
+				},
+			},
+			fileUploadTextAccessorMock: &storage.FileUploadTextAccessorConfigurableMock{
+				UpsertFileUploadTextUsingTxInternal: func(fileUploadText *model.FileUploadText, tx storage.DatabaseTransaction) error {
+					if fileUploadText.FileUploadId() != "fp_id1" || fileUploadText.PageCount() != 2 || fileUploadText.ExtractorVersion() != "pdf2go-v0.1.1" {
+						return errors.New("unexpected file upload text")
+					}
+					return errors.New("unable to save file upload text")
+				},
+			},
+			fileStorerMock: &filestorage.FileStorerMock{
+				LocalFilePath: "test_fixtures/test-resume.pdf",
+			},
+			txMock:          &storage.DatabaseTransactionMock{},
+			txShouldCommit:  false,
+			errorExpected:   true,
+			errorString:     "unable to save file upload text",
+			failureCategory: "STORAGE ERROR",
+		},
+		{
+			name:  "errors if unable to build persona",
+			input: fileUpload,
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				UpdateFileUploadWithContentHashUsingTxInternal: func(id, contentHash string, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+				GetDuplicateCandidateIdForFileUploadUsingTxInternal: func(fileUpload *model.FileUpload, contentHash string, tx storage.DatabaseTransaction) (string, error) {
+					return "", nil
+				},
+			},
+			fileUploadTextAccessorMock: &storage.FileUploadTextAccessorConfigurableMock{
+				UpsertFileUploadTextUsingTxInternal: func(fileUploadText *model.FileUploadText, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+			},
 			fileStorerMock: &filestorage.FileStorerMock{
 				LocalFilePath: "test_fixtures/test-resume.pdf",
 			},
 			openAiClientMock: &openai.MockClientSuccess{
 				Text: "what",
 			},
-			txMock:         &storage.DatabaseTransactionMock{},
-			txShouldCommit: false,
-			errorExpected:  true,
-			errorString:    "unable to parse persona json: invalid character 'w' looking for beginning of value",
+			txMock:          &storage.DatabaseTransactionMock{},
+			txShouldCommit:  false,
+			errorExpected:   true,
+			errorString:     "unable to parse persona json: invalid character 'w' looking for beginning of value",
+			failureCategory: "INVALID JSON",
 		},
 		{
-			name:                   "errors if unable to create candidate",
-			input:                  fileUpload,
-			fileUploadAccessorMock: nil,
+			name:  "errors if unable to create candidate",
+			input: fileUpload,
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				UpdateFileUploadWithContentHashUsingTxInternal: func(id, contentHash string, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+				GetDuplicateCandidateIdForFileUploadUsingTxInternal: func(fileUpload *model.FileUpload, contentHash string, tx storage.DatabaseTransaction) (string, error) {
+					return "", nil
+				},
+			},
+			fileUploadTextAccessorMock: &storage.FileUploadTextAccessorConfigurableMock{
+				UpsertFileUploadTextUsingTxInternal: func(fileUploadText *model.FileUploadText, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+			},
 			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
-				CreateCandidateWithAiGeneratedPersonaForTeamUsingTxInternal: func(persona *model.Persona, team *model.Team, tx storage.DatabaseTransaction) error {
+				UpsertCandidateWithAiGeneratedPersonaForTeamUsingTxInternal: func(persona *model.Persona, team *model.Team, tx storage.DatabaseTransaction) error {
 					return errors.New("unable to create candidate")
 				},
 			},
@@ -314,21 +641,33 @@ func Test_processFileUploadUsingAi(t *testing.T) {
 			openAiClientMock: &openai.MockClientSuccess{
 				Text: personaJson,
 			},
-			txMock:         &storage.DatabaseTransactionMock{},
-			txShouldCommit: false,
-			errorExpected:  true,
-			errorString:    "unable to create candidate",
+			txMock:          &storage.DatabaseTransactionMock{},
+			txShouldCommit:  false,
+			errorExpected:   true,
+			errorString:     "unable to create candidate",
+			failureCategory: "STORAGE ERROR",
 		},
 		{
 			name:  "errors if unable to update file upload",
 			input: fileUpload,
+			fileUploadTextAccessorMock: &storage.FileUploadTextAccessorConfigurableMock{
+				UpsertFileUploadTextUsingTxInternal: func(fileUploadText *model.FileUploadText, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+			},
 			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				UpdateFileUploadWithContentHashUsingTxInternal: func(id, contentHash string, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+				GetDuplicateCandidateIdForFileUploadUsingTxInternal: func(fileUpload *model.FileUpload, contentHash string, tx storage.DatabaseTransaction) (string, error) {
+					return "", nil
+				},
 				UpdateFileUploadWithProcessingStatusUsingTxInternal: func(id, processingStatus string, tx storage.DatabaseTransaction) error {
 					return errors.New("unable to update file upload")
 				},
 			},
 			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
-				CreateCandidateWithAiGeneratedPersonaForTeamUsingTxInternal: func(persona *model.Persona, team *model.Team, tx storage.DatabaseTransaction) error {
+				UpsertCandidateWithAiGeneratedPersonaForTeamUsingTxInternal: func(persona *model.Persona, team *model.Team, tx storage.DatabaseTransaction) error {
 					return nil
 				},
 			},
@@ -338,21 +677,33 @@ func Test_processFileUploadUsingAi(t *testing.T) {
 			openAiClientMock: &openai.MockClientSuccess{
 				Text: personaJson,
 			},
-			txMock:         &storage.DatabaseTransactionMock{},
-			txShouldCommit: false,
-			errorExpected:  true,
-			errorString:    "unable to update file upload",
+			txMock:          &storage.DatabaseTransactionMock{},
+			txShouldCommit:  false,
+			errorExpected:   true,
+			errorString:     "unable to update file upload",
+			failureCategory: "STORAGE ERROR",
 		},
 		{
 			name:  "success",
 			input: fileUpload,
+			fileUploadTextAccessorMock: &storage.FileUploadTextAccessorConfigurableMock{
+				UpsertFileUploadTextUsingTxInternal: func(fileUploadText *model.FileUploadText, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+			},
 			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				UpdateFileUploadWithContentHashUsingTxInternal: func(id, contentHash string, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+				GetDuplicateCandidateIdForFileUploadUsingTxInternal: func(fileUpload *model.FileUpload, contentHash string, tx storage.DatabaseTransaction) (string, error) {
+					return "", nil
+				},
 				UpdateFileUploadWithProcessingStatusUsingTxInternal: func(id, processingStatus string, tx storage.DatabaseTransaction) error {
 					return nil
 				},
 			},
 			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
-				CreateCandidateWithAiGeneratedPersonaForTeamUsingTxInternal: func(persona *model.Persona, team *model.Team, tx storage.DatabaseTransaction) error {
+				UpsertCandidateWithAiGeneratedPersonaForTeamUsingTxInternal: func(persona *model.Persona, team *model.Team, tx storage.DatabaseTransaction) error {
 					return nil
 				},
 			},
@@ -367,6 +718,139 @@ func Test_processFileUploadUsingAi(t *testing.T) {
 			errorExpected:  false,
 			errorString:    "",
 		},
+		{
+			name:  "errors if unable to get stored file upload text",
+			input: processedFileUpload,
+			fileUploadTextAccessorMock: &storage.FileUploadTextAccessorConfigurableMock{
+				GetFileUploadTextUsingTxInternal: func(fileUploadId string, tx storage.DatabaseTransaction) (*model.FileUploadText, error) {
+					return nil, errors.New("unable to get file upload text")
+				},
+			},
+			fileStorerMock:  &filestorage.FileStorerMock{},
+			txMock:          &storage.DatabaseTransactionMock{},
+			txShouldCommit:  false,
+			errorExpected:   true,
+			errorString:     "unable to get file upload text",
+			failureCategory: "STORAGE ERROR",
+		},
+		{
+			name:  "rebuilds persona from stored file upload text without getting the file",
+			input: processedFileUpload,
+			fileUploadTextAccessorMock: &storage.FileUploadTextAccessorConfigurableMock{
+				GetFileUploadTextUsingTxInternal: func(fileUploadId string, tx storage.DatabaseTransaction) (*model.FileUploadText, error) {
+					return storedFileUploadText, nil
+				},
+				UpsertFileUploadTextUsingTxInternal: func(fileUploadText *model.FileUploadText, tx storage.DatabaseTransaction) error {
+					return errors.New("stored file upload text should not be saved again")
+				},
+			},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				UpdateFileUploadWithContentHashUsingTxInternal: func(id, contentHash string, tx storage.DatabaseTransaction) error {
+					return errors.New("stored content hash should not be saved again")
+				},
+				GetDuplicateCandidateIdForFileUploadUsingTxInternal: func(fileUpload *model.FileUpload, contentHash string, tx storage.DatabaseTransaction) (string, error) {
+					if contentHash != "stored_content_hash" {
+						return "", errors.New("unexpected content hash")
+					}
+					return "", nil
+				},
+				UpdateFileUploadWithProcessingStatusUsingTxInternal: func(id, processingStatus string, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+			},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				UpsertCandidateWithAiGeneratedPersonaForTeamUsingTxInternal: func(persona *model.Persona, team *model.Team, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+			},
+			fileStorerMock: &filestorage.FileStorerMock{},
+			openAiClientMock: &openai.MockClientSuccess{
+				Text: personaJson,
+			},
+			txMock:         &storage.DatabaseTransactionMock{},
+			txShouldCommit: true,
+			errorExpected:  false,
+			errorString:    "",
+		},
+		{
+			name:  "extracts text again if stored file upload text is from an older extractor",
+			input: processedDocxFileUpload,
+			fileUploadTextAccessorMock: &storage.FileUploadTextAccessorConfigurableMock{
+				GetFileUploadTextUsingTxInternal: func(fileUploadId string, tx storage.DatabaseTransaction) (*model.FileUploadText, error) {
+					return staleFileUploadText, nil
+				},
+				UpsertFileUploadTextUsingTxInternal: func(fileUploadText *model.FileUploadText, tx storage.DatabaseTransaction) error {
+					if fileUploadText.FileUploadId() != "fp_id4" || fileUploadText.ExtractorVersion() != "docx-v1" {
+						return errors.New("unexpected file upload text")
+					}
+					return nil
+				},
+			},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				UpdateFileUploadWithContentHashUsingTxInternal: func(id, contentHash string, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+				GetDuplicateCandidateIdForFileUploadUsingTxInternal: func(fileUpload *model.FileUpload, contentHash string, tx storage.DatabaseTransaction) (string, error) {
+					return "", nil
+				},
+				UpdateFileUploadWithProcessingStatusUsingTxInternal: func(id, processingStatus string, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+			},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				UpsertCandidateWithAiGeneratedPersonaForTeamUsingTxInternal: func(persona *model.Persona, team *model.Team, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+			},
+			fileStorerMock: &filestorage.FileStorerMock{
+				LocalFilePath: "test_fixtures/test-resume.docx",
+			},
+			openAiClientMock: &openai.MockClientSuccess{
+				Text: personaJson,
+			},
+			txMock:         &storage.DatabaseTransactionMock{},
+			txShouldCommit: true,
+			errorExpected:  false,
+			errorString:    "",
+		},
+		{
+			name:  "success with a docx file",
+			input: docxFileUpload,
+			fileUploadTextAccessorMock: &storage.FileUploadTextAccessorConfigurableMock{
+				UpsertFileUploadTextUsingTxInternal: func(fileUploadText *model.FileUploadText, tx storage.DatabaseTransaction) error {
+					if fileUploadText.FileUploadId() != "fp_id2" || fileUploadText.PageCount() != 2 || fileUploadText.ExtractorVersion() != "docx-v1" {
+						return errors.New("unexpected file upload text")
+					}
+					return nil
+				},
+			},
+			fileUploadAccessorMock: &storage.FileUploadAccessorConfigurableMock{
+				UpdateFileUploadWithContentHashUsingTxInternal: func(id, contentHash string, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+				GetDuplicateCandidateIdForFileUploadUsingTxInternal: func(fileUpload *model.FileUpload, contentHash string, tx storage.DatabaseTransaction) (string, error) {
+					return "", nil
+				},
+				UpdateFileUploadWithProcessingStatusUsingTxInternal: func(id, processingStatus string, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+			},
+			candidateAccessorMock: &storage.CandidateAccessorConfigurableMock{
+				UpsertCandidateWithAiGeneratedPersonaForTeamUsingTxInternal: func(persona *model.Persona, team *model.Team, tx storage.DatabaseTransaction) error {
+					return nil
+				},
+			},
+			fileStorerMock: &filestorage.FileStorerMock{
+				LocalFilePath: "test_fixtures/test-resume.docx",
+			},
+			openAiClientMock: &openai.MockClientSuccess{
+				Text: personaJson,
+			},
+			txMock:         &storage.DatabaseTransactionMock{},
+			txShouldCommit: true,
+			errorExpected:  false,
+			errorString:    "",
+		},
 	}
 
 	for _, tt := range tests {
@@ -376,6 +860,7 @@ func Test_processFileUploadUsingAi(t *testing.T) {
 				Transaction: tt.txMock,
 			}),
 			storage.WithFileUploadAccessorMock(tt.fileUploadAccessorMock),
+			storage.WithFileUploadTextAccessorMock(tt.fileUploadTextAccessorMock),
 			storage.WithCandidateAccessorMock(tt.candidateAccessorMock),
 		)
 		fileStorer = tt.fileStorerMock
@@ -385,6 +870,7 @@ func Test_processFileUploadUsingAi(t *testing.T) {
 			err := processFileUploadUsingAi(tt.input)
 			if tt.errorExpected {
 				assert.EqualError(t, err, tt.errorString)
+				assert.Equal(t, tt.failureCategory, processingFailureCategory(err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -1,6 +1,8 @@
 package workers
 
 import (
+	"time"
+
 	"github.com/gocraft/work"
 	"github.com/gomodule/redigo/redis"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/clients/openai"
@@ -10,12 +12,20 @@ import (
 )
 
 const PROCESS_FILE_UPLOAD = "process_file_upload"
+const ASSESS_CANDIDATE_FIT = "assess_candidate_fit"
+const REAP_STALE_FILE_UPLOADS = "reap_stale_file_uploads"
 
 var workerStorage storage.StorageAccessor
 var openAiClient openai.Client
 var logger utilities.Logger
 var fileStorer filestorage.FileStorer
 
+const defaultMaxConcurrentFileUploads = 10
+
+// Fit assessments and the reaper get their own workers, so a large batch of file uploads does not hold them up.
+const maxConcurrentFitAssessments = 5
+const maxConcurrentReapers = 1
+
 type PoolDependencies struct {
 	Namespace    string
 	RedisPool    *redis.Pool
@@ -23,21 +33,47 @@ type PoolDependencies struct {
 	OpenAiApiKey string
 	Logger       utilities.Logger
 	FileStorer   filestorage.FileStorer
+	// ProcessingLease defaults to 5 minutes when it is not set.
+	ProcessingLease time.Duration
+	// MaxConcurrentFileUploads is the most file uploads processed at once across all pools. It defaults to 10 when it is not set.
+	MaxConcurrentFileUploads int
 }
 
 func NewPool(deps PoolDependencies) *work.WorkerPool {
-	pool := work.NewWorkerPool(jobContext{}, 10, deps.Namespace, deps.RedisPool)
+	maxConcurrentFileUploads := deps.MaxConcurrentFileUploads
+	if maxConcurrentFileUploads <= 0 {
+		maxConcurrentFileUploads = defaultMaxConcurrentFileUploads
+	}
+	concurrency := uint(maxConcurrentFileUploads + maxConcurrentFitAssessments + maxConcurrentReapers)
+	pool := work.NewWorkerPool(jobContext{}, concurrency, deps.Namespace, deps.RedisPool)
 
 	pool.JobWithOptions(
 		PROCESS_FILE_UPLOAD,
-		work.JobOptions{MaxFails: 1},
+		work.JobOptions{MaxFails: processFileUploadMaxFails, Backoff: processingRetryBackoff, MaxConcurrency: uint(maxConcurrentFileUploads)},
 		(*jobContext).processFileUpload,
 	)
 
+	pool.JobWithOptions(
+		ASSESS_CANDIDATE_FIT,
+		work.JobOptions{MaxFails: 1, MaxConcurrency: maxConcurrentFitAssessments},
+		(*jobContext).assessCandidateFit,
+	)
+
+	pool.JobWithOptions(
+		REAP_STALE_FILE_UPLOADS,
+		work.JobOptions{MaxFails: 1, MaxConcurrency: maxConcurrentReapers},
+		(*jobContext).reapStaleFileUploads,
+	)
+	// gocraft only enqueues one periodic job per schedule, however many pools are running.
+	pool.PeriodicallyEnqueue("0 * * * * *", REAP_STALE_FILE_UPLOADS)
+
 	// TODO: Not sure if this is the best way to do this. But using Package variables for all dependencies required inside any of the jobs.
 	workerStorage = deps.Storage
 	logger = deps.Logger
 	fileStorer = deps.FileStorer
+	if deps.ProcessingLease > 0 {
+		processingLease = deps.ProcessingLease
+	}
 	openAiClient = openai.NewClient(openai.ClientOptions{ApiKey: deps.OpenAiApiKey}, logger)
 	return pool
 }
@@ -0,0 +1,69 @@
+package workers
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/clients/openai"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/lib/parser/personabuilder"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/model"
+)
+
+// processingError tags an error with the failure category that is shown to the team.
+type processingError struct {
+	category string
+	err      error
+}
+
+func (e *processingError) Error() string {
+	return e.err.Error()
+}
+
+func (e *processingError) Unwrap() error {
+	return e.err
+}
+
+func newProcessingError(category string, err error) error {
+	return &processingError{category: category, err: err}
+}
+
+func newPersonaBuildProcessingError(err error) error {
+	switch {
+	case errors.Is(err, personabuilder.ErrNotAResume):
+		return newProcessingError("NOT A RESUME", err)
+	case errors.Is(err, personabuilder.ErrInvalidPersonaJson):
+		return newProcessingError("INVALID JSON", err)
+	default:
+		return newProcessingError("LLM ERROR", err)
+	}
+}
+
+func processingFailureCategory(err error) string {
+	var pErr *processingError
+	if errors.As(err, &pErr) {
+		return pErr.category
+	}
+	return "UNKNOWN ERROR"
+}
+
+// isRetryableProcessingError reports whether processing may succeed if it is tried again.
+// Storage errors and transient OpenAI errors are retried. Anything about the file itself, such as a file that
+// cannot be read or is not a resume, fails the same way every time and is not.
+func isRetryableProcessingError(err error) bool {
+	switch processingFailureCategory(err) {
+	case "STORAGE ERROR":
+		return true
+	case "LLM ERROR":
+		return openai.IsTransientError(err)
+	default:
+		return false
+	}
+}
+
+func newFileUploadFailureFromError(err error, failedAt time.Time) (*model.FileUploadFailure, error) {
+	return model.NewFileUploadFailure(model.FileUploadFailureOptions{
+		Category: processingFailureCategory(err),
+		Message:  err.Error(),
+		FailedAt: failedAt,
+	})
+}
@@ -0,0 +1,108 @@
+package workers
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	openaigo "github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/lib/parser/personabuilder"
+)
+
+func Test_newFileUploadFailureFromError(t *testing.T) {
+	failedAt := time.Date(2023, time.March, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name             string
+		input            error
+		expectedCategory string
+		expectedMessage  string
+	}{
+		{
+			name:             "uses the category of a processing error",
+			input:            newProcessingError("UNREADABLE FILE", errors.New("exit status 1")),
+			expectedCategory: "UNREADABLE FILE",
+			expectedMessage:  "exit status 1",
+		},
+		{
+			name:             "categorises a non resume as NOT A RESUME",
+			input:            newPersonaBuildProcessingError(personabuilder.ErrNotAResume),
+			expectedCategory: "NOT A RESUME",
+			expectedMessage:  "needs a valid resume to parse",
+		},
+		{
+			name:             "categorises unparseable persona json as INVALID JSON",
+			input:            newPersonaBuildProcessingError(fmt.Errorf("%w: unexpected end of JSON input", personabuilder.ErrInvalidPersonaJson)),
+			expectedCategory: "INVALID JSON",
+			expectedMessage:  "unable to parse persona json: unexpected end of JSON input",
+		},
+		{
+			name:             "categorises any other persona build error as LLM ERROR",
+			input:            newPersonaBuildProcessingError(errors.New("openai request timed out")),
+			expectedCategory: "LLM ERROR",
+			expectedMessage:  "openai request timed out",
+		},
+		{
+			name:             "categorises an untagged error as UNKNOWN ERROR",
+			input:            errors.New("something went wrong"),
+			expectedCategory: "UNKNOWN ERROR",
+			expectedMessage:  "something went wrong",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failure, err := newFileUploadFailureFromError(tt.input, failedAt)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedCategory, failure.Category())
+			assert.Equal(t, tt.expectedMessage, failure.Message())
+			assert.Equal(t, failedAt, failure.FailedAt())
+		})
+	}
+}
+
+func Test_isRetryableProcessingError(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          error
+		expectedOutput bool
+	}{
+		{
+			name:           "retries a storage error",
+			input:          newProcessingError("STORAGE ERROR", errors.New("connection reset by peer")),
+			expectedOutput: true,
+		},
+		{
+			name:           "retries a rate limited LLM call",
+			input:          newPersonaBuildProcessingError(fmt.Errorf("error, status code: 429, message: %w", &openaigo.APIError{StatusCode: 429})),
+			expectedOutput: true,
+		},
+		{
+			name:           "does not retry a rejected LLM call",
+			input:          newPersonaBuildProcessingError(fmt.Errorf("error, status code: 401, message: %w", &openaigo.APIError{StatusCode: 401})),
+			expectedOutput: false,
+		},
+		{
+			name:           "does not retry a non resume",
+			input:          newPersonaBuildProcessingError(personabuilder.ErrNotAResume),
+			expectedOutput: false,
+		},
+		{
+			name:           "does not retry an unreadable file",
+			input:          newProcessingError("UNREADABLE FILE", errors.New("exit status 1")),
+			expectedOutput: false,
+		},
+		{
+			name:           "does not retry an untagged error",
+			input:          errors.New("something went wrong"),
+			expectedOutput: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedOutput, isRetryableProcessingError(tt.input))
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package workers
+
+import (
+	"time"
+
+	"github.com/gocraft/work"
+)
+
+// A file upload left ONGOING by a crashed worker, or RETRYING by a lost retry job, is processed again at most this many times before it is marked FAILED.
+const maxFileUploadReapCount = 2
+
+const defaultProcessingLease = 5 * time.Minute
+
+// processingLease is how long a file upload can go without a heartbeat before it is reaped.
+// Heartbeats are sent a few times per lease, so a single slow heartbeat does not get a live upload reaped.
+var processingLease = defaultProcessingLease
+
+// reapStaleFileUploads also fails fit assessments left ONGOING by a crashed worker, using the same lease.
+func (j *jobContext) reapStaleFileUploads(job *work.Job) error {
+	reapedCount, err := workerStorage.ReapStaleProcessingFileUploads(processingLease, maxFileUploadReapCount)
+	if err != nil {
+		logger.LogError(err)
+		return err
+	}
+	if reapedCount > 0 {
+		logger.LogMessagef("reaped %d stale file uploads\n", reapedCount)
+	}
+
+	failedCount, err := workerStorage.FailStaleFitAssessments(processingLease)
+	if err != nil {
+		logger.LogError(err)
+		return err
+	}
+	if failedCount > 0 {
+		logger.LogMessagef("failed %d stale fit assessments\n", failedCount)
+	}
+	return nil
+}
+
+// startFileUploadHeartbeat keeps recording that the file upload is being processed until the returned func is called.
+func startFileUploadHeartbeat(fileUploadId string, interval time.Duration) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				err := workerStorage.UpdateFileUploadProcessingHeartbeat(fileUploadId)
+				if err != nil {
+					logger.LogError(err)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() { close(done) }
+}
@@ -0,0 +1,106 @@
+package workers
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gocraft/work"
+	"github.com/stretchr/testify/assert"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
+)
+
+func Test_reapStaleFileUploads(t *testing.T) {
+	tests := []struct {
+		name          string
+		reapErr       error
+		failStaleErr  error
+		errorExpected bool
+		errorString   string
+	}{
+		{
+			name:          "reaps stale file uploads and fit assessments using the processing lease",
+			reapErr:       nil,
+			failStaleErr:  nil,
+			errorExpected: false,
+			errorString:   "",
+		},
+		{
+			name:          "errors when reaping fails",
+			reapErr:       errors.New("reap failed"),
+			failStaleErr:  nil,
+			errorExpected: true,
+			errorString:   "reap failed",
+		},
+		{
+			name:          "errors when failing stale fit assessments fails",
+			reapErr:       nil,
+			failStaleErr:  errors.New("fail stale failed"),
+			errorExpected: true,
+			errorString:   "fail stale failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calledLease, calledFitAssessmentLease time.Duration
+			var calledMaxReapCount int
+			logger = &utilities.NullLogger{}
+			workerStorage = storage.NewStorageAccessorMock(
+				storage.WithFileUploadAccessorMock(&storage.FileUploadAccessorConfigurableMock{
+					ReapStaleProcessingFileUploadsInternal: func(lease time.Duration, maxReapCount int) (int, error) {
+						calledLease = lease
+						calledMaxReapCount = maxReapCount
+						return 1, tt.reapErr
+					},
+				}),
+				storage.WithFitAssessmentAccessorMock(&storage.FitAssessmentAccessorConfigurableMock{
+					FailStaleFitAssessmentsInternal: func(lease time.Duration) (int, error) {
+						calledFitAssessmentLease = lease
+						return 1, tt.failStaleErr
+					},
+				}),
+			)
+
+			j := &jobContext{}
+			err := j.reapStaleFileUploads(&work.Job{Name: REAP_STALE_FILE_UPLOADS})
+			if tt.errorExpected {
+				assert.EqualError(t, err, tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, processingLease, calledLease)
+			assert.Equal(t, maxFileUploadReapCount, calledMaxReapCount)
+			if tt.reapErr == nil {
+				assert.Equal(t, processingLease, calledFitAssessmentLease)
+			}
+		})
+	}
+}
+
+func Test_startFileUploadHeartbeat(t *testing.T) {
+	t.Run("sends heartbeats for the file upload until stopped", func(t *testing.T) {
+		var heartbeatCount atomic.Int32
+		logger = &utilities.NullLogger{}
+		workerStorage = storage.NewStorageAccessorMock(
+			storage.WithFileUploadAccessorMock(&storage.FileUploadAccessorConfigurableMock{
+				UpdateFileUploadProcessingHeartbeatInternal: func(id string) error {
+					assert.Equal(t, "fp_id1", id)
+					heartbeatCount.Add(1)
+					return nil
+				},
+			}),
+		)
+
+		stopHeartbeat := startFileUploadHeartbeat("fp_id1", 10*time.Millisecond)
+		time.Sleep(45 * time.Millisecond)
+		stopHeartbeat()
+		countWhenStopped := heartbeatCount.Load()
+		assert.GreaterOrEqual(t, countWhenStopped, int32(2))
+
+		time.Sleep(30 * time.Millisecond)
+		assert.LessOrEqual(t, heartbeatCount.Load(), countWhenStopped+1, "heartbeats should stop once stopped")
+	})
+}
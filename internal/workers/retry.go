@@ -0,0 +1,36 @@
+package workers
+
+import (
+	"math/rand"
+
+	"github.com/gocraft/work"
+)
+
+// A file upload is processed at most this many times before it is marked FAILED.
+const processFileUploadMaxFails = 5
+
+const processingRetryBaseBackoffSeconds = 30
+const processingRetryMaxBackoffSeconds = 60 * 60
+
+// processingRetryBackoff waits exponentially longer after every failure, up to an hour. Half of the wait is random,
+// so uploads that failed together, such as when OpenAI rate limits a large batch, do not all retry at the same moment.
+func processingRetryBackoff(job *work.Job) int64 {
+	return processingRetryBackoffWithJitter(job.Fails, rand.Int63n)
+}
+
+func processingRetryBackoffWithJitter(fails int64, jitter func(n int64) int64) int64 {
+	half := processingRetryBackoffCeiling(fails) / 2
+	return half + jitter(half+1)
+}
+
+// processingRetryBackoffCeiling is the longest processingRetryBackoff waits after the given number of failures.
+func processingRetryBackoffCeiling(fails int64) int64 {
+	backoff := int64(processingRetryBaseBackoffSeconds)
+	for i := int64(1); i < fails && backoff < processingRetryMaxBackoffSeconds; i++ {
+		backoff *= 2
+	}
+	if backoff > processingRetryMaxBackoffSeconds {
+		backoff = processingRetryMaxBackoffSeconds
+	}
+	return backoff
+}
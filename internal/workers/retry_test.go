@@ -0,0 +1,44 @@
+package workers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_processingRetryBackoffWithJitter(t *testing.T) {
+	noJitter := func(n int64) int64 { return 0 }
+	fullJitter := func(n int64) int64 { return n - 1 }
+	tests := []struct {
+		name        string
+		fails       int64
+		expectedMin int64
+		expectedMax int64
+	}{
+		{
+			name:        "waits around the base backoff after the first failure",
+			fails:       1,
+			expectedMin: 15,
+			expectedMax: 30,
+		},
+		{
+			name:        "doubles the backoff after every failure",
+			fails:       3,
+			expectedMin: 60,
+			expectedMax: 120,
+		},
+		{
+			name:        "caps the backoff at an hour",
+			fails:       20,
+			expectedMin: 1800,
+			expectedMax: 3600,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedMin, processingRetryBackoffWithJitter(tt.fails, noJitter))
+			assert.Equal(t, tt.expectedMax, processingRetryBackoffWithJitter(tt.fails, fullJitter))
+		})
+	}
+}
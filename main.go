@@ -20,6 +20,7 @@ import (
 	"github.com/vipulvpatil/candidate-tracker-go/internal/health"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/server"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/services/filestorage"
+	"github.com/vipulvpatil/candidate-tracker-go/internal/services/leaderelection"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/storage"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/tls"
 	"github.com/vipulvpatil/candidate-tracker-go/internal/utilities"
@@ -30,6 +31,9 @@ import (
 
 const WORKER_NAMESPACE = "candidate_tracker_go"
 
+// LEADER_LOCK_KEY is the postgres advisory lock held by the leader. It only has to differ from any other advisory lock taken on the database.
+const LEADER_LOCK_KEY int64 = 7461290031
+
 func main() {
 	rand.Seed(time.Now().UTC().UnixNano())
 
@@ -104,6 +108,7 @@ func main() {
 		Config:       cfg,
 		Logger:       logger,
 		FileStorer:   fileStorer,
+		JobStarter:   jobStarter,
 	}
 
 	s, err := server.NewServer(serverDeps)
@@ -113,12 +118,14 @@ func main() {
 	grpcServer := setupGrpcServer(s, cfg, logger)
 
 	workerPooldeps := workers.PoolDependencies{
-		RedisPool:    redisPool,
-		Namespace:    WORKER_NAMESPACE,
-		Storage:      dbStorage,
-		OpenAiApiKey: cfg.OpenAiApiKey,
-		Logger:       logger,
-		FileStorer:   fileStorer,
+		RedisPool:                redisPool,
+		Namespace:                WORKER_NAMESPACE,
+		Storage:                  dbStorage,
+		OpenAiApiKey:             cfg.OpenAiApiKey,
+		Logger:                   logger,
+		FileStorer:               fileStorer,
+		ProcessingLease:          cfg.ProcessingLease,
+		MaxConcurrentFileUploads: cfg.MaxConcurrentFileUploads,
 	}
 	workerPool := workers.NewPool(workerPooldeps)
 	workerPool.Start()
@@ -127,9 +134,25 @@ func main() {
 	startGrpcServerAsync("candidate tracker go", &wg, grpcServer, "9000", logger)
 	httpHealthServer := startHTTPHealthServer(&wg, logger)
 
-	processingLoopCtx, cancelProcessingLoop := context.WithCancel(context.Background())
-	processingLoopTickerDuration := 1 * time.Second
-	go s.ProcessingLoop(processingLoopCtx, processingLoopTickerDuration, &wg, jobStarter)
+	leaderLock, err := storage.NewAdvisoryLeaderLock(db, LEADER_LOCK_KEY)
+	if err != nil {
+		log.Fatalf("Unable to initialize leader lock: %v", err)
+	}
+
+	elector, err := leaderelection.NewElector(leaderelection.ElectorOptions{
+		Lock:          leaderLock,
+		CheckInterval: 5 * time.Second,
+		Logger:        logger,
+	})
+	if err != nil {
+		log.Fatalf("Unable to initialize leader election: %v", err)
+	}
+
+	// Only the leader dispatches processing jobs, so replicas do not all enqueue the same ids.
+	leaderElectionCtx, cancelLeaderElection := context.WithCancel(context.Background())
+	go elector.Run(leaderElectionCtx, &wg, func(ctx context.Context) {
+		runProcessingLoop(ctx, s, cfg, &wg, jobStarter, logger)
+	})
 
 	osTermSig := make(chan os.Signal, 1)
 	signal.Notify(osTermSig, syscall.SIGINT, syscall.SIGTERM)
@@ -138,7 +161,7 @@ func main() {
 
 	<-osTermSig
 
-	cancelProcessingLoop()
+	cancelLeaderElection()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -151,6 +174,30 @@ func main() {
 	logger.LogMessageln("Stopping Service")
 }
 
+// runProcessingLoop only runs on the leader, so followers do not hold a listener whose notifications nobody reads.
+func runProcessingLoop(ctx context.Context, s *server.CandidateTrackerGoService, cfg *config.Config, wg *sync.WaitGroup, jobStarter workers.JobStarter, logger utilities.Logger) {
+	processingNotificationListener, err := storage.NewNotificationListener(
+		cfg.DbUrl,
+		logger,
+		storage.FILE_UPLOAD_READY_CHANNEL,
+		storage.FIT_ASSESSMENT_READY_CHANNEL,
+	)
+	if err != nil {
+		// The processing loop still sweeps for work without notifications, just less often.
+		logger.LogError(err)
+	} else {
+		defer processingNotificationListener.Close()
+	}
+
+	processingLoopOpts := server.ProcessingLoopOptions{
+		ReconciliationInterval:          1 * time.Minute,
+		MaxConcurrentFileUploads:        cfg.MaxConcurrentFileUploads,
+		MaxConcurrentFileUploadsPerTeam: cfg.MaxConcurrentFileUploadsPerTeam,
+		DispatchLease:                   cfg.ProcessingLease,
+	}
+	s.ProcessingLoop(ctx, processingLoopOpts, wg, jobStarter, processingNotificationListener)
+}
+
 func setupGrpcServer(s *server.CandidateTrackerGoService, cfg *config.Config, logger utilities.Logger) *grpc.Server {
 	serverOpts := make([]grpc.ServerOption, 0)
 	tlsServerOpts := tlsGrpcServerOptions(cfg, logger)
@@ -27,12 +27,58 @@ type CandidateTrackerGoClient interface {
 	GetUnprocessedFileUploadsCount(ctx context.Context, in *GetUnprocessedFileUploadsCountRequest, opts ...grpc.CallOption) (*GetUnprocessedFileUploadsCountResponse, error)
 	GetFileUpload(ctx context.Context, in *GetFileUploadRequest, opts ...grpc.CallOption) (*GetFileUploadResponse, error)
 	GetFileUploads(ctx context.Context, in *GetFileUploadsRequest, opts ...grpc.CallOption) (*GetFileUploadsResponse, error)
+	GetChildFileUploads(ctx context.Context, in *GetChildFileUploadsRequest, opts ...grpc.CallOption) (*GetChildFileUploadsResponse, error)
+	GetFileUploadText(ctx context.Context, in *GetFileUploadTextRequest, opts ...grpc.CallOption) (*GetFileUploadTextResponse, error)
 	UploadFiles(ctx context.Context, in *UploadFilesRequest, opts ...grpc.CallOption) (*UploadFilesResponse, error)
 	CompleteFileUploads(ctx context.Context, in *CompleteFileUploadsRequest, opts ...grpc.CallOption) (*CompleteFileUploadsResponse, error)
 	DeleteFileUpload(ctx context.Context, in *DeleteFileUploadRequest, opts ...grpc.CallOption) (*DeleteFileUploadResponse, error)
+	ReprocessFileUpload(ctx context.Context, in *ReprocessFileUploadRequest, opts ...grpc.CallOption) (*ReprocessFileUploadResponse, error)
+	ReprocessFileUploads(ctx context.Context, in *ReprocessFileUploadsRequest, opts ...grpc.CallOption) (*ReprocessFileUploadsResponse, error)
 	GetCandidates(ctx context.Context, in *GetCandidatesRequest, opts ...grpc.CallOption) (*GetCandidatesResponse, error)
 	GetCandidate(ctx context.Context, in *GetCandidateRequest, opts ...grpc.CallOption) (*GetCandidateResponse, error)
+	SearchCandidates(ctx context.Context, in *SearchCandidatesRequest, opts ...grpc.CallOption) (*SearchCandidatesResponse, error)
 	UpdateCandidate(ctx context.Context, in *UpdateCandidateRequest, opts ...grpc.CallOption) (*UpdateCandidateResponse, error)
+	GetDuplicateCandidates(ctx context.Context, in *GetDuplicateCandidatesRequest, opts ...grpc.CallOption) (*GetDuplicateCandidatesResponse, error)
+	MergeCandidates(ctx context.Context, in *MergeCandidatesRequest, opts ...grpc.CallOption) (*MergeCandidatesResponse, error)
+	ArchiveCandidate(ctx context.Context, in *ArchiveCandidateRequest, opts ...grpc.CallOption) (*ArchiveCandidateResponse, error)
+	UnarchiveCandidate(ctx context.Context, in *UnarchiveCandidateRequest, opts ...grpc.CallOption) (*UnarchiveCandidateResponse, error)
+	DeleteCandidate(ctx context.Context, in *DeleteCandidateRequest, opts ...grpc.CallOption) (*DeleteCandidateResponse, error)
+	GetPipelineStages(ctx context.Context, in *GetPipelineStagesRequest, opts ...grpc.CallOption) (*GetPipelineStagesResponse, error)
+	CreatePipelineStage(ctx context.Context, in *CreatePipelineStageRequest, opts ...grpc.CallOption) (*CreatePipelineStageResponse, error)
+	RenamePipelineStage(ctx context.Context, in *RenamePipelineStageRequest, opts ...grpc.CallOption) (*RenamePipelineStageResponse, error)
+	ReorderPipelineStages(ctx context.Context, in *ReorderPipelineStagesRequest, opts ...grpc.CallOption) (*ReorderPipelineStagesResponse, error)
+	DeletePipelineStage(ctx context.Context, in *DeletePipelineStageRequest, opts ...grpc.CallOption) (*DeletePipelineStageResponse, error)
+	MoveCandidatesToPipelineStage(ctx context.Context, in *MoveCandidatesToPipelineStageRequest, opts ...grpc.CallOption) (*MoveCandidatesToPipelineStageResponse, error)
+	GetCandidateStageHistory(ctx context.Context, in *GetCandidateStageHistoryRequest, opts ...grpc.CallOption) (*GetCandidateStageHistoryResponse, error)
+	AddCandidateNote(ctx context.Context, in *AddCandidateNoteRequest, opts ...grpc.CallOption) (*AddCandidateNoteResponse, error)
+	GetCandidateNotes(ctx context.Context, in *GetCandidateNotesRequest, opts ...grpc.CallOption) (*GetCandidateNotesResponse, error)
+	UpdateCandidateNote(ctx context.Context, in *UpdateCandidateNoteRequest, opts ...grpc.CallOption) (*UpdateCandidateNoteResponse, error)
+	DeleteCandidateNote(ctx context.Context, in *DeleteCandidateNoteRequest, opts ...grpc.CallOption) (*DeleteCandidateNoteResponse, error)
+	GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*GetTagsResponse, error)
+	CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error)
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error)
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error)
+	AddTagsToCandidates(ctx context.Context, in *AddTagsToCandidatesRequest, opts ...grpc.CallOption) (*AddTagsToCandidatesResponse, error)
+	RemoveTagsFromCandidates(ctx context.Context, in *RemoveTagsFromCandidatesRequest, opts ...grpc.CallOption) (*RemoveTagsFromCandidatesResponse, error)
+	GetScorecardCriteria(ctx context.Context, in *GetScorecardCriteriaRequest, opts ...grpc.CallOption) (*GetScorecardCriteriaResponse, error)
+	CreateScorecardCriterion(ctx context.Context, in *CreateScorecardCriterionRequest, opts ...grpc.CallOption) (*CreateScorecardCriterionResponse, error)
+	DeleteScorecardCriterion(ctx context.Context, in *DeleteScorecardCriterionRequest, opts ...grpc.CallOption) (*DeleteScorecardCriterionResponse, error)
+	SubmitScorecard(ctx context.Context, in *SubmitScorecardRequest, opts ...grpc.CallOption) (*SubmitScorecardResponse, error)
+	GetScorecards(ctx context.Context, in *GetScorecardsRequest, opts ...grpc.CallOption) (*GetScorecardsResponse, error)
+	GetCandidatePersonaHistory(ctx context.Context, in *GetCandidatePersonaHistoryRequest, opts ...grpc.CallOption) (*GetCandidatePersonaHistoryResponse, error)
+	RevertCandidatePersona(ctx context.Context, in *RevertCandidatePersonaRequest, opts ...grpc.CallOption) (*RevertCandidatePersonaResponse, error)
+	DiffCandidatePersonaRevisions(ctx context.Context, in *DiffCandidatePersonaRevisionsRequest, opts ...grpc.CallOption) (*DiffCandidatePersonaRevisionsResponse, error)
+	CreateJobOpening(ctx context.Context, in *CreateJobOpeningRequest, opts ...grpc.CallOption) (*CreateJobOpeningResponse, error)
+	GetJobOpenings(ctx context.Context, in *GetJobOpeningsRequest, opts ...grpc.CallOption) (*GetJobOpeningsResponse, error)
+	GetJobOpening(ctx context.Context, in *GetJobOpeningRequest, opts ...grpc.CallOption) (*GetJobOpeningResponse, error)
+	UpdateJobOpening(ctx context.Context, in *UpdateJobOpeningRequest, opts ...grpc.CallOption) (*UpdateJobOpeningResponse, error)
+	DeleteJobOpening(ctx context.Context, in *DeleteJobOpeningRequest, opts ...grpc.CallOption) (*DeleteJobOpeningResponse, error)
+	AddCandidateToJobOpening(ctx context.Context, in *AddCandidateToJobOpeningRequest, opts ...grpc.CallOption) (*AddCandidateToJobOpeningResponse, error)
+	RemoveCandidateFromJobOpening(ctx context.Context, in *RemoveCandidateFromJobOpeningRequest, opts ...grpc.CallOption) (*RemoveCandidateFromJobOpeningResponse, error)
+	GetJobApplications(ctx context.Context, in *GetJobApplicationsRequest, opts ...grpc.CallOption) (*GetJobApplicationsResponse, error)
+	UpdateJobApplicationStage(ctx context.Context, in *UpdateJobApplicationStageRequest, opts ...grpc.CallOption) (*UpdateJobApplicationStageResponse, error)
+	RankCandidatesForJob(ctx context.Context, in *RankCandidatesForJobRequest, opts ...grpc.CallOption) (*RankCandidatesForJobResponse, error)
+	AssessCandidateFit(ctx context.Context, in *AssessCandidateFitRequest, opts ...grpc.CallOption) (*AssessCandidateFitResponse, error)
 }
 
 type candidateTrackerGoClient struct {
@@ -88,6 +134,24 @@ func (c *candidateTrackerGoClient) GetFileUploads(ctx context.Context, in *GetFi
 	return out, nil
 }
 
+func (c *candidateTrackerGoClient) GetChildFileUploads(ctx context.Context, in *GetChildFileUploadsRequest, opts ...grpc.CallOption) (*GetChildFileUploadsResponse, error) {
+	out := new(GetChildFileUploadsResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/GetChildFileUploads", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) GetFileUploadText(ctx context.Context, in *GetFileUploadTextRequest, opts ...grpc.CallOption) (*GetFileUploadTextResponse, error) {
+	out := new(GetFileUploadTextResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/GetFileUploadText", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *candidateTrackerGoClient) UploadFiles(ctx context.Context, in *UploadFilesRequest, opts ...grpc.CallOption) (*UploadFilesResponse, error) {
 	out := new(UploadFilesResponse)
 	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/UploadFiles", in, out, opts...)
@@ -115,295 +179,1703 @@ func (c *candidateTrackerGoClient) DeleteFileUpload(ctx context.Context, in *Del
 	return out, nil
 }
 
+func (c *candidateTrackerGoClient) ReprocessFileUpload(ctx context.Context, in *ReprocessFileUploadRequest, opts ...grpc.CallOption) (*ReprocessFileUploadResponse, error) {
+	out := new(ReprocessFileUploadResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/ReprocessFileUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) ReprocessFileUploads(ctx context.Context, in *ReprocessFileUploadsRequest, opts ...grpc.CallOption) (*ReprocessFileUploadsResponse, error) {
+	out := new(ReprocessFileUploadsResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/ReprocessFileUploads", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *candidateTrackerGoClient) GetCandidates(ctx context.Context, in *GetCandidatesRequest, opts ...grpc.CallOption) (*GetCandidatesResponse, error) {
 	out := new(GetCandidatesResponse)
 	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/GetCandidates", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) GetCandidate(ctx context.Context, in *GetCandidateRequest, opts ...grpc.CallOption) (*GetCandidateResponse, error) {
+	out := new(GetCandidateResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/GetCandidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) SearchCandidates(ctx context.Context, in *SearchCandidatesRequest, opts ...grpc.CallOption) (*SearchCandidatesResponse, error) {
+	out := new(SearchCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/SearchCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) UpdateCandidate(ctx context.Context, in *UpdateCandidateRequest, opts ...grpc.CallOption) (*UpdateCandidateResponse, error) {
+	out := new(UpdateCandidateResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/UpdateCandidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) GetDuplicateCandidates(ctx context.Context, in *GetDuplicateCandidatesRequest, opts ...grpc.CallOption) (*GetDuplicateCandidatesResponse, error) {
+	out := new(GetDuplicateCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/GetDuplicateCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) MergeCandidates(ctx context.Context, in *MergeCandidatesRequest, opts ...grpc.CallOption) (*MergeCandidatesResponse, error) {
+	out := new(MergeCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/MergeCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) ArchiveCandidate(ctx context.Context, in *ArchiveCandidateRequest, opts ...grpc.CallOption) (*ArchiveCandidateResponse, error) {
+	out := new(ArchiveCandidateResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/ArchiveCandidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) UnarchiveCandidate(ctx context.Context, in *UnarchiveCandidateRequest, opts ...grpc.CallOption) (*UnarchiveCandidateResponse, error) {
+	out := new(UnarchiveCandidateResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/UnarchiveCandidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) DeleteCandidate(ctx context.Context, in *DeleteCandidateRequest, opts ...grpc.CallOption) (*DeleteCandidateResponse, error) {
+	out := new(DeleteCandidateResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/DeleteCandidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) GetPipelineStages(ctx context.Context, in *GetPipelineStagesRequest, opts ...grpc.CallOption) (*GetPipelineStagesResponse, error) {
+	out := new(GetPipelineStagesResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/GetPipelineStages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) CreatePipelineStage(ctx context.Context, in *CreatePipelineStageRequest, opts ...grpc.CallOption) (*CreatePipelineStageResponse, error) {
+	out := new(CreatePipelineStageResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/CreatePipelineStage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) RenamePipelineStage(ctx context.Context, in *RenamePipelineStageRequest, opts ...grpc.CallOption) (*RenamePipelineStageResponse, error) {
+	out := new(RenamePipelineStageResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/RenamePipelineStage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) ReorderPipelineStages(ctx context.Context, in *ReorderPipelineStagesRequest, opts ...grpc.CallOption) (*ReorderPipelineStagesResponse, error) {
+	out := new(ReorderPipelineStagesResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/ReorderPipelineStages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) DeletePipelineStage(ctx context.Context, in *DeletePipelineStageRequest, opts ...grpc.CallOption) (*DeletePipelineStageResponse, error) {
+	out := new(DeletePipelineStageResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/DeletePipelineStage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) MoveCandidatesToPipelineStage(ctx context.Context, in *MoveCandidatesToPipelineStageRequest, opts ...grpc.CallOption) (*MoveCandidatesToPipelineStageResponse, error) {
+	out := new(MoveCandidatesToPipelineStageResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/MoveCandidatesToPipelineStage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) GetCandidateStageHistory(ctx context.Context, in *GetCandidateStageHistoryRequest, opts ...grpc.CallOption) (*GetCandidateStageHistoryResponse, error) {
+	out := new(GetCandidateStageHistoryResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/GetCandidateStageHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) AddCandidateNote(ctx context.Context, in *AddCandidateNoteRequest, opts ...grpc.CallOption) (*AddCandidateNoteResponse, error) {
+	out := new(AddCandidateNoteResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/AddCandidateNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) GetCandidateNotes(ctx context.Context, in *GetCandidateNotesRequest, opts ...grpc.CallOption) (*GetCandidateNotesResponse, error) {
+	out := new(GetCandidateNotesResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/GetCandidateNotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) UpdateCandidateNote(ctx context.Context, in *UpdateCandidateNoteRequest, opts ...grpc.CallOption) (*UpdateCandidateNoteResponse, error) {
+	out := new(UpdateCandidateNoteResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/UpdateCandidateNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) DeleteCandidateNote(ctx context.Context, in *DeleteCandidateNoteRequest, opts ...grpc.CallOption) (*DeleteCandidateNoteResponse, error) {
+	out := new(DeleteCandidateNoteResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/DeleteCandidateNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*GetTagsResponse, error) {
+	out := new(GetTagsResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/GetTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error) {
+	out := new(CreateTagResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/CreateTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error) {
+	out := new(RenameTagResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/RenameTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error) {
+	out := new(DeleteTagResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/DeleteTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) AddTagsToCandidates(ctx context.Context, in *AddTagsToCandidatesRequest, opts ...grpc.CallOption) (*AddTagsToCandidatesResponse, error) {
+	out := new(AddTagsToCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/AddTagsToCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) RemoveTagsFromCandidates(ctx context.Context, in *RemoveTagsFromCandidatesRequest, opts ...grpc.CallOption) (*RemoveTagsFromCandidatesResponse, error) {
+	out := new(RemoveTagsFromCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/RemoveTagsFromCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) GetScorecardCriteria(ctx context.Context, in *GetScorecardCriteriaRequest, opts ...grpc.CallOption) (*GetScorecardCriteriaResponse, error) {
+	out := new(GetScorecardCriteriaResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/GetScorecardCriteria", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) CreateScorecardCriterion(ctx context.Context, in *CreateScorecardCriterionRequest, opts ...grpc.CallOption) (*CreateScorecardCriterionResponse, error) {
+	out := new(CreateScorecardCriterionResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/CreateScorecardCriterion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) DeleteScorecardCriterion(ctx context.Context, in *DeleteScorecardCriterionRequest, opts ...grpc.CallOption) (*DeleteScorecardCriterionResponse, error) {
+	out := new(DeleteScorecardCriterionResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/DeleteScorecardCriterion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) SubmitScorecard(ctx context.Context, in *SubmitScorecardRequest, opts ...grpc.CallOption) (*SubmitScorecardResponse, error) {
+	out := new(SubmitScorecardResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/SubmitScorecard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) GetScorecards(ctx context.Context, in *GetScorecardsRequest, opts ...grpc.CallOption) (*GetScorecardsResponse, error) {
+	out := new(GetScorecardsResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/GetScorecards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) GetCandidatePersonaHistory(ctx context.Context, in *GetCandidatePersonaHistoryRequest, opts ...grpc.CallOption) (*GetCandidatePersonaHistoryResponse, error) {
+	out := new(GetCandidatePersonaHistoryResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/GetCandidatePersonaHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) RevertCandidatePersona(ctx context.Context, in *RevertCandidatePersonaRequest, opts ...grpc.CallOption) (*RevertCandidatePersonaResponse, error) {
+	out := new(RevertCandidatePersonaResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/RevertCandidatePersona", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) DiffCandidatePersonaRevisions(ctx context.Context, in *DiffCandidatePersonaRevisionsRequest, opts ...grpc.CallOption) (*DiffCandidatePersonaRevisionsResponse, error) {
+	out := new(DiffCandidatePersonaRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/DiffCandidatePersonaRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) CreateJobOpening(ctx context.Context, in *CreateJobOpeningRequest, opts ...grpc.CallOption) (*CreateJobOpeningResponse, error) {
+	out := new(CreateJobOpeningResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/CreateJobOpening", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) GetJobOpenings(ctx context.Context, in *GetJobOpeningsRequest, opts ...grpc.CallOption) (*GetJobOpeningsResponse, error) {
+	out := new(GetJobOpeningsResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/GetJobOpenings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) GetJobOpening(ctx context.Context, in *GetJobOpeningRequest, opts ...grpc.CallOption) (*GetJobOpeningResponse, error) {
+	out := new(GetJobOpeningResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/GetJobOpening", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) UpdateJobOpening(ctx context.Context, in *UpdateJobOpeningRequest, opts ...grpc.CallOption) (*UpdateJobOpeningResponse, error) {
+	out := new(UpdateJobOpeningResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/UpdateJobOpening", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) DeleteJobOpening(ctx context.Context, in *DeleteJobOpeningRequest, opts ...grpc.CallOption) (*DeleteJobOpeningResponse, error) {
+	out := new(DeleteJobOpeningResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/DeleteJobOpening", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) AddCandidateToJobOpening(ctx context.Context, in *AddCandidateToJobOpeningRequest, opts ...grpc.CallOption) (*AddCandidateToJobOpeningResponse, error) {
+	out := new(AddCandidateToJobOpeningResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/AddCandidateToJobOpening", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) RemoveCandidateFromJobOpening(ctx context.Context, in *RemoveCandidateFromJobOpeningRequest, opts ...grpc.CallOption) (*RemoveCandidateFromJobOpeningResponse, error) {
+	out := new(RemoveCandidateFromJobOpeningResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/RemoveCandidateFromJobOpening", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) GetJobApplications(ctx context.Context, in *GetJobApplicationsRequest, opts ...grpc.CallOption) (*GetJobApplicationsResponse, error) {
+	out := new(GetJobApplicationsResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/GetJobApplications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) UpdateJobApplicationStage(ctx context.Context, in *UpdateJobApplicationStageRequest, opts ...grpc.CallOption) (*UpdateJobApplicationStageResponse, error) {
+	out := new(UpdateJobApplicationStageResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/UpdateJobApplicationStage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) RankCandidatesForJob(ctx context.Context, in *RankCandidatesForJobRequest, opts ...grpc.CallOption) (*RankCandidatesForJobResponse, error) {
+	out := new(RankCandidatesForJobResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/RankCandidatesForJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateTrackerGoClient) AssessCandidateFit(ctx context.Context, in *AssessCandidateFitRequest, opts ...grpc.CallOption) (*AssessCandidateFitResponse, error) {
+	out := new(AssessCandidateFitResponse)
+	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/AssessCandidateFit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CandidateTrackerGoServer is the server API for CandidateTrackerGo service.
+// All implementations must embed UnimplementedCandidateTrackerGoServer
+// for forward compatibility
+type CandidateTrackerGoServer interface {
+	CheckConnection(context.Context, *CheckConnectionRequest) (*CheckConnectionResponse, error)
+	GetUserData(context.Context, *GetUserDataRequest) (*GetUserDataResponse, error)
+	GetUnprocessedFileUploadsCount(context.Context, *GetUnprocessedFileUploadsCountRequest) (*GetUnprocessedFileUploadsCountResponse, error)
+	GetFileUpload(context.Context, *GetFileUploadRequest) (*GetFileUploadResponse, error)
+	GetFileUploads(context.Context, *GetFileUploadsRequest) (*GetFileUploadsResponse, error)
+	GetChildFileUploads(context.Context, *GetChildFileUploadsRequest) (*GetChildFileUploadsResponse, error)
+	GetFileUploadText(context.Context, *GetFileUploadTextRequest) (*GetFileUploadTextResponse, error)
+	UploadFiles(context.Context, *UploadFilesRequest) (*UploadFilesResponse, error)
+	CompleteFileUploads(context.Context, *CompleteFileUploadsRequest) (*CompleteFileUploadsResponse, error)
+	DeleteFileUpload(context.Context, *DeleteFileUploadRequest) (*DeleteFileUploadResponse, error)
+	ReprocessFileUpload(context.Context, *ReprocessFileUploadRequest) (*ReprocessFileUploadResponse, error)
+	ReprocessFileUploads(context.Context, *ReprocessFileUploadsRequest) (*ReprocessFileUploadsResponse, error)
+	GetCandidates(context.Context, *GetCandidatesRequest) (*GetCandidatesResponse, error)
+	GetCandidate(context.Context, *GetCandidateRequest) (*GetCandidateResponse, error)
+	SearchCandidates(context.Context, *SearchCandidatesRequest) (*SearchCandidatesResponse, error)
+	UpdateCandidate(context.Context, *UpdateCandidateRequest) (*UpdateCandidateResponse, error)
+	GetDuplicateCandidates(context.Context, *GetDuplicateCandidatesRequest) (*GetDuplicateCandidatesResponse, error)
+	MergeCandidates(context.Context, *MergeCandidatesRequest) (*MergeCandidatesResponse, error)
+	ArchiveCandidate(context.Context, *ArchiveCandidateRequest) (*ArchiveCandidateResponse, error)
+	UnarchiveCandidate(context.Context, *UnarchiveCandidateRequest) (*UnarchiveCandidateResponse, error)
+	DeleteCandidate(context.Context, *DeleteCandidateRequest) (*DeleteCandidateResponse, error)
+	GetPipelineStages(context.Context, *GetPipelineStagesRequest) (*GetPipelineStagesResponse, error)
+	CreatePipelineStage(context.Context, *CreatePipelineStageRequest) (*CreatePipelineStageResponse, error)
+	RenamePipelineStage(context.Context, *RenamePipelineStageRequest) (*RenamePipelineStageResponse, error)
+	ReorderPipelineStages(context.Context, *ReorderPipelineStagesRequest) (*ReorderPipelineStagesResponse, error)
+	DeletePipelineStage(context.Context, *DeletePipelineStageRequest) (*DeletePipelineStageResponse, error)
+	MoveCandidatesToPipelineStage(context.Context, *MoveCandidatesToPipelineStageRequest) (*MoveCandidatesToPipelineStageResponse, error)
+	GetCandidateStageHistory(context.Context, *GetCandidateStageHistoryRequest) (*GetCandidateStageHistoryResponse, error)
+	AddCandidateNote(context.Context, *AddCandidateNoteRequest) (*AddCandidateNoteResponse, error)
+	GetCandidateNotes(context.Context, *GetCandidateNotesRequest) (*GetCandidateNotesResponse, error)
+	UpdateCandidateNote(context.Context, *UpdateCandidateNoteRequest) (*UpdateCandidateNoteResponse, error)
+	DeleteCandidateNote(context.Context, *DeleteCandidateNoteRequest) (*DeleteCandidateNoteResponse, error)
+	GetTags(context.Context, *GetTagsRequest) (*GetTagsResponse, error)
+	CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error)
+	RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error)
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error)
+	AddTagsToCandidates(context.Context, *AddTagsToCandidatesRequest) (*AddTagsToCandidatesResponse, error)
+	RemoveTagsFromCandidates(context.Context, *RemoveTagsFromCandidatesRequest) (*RemoveTagsFromCandidatesResponse, error)
+	GetScorecardCriteria(context.Context, *GetScorecardCriteriaRequest) (*GetScorecardCriteriaResponse, error)
+	CreateScorecardCriterion(context.Context, *CreateScorecardCriterionRequest) (*CreateScorecardCriterionResponse, error)
+	DeleteScorecardCriterion(context.Context, *DeleteScorecardCriterionRequest) (*DeleteScorecardCriterionResponse, error)
+	SubmitScorecard(context.Context, *SubmitScorecardRequest) (*SubmitScorecardResponse, error)
+	GetScorecards(context.Context, *GetScorecardsRequest) (*GetScorecardsResponse, error)
+	GetCandidatePersonaHistory(context.Context, *GetCandidatePersonaHistoryRequest) (*GetCandidatePersonaHistoryResponse, error)
+	RevertCandidatePersona(context.Context, *RevertCandidatePersonaRequest) (*RevertCandidatePersonaResponse, error)
+	DiffCandidatePersonaRevisions(context.Context, *DiffCandidatePersonaRevisionsRequest) (*DiffCandidatePersonaRevisionsResponse, error)
+	CreateJobOpening(context.Context, *CreateJobOpeningRequest) (*CreateJobOpeningResponse, error)
+	GetJobOpenings(context.Context, *GetJobOpeningsRequest) (*GetJobOpeningsResponse, error)
+	GetJobOpening(context.Context, *GetJobOpeningRequest) (*GetJobOpeningResponse, error)
+	UpdateJobOpening(context.Context, *UpdateJobOpeningRequest) (*UpdateJobOpeningResponse, error)
+	DeleteJobOpening(context.Context, *DeleteJobOpeningRequest) (*DeleteJobOpeningResponse, error)
+	AddCandidateToJobOpening(context.Context, *AddCandidateToJobOpeningRequest) (*AddCandidateToJobOpeningResponse, error)
+	RemoveCandidateFromJobOpening(context.Context, *RemoveCandidateFromJobOpeningRequest) (*RemoveCandidateFromJobOpeningResponse, error)
+	GetJobApplications(context.Context, *GetJobApplicationsRequest) (*GetJobApplicationsResponse, error)
+	UpdateJobApplicationStage(context.Context, *UpdateJobApplicationStageRequest) (*UpdateJobApplicationStageResponse, error)
+	RankCandidatesForJob(context.Context, *RankCandidatesForJobRequest) (*RankCandidatesForJobResponse, error)
+	AssessCandidateFit(context.Context, *AssessCandidateFitRequest) (*AssessCandidateFitResponse, error)
+	mustEmbedUnimplementedCandidateTrackerGoServer()
+}
+
+// UnimplementedCandidateTrackerGoServer must be embedded to have forward compatible implementations.
+type UnimplementedCandidateTrackerGoServer struct {
+}
+
+func (UnimplementedCandidateTrackerGoServer) CheckConnection(context.Context, *CheckConnectionRequest) (*CheckConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckConnection not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetUserData(context.Context, *GetUserDataRequest) (*GetUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserData not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetUnprocessedFileUploadsCount(context.Context, *GetUnprocessedFileUploadsCountRequest) (*GetUnprocessedFileUploadsCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnprocessedFileUploadsCount not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetFileUpload(context.Context, *GetFileUploadRequest) (*GetFileUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileUpload not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetFileUploads(context.Context, *GetFileUploadsRequest) (*GetFileUploadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileUploads not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetChildFileUploads(context.Context, *GetChildFileUploadsRequest) (*GetChildFileUploadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChildFileUploads not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetFileUploadText(context.Context, *GetFileUploadTextRequest) (*GetFileUploadTextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileUploadText not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) UploadFiles(context.Context, *UploadFilesRequest) (*UploadFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadFiles not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) CompleteFileUploads(context.Context, *CompleteFileUploadsRequest) (*CompleteFileUploadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteFileUploads not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) DeleteFileUpload(context.Context, *DeleteFileUploadRequest) (*DeleteFileUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFileUpload not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) ReprocessFileUpload(context.Context, *ReprocessFileUploadRequest) (*ReprocessFileUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReprocessFileUpload not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) ReprocessFileUploads(context.Context, *ReprocessFileUploadsRequest) (*ReprocessFileUploadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReprocessFileUploads not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetCandidates(context.Context, *GetCandidatesRequest) (*GetCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandidates not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetCandidate(context.Context, *GetCandidateRequest) (*GetCandidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandidate not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) SearchCandidates(context.Context, *SearchCandidatesRequest) (*SearchCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCandidates not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) UpdateCandidate(context.Context, *UpdateCandidateRequest) (*UpdateCandidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCandidate not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetDuplicateCandidates(context.Context, *GetDuplicateCandidatesRequest) (*GetDuplicateCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDuplicateCandidates not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) MergeCandidates(context.Context, *MergeCandidatesRequest) (*MergeCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeCandidates not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) ArchiveCandidate(context.Context, *ArchiveCandidateRequest) (*ArchiveCandidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveCandidate not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) UnarchiveCandidate(context.Context, *UnarchiveCandidateRequest) (*UnarchiveCandidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveCandidate not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) DeleteCandidate(context.Context, *DeleteCandidateRequest) (*DeleteCandidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCandidate not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetPipelineStages(context.Context, *GetPipelineStagesRequest) (*GetPipelineStagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPipelineStages not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) CreatePipelineStage(context.Context, *CreatePipelineStageRequest) (*CreatePipelineStageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePipelineStage not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) RenamePipelineStage(context.Context, *RenamePipelineStageRequest) (*RenamePipelineStageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenamePipelineStage not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) ReorderPipelineStages(context.Context, *ReorderPipelineStagesRequest) (*ReorderPipelineStagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderPipelineStages not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) DeletePipelineStage(context.Context, *DeletePipelineStageRequest) (*DeletePipelineStageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePipelineStage not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) MoveCandidatesToPipelineStage(context.Context, *MoveCandidatesToPipelineStageRequest) (*MoveCandidatesToPipelineStageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCandidatesToPipelineStage not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetCandidateStageHistory(context.Context, *GetCandidateStageHistoryRequest) (*GetCandidateStageHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandidateStageHistory not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) AddCandidateNote(context.Context, *AddCandidateNoteRequest) (*AddCandidateNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCandidateNote not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetCandidateNotes(context.Context, *GetCandidateNotesRequest) (*GetCandidateNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandidateNotes not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) UpdateCandidateNote(context.Context, *UpdateCandidateNoteRequest) (*UpdateCandidateNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCandidateNote not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) DeleteCandidateNote(context.Context, *DeleteCandidateNoteRequest) (*DeleteCandidateNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCandidateNote not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetTags(context.Context, *GetTagsRequest) (*GetTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) AddTagsToCandidates(context.Context, *AddTagsToCandidatesRequest) (*AddTagsToCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTagsToCandidates not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) RemoveTagsFromCandidates(context.Context, *RemoveTagsFromCandidatesRequest) (*RemoveTagsFromCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTagsFromCandidates not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetScorecardCriteria(context.Context, *GetScorecardCriteriaRequest) (*GetScorecardCriteriaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScorecardCriteria not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) CreateScorecardCriterion(context.Context, *CreateScorecardCriterionRequest) (*CreateScorecardCriterionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScorecardCriterion not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) DeleteScorecardCriterion(context.Context, *DeleteScorecardCriterionRequest) (*DeleteScorecardCriterionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScorecardCriterion not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) SubmitScorecard(context.Context, *SubmitScorecardRequest) (*SubmitScorecardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitScorecard not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetScorecards(context.Context, *GetScorecardsRequest) (*GetScorecardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScorecards not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetCandidatePersonaHistory(context.Context, *GetCandidatePersonaHistoryRequest) (*GetCandidatePersonaHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandidatePersonaHistory not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) RevertCandidatePersona(context.Context, *RevertCandidatePersonaRequest) (*RevertCandidatePersonaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertCandidatePersona not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) DiffCandidatePersonaRevisions(context.Context, *DiffCandidatePersonaRevisionsRequest) (*DiffCandidatePersonaRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffCandidatePersonaRevisions not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) CreateJobOpening(context.Context, *CreateJobOpeningRequest) (*CreateJobOpeningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJobOpening not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetJobOpenings(context.Context, *GetJobOpeningsRequest) (*GetJobOpeningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobOpenings not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetJobOpening(context.Context, *GetJobOpeningRequest) (*GetJobOpeningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobOpening not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) UpdateJobOpening(context.Context, *UpdateJobOpeningRequest) (*UpdateJobOpeningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJobOpening not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) DeleteJobOpening(context.Context, *DeleteJobOpeningRequest) (*DeleteJobOpeningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJobOpening not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) AddCandidateToJobOpening(context.Context, *AddCandidateToJobOpeningRequest) (*AddCandidateToJobOpeningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCandidateToJobOpening not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) RemoveCandidateFromJobOpening(context.Context, *RemoveCandidateFromJobOpeningRequest) (*RemoveCandidateFromJobOpeningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCandidateFromJobOpening not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) GetJobApplications(context.Context, *GetJobApplicationsRequest) (*GetJobApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobApplications not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) UpdateJobApplicationStage(context.Context, *UpdateJobApplicationStageRequest) (*UpdateJobApplicationStageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJobApplicationStage not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) RankCandidatesForJob(context.Context, *RankCandidatesForJobRequest) (*RankCandidatesForJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RankCandidatesForJob not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) AssessCandidateFit(context.Context, *AssessCandidateFitRequest) (*AssessCandidateFitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssessCandidateFit not implemented")
+}
+func (UnimplementedCandidateTrackerGoServer) mustEmbedUnimplementedCandidateTrackerGoServer() {}
+
+// UnsafeCandidateTrackerGoServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CandidateTrackerGoServer will
+// result in compilation errors.
+type UnsafeCandidateTrackerGoServer interface {
+	mustEmbedUnimplementedCandidateTrackerGoServer()
+}
+
+func RegisterCandidateTrackerGoServer(s grpc.ServiceRegistrar, srv CandidateTrackerGoServer) {
+	s.RegisterService(&CandidateTrackerGo_ServiceDesc, srv)
+}
+
+func _CandidateTrackerGo_CheckConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).CheckConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/CheckConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).CheckConnection(ctx, req.(*CheckConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_GetUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).GetUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/GetUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).GetUserData(ctx, req.(*GetUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_GetUnprocessedFileUploadsCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnprocessedFileUploadsCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).GetUnprocessedFileUploadsCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/GetUnprocessedFileUploadsCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).GetUnprocessedFileUploadsCount(ctx, req.(*GetUnprocessedFileUploadsCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_GetFileUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).GetFileUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/GetFileUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).GetFileUpload(ctx, req.(*GetFileUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_GetFileUploads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileUploadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).GetFileUploads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/GetFileUploads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).GetFileUploads(ctx, req.(*GetFileUploadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_GetChildFileUploads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChildFileUploadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).GetChildFileUploads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/GetChildFileUploads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).GetChildFileUploads(ctx, req.(*GetChildFileUploadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_GetFileUploadText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileUploadTextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).GetFileUploadText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/GetFileUploadText",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).GetFileUploadText(ctx, req.(*GetFileUploadTextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_UploadFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).UploadFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/UploadFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).UploadFiles(ctx, req.(*UploadFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_CompleteFileUploads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteFileUploadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).CompleteFileUploads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/CompleteFileUploads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).CompleteFileUploads(ctx, req.(*CompleteFileUploadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_DeleteFileUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFileUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).DeleteFileUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/DeleteFileUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).DeleteFileUpload(ctx, req.(*DeleteFileUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_ReprocessFileUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReprocessFileUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).ReprocessFileUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/ReprocessFileUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).ReprocessFileUpload(ctx, req.(*ReprocessFileUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_ReprocessFileUploads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReprocessFileUploadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).ReprocessFileUploads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/ReprocessFileUploads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).ReprocessFileUploads(ctx, req.(*ReprocessFileUploadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_GetCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).GetCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/GetCandidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).GetCandidates(ctx, req.(*GetCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_GetCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).GetCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/GetCandidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).GetCandidate(ctx, req.(*GetCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_SearchCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).SearchCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/SearchCandidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).SearchCandidates(ctx, req.(*SearchCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_UpdateCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).UpdateCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/UpdateCandidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).UpdateCandidate(ctx, req.(*UpdateCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_GetDuplicateCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDuplicateCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).GetDuplicateCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/GetDuplicateCandidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).GetDuplicateCandidates(ctx, req.(*GetDuplicateCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_MergeCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).MergeCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/MergeCandidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).MergeCandidates(ctx, req.(*MergeCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_ArchiveCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).ArchiveCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/ArchiveCandidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).ArchiveCandidate(ctx, req.(*ArchiveCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_UnarchiveCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).UnarchiveCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/UnarchiveCandidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).UnarchiveCandidate(ctx, req.(*UnarchiveCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_DeleteCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).DeleteCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/DeleteCandidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).DeleteCandidate(ctx, req.(*DeleteCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_GetPipelineStages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineStagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).GetPipelineStages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/GetPipelineStages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).GetPipelineStages(ctx, req.(*GetPipelineStagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_CreatePipelineStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePipelineStageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).CreatePipelineStage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/CreatePipelineStage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).CreatePipelineStage(ctx, req.(*CreatePipelineStageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_RenamePipelineStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenamePipelineStageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).RenamePipelineStage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/RenamePipelineStage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).RenamePipelineStage(ctx, req.(*RenamePipelineStageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_ReorderPipelineStages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderPipelineStagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).ReorderPipelineStages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/ReorderPipelineStages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).ReorderPipelineStages(ctx, req.(*ReorderPipelineStagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_DeletePipelineStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePipelineStageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).DeletePipelineStage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/DeletePipelineStage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).DeletePipelineStage(ctx, req.(*DeletePipelineStageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_MoveCandidatesToPipelineStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCandidatesToPipelineStageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).MoveCandidatesToPipelineStage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/MoveCandidatesToPipelineStage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).MoveCandidatesToPipelineStage(ctx, req.(*MoveCandidatesToPipelineStageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_GetCandidateStageHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandidateStageHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).GetCandidateStageHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/GetCandidateStageHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).GetCandidateStageHistory(ctx, req.(*GetCandidateStageHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_AddCandidateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCandidateNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).AddCandidateNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/AddCandidateNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).AddCandidateNote(ctx, req.(*AddCandidateNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_GetCandidateNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandidateNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).GetCandidateNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/GetCandidateNotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).GetCandidateNotes(ctx, req.(*GetCandidateNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_UpdateCandidateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCandidateNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).UpdateCandidateNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/UpdateCandidateNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).UpdateCandidateNote(ctx, req.(*UpdateCandidateNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_DeleteCandidateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCandidateNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).DeleteCandidateNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/DeleteCandidateNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).DeleteCandidateNote(ctx, req.(*DeleteCandidateNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).GetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/GetTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).GetTags(ctx, req.(*GetTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).CreateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/CreateTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).CreateTag(ctx, req.(*CreateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).RenameTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/RenameTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).RenameTag(ctx, req.(*RenameTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/DeleteTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).DeleteTag(ctx, req.(*DeleteTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_AddTagsToCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTagsToCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).AddTagsToCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/AddTagsToCandidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).AddTagsToCandidates(ctx, req.(*AddTagsToCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_RemoveTagsFromCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTagsFromCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).RemoveTagsFromCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/RemoveTagsFromCandidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).RemoveTagsFromCandidates(ctx, req.(*RemoveTagsFromCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_GetScorecardCriteria_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScorecardCriteriaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).GetScorecardCriteria(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/GetScorecardCriteria",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).GetScorecardCriteria(ctx, req.(*GetScorecardCriteriaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_CreateScorecardCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScorecardCriterionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).CreateScorecardCriterion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/CreateScorecardCriterion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).CreateScorecardCriterion(ctx, req.(*CreateScorecardCriterionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_DeleteScorecardCriterion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScorecardCriterionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).DeleteScorecardCriterion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/DeleteScorecardCriterion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).DeleteScorecardCriterion(ctx, req.(*DeleteScorecardCriterionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_SubmitScorecard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitScorecardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).SubmitScorecard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/SubmitScorecard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).SubmitScorecard(ctx, req.(*SubmitScorecardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateTrackerGo_GetScorecards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScorecardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).GetScorecards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/GetScorecards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).GetScorecards(ctx, req.(*GetScorecardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *candidateTrackerGoClient) GetCandidate(ctx context.Context, in *GetCandidateRequest, opts ...grpc.CallOption) (*GetCandidateResponse, error) {
-	out := new(GetCandidateResponse)
-	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/GetCandidate", in, out, opts...)
-	if err != nil {
+func _CandidateTrackerGo_GetCandidatePersonaHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandidatePersonaHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).GetCandidatePersonaHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/GetCandidatePersonaHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).GetCandidatePersonaHistory(ctx, req.(*GetCandidatePersonaHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *candidateTrackerGoClient) UpdateCandidate(ctx context.Context, in *UpdateCandidateRequest, opts ...grpc.CallOption) (*UpdateCandidateResponse, error) {
-	out := new(UpdateCandidateResponse)
-	err := c.cc.Invoke(ctx, "/protos.CandidateTrackerGo/UpdateCandidate", in, out, opts...)
-	if err != nil {
+func _CandidateTrackerGo_RevertCandidatePersona_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertCandidatePersonaRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// CandidateTrackerGoServer is the server API for CandidateTrackerGo service.
-// All implementations must embed UnimplementedCandidateTrackerGoServer
-// for forward compatibility
-type CandidateTrackerGoServer interface {
-	CheckConnection(context.Context, *CheckConnectionRequest) (*CheckConnectionResponse, error)
-	GetUserData(context.Context, *GetUserDataRequest) (*GetUserDataResponse, error)
-	GetUnprocessedFileUploadsCount(context.Context, *GetUnprocessedFileUploadsCountRequest) (*GetUnprocessedFileUploadsCountResponse, error)
-	GetFileUpload(context.Context, *GetFileUploadRequest) (*GetFileUploadResponse, error)
-	GetFileUploads(context.Context, *GetFileUploadsRequest) (*GetFileUploadsResponse, error)
-	UploadFiles(context.Context, *UploadFilesRequest) (*UploadFilesResponse, error)
-	CompleteFileUploads(context.Context, *CompleteFileUploadsRequest) (*CompleteFileUploadsResponse, error)
-	DeleteFileUpload(context.Context, *DeleteFileUploadRequest) (*DeleteFileUploadResponse, error)
-	GetCandidates(context.Context, *GetCandidatesRequest) (*GetCandidatesResponse, error)
-	GetCandidate(context.Context, *GetCandidateRequest) (*GetCandidateResponse, error)
-	UpdateCandidate(context.Context, *UpdateCandidateRequest) (*UpdateCandidateResponse, error)
-	mustEmbedUnimplementedCandidateTrackerGoServer()
-}
-
-// UnimplementedCandidateTrackerGoServer must be embedded to have forward compatible implementations.
-type UnimplementedCandidateTrackerGoServer struct {
-}
-
-func (UnimplementedCandidateTrackerGoServer) CheckConnection(context.Context, *CheckConnectionRequest) (*CheckConnectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckConnection not implemented")
-}
-func (UnimplementedCandidateTrackerGoServer) GetUserData(context.Context, *GetUserDataRequest) (*GetUserDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserData not implemented")
-}
-func (UnimplementedCandidateTrackerGoServer) GetUnprocessedFileUploadsCount(context.Context, *GetUnprocessedFileUploadsCountRequest) (*GetUnprocessedFileUploadsCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUnprocessedFileUploadsCount not implemented")
-}
-func (UnimplementedCandidateTrackerGoServer) GetFileUpload(context.Context, *GetFileUploadRequest) (*GetFileUploadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFileUpload not implemented")
-}
-func (UnimplementedCandidateTrackerGoServer) GetFileUploads(context.Context, *GetFileUploadsRequest) (*GetFileUploadsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFileUploads not implemented")
-}
-func (UnimplementedCandidateTrackerGoServer) UploadFiles(context.Context, *UploadFilesRequest) (*UploadFilesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UploadFiles not implemented")
-}
-func (UnimplementedCandidateTrackerGoServer) CompleteFileUploads(context.Context, *CompleteFileUploadsRequest) (*CompleteFileUploadsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompleteFileUploads not implemented")
-}
-func (UnimplementedCandidateTrackerGoServer) DeleteFileUpload(context.Context, *DeleteFileUploadRequest) (*DeleteFileUploadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteFileUpload not implemented")
-}
-func (UnimplementedCandidateTrackerGoServer) GetCandidates(context.Context, *GetCandidatesRequest) (*GetCandidatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCandidates not implemented")
-}
-func (UnimplementedCandidateTrackerGoServer) GetCandidate(context.Context, *GetCandidateRequest) (*GetCandidateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCandidate not implemented")
-}
-func (UnimplementedCandidateTrackerGoServer) UpdateCandidate(context.Context, *UpdateCandidateRequest) (*UpdateCandidateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCandidate not implemented")
-}
-func (UnimplementedCandidateTrackerGoServer) mustEmbedUnimplementedCandidateTrackerGoServer() {}
-
-// UnsafeCandidateTrackerGoServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CandidateTrackerGoServer will
-// result in compilation errors.
-type UnsafeCandidateTrackerGoServer interface {
-	mustEmbedUnimplementedCandidateTrackerGoServer()
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).RevertCandidatePersona(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/RevertCandidatePersona",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).RevertCandidatePersona(ctx, req.(*RevertCandidatePersonaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterCandidateTrackerGoServer(s grpc.ServiceRegistrar, srv CandidateTrackerGoServer) {
-	s.RegisterService(&CandidateTrackerGo_ServiceDesc, srv)
+func _CandidateTrackerGo_DiffCandidatePersonaRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffCandidatePersonaRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateTrackerGoServer).DiffCandidatePersonaRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.CandidateTrackerGo/DiffCandidatePersonaRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateTrackerGoServer).DiffCandidatePersonaRevisions(ctx, req.(*DiffCandidatePersonaRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _CandidateTrackerGo_CheckConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckConnectionRequest)
+func _CandidateTrackerGo_CreateJobOpening_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJobOpeningRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CandidateTrackerGoServer).CheckConnection(ctx, in)
+		return srv.(CandidateTrackerGoServer).CreateJobOpening(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protos.CandidateTrackerGo/CheckConnection",
+		FullMethod: "/protos.CandidateTrackerGo/CreateJobOpening",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CandidateTrackerGoServer).CheckConnection(ctx, req.(*CheckConnectionRequest))
+		return srv.(CandidateTrackerGoServer).CreateJobOpening(ctx, req.(*CreateJobOpeningRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CandidateTrackerGo_GetUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserDataRequest)
+func _CandidateTrackerGo_GetJobOpenings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobOpeningsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CandidateTrackerGoServer).GetUserData(ctx, in)
+		return srv.(CandidateTrackerGoServer).GetJobOpenings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protos.CandidateTrackerGo/GetUserData",
+		FullMethod: "/protos.CandidateTrackerGo/GetJobOpenings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CandidateTrackerGoServer).GetUserData(ctx, req.(*GetUserDataRequest))
+		return srv.(CandidateTrackerGoServer).GetJobOpenings(ctx, req.(*GetJobOpeningsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CandidateTrackerGo_GetUnprocessedFileUploadsCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUnprocessedFileUploadsCountRequest)
+func _CandidateTrackerGo_GetJobOpening_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobOpeningRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CandidateTrackerGoServer).GetUnprocessedFileUploadsCount(ctx, in)
+		return srv.(CandidateTrackerGoServer).GetJobOpening(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protos.CandidateTrackerGo/GetUnprocessedFileUploadsCount",
+		FullMethod: "/protos.CandidateTrackerGo/GetJobOpening",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CandidateTrackerGoServer).GetUnprocessedFileUploadsCount(ctx, req.(*GetUnprocessedFileUploadsCountRequest))
+		return srv.(CandidateTrackerGoServer).GetJobOpening(ctx, req.(*GetJobOpeningRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CandidateTrackerGo_GetFileUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFileUploadRequest)
+func _CandidateTrackerGo_UpdateJobOpening_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJobOpeningRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CandidateTrackerGoServer).GetFileUpload(ctx, in)
+		return srv.(CandidateTrackerGoServer).UpdateJobOpening(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protos.CandidateTrackerGo/GetFileUpload",
+		FullMethod: "/protos.CandidateTrackerGo/UpdateJobOpening",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CandidateTrackerGoServer).GetFileUpload(ctx, req.(*GetFileUploadRequest))
+		return srv.(CandidateTrackerGoServer).UpdateJobOpening(ctx, req.(*UpdateJobOpeningRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CandidateTrackerGo_GetFileUploads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFileUploadsRequest)
+func _CandidateTrackerGo_DeleteJobOpening_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJobOpeningRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CandidateTrackerGoServer).GetFileUploads(ctx, in)
+		return srv.(CandidateTrackerGoServer).DeleteJobOpening(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protos.CandidateTrackerGo/GetFileUploads",
+		FullMethod: "/protos.CandidateTrackerGo/DeleteJobOpening",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CandidateTrackerGoServer).GetFileUploads(ctx, req.(*GetFileUploadsRequest))
+		return srv.(CandidateTrackerGoServer).DeleteJobOpening(ctx, req.(*DeleteJobOpeningRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CandidateTrackerGo_UploadFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UploadFilesRequest)
+func _CandidateTrackerGo_AddCandidateToJobOpening_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCandidateToJobOpeningRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CandidateTrackerGoServer).UploadFiles(ctx, in)
+		return srv.(CandidateTrackerGoServer).AddCandidateToJobOpening(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protos.CandidateTrackerGo/UploadFiles",
+		FullMethod: "/protos.CandidateTrackerGo/AddCandidateToJobOpening",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CandidateTrackerGoServer).UploadFiles(ctx, req.(*UploadFilesRequest))
+		return srv.(CandidateTrackerGoServer).AddCandidateToJobOpening(ctx, req.(*AddCandidateToJobOpeningRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CandidateTrackerGo_CompleteFileUploads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CompleteFileUploadsRequest)
+func _CandidateTrackerGo_RemoveCandidateFromJobOpening_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCandidateFromJobOpeningRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CandidateTrackerGoServer).CompleteFileUploads(ctx, in)
+		return srv.(CandidateTrackerGoServer).RemoveCandidateFromJobOpening(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protos.CandidateTrackerGo/CompleteFileUploads",
+		FullMethod: "/protos.CandidateTrackerGo/RemoveCandidateFromJobOpening",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CandidateTrackerGoServer).CompleteFileUploads(ctx, req.(*CompleteFileUploadsRequest))
+		return srv.(CandidateTrackerGoServer).RemoveCandidateFromJobOpening(ctx, req.(*RemoveCandidateFromJobOpeningRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CandidateTrackerGo_DeleteFileUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteFileUploadRequest)
+func _CandidateTrackerGo_GetJobApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobApplicationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CandidateTrackerGoServer).DeleteFileUpload(ctx, in)
+		return srv.(CandidateTrackerGoServer).GetJobApplications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protos.CandidateTrackerGo/DeleteFileUpload",
+		FullMethod: "/protos.CandidateTrackerGo/GetJobApplications",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CandidateTrackerGoServer).DeleteFileUpload(ctx, req.(*DeleteFileUploadRequest))
+		return srv.(CandidateTrackerGoServer).GetJobApplications(ctx, req.(*GetJobApplicationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CandidateTrackerGo_GetCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCandidatesRequest)
+func _CandidateTrackerGo_UpdateJobApplicationStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJobApplicationStageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CandidateTrackerGoServer).GetCandidates(ctx, in)
+		return srv.(CandidateTrackerGoServer).UpdateJobApplicationStage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protos.CandidateTrackerGo/GetCandidates",
+		FullMethod: "/protos.CandidateTrackerGo/UpdateJobApplicationStage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CandidateTrackerGoServer).GetCandidates(ctx, req.(*GetCandidatesRequest))
+		return srv.(CandidateTrackerGoServer).UpdateJobApplicationStage(ctx, req.(*UpdateJobApplicationStageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CandidateTrackerGo_GetCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCandidateRequest)
+func _CandidateTrackerGo_RankCandidatesForJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RankCandidatesForJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CandidateTrackerGoServer).GetCandidate(ctx, in)
+		return srv.(CandidateTrackerGoServer).RankCandidatesForJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protos.CandidateTrackerGo/GetCandidate",
+		FullMethod: "/protos.CandidateTrackerGo/RankCandidatesForJob",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CandidateTrackerGoServer).GetCandidate(ctx, req.(*GetCandidateRequest))
+		return srv.(CandidateTrackerGoServer).RankCandidatesForJob(ctx, req.(*RankCandidatesForJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CandidateTrackerGo_UpdateCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCandidateRequest)
+func _CandidateTrackerGo_AssessCandidateFit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssessCandidateFitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CandidateTrackerGoServer).UpdateCandidate(ctx, in)
+		return srv.(CandidateTrackerGoServer).AssessCandidateFit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/protos.CandidateTrackerGo/UpdateCandidate",
+		FullMethod: "/protos.CandidateTrackerGo/AssessCandidateFit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CandidateTrackerGoServer).UpdateCandidate(ctx, req.(*UpdateCandidateRequest))
+		return srv.(CandidateTrackerGoServer).AssessCandidateFit(ctx, req.(*AssessCandidateFitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -435,6 +1907,14 @@ var CandidateTrackerGo_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFileUploads",
 			Handler:    _CandidateTrackerGo_GetFileUploads_Handler,
 		},
+		{
+			MethodName: "GetChildFileUploads",
+			Handler:    _CandidateTrackerGo_GetChildFileUploads_Handler,
+		},
+		{
+			MethodName: "GetFileUploadText",
+			Handler:    _CandidateTrackerGo_GetFileUploadText_Handler,
+		},
 		{
 			MethodName: "UploadFiles",
 			Handler:    _CandidateTrackerGo_UploadFiles_Handler,
@@ -447,6 +1927,14 @@ var CandidateTrackerGo_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFileUpload",
 			Handler:    _CandidateTrackerGo_DeleteFileUpload_Handler,
 		},
+		{
+			MethodName: "ReprocessFileUpload",
+			Handler:    _CandidateTrackerGo_ReprocessFileUpload_Handler,
+		},
+		{
+			MethodName: "ReprocessFileUploads",
+			Handler:    _CandidateTrackerGo_ReprocessFileUploads_Handler,
+		},
 		{
 			MethodName: "GetCandidates",
 			Handler:    _CandidateTrackerGo_GetCandidates_Handler,
@@ -455,10 +1943,178 @@ var CandidateTrackerGo_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCandidate",
 			Handler:    _CandidateTrackerGo_GetCandidate_Handler,
 		},
+		{
+			MethodName: "SearchCandidates",
+			Handler:    _CandidateTrackerGo_SearchCandidates_Handler,
+		},
 		{
 			MethodName: "UpdateCandidate",
 			Handler:    _CandidateTrackerGo_UpdateCandidate_Handler,
 		},
+		{
+			MethodName: "GetDuplicateCandidates",
+			Handler:    _CandidateTrackerGo_GetDuplicateCandidates_Handler,
+		},
+		{
+			MethodName: "MergeCandidates",
+			Handler:    _CandidateTrackerGo_MergeCandidates_Handler,
+		},
+		{
+			MethodName: "ArchiveCandidate",
+			Handler:    _CandidateTrackerGo_ArchiveCandidate_Handler,
+		},
+		{
+			MethodName: "UnarchiveCandidate",
+			Handler:    _CandidateTrackerGo_UnarchiveCandidate_Handler,
+		},
+		{
+			MethodName: "DeleteCandidate",
+			Handler:    _CandidateTrackerGo_DeleteCandidate_Handler,
+		},
+		{
+			MethodName: "GetPipelineStages",
+			Handler:    _CandidateTrackerGo_GetPipelineStages_Handler,
+		},
+		{
+			MethodName: "CreatePipelineStage",
+			Handler:    _CandidateTrackerGo_CreatePipelineStage_Handler,
+		},
+		{
+			MethodName: "RenamePipelineStage",
+			Handler:    _CandidateTrackerGo_RenamePipelineStage_Handler,
+		},
+		{
+			MethodName: "ReorderPipelineStages",
+			Handler:    _CandidateTrackerGo_ReorderPipelineStages_Handler,
+		},
+		{
+			MethodName: "DeletePipelineStage",
+			Handler:    _CandidateTrackerGo_DeletePipelineStage_Handler,
+		},
+		{
+			MethodName: "MoveCandidatesToPipelineStage",
+			Handler:    _CandidateTrackerGo_MoveCandidatesToPipelineStage_Handler,
+		},
+		{
+			MethodName: "GetCandidateStageHistory",
+			Handler:    _CandidateTrackerGo_GetCandidateStageHistory_Handler,
+		},
+		{
+			MethodName: "AddCandidateNote",
+			Handler:    _CandidateTrackerGo_AddCandidateNote_Handler,
+		},
+		{
+			MethodName: "GetCandidateNotes",
+			Handler:    _CandidateTrackerGo_GetCandidateNotes_Handler,
+		},
+		{
+			MethodName: "UpdateCandidateNote",
+			Handler:    _CandidateTrackerGo_UpdateCandidateNote_Handler,
+		},
+		{
+			MethodName: "DeleteCandidateNote",
+			Handler:    _CandidateTrackerGo_DeleteCandidateNote_Handler,
+		},
+		{
+			MethodName: "GetTags",
+			Handler:    _CandidateTrackerGo_GetTags_Handler,
+		},
+		{
+			MethodName: "CreateTag",
+			Handler:    _CandidateTrackerGo_CreateTag_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _CandidateTrackerGo_RenameTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _CandidateTrackerGo_DeleteTag_Handler,
+		},
+		{
+			MethodName: "AddTagsToCandidates",
+			Handler:    _CandidateTrackerGo_AddTagsToCandidates_Handler,
+		},
+		{
+			MethodName: "RemoveTagsFromCandidates",
+			Handler:    _CandidateTrackerGo_RemoveTagsFromCandidates_Handler,
+		},
+		{
+			MethodName: "GetScorecardCriteria",
+			Handler:    _CandidateTrackerGo_GetScorecardCriteria_Handler,
+		},
+		{
+			MethodName: "CreateScorecardCriterion",
+			Handler:    _CandidateTrackerGo_CreateScorecardCriterion_Handler,
+		},
+		{
+			MethodName: "DeleteScorecardCriterion",
+			Handler:    _CandidateTrackerGo_DeleteScorecardCriterion_Handler,
+		},
+		{
+			MethodName: "SubmitScorecard",
+			Handler:    _CandidateTrackerGo_SubmitScorecard_Handler,
+		},
+		{
+			MethodName: "GetScorecards",
+			Handler:    _CandidateTrackerGo_GetScorecards_Handler,
+		},
+		{
+			MethodName: "GetCandidatePersonaHistory",
+			Handler:    _CandidateTrackerGo_GetCandidatePersonaHistory_Handler,
+		},
+		{
+			MethodName: "RevertCandidatePersona",
+			Handler:    _CandidateTrackerGo_RevertCandidatePersona_Handler,
+		},
+		{
+			MethodName: "DiffCandidatePersonaRevisions",
+			Handler:    _CandidateTrackerGo_DiffCandidatePersonaRevisions_Handler,
+		},
+		{
+			MethodName: "CreateJobOpening",
+			Handler:    _CandidateTrackerGo_CreateJobOpening_Handler,
+		},
+		{
+			MethodName: "GetJobOpenings",
+			Handler:    _CandidateTrackerGo_GetJobOpenings_Handler,
+		},
+		{
+			MethodName: "GetJobOpening",
+			Handler:    _CandidateTrackerGo_GetJobOpening_Handler,
+		},
+		{
+			MethodName: "UpdateJobOpening",
+			Handler:    _CandidateTrackerGo_UpdateJobOpening_Handler,
+		},
+		{
+			MethodName: "DeleteJobOpening",
+			Handler:    _CandidateTrackerGo_DeleteJobOpening_Handler,
+		},
+		{
+			MethodName: "AddCandidateToJobOpening",
+			Handler:    _CandidateTrackerGo_AddCandidateToJobOpening_Handler,
+		},
+		{
+			MethodName: "RemoveCandidateFromJobOpening",
+			Handler:    _CandidateTrackerGo_RemoveCandidateFromJobOpening_Handler,
+		},
+		{
+			MethodName: "GetJobApplications",
+			Handler:    _CandidateTrackerGo_GetJobApplications_Handler,
+		},
+		{
+			MethodName: "UpdateJobApplicationStage",
+			Handler:    _CandidateTrackerGo_UpdateJobApplicationStage_Handler,
+		},
+		{
+			MethodName: "RankCandidatesForJob",
+			Handler:    _CandidateTrackerGo_RankCandidatesForJob_Handler,
+		},
+		{
+			MethodName: "AssessCandidateFit",
+			Handler:    _CandidateTrackerGo_AssessCandidateFit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/server.proto",